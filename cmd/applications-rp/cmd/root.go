@@ -35,9 +35,13 @@ import (
 	"github.com/radius-project/radius/pkg/components/hosting"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 
+	corerp_dm "github.com/radius-project/radius/pkg/corerp/datamodel"
 	corerp_setup "github.com/radius-project/radius/pkg/corerp/setup"
+	daprrp_ctrl "github.com/radius-project/radius/pkg/daprrp/frontend/controller"
 	daprrp_setup "github.com/radius-project/radius/pkg/daprrp/setup"
+	dsrp_ctrl "github.com/radius-project/radius/pkg/datastoresrp/frontend/controller"
 	dsrp_setup "github.com/radius-project/radius/pkg/datastoresrp/setup"
+	msgrp_ctrl "github.com/radius-project/radius/pkg/messagingrp/frontend/controller"
 	msgrp_setup "github.com/radius-project/radius/pkg/messagingrp/setup"
 )
 
@@ -76,17 +80,23 @@ var rootCmd = &cobra.Command{
 			services = append(services, &traceservice.Service{Options: &options.Config.TracerProvider})
 		}
 
-		builders, err := builders(options)
+		config, err := controllerconfig.New(options)
 		if err != nil {
 			return err
 		}
 
+		builders := builders(config)
+
 		services = append(
 			services,
 			server.NewAPIService(options, builders),
 			server.NewAsyncWorker(options, builders),
 		)
 
+		if options.Config.DriftDetection.Enabled {
+			services = append(services, server.NewDriftDetector(options, config, portableResourceTypes()))
+		}
+
 		host := &hosting.Host{
 			Services: services,
 		}
@@ -107,17 +117,31 @@ func Execute() {
 	cobra.CheckErr(rootCmd.ExecuteContext(context.Background()))
 }
 
-func builders(options hostoptions.HostOptions) ([]builder.Builder, error) {
-	config, err := controllerconfig.New(options)
-	if err != nil {
-		return nil, err
-	}
-
+func builders(config *controllerconfig.RecipeControllerConfig) []builder.Builder {
 	return []builder.Builder{
 		corerp_setup.SetupNamespace(config).GenerateBuilder(),
 		daprrp_setup.SetupNamespace(config).GenerateBuilder(),
 		msgrp_setup.SetupNamespace(config).GenerateBuilder(),
 		dsrp_setup.SetupNamespace(config).GenerateBuilder(),
 		// Add resource provider builders...
-	}, nil
+	}
+}
+
+// portableResourceTypes returns the portable resource types whose recipes are checked for drift.
+//
+// Resources of user-defined resource types are not checked. They are stored by dynamic-rp rather than
+// applications-rp, and their resource types are only known at runtime.
+func portableResourceTypes() []string {
+	return []string{
+		corerp_dm.ExtenderResourceType,
+		daprrp_ctrl.DaprStateStoresResourceType,
+		daprrp_ctrl.DaprSecretStoresResourceType,
+		daprrp_ctrl.DaprPubSubBrokersResourceType,
+		daprrp_ctrl.DaprConfigurationStoresResourceType,
+		msgrp_ctrl.RabbitMQQueuesResourceType,
+		dsrp_ctrl.MongoDatabasesResourceType,
+		dsrp_ctrl.RedisCachesResourceType,
+		dsrp_ctrl.SqlDatabasesResourceType,
+		// Add portable resource types...
+	}
 }
@@ -0,0 +1,36 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewRecipePackCommand creates the `rad recipe-pack` command group.
+func NewRecipePackCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "recipe-pack",
+		Short: "Manage recipe packs",
+		Long: `Manage recipe packs
+		Recipe packs are named, versioned collections of recipes that are registered to environments as a unit.`,
+	}
+}
+
+func init() {
+	RootCmd.AddCommand(recipePackCmd)
+	recipePackCmd.PersistentFlags().StringP("workspace", "w", "", "The workspace name")
+}
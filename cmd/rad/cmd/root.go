@@ -52,12 +52,18 @@ import (
 	install_kubernetes "github.com/radius-project/radius/pkg/cli/cmd/install/kubernetes"
 	"github.com/radius-project/radius/pkg/cli/cmd/radinit"
 	recipe_list "github.com/radius-project/radius/pkg/cli/cmd/recipe/list"
+	recipe_outdated "github.com/radius-project/radius/pkg/cli/cmd/recipe/outdated"
 	recipe_register "github.com/radius-project/radius/pkg/cli/cmd/recipe/register"
 	recipe_show "github.com/radius-project/radius/pkg/cli/cmd/recipe/show"
 	recipe_unregister "github.com/radius-project/radius/pkg/cli/cmd/recipe/unregister"
+	recipe_upgrade "github.com/radius-project/radius/pkg/cli/cmd/recipe/upgrade"
+	recipepack_diff "github.com/radius-project/radius/pkg/cli/cmd/recipepack/diff"
+	recipepack_list "github.com/radius-project/radius/pkg/cli/cmd/recipepack/list"
+	recipepack_publish "github.com/radius-project/radius/pkg/cli/cmd/recipepack/publish"
 	resource_create "github.com/radius-project/radius/pkg/cli/cmd/resource/create"
 	resource_delete "github.com/radius-project/radius/pkg/cli/cmd/resource/delete"
 	resource_list "github.com/radius-project/radius/pkg/cli/cmd/resource/list"
+	resource_logs "github.com/radius-project/radius/pkg/cli/cmd/resource/logs"
 	resource_show "github.com/radius-project/radius/pkg/cli/cmd/resource/show"
 	resourceprovider_create "github.com/radius-project/radius/pkg/cli/cmd/resourceprovider/create"
 	resourceprovider_delete "github.com/radius-project/radius/pkg/cli/cmd/resourceprovider/delete"
@@ -67,6 +73,7 @@ import (
 	resourcetype_delete "github.com/radius-project/radius/pkg/cli/cmd/resourcetype/delete"
 	resourcetype_list "github.com/radius-project/radius/pkg/cli/cmd/resourcetype/list"
 	resourcetype_show "github.com/radius-project/radius/pkg/cli/cmd/resourcetype/show"
+	resourcetype_validate "github.com/radius-project/radius/pkg/cli/cmd/resourcetype/validate"
 	"github.com/radius-project/radius/pkg/cli/cmd/run"
 	"github.com/radius-project/radius/pkg/cli/cmd/uninstall"
 	uninstall_kubernetes "github.com/radius-project/radius/pkg/cli/cmd/uninstall/kubernetes"
@@ -118,6 +125,7 @@ var resourceCmd = NewResourceCommand()
 var resourceProviderCmd = NewResourceProviderCommand()
 var resourceTypeCmd = NewResourceTypeCommand()
 var recipeCmd = NewRecipeCommand()
+var recipePackCmd = NewRecipePackCommand()
 var envCmd = NewEnvironmentCommand()
 var workspaceCmd = NewWorkspaceCommand()
 
@@ -258,6 +266,9 @@ func initSubCommands() {
 	resourceDeleteCmd, _ := resource_delete.NewCommand(framework)
 	resourceCmd.AddCommand(resourceDeleteCmd)
 
+	resourceLogsCmd, _ := resource_logs.NewCommand(framework)
+	resourceCmd.AddCommand(resourceLogsCmd)
+
 	resourceProviderShowCmd, _ := resourceprovider_show.NewCommand(framework)
 	resourceProviderCmd.AddCommand(resourceProviderShowCmd)
 
@@ -282,6 +293,9 @@ func initSubCommands() {
 	resourceTypeCreateCmd, _ := resourcetype_create.NewCommand(framework)
 	resourceTypeCmd.AddCommand(resourceTypeCreateCmd)
 
+	resourceTypeValidateCmd, _ := resourcetype_validate.NewCommand(framework)
+	resourceTypeCmd.AddCommand(resourceTypeValidateCmd)
+
 	listRecipeCmd, _ := recipe_list.NewCommand(framework)
 	recipeCmd.AddCommand(listRecipeCmd)
 
@@ -294,6 +308,21 @@ func initSubCommands() {
 	unregisterRecipeCmd, _ := recipe_unregister.NewCommand(framework)
 	recipeCmd.AddCommand(unregisterRecipeCmd)
 
+	outdatedRecipeCmd, _ := recipe_outdated.NewCommand(framework)
+	recipeCmd.AddCommand(outdatedRecipeCmd)
+
+	upgradeRecipeCmd, _ := recipe_upgrade.NewCommand(framework)
+	recipeCmd.AddCommand(upgradeRecipeCmd)
+
+	publishRecipePackCmd, _ := recipepack_publish.NewCommand(framework)
+	recipePackCmd.AddCommand(publishRecipePackCmd)
+
+	listRecipePackCmd, _ := recipepack_list.NewCommand(framework)
+	recipePackCmd.AddCommand(listRecipePackCmd)
+
+	diffRecipePackCmd, _ := recipepack_diff.NewCommand(framework)
+	recipePackCmd.AddCommand(diffRecipePackCmd)
+
 	providerCmd := credential.NewCommand(framework)
 	RootCmd.AddCommand(providerCmd)
 
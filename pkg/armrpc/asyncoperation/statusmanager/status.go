@@ -43,4 +43,8 @@ type Status struct {
 
 	// LastUpdatedTime represents the async operation last updated time.
 	LastUpdatedTime time.Time `json:"lastUpdatedTime,omitempty"`
+
+	// CancelRequested is true if the user requested the cancellation of the async operation. The worker processing
+	// the operation cancels it when it observes the request.
+	CancelRequested bool `json:"cancelRequested,omitempty"`
 }
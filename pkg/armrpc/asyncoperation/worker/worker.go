@@ -58,6 +58,15 @@ const (
 
 	// defaultDequeueInterval is the default duration for the dequeue interval.
 	defaultDequeueInterval = time.Duration(200) * time.Millisecond
+
+	// defaultCancellationPollInterval is the default interval to check whether the cancellation of an operation was requested.
+	defaultCancellationPollInterval = time.Duration(5) * time.Second
+
+	// defaultMaxCancellationPollInterval is the default maximum interval to check whether the cancellation of an operation was requested.
+	defaultMaxCancellationPollInterval = time.Duration(1) * time.Minute
+
+	// defaultCancellationGracePeriod is the default duration to wait for a canceled operation to stop before it is completed.
+	defaultCancellationGracePeriod = time.Duration(5) * time.Minute
 )
 
 // Options configures AsyncRequestProcessorWorker
@@ -79,6 +88,19 @@ type Options struct {
 
 	// DequeueIntervalDuration is the duration for the dequeue interval.
 	DequeueIntervalDuration time.Duration
+
+	// CancellationPollInterval is the initial interval to check whether the user requested the cancellation of an
+	// operation. The interval doubles after each check, up to MaxCancellationPollInterval, so that long-running
+	// operations don't read their status from the database at a constant rate.
+	CancellationPollInterval time.Duration
+
+	// MaxCancellationPollInterval is the maximum interval to check whether the user requested the cancellation of an
+	// operation.
+	MaxCancellationPollInterval time.Duration
+
+	// CancellationGracePeriod is the duration to wait for the controller of a canceled or timed out operation to stop
+	// before the operation is completed. Controllers use it to stop gracefully, e.g. to let Terraform save its state.
+	CancellationGracePeriod time.Duration
 }
 
 // AsyncRequestProcessWorker is the worker to process async requests.
@@ -115,6 +137,18 @@ func New(
 	if options.DequeueIntervalDuration == time.Duration(0) {
 		options.DequeueIntervalDuration = defaultDequeueInterval
 	}
+	if options.CancellationPollInterval == time.Duration(0) {
+		options.CancellationPollInterval = defaultCancellationPollInterval
+	}
+	if options.MaxCancellationPollInterval == time.Duration(0) {
+		options.MaxCancellationPollInterval = defaultMaxCancellationPollInterval
+	}
+	if options.MaxCancellationPollInterval < options.CancellationPollInterval {
+		options.MaxCancellationPollInterval = options.CancellationPollInterval
+	}
+	if options.CancellationGracePeriod == time.Duration(0) {
+		options.CancellationGracePeriod = defaultCancellationGracePeriod
+	}
 
 	return &AsyncRequestProcessWorker{
 		options:      options,
@@ -275,7 +309,8 @@ func (w *AsyncRequestProcessWorker) runOperation(ctx context.Context, message *q
 		}
 
 		// There are two cases when asyncReqCtx is canceled.
-		// 1. When the operation is timed out, w.completeOperation will be called in L186
+		// 1. When the operation is timed out or canceled by the user, w.completeOperation will be called by the loop below
+		//    once this controller stops or the grace period expires.
 		// 2. When parent context is canceled or done, we need to requeue the operation to reprocess the request.
 		// Such cases should not call w.completeOperation.
 		if !errors.Is(asyncReqCtx.Err(), context.Canceled) {
@@ -285,27 +320,61 @@ func (w *AsyncRequestProcessWorker) runOperation(ctx context.Context, message *q
 	}()
 
 	operationTimeoutAfter := time.After(asyncReq.Timeout())
-	messageExtendAfter := w.getMessageExtendDuration(message.NextVisibleAt)
+	// The timer is not recreated on each iteration of the loop, so checking for the cancellation does not delay the extension.
+	messageExtendTimer := time.NewTimer(w.getMessageExtendDuration(message.NextVisibleAt))
+	defer messageExtendTimer.Stop()
+	cancellationPollInterval := w.options.CancellationPollInterval
+	cancellationPoll := time.NewTimer(cancellationPollInterval)
+	defer cancellationPoll.Stop()
+
+	// canceled is the result of the operation once it is canceled by the user or timed out. The controller is given
+	// a grace period to stop before the operation is completed with this result.
+	var canceled *ctrl.Result
+	var gracePeriodAfter <-chan time.Time
+	cancel := func(result ctrl.Result) {
+		opCancel()
+		canceled = &result
+		gracePeriodAfter = time.After(w.options.CancellationGracePeriod)
+		operationTimeoutAfter = nil
+		cancellationPoll.Stop()
+	}
 
 	for {
 		select {
-		case <-time.After(messageExtendAfter):
+		case <-messageExtendTimer.C:
 			if err := w.requestQueue.ExtendMessage(ctx, message); err != nil {
 				logger.Error(err, "fails to extend message lock")
 			} else {
 				logger.Info("Extended message lock duration.", "nextVisibleTime", message.NextVisibleAt.UTC().String())
 				metrics.DefaultAsyncOperationMetrics.RecordExtendedAsyncOperation(ctx, asyncReq)
 			}
-			messageExtendAfter = w.getMessageExtendDuration(message.NextVisibleAt)
+			messageExtendTimer.Reset(w.getMessageExtendDuration(message.NextVisibleAt))
 
 		case <-operationTimeoutAfter:
 			logger.Info("Cancelling async operation.")
 
-			opCancel()
 			errMessage := fmt.Sprintf("Operation (%s) has timed out because it was processing longer than %d s.", asyncReq.OperationType, int(asyncReq.Timeout().Seconds()))
 			result := ctrl.NewCanceledResult(errMessage)
 			result.Error.Target = asyncReq.ResourceID
-			w.completeOperation(ctx, message, result, asyncCtrl.DatabaseClient())
+			cancel(result)
+
+		case <-cancellationPoll.C:
+			if !w.isCancelRequested(ctx, asyncReq) {
+				cancellationPollInterval = min(2*cancellationPollInterval, w.options.MaxCancellationPollInterval)
+				cancellationPoll.Reset(cancellationPollInterval)
+				continue
+			}
+
+			logger.Info("Cancelling async operation as requested by the user.")
+
+			errMessage := fmt.Sprintf("Operation (%s) was canceled by the user.", asyncReq.OperationType)
+			result := ctrl.NewCanceledResult(errMessage)
+			result.Error.Target = asyncReq.ResourceID
+			cancel(result)
+
+		case <-gracePeriodAfter:
+			logger.Info("Canceled async operation did not stop within the grace period.", "gracePeriod", w.options.CancellationGracePeriod.String())
+			w.completeOperation(ctx, message, *canceled, asyncCtrl.DatabaseClient())
 			return
 
 		case <-ctx.Done():
@@ -313,6 +382,12 @@ func (w *AsyncRequestProcessWorker) runOperation(ctx context.Context, message *q
 			return
 
 		case <-opDone:
+			// The controller stopped after the operation was canceled, so the state of the resource is consistent.
+			if canceled != nil {
+				w.completeOperation(ctx, message, *canceled, asyncCtrl.DatabaseClient())
+				return
+			}
+
 			// FIXME: Would this give me all the operations? No matter if it is successful or cancelled or failed?
 			metrics.DefaultAsyncOperationMetrics.RecordAsyncOperationDuration(ctx, asyncReq, opStartAt)
 
@@ -323,6 +398,26 @@ func (w *AsyncRequestProcessWorker) runOperation(ctx context.Context, message *q
 	}
 }
 
+// isCancelRequested returns true if the user requested the cancellation of the operation.
+func (w *AsyncRequestProcessWorker) isCancelRequested(ctx context.Context, req *ctrl.Request) bool {
+	if w.sm == nil {
+		return false
+	}
+
+	rID, err := resources.Parse(req.ResourceID)
+	if err != nil {
+		return false
+	}
+
+	status, err := w.sm.Get(ctx, rID, req.OperationID)
+	if err != nil {
+		ucplog.FromContextOrDiscard(ctx).Error(err, "failed to get operation status to check for cancellation")
+		return false
+	}
+
+	return status.CancelRequested
+}
+
 func extractError(err error) v1.ErrorDetails {
 	if clientErr, ok := err.(*v1.ErrClientRP); ok {
 		return v1.ErrorDetails{Code: clientErr.Code, Message: clientErr.Message}
@@ -358,18 +453,22 @@ func (w *AsyncRequestProcessWorker) completeOperation(ctx context.Context, messa
 func (w *AsyncRequestProcessWorker) updateResourceAndOperationStatus(ctx context.Context, sc database.Client, req *ctrl.Request, state v1.ProvisioningState, opErr *v1.ErrorDetails) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
-	rID, err := resources.ParseResource(req.ResourceID)
+	rID, err := resources.Parse(req.ResourceID)
 	if err != nil {
 		logger.Error(err, "failed to parse resource ID")
 		return err
 	}
 
-	err = updateResourceState(ctx, sc, rID.String(), state)
-	if errors.Is(err, &database.ErrNotFound{}) {
-		logger.Info("failed to update the provisioningState in resource because it no longer exists.")
-	} else if err != nil {
-		logger.Error(err, "failed to update the provisioningState in resource.")
-		return err
+	// Operations on custom actions are queued with the ID of the action (e.g. .../resourceName/actionName) rather
+	// than the ID of a resource. Actions do not change the provisioningState of the resource they are invoked on.
+	if rID.IsResource() {
+		err = updateResourceState(ctx, sc, rID.String(), state)
+		if errors.Is(err, &database.ErrNotFound{}) {
+			logger.Info("failed to update the provisioningState in resource because it no longer exists.")
+		} else if err != nil {
+			logger.Error(err, "failed to update the provisioningState in resource.")
+			return err
+		}
 	}
 
 	// Otherwise we update the operationStatus to the result.
@@ -384,7 +483,7 @@ func (w *AsyncRequestProcessWorker) updateResourceAndOperationStatus(ctx context
 }
 
 func (w *AsyncRequestProcessWorker) isDuplicated(ctx context.Context, resourceID string, operationID uuid.UUID) (bool, error) {
-	rID, err := resources.ParseResource(resourceID)
+	rID, err := resources.Parse(resourceID)
 	if err != nil {
 		return false, err
 	}
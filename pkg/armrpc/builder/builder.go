@@ -40,8 +40,8 @@ type Builder struct {
 	registrations []*OperationRegistration
 }
 
-// defaultHandlerOptions returns HandlerOption for the default operations such as getting operationStatuses and
-// operationResults.
+// defaultHandlerOptions returns HandlerOption for the default operations such as getting and canceling operationStatuses,
+// and getting operationResults.
 func defaultHandlerOptions(
 	rootRouter chi.Router,
 	rootScopePath string,
@@ -73,6 +73,14 @@ func defaultHandlerOptions(
 		ControllerFactory: defaultoperation.NewGetOperationStatus,
 	})
 
+	handlers = append(handlers, server.HandlerOptions{
+		ParentRouter:      rootRouter,
+		Path:              fmt.Sprintf("%s/providers/%s/locations/{location}/operationstatuses/{operationId}/cancel", rootScopePath, namespace),
+		ResourceType:      statusType,
+		Method:            customActionPrefix + "CANCEL",
+		ControllerFactory: defaultoperation.NewCancelOperation,
+	})
+
 	handlers = append(handlers, server.HandlerOptions{
 		ParentRouter:      rootRouter,
 		Path:              fmt.Sprintf("%s/providers/%s/locations/{location}/operationresults/{operationId}", rootScopePath, namespace),
@@ -199,8 +199,8 @@ func withOtelLabelsForRequest(req *http.Request) {
 	}
 }
 
-// ConfigureDefaultHandlers registers handlers for the default operations such as getting operationStatuses and
-// operationResults, and updating a subscription lifecycle. It returns an error if any of the handler registrations fail.
+// ConfigureDefaultHandlers registers handlers for the default operations such as getting and canceling operationStatuses,
+// getting operationResults, and updating a subscription lifecycle. It returns an error if any of the handler registrations fail.
 func ConfigureDefaultHandlers(
 	ctx context.Context,
 	rootRouter chi.Router,
@@ -251,6 +251,17 @@ func ConfigureDefaultHandlers(
 		return err
 	}
 
+	err = RegisterHandler(ctx, HandlerOptions{
+		ParentRouter:      rootRouter,
+		Path:              opStatus + "/cancel",
+		ResourceType:      statusRT,
+		Method:            "ACTIONCANCEL",
+		ControllerFactory: defaultoperation.NewCancelOperation,
+	}, ctrlOpts)
+	if err != nil {
+		return err
+	}
+
 	opResult := fmt.Sprintf("%s/providers/%s/locations/{location}/operationresults/{operationId}", rootScopePath, providerNamespace)
 	err = RegisterHandler(ctx, HandlerOptions{
 		ParentRouter:      rootRouter,
@@ -46,6 +46,7 @@ type ProviderConfig struct {
 	Logging          ucplog.LoggingOptions                `yaml:"logging"`
 	Bicep            BicepOptions                         `yaml:"bicep,omitempty"`
 	Terraform        TerraformOptions                     `yaml:"terraform,omitempty"`
+	DriftDetection   DriftDetectionOptions                `yaml:"driftDetection,omitempty"`
 
 	// FeatureFlags includes the list of feature flags.
 	FeatureFlags []string `yaml:"featureFlags"`
@@ -84,6 +85,12 @@ type WorkerServerOptions struct {
 	MaxOperationConcurrency *int `yaml:"maxOperationConcurrency,omitempty"`
 	// MaxOperationRetryCount is the maximum retry count to process async request operation.
 	MaxOperationRetryCount *int `yaml:"maxOperationRetryCount,omitempty"`
+	// CancellationPollIntervalSeconds is the initial interval in seconds to check whether the user requested the
+	// cancellation of an async operation. The interval doubles after each check, up to MaxCancellationPollIntervalSeconds.
+	CancellationPollIntervalSeconds *int `yaml:"cancellationPollIntervalSeconds,omitempty"`
+	// MaxCancellationPollIntervalSeconds is the maximum interval in seconds to check whether the user requested the
+	// cancellation of an async operation.
+	MaxCancellationPollIntervalSeconds *int `yaml:"maxCancellationPollIntervalSeconds,omitempty"`
 }
 
 // BicepOptions includes options required for bicep execution.
@@ -98,4 +105,46 @@ type BicepOptions struct {
 type TerraformOptions struct {
 	// Path is the path to the directory mounted to the container where terraform can be installed and executed.
 	Path string `yaml:"path,omitempty"`
+
+	// BinaryPath is the path to a pre-installed Terraform binary. When set, Terraform is never downloaded, which
+	// is required for air-gapped clusters.
+	BinaryPath string `yaml:"binaryPath,omitempty"`
+
+	// TofuBinaryPath is the path to a pre-installed OpenTofu binary, used by the environments that select the
+	// OpenTofu distribution. When set, OpenTofu is never downloaded.
+	TofuBinaryPath string `yaml:"tofuBinaryPath,omitempty"`
+
+	// TofuSigningKeyPath is the path to the armored public key that signs the OpenTofu releases. The checksums of
+	// downloaded OpenTofu releases are verified against it. OpenTofu is only downloaded when it is set.
+	TofuSigningKeyPath string `yaml:"tofuSigningKeyPath,omitempty"`
+
+	// MirrorDir is the path to a directory of pre-installed Terraform and OpenTofu binaries organized by version, e.g.
+	// <mirrorDir>/1.7.5/terraform or <mirrorDir>/1.8.0/tofu. When set, Terraform is never downloaded.
+	MirrorDir string `yaml:"mirrorDir,omitempty"`
+
+	// Cache configures the cache of Terraform provider plugins and modules shared by recipe executions.
+	Cache TerraformCacheOptions `yaml:"cache,omitempty"`
+}
+
+// TerraformCacheOptions includes options for the cache of Terraform provider plugins and modules.
+type TerraformCacheOptions struct {
+	// Disabled disables the cache, so providers and modules are downloaded for every recipe execution.
+	Disabled bool `yaml:"disabled,omitempty"`
+
+	// MaxSizeMB is the size limit of the cache in megabytes. The default limit is used if it is not set.
+	MaxSizeMB int64 `yaml:"maxSizeMB,omitempty"`
+}
+
+// DriftDetectionOptions includes options for detecting drift of the cloud resources deployed by recipes. Only the
+// portable resource types implemented by applications-rp are checked; resources of user-defined resource types are not.
+type DriftDetectionOptions struct {
+	// Enabled enables the periodic drift detection of the resources deployed by recipes.
+	Enabled bool `yaml:"enabled,omitempty"`
+
+	// Interval is the interval between drift checks, e.g. 30m. The default interval is used if it is not set.
+	Interval string `yaml:"interval,omitempty"`
+
+	// RootScope is the scope of the portable resources that are checked for drift, e.g. /planes/radius/local.
+	// The default scope is used if it is not set.
+	RootScope string `yaml:"rootScope,omitempty"`
 }
@@ -197,12 +197,30 @@ type ApplicationsManagementClient interface {
 	// GetRecipeMetadata shows recipe details including list of all parameters for a given recipe registered to an environment.
 	GetRecipeMetadata(ctx context.Context, environmentNameOrID string, recipe corerp.RecipeGetMetadata) (corerp.RecipeGetMetadataResponse, error)
 
+	// PlanRecipe previews the changes the recipe of a portable resource would make if it was deployed to an environment.
+	PlanRecipe(ctx context.Context, environmentNameOrID string, request corerp.RecipePlanRequest) (corerp.RecipePlanResponse, error)
+
+	// GetRecipeLogs gets the logs of the most recent recipe executions of a portable resource in an environment.
+	GetRecipeLogs(ctx context.Context, environmentNameOrID string, request corerp.RecipeLogsRequest) (corerp.RecipeLogsResponse, error)
+
 	// CreateOrUpdateEnvironment creates an environment by its name (or id).
 	CreateOrUpdateEnvironment(ctx context.Context, environmentNameOrID string, resource *corerp.EnvironmentResource) error
 
 	// DeleteEnvironment deletes an environment and all of its resources by its name (in the configured scope) or resource ID.
 	DeleteEnvironment(ctx context.Context, environmentNameOrID string) (bool, error)
 
+	// ListRecipePacks lists all recipe packs in the configured scope.
+	ListRecipePacks(ctx context.Context) ([]corerp.RecipePackResource, error)
+
+	// GetRecipePack retrieves a recipe pack by its name (in the configured scope) or resource ID.
+	GetRecipePack(ctx context.Context, recipePackNameOrID string) (corerp.RecipePackResource, error)
+
+	// CreateOrUpdateRecipePack creates or updates a recipe pack by its name (in the configured scope) or resource ID.
+	CreateOrUpdateRecipePack(ctx context.Context, recipePackNameOrID string, resource *corerp.RecipePackResource) error
+
+	// DeleteRecipePack deletes a recipe pack by its name (in the configured scope) or resource ID.
+	DeleteRecipePack(ctx context.Context, recipePackNameOrID string) (bool, error)
+
 	// ListResourceGroups lists all resource groups in the configured scope.
 	ListResourceGroups(ctx context.Context, planeName string) ([]ucp_v20231001preview.ResourceGroupResource, error)
 
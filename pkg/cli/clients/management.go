@@ -42,6 +42,7 @@ type UCPApplicationsManagementClient struct {
 	genericResourceClientFactory     func(scope string, resourceType string) (genericResourceClient, error)
 	applicationResourceClientFactory func(scope string) (applicationResourceClient, error)
 	environmentResourceClientFactory func(scope string) (environmentResourceClient, error)
+	recipePackResourceClientFactory  func(scope string) (recipePackResourceClient, error)
 	resourceGroupClientFactory       func() (resourceGroupClient, error)
 	resourceProviderClientFactory    func() (resourceProviderClient, error)
 	resourceTypeClientFactory        func() (resourceTypeClient, error)
@@ -484,6 +485,44 @@ func (amc *UCPApplicationsManagementClient) GetRecipeMetadata(ctx context.Contex
 	return resp.RecipeGetMetadataResponse, nil
 }
 
+// PlanRecipe previews the changes the recipe of a portable resource would make if it was deployed to an environment.
+func (amc *UCPApplicationsManagementClient) PlanRecipe(ctx context.Context, environmentNameOrID string, request corerpv20231001.RecipePlanRequest) (corerpv20231001.RecipePlanResponse, error) {
+	scope, name, err := amc.extractScopeAndName(environmentNameOrID)
+	if err != nil {
+		return corerpv20231001.RecipePlanResponse{}, err
+	}
+	client, err := amc.createEnvironmentClient(scope)
+	if err != nil {
+		return corerpv20231001.RecipePlanResponse{}, err
+	}
+
+	resp, err := client.PlanRecipe(ctx, name, request, &corerpv20231001.EnvironmentsClientPlanRecipeOptions{})
+	if err != nil {
+		return corerpv20231001.RecipePlanResponse{}, err
+	}
+
+	return resp.RecipePlanResponse, nil
+}
+
+// GetRecipeLogs gets the logs of the most recent recipe executions of a portable resource in an environment.
+func (amc *UCPApplicationsManagementClient) GetRecipeLogs(ctx context.Context, environmentNameOrID string, request corerpv20231001.RecipeLogsRequest) (corerpv20231001.RecipeLogsResponse, error) {
+	scope, name, err := amc.extractScopeAndName(environmentNameOrID)
+	if err != nil {
+		return corerpv20231001.RecipeLogsResponse{}, err
+	}
+	client, err := amc.createEnvironmentClient(scope)
+	if err != nil {
+		return corerpv20231001.RecipeLogsResponse{}, err
+	}
+
+	resp, err := client.GetRecipeLogs(ctx, name, request, &corerpv20231001.EnvironmentsClientGetRecipeLogsOptions{})
+	if err != nil {
+		return corerpv20231001.RecipeLogsResponse{}, err
+	}
+
+	return resp.RecipeLogsResponse, nil
+}
+
 // CreateOrUpdateEnvironment creates an environment by its name (or id).
 func (amc *UCPApplicationsManagementClient) CreateOrUpdateEnvironment(ctx context.Context, environmentNameOrID string, resource *corerpv20231001.EnvironmentResource) error {
 	scope, name, err := amc.extractScopeAndName(environmentNameOrID)
@@ -547,6 +586,96 @@ func (amc *UCPApplicationsManagementClient) DeleteEnvironment(ctx context.Contex
 	return response.StatusCode != 204, nil
 }
 
+// ListRecipePacks lists all recipe packs in the configured scope.
+func (amc *UCPApplicationsManagementClient) ListRecipePacks(ctx context.Context) ([]corerpv20231001.RecipePackResource, error) {
+	client, err := amc.createRecipePackClient(amc.RootScope)
+	if err != nil {
+		return []corerpv20231001.RecipePackResource{}, err
+	}
+
+	recipePacks := []corerpv20231001.RecipePackResource{}
+	pager := client.NewListByScopePager(&corerpv20231001.RecipePacksClientListByScopeOptions{})
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return []corerpv20231001.RecipePackResource{}, err
+		}
+
+		for _, recipePack := range page.RecipePackResourceListResult.Value {
+			recipePacks = append(recipePacks, *recipePack)
+		}
+	}
+
+	return recipePacks, nil
+}
+
+// GetRecipePack retrieves a recipe pack by its name (in the configured scope) or resource ID.
+func (amc *UCPApplicationsManagementClient) GetRecipePack(ctx context.Context, recipePackNameOrID string) (corerpv20231001.RecipePackResource, error) {
+	scope, name, err := amc.extractScopeAndName(recipePackNameOrID)
+	if err != nil {
+		return corerpv20231001.RecipePackResource{}, err
+	}
+
+	client, err := amc.createRecipePackClient(scope)
+	if err != nil {
+		return corerpv20231001.RecipePackResource{}, err
+	}
+
+	response, err := client.Get(ctx, name, &corerpv20231001.RecipePacksClientGetOptions{})
+	if err != nil {
+		return corerpv20231001.RecipePackResource{}, err
+	}
+
+	return response.RecipePackResource, nil
+}
+
+// CreateOrUpdateRecipePack creates or updates a recipe pack by its name (in the configured scope) or resource ID.
+func (amc *UCPApplicationsManagementClient) CreateOrUpdateRecipePack(ctx context.Context, recipePackNameOrID string, resource *corerpv20231001.RecipePackResource) error {
+	scope, name, err := amc.extractScopeAndName(recipePackNameOrID)
+	if err != nil {
+		return err
+	}
+
+	client, err := amc.createRecipePackClient(scope)
+	if err != nil {
+		return err
+	}
+
+	// The server can return invalid system data, which fails to roundtrip when the client does a "GET -> modify -> PUT".
+	resource.SystemData = nil
+
+	_, err = client.CreateOrUpdate(ctx, name, *resource, &corerpv20231001.RecipePacksClientCreateOrUpdateOptions{})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteRecipePack deletes a recipe pack by its name (in the configured scope) or resource ID.
+func (amc *UCPApplicationsManagementClient) DeleteRecipePack(ctx context.Context, recipePackNameOrID string) (bool, error) {
+	scope, name, err := amc.extractScopeAndName(recipePackNameOrID)
+	if err != nil {
+		return false, err
+	}
+
+	client, err := amc.createRecipePackClient(scope)
+	if err != nil {
+		return false, err
+	}
+
+	// Capture the raw HTTP response so we can check the status code.
+	var response *http.Response
+	ctx = amc.captureResponse(ctx, &response)
+
+	_, err = client.Delete(ctx, name, nil)
+	if err != nil {
+		return false, err
+	}
+
+	return response.StatusCode != 204, nil
+}
+
 // ListResourceGroups lists all resource groups in the configured scope.
 func (amc *UCPApplicationsManagementClient) ListResourceGroups(ctx context.Context, planeName string) ([]ucpv20231001.ResourceGroupResource, error) {
 	client, err := amc.createResourceGroupClient()
@@ -923,6 +1052,15 @@ func (amc *UCPApplicationsManagementClient) createEnvironmentClient(scope string
 	return amc.environmentResourceClientFactory(scope)
 }
 
+func (amc *UCPApplicationsManagementClient) createRecipePackClient(scope string) (recipePackResourceClient, error) {
+	if amc.recipePackResourceClientFactory == nil {
+		// Generated client doesn't like the leading '/' in the scope.
+		return corerpv20231001.NewRecipePacksClient(strings.TrimPrefix(scope, resources.SegmentSeparator), &aztoken.AnonymousCredential{}, amc.ClientOptions)
+	}
+
+	return amc.recipePackResourceClientFactory(scope)
+}
+
 func (amc *UCPApplicationsManagementClient) createGenericClient(scope string, resourceType string) (genericResourceClient, error) {
 	if amc.genericResourceClientFactory == nil {
 		// Generated client doesn't like the leading '/' in the scope.
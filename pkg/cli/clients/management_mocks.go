@@ -34,7 +34,7 @@ import (
 // Because these interfaces are non-exported, they MUST be defined in their own file
 // and we MUST use -source on mockgen to generate mocks for them.
 
-//go:generate mockgen -typed -source=./management_mocks.go -destination=./mock_management_wrapped_clients.go -package=clients -self_package github.com/radius-project/radius/pkg/cli/clients github.com/radius-project/radius/pkg/cli/clients genericResourceClient,applicationResourceClient,environmentResourceClient,resourceGroupClient,resourceProviderClient,resourceTypeClient,apiVersonClient,locationClient
+//go:generate mockgen -typed -source=./management_mocks.go -destination=./mock_management_wrapped_clients.go -package=clients -self_package github.com/radius-project/radius/pkg/cli/clients github.com/radius-project/radius/pkg/cli/clients genericResourceClient,applicationResourceClient,environmentResourceClient,recipePackResourceClient,resourceGroupClient,resourceProviderClient,resourceTypeClient,apiVersonClient,locationClient
 
 // genericResourceClient is an interface for mocking the generated SDK client for any resource.
 type genericResourceClient interface {
@@ -62,6 +62,16 @@ type environmentResourceClient interface {
 	NewListByScopePager(options *corerpv20231001.EnvironmentsClientListByScopeOptions) *runtime.Pager[corerpv20231001.EnvironmentsClientListByScopeResponse]
 
 	GetMetadata(ctx context.Context, environmentName string, body corerpv20231001.RecipeGetMetadata, options *corerpv20231001.EnvironmentsClientGetMetadataOptions) (corerpv20231001.EnvironmentsClientGetMetadataResponse, error)
+	PlanRecipe(ctx context.Context, environmentName string, body corerpv20231001.RecipePlanRequest, options *corerpv20231001.EnvironmentsClientPlanRecipeOptions) (corerpv20231001.EnvironmentsClientPlanRecipeResponse, error)
+	GetRecipeLogs(ctx context.Context, environmentName string, body corerpv20231001.RecipeLogsRequest, options *corerpv20231001.EnvironmentsClientGetRecipeLogsOptions) (corerpv20231001.EnvironmentsClientGetRecipeLogsResponse, error)
+}
+
+// recipePackResourceClient is an interface for mocking the generated SDK client for recipe pack resources.
+type recipePackResourceClient interface {
+	CreateOrUpdate(ctx context.Context, recipePackName string, resource corerpv20231001.RecipePackResource, options *corerpv20231001.RecipePacksClientCreateOrUpdateOptions) (corerpv20231001.RecipePacksClientCreateOrUpdateResponse, error)
+	Delete(ctx context.Context, recipePackName string, options *corerpv20231001.RecipePacksClientDeleteOptions) (corerpv20231001.RecipePacksClientDeleteResponse, error)
+	Get(ctx context.Context, recipePackName string, options *corerpv20231001.RecipePacksClientGetOptions) (corerpv20231001.RecipePacksClientGetResponse, error)
+	NewListByScopePager(options *corerpv20231001.RecipePacksClientListByScopeOptions) *runtime.Pager[corerpv20231001.RecipePacksClientListByScopeResponse]
 }
 
 // resourceGroupClient is an interface for mocking the generated SDK client for resource groups.
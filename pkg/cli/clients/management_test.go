@@ -711,6 +711,35 @@ func Test_Environment(t *testing.T) {
 		require.Equal(t, expectedResult, result)
 	})
 
+	t.Run("GetRecipeLogs", func(t *testing.T) {
+		mock := NewMockenvironmentResourceClient(gomock.NewController(t))
+		client := createClient(mock)
+
+		request := corerp.RecipeLogsRequest{
+			ResourceID: to.Ptr("/planes/radius/local/resourceGroups/test-group/providers/Applications.Datastores/redisCaches/redis"),
+		}
+
+		expectedResult := corerp.RecipeLogsResponse{
+			Operations: []*corerp.RecipeOperationLog{
+				{
+					OperationID: to.Ptr("operation-id"),
+					Status:      to.Ptr("Succeeded"),
+					Entries: []*corerp.RecipeLogEntry{
+						{Source: to.Ptr("engine"), Message: to.Ptr("Executing recipe \"default\"")},
+					},
+				},
+			},
+		}
+
+		mock.EXPECT().
+			GetRecipeLogs(gomock.Any(), testResourceName, request, gomock.Any()).
+			Return(corerp.EnvironmentsClientGetRecipeLogsResponse{RecipeLogsResponse: expectedResult}, nil)
+
+		result, err := client.GetRecipeLogs(context.Background(), testResourceID, request)
+		require.NoError(t, err)
+		require.Equal(t, expectedResult, result)
+	})
+
 	t.Run("CreateOrUpdateEnviroment", func(t *testing.T) {
 		mock := NewMockenvironmentResourceClient(gomock.NewController(t))
 		client := createClient(mock)
@@ -816,6 +845,125 @@ func Test_Environment(t *testing.T) {
 	})
 }
 
+func Test_RecipePack(t *testing.T) {
+	createClient := func(wrapped recipePackResourceClient) *UCPApplicationsManagementClient {
+		return &UCPApplicationsManagementClient{
+			RootScope: testScope,
+			recipePackResourceClientFactory: func(scope string) (recipePackResourceClient, error) {
+				return wrapped, nil
+			},
+			capture: testCapture,
+		}
+	}
+
+	testResourceType := "Applications.Core/recipePacks"
+	testResourceName := "test-recipe-pack"
+	testResourceID := testScope + "/providers/" + testResourceType + "/" + testResourceName
+
+	expectedResource := corerp.RecipePackResource{
+		ID:       &testResourceID,
+		Name:     &testResourceName,
+		Type:     &testResourceType,
+		Location: to.Ptr(v1.LocationGlobal),
+		Properties: &corerp.RecipePackProperties{
+			Version: to.Ptr("1.0.0"),
+			Recipes: map[string]map[string]corerp.RecipePropertiesClassification{
+				"Applications.Datastores/redisCaches": {
+					"default": &corerp.BicepRecipeProperties{
+						TemplateKind: to.Ptr("bicep"),
+						TemplatePath: to.Ptr("ghcr.io/radius-project/recipes/redis:1.0"),
+					},
+				},
+			},
+		},
+	}
+
+	listPages := []corerp.RecipePacksClientListByScopeResponse{
+		{
+			RecipePackResourceListResult: corerp.RecipePackResourceListResult{
+				Value: []*corerp.RecipePackResource{
+					{
+						ID:       to.Ptr(testScope + "/providers/" + testResourceType + "/" + "test1"),
+						Name:     to.Ptr("test1"),
+						Type:     &testResourceType,
+						Location: to.Ptr(v1.LocationGlobal),
+					},
+				},
+				NextLink: to.Ptr("0"),
+			},
+		},
+		{
+			RecipePackResourceListResult: corerp.RecipePackResourceListResult{
+				Value: []*corerp.RecipePackResource{
+					{
+						ID:       to.Ptr(testScope + "/providers/" + testResourceType + "/" + "test2"),
+						Name:     to.Ptr("test2"),
+						Type:     &testResourceType,
+						Location: to.Ptr(v1.LocationGlobal),
+					},
+				},
+				NextLink: to.Ptr("1"),
+			},
+		},
+	}
+
+	t.Run("ListRecipePacks", func(t *testing.T) {
+		mock := NewMockrecipePackResourceClient(gomock.NewController(t))
+		client := createClient(mock)
+
+		mock.EXPECT().
+			NewListByScopePager(gomock.Any()).
+			Return(pager(listPages))
+
+		expectedResourceList := []corerp.RecipePackResource{*listPages[0].Value[0], *listPages[1].Value[0]}
+
+		resources, err := client.ListRecipePacks(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, expectedResourceList, resources)
+	})
+
+	t.Run("GetRecipePack", func(t *testing.T) {
+		mock := NewMockrecipePackResourceClient(gomock.NewController(t))
+		client := createClient(mock)
+
+		mock.EXPECT().
+			Get(gomock.Any(), testResourceName, gomock.Any()).
+			Return(corerp.RecipePacksClientGetResponse{RecipePackResource: expectedResource}, nil)
+
+		recipePack, err := client.GetRecipePack(context.Background(), testResourceID)
+		require.NoError(t, err)
+		require.Equal(t, expectedResource, recipePack)
+	})
+
+	t.Run("CreateOrUpdateRecipePack", func(t *testing.T) {
+		mock := NewMockrecipePackResourceClient(gomock.NewController(t))
+		client := createClient(mock)
+
+		mock.EXPECT().
+			CreateOrUpdate(gomock.Any(), testResourceName, expectedResource, gomock.Any()).
+			Return(corerp.RecipePacksClientCreateOrUpdateResponse{RecipePackResource: expectedResource}, nil)
+
+		err := client.CreateOrUpdateRecipePack(context.Background(), testResourceName, &expectedResource)
+		require.NoError(t, err)
+	})
+
+	t.Run("DeleteRecipePack", func(t *testing.T) {
+		mock := NewMockrecipePackResourceClient(gomock.NewController(t))
+		client := createClient(mock)
+
+		mock.EXPECT().
+			Delete(gomock.Any(), testResourceName, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, s string, options *corerp.RecipePacksClientDeleteOptions) (corerp.RecipePacksClientDeleteResponse, error) {
+				setCapture(ctx, &http.Response{StatusCode: 204})
+				return corerp.RecipePacksClientDeleteResponse{}, nil
+			})
+
+		deleted, err := client.DeleteRecipePack(context.Background(), testResourceID)
+		require.NoError(t, err)
+		require.False(t, deleted)
+	})
+}
+
 func Test_ResourceGroup(t *testing.T) {
 	createClient := func(wrapped resourceGroupClient) *UCPApplicationsManagementClient {
 		return &UCPApplicationsManagementClient{
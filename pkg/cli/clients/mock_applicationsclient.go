@@ -234,6 +234,44 @@ func (c *MockApplicationsManagementClientCreateOrUpdateLocationCall) DoAndReturn
 	return c
 }
 
+// CreateOrUpdateRecipePack mocks base method.
+func (m *MockApplicationsManagementClient) CreateOrUpdateRecipePack(arg0 context.Context, arg1 string, arg2 *v20231001preview.RecipePackResource) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdateRecipePack", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOrUpdateRecipePack indicates an expected call of CreateOrUpdateRecipePack.
+func (mr *MockApplicationsManagementClientMockRecorder) CreateOrUpdateRecipePack(arg0, arg1, arg2 any) *MockApplicationsManagementClientCreateOrUpdateRecipePackCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdateRecipePack", reflect.TypeOf((*MockApplicationsManagementClient)(nil).CreateOrUpdateRecipePack), arg0, arg1, arg2)
+	return &MockApplicationsManagementClientCreateOrUpdateRecipePackCall{Call: call}
+}
+
+// MockApplicationsManagementClientCreateOrUpdateRecipePackCall wrap *gomock.Call
+type MockApplicationsManagementClientCreateOrUpdateRecipePackCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationsManagementClientCreateOrUpdateRecipePackCall) Return(arg0 error) *MockApplicationsManagementClientCreateOrUpdateRecipePackCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationsManagementClientCreateOrUpdateRecipePackCall) Do(f func(context.Context, string, *v20231001preview.RecipePackResource) error) *MockApplicationsManagementClientCreateOrUpdateRecipePackCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationsManagementClientCreateOrUpdateRecipePackCall) DoAndReturn(f func(context.Context, string, *v20231001preview.RecipePackResource) error) *MockApplicationsManagementClientCreateOrUpdateRecipePackCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CreateOrUpdateResource mocks base method.
 func (m *MockApplicationsManagementClient) CreateOrUpdateResource(arg0 context.Context, arg1, arg2 string, arg3 *generated.GenericResource) (generated.GenericResource, error) {
 	m.ctrl.T.Helper()
@@ -467,6 +505,45 @@ func (c *MockApplicationsManagementClientDeleteEnvironmentCall) DoAndReturn(f fu
 	return c
 }
 
+// DeleteRecipePack mocks base method.
+func (m *MockApplicationsManagementClient) DeleteRecipePack(arg0 context.Context, arg1 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRecipePack", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRecipePack indicates an expected call of DeleteRecipePack.
+func (mr *MockApplicationsManagementClientMockRecorder) DeleteRecipePack(arg0, arg1 any) *MockApplicationsManagementClientDeleteRecipePackCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRecipePack", reflect.TypeOf((*MockApplicationsManagementClient)(nil).DeleteRecipePack), arg0, arg1)
+	return &MockApplicationsManagementClientDeleteRecipePackCall{Call: call}
+}
+
+// MockApplicationsManagementClientDeleteRecipePackCall wrap *gomock.Call
+type MockApplicationsManagementClientDeleteRecipePackCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationsManagementClientDeleteRecipePackCall) Return(arg0 bool, arg1 error) *MockApplicationsManagementClientDeleteRecipePackCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationsManagementClientDeleteRecipePackCall) Do(f func(context.Context, string) (bool, error)) *MockApplicationsManagementClientDeleteRecipePackCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationsManagementClientDeleteRecipePackCall) DoAndReturn(f func(context.Context, string) (bool, error)) *MockApplicationsManagementClientDeleteRecipePackCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // DeleteResource mocks base method.
 func (m *MockApplicationsManagementClient) DeleteResource(arg0 context.Context, arg1, arg2 string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -740,6 +817,45 @@ func (c *MockApplicationsManagementClientGetEnvironmentCall) DoAndReturn(f func(
 	return c
 }
 
+// GetRecipeLogs mocks base method.
+func (m *MockApplicationsManagementClient) GetRecipeLogs(arg0 context.Context, arg1 string, arg2 v20231001preview.RecipeLogsRequest) (v20231001preview.RecipeLogsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecipeLogs", arg0, arg1, arg2)
+	ret0, _ := ret[0].(v20231001preview.RecipeLogsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecipeLogs indicates an expected call of GetRecipeLogs.
+func (mr *MockApplicationsManagementClientMockRecorder) GetRecipeLogs(arg0, arg1, arg2 any) *MockApplicationsManagementClientGetRecipeLogsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecipeLogs", reflect.TypeOf((*MockApplicationsManagementClient)(nil).GetRecipeLogs), arg0, arg1, arg2)
+	return &MockApplicationsManagementClientGetRecipeLogsCall{Call: call}
+}
+
+// MockApplicationsManagementClientGetRecipeLogsCall wrap *gomock.Call
+type MockApplicationsManagementClientGetRecipeLogsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationsManagementClientGetRecipeLogsCall) Return(arg0 v20231001preview.RecipeLogsResponse, arg1 error) *MockApplicationsManagementClientGetRecipeLogsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationsManagementClientGetRecipeLogsCall) Do(f func(context.Context, string, v20231001preview.RecipeLogsRequest) (v20231001preview.RecipeLogsResponse, error)) *MockApplicationsManagementClientGetRecipeLogsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationsManagementClientGetRecipeLogsCall) DoAndReturn(f func(context.Context, string, v20231001preview.RecipeLogsRequest) (v20231001preview.RecipeLogsResponse, error)) *MockApplicationsManagementClientGetRecipeLogsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetRecipeMetadata mocks base method.
 func (m *MockApplicationsManagementClient) GetRecipeMetadata(arg0 context.Context, arg1 string, arg2 v20231001preview.RecipeGetMetadata) (v20231001preview.RecipeGetMetadataResponse, error) {
 	m.ctrl.T.Helper()
@@ -779,6 +895,45 @@ func (c *MockApplicationsManagementClientGetRecipeMetadataCall) DoAndReturn(f fu
 	return c
 }
 
+// GetRecipePack mocks base method.
+func (m *MockApplicationsManagementClient) GetRecipePack(arg0 context.Context, arg1 string) (v20231001preview.RecipePackResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecipePack", arg0, arg1)
+	ret0, _ := ret[0].(v20231001preview.RecipePackResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecipePack indicates an expected call of GetRecipePack.
+func (mr *MockApplicationsManagementClientMockRecorder) GetRecipePack(arg0, arg1 any) *MockApplicationsManagementClientGetRecipePackCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecipePack", reflect.TypeOf((*MockApplicationsManagementClient)(nil).GetRecipePack), arg0, arg1)
+	return &MockApplicationsManagementClientGetRecipePackCall{Call: call}
+}
+
+// MockApplicationsManagementClientGetRecipePackCall wrap *gomock.Call
+type MockApplicationsManagementClientGetRecipePackCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationsManagementClientGetRecipePackCall) Return(arg0 v20231001preview.RecipePackResource, arg1 error) *MockApplicationsManagementClientGetRecipePackCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationsManagementClientGetRecipePackCall) Do(f func(context.Context, string) (v20231001preview.RecipePackResource, error)) *MockApplicationsManagementClientGetRecipePackCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationsManagementClientGetRecipePackCall) DoAndReturn(f func(context.Context, string) (v20231001preview.RecipePackResource, error)) *MockApplicationsManagementClientGetRecipePackCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetResource mocks base method.
 func (m *MockApplicationsManagementClient) GetResource(arg0 context.Context, arg1, arg2 string) (generated.GenericResource, error) {
 	m.ctrl.T.Helper()
@@ -1091,6 +1246,45 @@ func (c *MockApplicationsManagementClientListEnvironmentsAllCall) DoAndReturn(f
 	return c
 }
 
+// ListRecipePacks mocks base method.
+func (m *MockApplicationsManagementClient) ListRecipePacks(arg0 context.Context) ([]v20231001preview.RecipePackResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRecipePacks", arg0)
+	ret0, _ := ret[0].([]v20231001preview.RecipePackResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRecipePacks indicates an expected call of ListRecipePacks.
+func (mr *MockApplicationsManagementClientMockRecorder) ListRecipePacks(arg0 any) *MockApplicationsManagementClientListRecipePacksCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRecipePacks", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ListRecipePacks), arg0)
+	return &MockApplicationsManagementClientListRecipePacksCall{Call: call}
+}
+
+// MockApplicationsManagementClientListRecipePacksCall wrap *gomock.Call
+type MockApplicationsManagementClientListRecipePacksCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationsManagementClientListRecipePacksCall) Return(arg0 []v20231001preview.RecipePackResource, arg1 error) *MockApplicationsManagementClientListRecipePacksCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationsManagementClientListRecipePacksCall) Do(f func(context.Context) ([]v20231001preview.RecipePackResource, error)) *MockApplicationsManagementClientListRecipePacksCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationsManagementClientListRecipePacksCall) DoAndReturn(f func(context.Context) ([]v20231001preview.RecipePackResource, error)) *MockApplicationsManagementClientListRecipePacksCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ListResourceGroups mocks base method.
 func (m *MockApplicationsManagementClient) ListResourceGroups(arg0 context.Context, arg1 string) ([]v20231001preview0.ResourceGroupResource, error) {
 	m.ctrl.T.Helper()
@@ -1402,3 +1596,42 @@ func (c *MockApplicationsManagementClientListResourcesOfTypeInEnvironmentCall) D
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// PlanRecipe mocks base method.
+func (m *MockApplicationsManagementClient) PlanRecipe(arg0 context.Context, arg1 string, arg2 v20231001preview.RecipePlanRequest) (v20231001preview.RecipePlanResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlanRecipe", arg0, arg1, arg2)
+	ret0, _ := ret[0].(v20231001preview.RecipePlanResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlanRecipe indicates an expected call of PlanRecipe.
+func (mr *MockApplicationsManagementClientMockRecorder) PlanRecipe(arg0, arg1, arg2 any) *MockApplicationsManagementClientPlanRecipeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlanRecipe", reflect.TypeOf((*MockApplicationsManagementClient)(nil).PlanRecipe), arg0, arg1, arg2)
+	return &MockApplicationsManagementClientPlanRecipeCall{Call: call}
+}
+
+// MockApplicationsManagementClientPlanRecipeCall wrap *gomock.Call
+type MockApplicationsManagementClientPlanRecipeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationsManagementClientPlanRecipeCall) Return(arg0 v20231001preview.RecipePlanResponse, arg1 error) *MockApplicationsManagementClientPlanRecipeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationsManagementClientPlanRecipeCall) Do(f func(context.Context, string, v20231001preview.RecipePlanRequest) (v20231001preview.RecipePlanResponse, error)) *MockApplicationsManagementClientPlanRecipeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationsManagementClientPlanRecipeCall) DoAndReturn(f func(context.Context, string, v20231001preview.RecipePlanRequest) (v20231001preview.RecipePlanResponse, error)) *MockApplicationsManagementClientPlanRecipeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -3,7 +3,7 @@
 //
 // Generated by this command:
 //
-//	mockgen -typed -source=./management_mocks.go -destination=./mock_management_wrapped_clients.go -package=clients -self_package github.com/radius-project/radius/pkg/cli/clients github.com/radius-project/radius/pkg/cli/clients genericResourceClient,applicationResourceClient,environmentResourceClient,resourceGroupClient,resourceProviderClient,resourceTypeClient,apiVersonClient,locationClient
+//	mockgen -typed -source=./management_mocks.go -destination=./mock_management_wrapped_clients.go -package=clients -self_package github.com/radius-project/radius/pkg/cli/clients github.com/radius-project/radius/pkg/cli/clients genericResourceClient,applicationResourceClient,environmentResourceClient,recipePackResourceClient,resourceGroupClient,resourceProviderClient,resourceTypeClient,apiVersonClient,locationClient
 //
 
 // Package clients is a generated GoMock package.
@@ -594,6 +594,45 @@ func (c *MockenvironmentResourceClientGetMetadataCall) DoAndReturn(f func(contex
 	return c
 }
 
+// GetRecipeLogs mocks base method.
+func (m *MockenvironmentResourceClient) GetRecipeLogs(ctx context.Context, environmentName string, body v20231001preview.RecipeLogsRequest, options *v20231001preview.EnvironmentsClientGetRecipeLogsOptions) (v20231001preview.EnvironmentsClientGetRecipeLogsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecipeLogs", ctx, environmentName, body, options)
+	ret0, _ := ret[0].(v20231001preview.EnvironmentsClientGetRecipeLogsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecipeLogs indicates an expected call of GetRecipeLogs.
+func (mr *MockenvironmentResourceClientMockRecorder) GetRecipeLogs(ctx, environmentName, body, options any) *MockenvironmentResourceClientGetRecipeLogsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecipeLogs", reflect.TypeOf((*MockenvironmentResourceClient)(nil).GetRecipeLogs), ctx, environmentName, body, options)
+	return &MockenvironmentResourceClientGetRecipeLogsCall{Call: call}
+}
+
+// MockenvironmentResourceClientGetRecipeLogsCall wrap *gomock.Call
+type MockenvironmentResourceClientGetRecipeLogsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockenvironmentResourceClientGetRecipeLogsCall) Return(arg0 v20231001preview.EnvironmentsClientGetRecipeLogsResponse, arg1 error) *MockenvironmentResourceClientGetRecipeLogsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockenvironmentResourceClientGetRecipeLogsCall) Do(f func(context.Context, string, v20231001preview.RecipeLogsRequest, *v20231001preview.EnvironmentsClientGetRecipeLogsOptions) (v20231001preview.EnvironmentsClientGetRecipeLogsResponse, error)) *MockenvironmentResourceClientGetRecipeLogsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockenvironmentResourceClientGetRecipeLogsCall) DoAndReturn(f func(context.Context, string, v20231001preview.RecipeLogsRequest, *v20231001preview.EnvironmentsClientGetRecipeLogsOptions) (v20231001preview.EnvironmentsClientGetRecipeLogsResponse, error)) *MockenvironmentResourceClientGetRecipeLogsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // NewListByScopePager mocks base method.
 func (m *MockenvironmentResourceClient) NewListByScopePager(options *v20231001preview.EnvironmentsClientListByScopeOptions) *runtime.Pager[v20231001preview.EnvironmentsClientListByScopeResponse] {
 	m.ctrl.T.Helper()
@@ -632,6 +671,223 @@ func (c *MockenvironmentResourceClientNewListByScopePagerCall) DoAndReturn(f fun
 	return c
 }
 
+// PlanRecipe mocks base method.
+func (m *MockenvironmentResourceClient) PlanRecipe(ctx context.Context, environmentName string, body v20231001preview.RecipePlanRequest, options *v20231001preview.EnvironmentsClientPlanRecipeOptions) (v20231001preview.EnvironmentsClientPlanRecipeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlanRecipe", ctx, environmentName, body, options)
+	ret0, _ := ret[0].(v20231001preview.EnvironmentsClientPlanRecipeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlanRecipe indicates an expected call of PlanRecipe.
+func (mr *MockenvironmentResourceClientMockRecorder) PlanRecipe(ctx, environmentName, body, options any) *MockenvironmentResourceClientPlanRecipeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlanRecipe", reflect.TypeOf((*MockenvironmentResourceClient)(nil).PlanRecipe), ctx, environmentName, body, options)
+	return &MockenvironmentResourceClientPlanRecipeCall{Call: call}
+}
+
+// MockenvironmentResourceClientPlanRecipeCall wrap *gomock.Call
+type MockenvironmentResourceClientPlanRecipeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockenvironmentResourceClientPlanRecipeCall) Return(arg0 v20231001preview.EnvironmentsClientPlanRecipeResponse, arg1 error) *MockenvironmentResourceClientPlanRecipeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockenvironmentResourceClientPlanRecipeCall) Do(f func(context.Context, string, v20231001preview.RecipePlanRequest, *v20231001preview.EnvironmentsClientPlanRecipeOptions) (v20231001preview.EnvironmentsClientPlanRecipeResponse, error)) *MockenvironmentResourceClientPlanRecipeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockenvironmentResourceClientPlanRecipeCall) DoAndReturn(f func(context.Context, string, v20231001preview.RecipePlanRequest, *v20231001preview.EnvironmentsClientPlanRecipeOptions) (v20231001preview.EnvironmentsClientPlanRecipeResponse, error)) *MockenvironmentResourceClientPlanRecipeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockrecipePackResourceClient is a mock of recipePackResourceClient interface.
+type MockrecipePackResourceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockrecipePackResourceClientMockRecorder
+}
+
+// MockrecipePackResourceClientMockRecorder is the mock recorder for MockrecipePackResourceClient.
+type MockrecipePackResourceClientMockRecorder struct {
+	mock *MockrecipePackResourceClient
+}
+
+// NewMockrecipePackResourceClient creates a new mock instance.
+func NewMockrecipePackResourceClient(ctrl *gomock.Controller) *MockrecipePackResourceClient {
+	mock := &MockrecipePackResourceClient{ctrl: ctrl}
+	mock.recorder = &MockrecipePackResourceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockrecipePackResourceClient) EXPECT() *MockrecipePackResourceClientMockRecorder {
+	return m.recorder
+}
+
+// CreateOrUpdate mocks base method.
+func (m *MockrecipePackResourceClient) CreateOrUpdate(ctx context.Context, recipePackName string, resource v20231001preview.RecipePackResource, options *v20231001preview.RecipePacksClientCreateOrUpdateOptions) (v20231001preview.RecipePacksClientCreateOrUpdateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdate", ctx, recipePackName, resource, options)
+	ret0, _ := ret[0].(v20231001preview.RecipePacksClientCreateOrUpdateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrUpdate indicates an expected call of CreateOrUpdate.
+func (mr *MockrecipePackResourceClientMockRecorder) CreateOrUpdate(ctx, recipePackName, resource, options any) *MockrecipePackResourceClientCreateOrUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdate", reflect.TypeOf((*MockrecipePackResourceClient)(nil).CreateOrUpdate), ctx, recipePackName, resource, options)
+	return &MockrecipePackResourceClientCreateOrUpdateCall{Call: call}
+}
+
+// MockrecipePackResourceClientCreateOrUpdateCall wrap *gomock.Call
+type MockrecipePackResourceClientCreateOrUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockrecipePackResourceClientCreateOrUpdateCall) Return(arg0 v20231001preview.RecipePacksClientCreateOrUpdateResponse, arg1 error) *MockrecipePackResourceClientCreateOrUpdateCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockrecipePackResourceClientCreateOrUpdateCall) Do(f func(context.Context, string, v20231001preview.RecipePackResource, *v20231001preview.RecipePacksClientCreateOrUpdateOptions) (v20231001preview.RecipePacksClientCreateOrUpdateResponse, error)) *MockrecipePackResourceClientCreateOrUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockrecipePackResourceClientCreateOrUpdateCall) DoAndReturn(f func(context.Context, string, v20231001preview.RecipePackResource, *v20231001preview.RecipePacksClientCreateOrUpdateOptions) (v20231001preview.RecipePacksClientCreateOrUpdateResponse, error)) *MockrecipePackResourceClientCreateOrUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Delete mocks base method.
+func (m *MockrecipePackResourceClient) Delete(ctx context.Context, recipePackName string, options *v20231001preview.RecipePacksClientDeleteOptions) (v20231001preview.RecipePacksClientDeleteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, recipePackName, options)
+	ret0, _ := ret[0].(v20231001preview.RecipePacksClientDeleteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockrecipePackResourceClientMockRecorder) Delete(ctx, recipePackName, options any) *MockrecipePackResourceClientDeleteCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockrecipePackResourceClient)(nil).Delete), ctx, recipePackName, options)
+	return &MockrecipePackResourceClientDeleteCall{Call: call}
+}
+
+// MockrecipePackResourceClientDeleteCall wrap *gomock.Call
+type MockrecipePackResourceClientDeleteCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockrecipePackResourceClientDeleteCall) Return(arg0 v20231001preview.RecipePacksClientDeleteResponse, arg1 error) *MockrecipePackResourceClientDeleteCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockrecipePackResourceClientDeleteCall) Do(f func(context.Context, string, *v20231001preview.RecipePacksClientDeleteOptions) (v20231001preview.RecipePacksClientDeleteResponse, error)) *MockrecipePackResourceClientDeleteCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockrecipePackResourceClientDeleteCall) DoAndReturn(f func(context.Context, string, *v20231001preview.RecipePacksClientDeleteOptions) (v20231001preview.RecipePacksClientDeleteResponse, error)) *MockrecipePackResourceClientDeleteCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Get mocks base method.
+func (m *MockrecipePackResourceClient) Get(ctx context.Context, recipePackName string, options *v20231001preview.RecipePacksClientGetOptions) (v20231001preview.RecipePacksClientGetResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, recipePackName, options)
+	ret0, _ := ret[0].(v20231001preview.RecipePacksClientGetResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockrecipePackResourceClientMockRecorder) Get(ctx, recipePackName, options any) *MockrecipePackResourceClientGetCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockrecipePackResourceClient)(nil).Get), ctx, recipePackName, options)
+	return &MockrecipePackResourceClientGetCall{Call: call}
+}
+
+// MockrecipePackResourceClientGetCall wrap *gomock.Call
+type MockrecipePackResourceClientGetCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockrecipePackResourceClientGetCall) Return(arg0 v20231001preview.RecipePacksClientGetResponse, arg1 error) *MockrecipePackResourceClientGetCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockrecipePackResourceClientGetCall) Do(f func(context.Context, string, *v20231001preview.RecipePacksClientGetOptions) (v20231001preview.RecipePacksClientGetResponse, error)) *MockrecipePackResourceClientGetCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockrecipePackResourceClientGetCall) DoAndReturn(f func(context.Context, string, *v20231001preview.RecipePacksClientGetOptions) (v20231001preview.RecipePacksClientGetResponse, error)) *MockrecipePackResourceClientGetCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// NewListByScopePager mocks base method.
+func (m *MockrecipePackResourceClient) NewListByScopePager(options *v20231001preview.RecipePacksClientListByScopeOptions) *runtime.Pager[v20231001preview.RecipePacksClientListByScopeResponse] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListByScopePager", options)
+	ret0, _ := ret[0].(*runtime.Pager[v20231001preview.RecipePacksClientListByScopeResponse])
+	return ret0
+}
+
+// NewListByScopePager indicates an expected call of NewListByScopePager.
+func (mr *MockrecipePackResourceClientMockRecorder) NewListByScopePager(options any) *MockrecipePackResourceClientNewListByScopePagerCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListByScopePager", reflect.TypeOf((*MockrecipePackResourceClient)(nil).NewListByScopePager), options)
+	return &MockrecipePackResourceClientNewListByScopePagerCall{Call: call}
+}
+
+// MockrecipePackResourceClientNewListByScopePagerCall wrap *gomock.Call
+type MockrecipePackResourceClientNewListByScopePagerCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockrecipePackResourceClientNewListByScopePagerCall) Return(arg0 *runtime.Pager[v20231001preview.RecipePacksClientListByScopeResponse]) *MockrecipePackResourceClientNewListByScopePagerCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockrecipePackResourceClientNewListByScopePagerCall) Do(f func(*v20231001preview.RecipePacksClientListByScopeOptions) *runtime.Pager[v20231001preview.RecipePacksClientListByScopeResponse]) *MockrecipePackResourceClientNewListByScopePagerCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockrecipePackResourceClientNewListByScopePagerCall) DoAndReturn(f func(*v20231001preview.RecipePacksClientListByScopeOptions) *runtime.Pager[v20231001preview.RecipePacksClientListByScopeResponse]) *MockrecipePackResourceClientNewListByScopePagerCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // MockresourceGroupClient is a mock of resourceGroupClient interface.
 type MockresourceGroupClient struct {
 	ctrl     *gomock.Controller
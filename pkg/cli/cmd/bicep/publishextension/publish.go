@@ -18,7 +18,6 @@ package publishextension
 
 import (
 	"context"
-	"errors"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -91,9 +90,7 @@ func NewRunner(factory framework.Factory) *Runner {
 func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 	// We read the resource provider manifest upfront to ensure it exists and is valid.
 	//
-	// The validation we implement in the `rad` CLI is the source of truth for the manifest. The
-	// manifest-to-bicep-extension tool does minimal validation, so we want to catch any issues
-	// early.
+	// The validation we implement in the `rad` CLI is the source of truth for the manifest.
 	rp, err := manifest.ReadFile(r.ResourceProviderManifestFilePath)
 	if err != nil {
 		return clierrors.MessageWithCause(err, "Failed to read resource provider %q", r.ResourceProviderManifestFilePath)
@@ -106,18 +103,13 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 
 // Run runs the `rad bicep publish-extension` command.
 func (r *Runner) Run(ctx context.Context) error {
-	// This command ties together two separate shell commands:
-	// 1. We use NPX to run https://github.com/radius-project/bicep-tools/tree/main/packages/manifest-to-bicep-extension
-	//       - This generates a Bicep extension "index"
+	// This command has two steps:
+	// 1. We generate a Bicep extension "index" from the resource provider manifest. The schemas of
+	//    the resource types are converted to Bicep types.
 	// 2. We use `bicep publish-extension` to publish the extension "index" to the "target"
 	//
 	// 3. We can clean up the "index" directory after publishing.
 
-	_, err := exec.LookPath("npx")
-	if errors.Is(err, exec.ErrNotFound) {
-		return clierrors.Message("The command 'npx' was not found on the PATH. Please install Node.js 16+ to use this command.")
-	}
-
 	temp, err := os.MkdirTemp("", "bicep-extension-*")
 	if err != nil {
 		return err
@@ -125,9 +117,9 @@ func (r *Runner) Run(ctx context.Context) error {
 
 	defer os.RemoveAll(temp)
 
-	err = generateBicepExtensionIndex(ctx, r.ResourceProviderManifestFilePath, temp)
+	err = manifest.GenerateBicepExtensionIndex(r.ResourceProvider, temp)
 	if err != nil {
-		return err
+		return clierrors.MessageWithCause(err, "Failed to generate Bicep extension")
 	}
 
 	err = publishExtension(ctx, temp, r.Target, r.Force)
@@ -139,26 +131,6 @@ func (r *Runner) Run(ctx context.Context) error {
 	return nil
 }
 
-func generateBicepExtensionIndex(ctx context.Context, inputFilePath string, outputDirectoryPath string) error {
-	// npx @radius-project/manifest-to-bicep-extension@alpha generate <resource provider> <temp>
-	args := []string{
-		"@radius-project/manifest-to-bicep-extension@alpha",
-		"generate",
-		inputFilePath,
-		outputDirectoryPath,
-	}
-	cmd := exec.CommandContext(ctx, "npx", args...)
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
-
-	err := cmd.Run()
-	if err != nil {
-		return clierrors.MessageWithCause(err, "Failed to generate Bicep extension")
-	}
-
-	return nil
-}
-
 func publishExtension(ctx context.Context, inputDirectoryPath string, target string, force bool) error {
 	bicepFilePath, err := bicep.GetBicepFilePath()
 	if err != nil {
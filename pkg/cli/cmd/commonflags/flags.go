@@ -37,6 +37,8 @@ const (
 	ClearEnvAzureFlag = "clear-azure"
 	// ClearEnvAWSFlag tells the command to clear aws scope on the environment it is configured.
 	ClearEnvAWSFlag = "clear-aws"
+	// RecipePacksFlag provides the recipe packs registered to the environment.
+	RecipePacksFlag = "recipe-packs"
 )
 
 // AddOutputFlag adds a flag to the given command that allows the user to specify the output format of the command's output.
@@ -71,6 +71,10 @@ When passing multiple parameters in a single file, use the format described here
 
 You can specify parameters using multiple sources. Parameters can be overridden based on the 
 order they are provided. Parameters appearing later in the argument list will override those defined earlier.
+
+Use the '--preview' flag to preview the cloud resources that the recipe of each portable resource in the template would
+create, change or destroy, without deploying the template. Resources whose name or recipe depend on template
+expressions other than parameters are skipped.
 `,
 		Example: `
 # deploy a Bicep template
@@ -106,6 +110,9 @@ rad deploy myapp.bicep --parameters @myfile.json
 
 # specify parameters from multiple sources
 rad deploy myapp.bicep --parameters @myfile.json --parameters version=latest
+
+# preview the cloud resources the recipes of the template would create, change or destroy
+rad deploy myapp.bicep --preview
 `,
 		Args: cobra.ExactArgs(1),
 		RunE: framework.RunCommand(runner),
@@ -116,6 +123,7 @@ rad deploy myapp.bicep --parameters @myfile.json --parameters version=latest
 	commonflags.AddEnvironmentNameFlag(cmd)
 	commonflags.AddApplicationNameFlag(cmd)
 	commonflags.AddParameterFlag(cmd)
+	cmd.Flags().Bool("preview", false, "Preview the changes the recipes of the portable resources would make, without deploying the template")
 
 	return cmd, runner
 }
@@ -132,6 +140,7 @@ type Runner struct {
 	EnvironmentNameOrID string
 	FilePath            string
 	Parameters          map[string]map[string]any
+	Preview             bool
 	Workspace           *workspaces.Workspace
 	Providers           *clients.Providers
 }
@@ -242,6 +251,14 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	// 'rad run' shares this validation but does not support previews.
+	if cmd.Flags().Lookup("preview") != nil {
+		r.Preview, err = cmd.Flags().GetBool("preview")
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -270,6 +287,10 @@ func (r *Runner) Run(ctx context.Context) error {
 		return err
 	}
 
+	if r.Preview {
+		return r.preview(ctx, template)
+	}
+
 	// Create application if specified. This supports the case where the application resource
 	// is not specified in Bicep. Creating the application automatically helps us "bootstrap" in a new environment.
 	if r.ApplicationName != "" {
@@ -584,6 +584,145 @@ func Test_Run(t *testing.T) {
 		// is always empty.
 		require.Empty(t, outputSink.Writes)
 	})
+
+	t.Run("Preview", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		scope := "/planes/radius/local/resourceGroups/test-group"
+		environmentID := scope + "/providers/Applications.Core/environments/test-env"
+		applicationID := scope + "/providers/Applications.Core/applications/test-application"
+		template := map[string]any{
+			"parameters": map[string]any{
+				"application": map[string]any{"type": "string"},
+				"size":        map[string]any{"type": "string", "defaultValue": "C1"},
+			},
+			"resources": map[string]any{
+				"redis": map[string]any{
+					"type": "Applications.Datastores/redisCaches@2023-10-01-preview",
+					"name": "redis",
+					"properties": map[string]any{
+						"application": "[parameters('application')]",
+						"environment": environmentID,
+						"recipe": map[string]any{
+							"name":       "azure",
+							"parameters": map[string]any{"size": "[parameters('size')]"},
+						},
+					},
+				},
+				"mongo": map[string]any{
+					"type": "Applications.Datastores/mongoDatabases@2023-10-01-preview",
+					"name": "[format('{0}-mongo', parameters('application'))]",
+				},
+				"manual": map[string]any{
+					"type":       "Applications.Datastores/sqlDatabases@2023-10-01-preview",
+					"name":       "manual",
+					"properties": map[string]any{"resourceProvisioning": "manual"},
+				},
+				"container": map[string]any{
+					"type": "Applications.Core/containers@2023-10-01-preview",
+					"name": "container",
+				},
+			},
+		}
+
+		bicep := bicep.NewMockInterface(ctrl)
+		bicep.EXPECT().
+			PrepareTemplate("app.bicep").
+			Return(template, nil).
+			Times(1)
+
+		appManagmentMock := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagmentMock.EXPECT().
+			GetApplication(gomock.Any(), applicationID).
+			Return(v20231001preview.ApplicationResource{}, nil).
+			Times(1)
+		appManagmentMock.EXPECT().
+			PlanRecipe(gomock.Any(), environmentID, v20231001preview.RecipePlanRequest{
+				ResourceID:    to.Ptr(scope + "/providers/Applications.Datastores/redisCaches/redis"),
+				RecipeName:    to.Ptr("azure"),
+				ApplicationID: to.Ptr(applicationID),
+				Parameters:    map[string]any{"size": "C1"},
+			}).
+			Return(v20231001preview.RecipePlanResponse{
+				ResourceChanges: []*v20231001preview.RecipeResourceChange{
+					{
+						ID:     to.Ptr("module.azure.azurerm_redis_cache.redis"),
+						Type:   to.Ptr("azurerm_redis_cache"),
+						Action: to.Ptr("create"),
+					},
+				},
+			}, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			Bicep:             bicep,
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagmentMock},
+			Output:            outputSink,
+			Providers: &clients.Providers{
+				Radius: &clients.RadiusProvider{
+					EnvironmentID: environmentID,
+					ApplicationID: applicationID,
+				},
+			},
+			FilePath:            "app.bicep",
+			ApplicationName:     "test-application",
+			EnvironmentNameOrID: environmentID,
+			Parameters:          map[string]map[string]any{},
+			Preview:             true,
+			Workspace:           &workspaces.Workspace{Name: "test-workspace", Scope: scope},
+		}
+
+		// Nothing is deployed, so the deploy client is not called.
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Skipping resource %q: its name depends on a template expression that cannot be previewed.",
+				Params: []any{"mongo"},
+			},
+			output.FormattedOutput{
+				Format: "table",
+				Obj: []resourceChange{
+					{
+						Resource:      "redis",
+						Recipe:        "azure",
+						Action:        "create",
+						CloudResource: "module.azure.azurerm_redis_cache.redis",
+						Type:          "azurerm_redis_cache",
+					},
+				},
+				Options: previewFormat(),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Preview requires an environment", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bicep := bicep.NewMockInterface(ctrl)
+		bicep.EXPECT().
+			PrepareTemplate("app.bicep").
+			Return(map[string]any{}, nil).
+			Times(1)
+
+		runner := &Runner{
+			Bicep:      bicep,
+			Output:     &output.MockOutput{},
+			Providers:  &clients.Providers{Radius: &clients.RadiusProvider{}},
+			FilePath:   "app.bicep",
+			Parameters: map[string]map[string]any{},
+			Preview:    true,
+			Workspace:  &workspaces.Workspace{Name: "test-workspace"},
+		}
+
+		err := runner.Run(context.Background())
+		require.EqualError(t, err, "Previewing a deployment requires an environment. Use --environment to specify the environment name.")
+	})
 }
 
 func Test_injectAutomaticParameters(t *testing.T) {
@@ -686,3 +825,42 @@ func Test_reportMissingParameters(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func Test_isPortableResourceType(t *testing.T) {
+	require.True(t, isPortableResourceType("Applications.Datastores/redisCaches", nil))
+	require.True(t, isPortableResourceType("Applications.Dapr/stateStores", nil))
+	require.True(t, isPortableResourceType("Applications.Messaging/rabbitMQQueues", nil))
+	require.True(t, isPortableResourceType("Applications.Core/extenders", nil))
+	require.False(t, isPortableResourceType("Applications.Core/containers", nil))
+	require.True(t, isPortableResourceType("MyCompany.Resources/postgres", map[string]any{"environment": "env"}))
+	require.False(t, isPortableResourceType("Microsoft.Storage/storageAccounts", map[string]any{}))
+}
+
+func Test_resolveValue(t *testing.T) {
+	declared := map[string]any{
+		"size":     map[string]any{"defaultValue": "C1"},
+		"location": map[string]any{"defaultValue": "[resourceGroup().location]"},
+	}
+	runner := Runner{
+		Parameters: map[string]map[string]any{
+			"Name": {"value": "redis"},
+		},
+	}
+
+	value, ok := runner.resolveValue("[parameters('name')]", declared)
+	require.True(t, ok)
+	require.Equal(t, "redis", value)
+
+	value, ok = runner.resolveValue(map[string]any{"size": "[parameters('size')]", "count": 1, "zones": []any{"[[1]"}}, declared)
+	require.True(t, ok)
+	require.Equal(t, map[string]any{"size": "C1", "count": 1, "zones": []any{"[1]"}}, value)
+
+	_, ok = runner.resolveValue("[parameters('location')]", declared)
+	require.False(t, ok)
+
+	_, ok = runner.resolveValue("[parameters('missing')]", declared)
+	require.False(t, ok)
+
+	_, ok = runner.resolveValue([]any{"[resourceGroup().location]"}, declared)
+	require.False(t, ok)
+}
@@ -0,0 +1,341 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deploy
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli/bicep"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+)
+
+const (
+	// defaultRecipeName is the name of the recipe used by a portable resource that does not specify a recipe.
+	defaultRecipeName = "default"
+
+	// resourceProvisioningManual is the provisioning mode of portable resources that do not use a recipe.
+	resourceProvisioningManual = "manual"
+)
+
+var (
+	// parameterExpression matches a template expression that references a parameter, e.g. "[parameters('name')]".
+	parameterExpression = regexp.MustCompile(`^\[parameters\('([^']+)'\)\]$`)
+
+	// portableResourceNamespaces are the namespaces of the Radius portable resource types that can use recipes.
+	portableResourceNamespaces = []string{"applications.datastores", "applications.messaging", "applications.dapr"}
+)
+
+// portableResource is a portable resource of a template whose recipe can be planned.
+type portableResource struct {
+	Name          string
+	Type          string
+	ID            string
+	RecipeName    string
+	ApplicationID string
+	Parameters    map[string]any
+}
+
+// resourceChange is a row of the output of `rad deploy --preview`.
+type resourceChange struct {
+	Resource      string
+	Recipe        string
+	Action        string
+	CloudResource string
+	Type          string
+}
+
+// previewFormat returns the table format of the output of `rad deploy --preview`.
+func previewFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "RESOURCE",
+				JSONPath: "{ .Resource }",
+			},
+			{
+				Heading:  "RECIPE",
+				JSONPath: "{ .Recipe }",
+			},
+			{
+				Heading:  "ACTION",
+				JSONPath: "{ .Action }",
+			},
+			{
+				Heading:  "CLOUD RESOURCE",
+				JSONPath: "{ .CloudResource }",
+			},
+			{
+				Heading:  "TYPE",
+				JSONPath: "{ .Type }",
+			},
+		},
+	}
+}
+
+// preview plans the recipes of the portable resources of the template and displays the cloud resources each of them
+// would create, change or destroy. Nothing is deployed.
+func (r *Runner) preview(ctx context.Context, template map[string]any) error {
+	if r.Providers.Radius.EnvironmentID == "" {
+		return clierrors.Message("Previewing a deployment requires an environment. Use --environment to specify the environment name.")
+	}
+
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	resources, err := r.extractPortableResources(template)
+	if err != nil {
+		return err
+	}
+
+	// The recipe context of a resource includes its application, which may not be deployed yet.
+	applications := map[string]bool{}
+	rows := []resourceChange{}
+	for _, resource := range resources {
+		request := v20231001preview.RecipePlanRequest{
+			ResourceID: to.Ptr(resource.ID),
+			RecipeName: to.Ptr(resource.RecipeName),
+			Parameters: resource.Parameters,
+		}
+
+		if resource.ApplicationID != "" {
+			exists, ok := applications[resource.ApplicationID]
+			if !ok {
+				_, err := client.GetApplication(ctx, resource.ApplicationID)
+				if err != nil && !clients.Is404Error(err) {
+					return err
+				}
+				exists = err == nil
+				applications[resource.ApplicationID] = exists
+			}
+			if exists {
+				request.ApplicationID = to.Ptr(resource.ApplicationID)
+			}
+		}
+
+		plan, err := client.PlanRecipe(ctx, r.Providers.Radius.EnvironmentID, request)
+		if err != nil {
+			return clierrors.MessageWithCause(err, "Failed to preview the recipe %q of resource %q.", resource.RecipeName, resource.Name)
+		}
+
+		if len(plan.ResourceChanges) == 0 {
+			rows = append(rows, resourceChange{Resource: resource.Name, Recipe: resource.RecipeName, Action: "noChange"})
+			continue
+		}
+
+		for _, change := range plan.ResourceChanges {
+			rows = append(rows, resourceChange{
+				Resource:      resource.Name,
+				Recipe:        resource.RecipeName,
+				Action:        to.String(change.Action),
+				CloudResource: to.String(change.ID),
+				Type:          to.String(change.Type),
+			})
+		}
+	}
+
+	if len(rows) == 0 {
+		r.Output.LogInfo("The template %q has no portable resources that use recipes.", r.FilePath)
+		return nil
+	}
+
+	return r.Output.WriteFormatted(output.FormatTable, rows, previewFormat())
+}
+
+// extractPortableResources returns the portable resources of the template that use a recipe. Resources whose name or
+// recipe depend on template expressions other than parameters cannot be planned, and are reported and skipped.
+func (r *Runner) extractPortableResources(template map[string]any) ([]portableResource, error) {
+	declaredParameters, err := bicep.ExtractParameters(template)
+	if err != nil {
+		return nil, err
+	}
+
+	// Templates with symbolic names have a map of resources, and other templates an array of resources.
+	var symbolicNames []string
+	var definitions []any
+	switch resources := template["resources"].(type) {
+	case map[string]any:
+		for symbolicName := range resources {
+			symbolicNames = append(symbolicNames, symbolicName)
+		}
+		sort.Strings(symbolicNames)
+		for _, symbolicName := range symbolicNames {
+			definitions = append(definitions, resources[symbolicName])
+		}
+	case []any:
+		definitions = resources
+	}
+
+	result := []portableResource{}
+	for i, definition := range definitions {
+		resource, ok := definition.(map[string]any)
+		if !ok || resource["existing"] == true {
+			continue
+		}
+
+		typeName, _ := resource["type"].(string)
+		resourceType, _, _ := strings.Cut(typeName, "@")
+		properties, _ := resource["properties"].(map[string]any)
+		if !isPortableResourceType(resourceType, properties) {
+			continue
+		}
+
+		displayName := fmt.Sprintf("%v", resource["name"])
+		if len(symbolicNames) > 0 {
+			displayName = symbolicNames[i]
+		}
+
+		provisioning, _ := r.resolveValue(properties["resourceProvisioning"], declaredParameters)
+		if provisioning == resourceProvisioningManual {
+			continue
+		}
+
+		name, ok := r.resolveValue(resource["name"], declaredParameters)
+		if !ok {
+			r.Output.LogInfo("Skipping resource %q: its name depends on a template expression that cannot be previewed.", displayName)
+			continue
+		}
+
+		recipe, _ := properties["recipe"].(map[string]any)
+		recipeName, ok := r.resolveValue(recipe["name"], declaredParameters)
+		if !ok {
+			r.Output.LogInfo("Skipping resource %q: its recipe name depends on a template expression that cannot be previewed.", displayName)
+			continue
+		}
+
+		var parameters map[string]any
+		if recipe["parameters"] != nil {
+			resolved, ok := r.resolveValue(recipe["parameters"], declaredParameters)
+			if !ok {
+				r.Output.LogInfo("Skipping resource %q: its recipe parameters depend on template expressions that cannot be previewed.", displayName)
+				continue
+			}
+			parameters, _ = resolved.(map[string]any)
+		}
+
+		// The application is optional, and a resource can still be planned if it cannot be resolved.
+		applicationID, ok := r.resolveValue(properties["application"], declaredParameters)
+		if !ok || applicationID == nil {
+			applicationID = r.Providers.Radius.ApplicationID
+		}
+
+		portable := portableResource{
+			Name:          fmt.Sprintf("%v", name),
+			Type:          resourceType,
+			ID:            fmt.Sprintf("%s/providers/%s/%v", r.Workspace.Scope, resourceType, name),
+			RecipeName:    defaultRecipeName,
+			ApplicationID: fmt.Sprintf("%v", applicationID),
+			Parameters:    parameters,
+		}
+		if recipeName != nil && recipeName != "" {
+			portable.RecipeName = fmt.Sprintf("%v", recipeName)
+		}
+
+		result = append(result, portable)
+	}
+
+	return result, nil
+}
+
+// isPortableResourceType returns true if resources of the given type can use recipes. These are the Radius portable
+// resource types, and the user-defined resource types, whose resources belong to an environment.
+func isPortableResourceType(resourceType string, properties map[string]any) bool {
+	namespace, _, _ := strings.Cut(strings.ToLower(resourceType), "/")
+	for _, portableNamespace := range portableResourceNamespaces {
+		if namespace == portableNamespace {
+			return true
+		}
+	}
+
+	if strings.EqualFold(resourceType, "Applications.Core/extenders") {
+		return true
+	} else if strings.HasPrefix(namespace, "applications.") {
+		return false
+	}
+
+	_, ok := properties["environment"]
+	return ok
+}
+
+// resolveValue resolves the template expressions of a value that reference parameters, using the parameters of the
+// deployment or the default values of the template parameters. It returns false if the value contains other expressions.
+func (r *Runner) resolveValue(value any, declaredParameters map[string]any) (any, bool) {
+	switch v := value.(type) {
+	case string:
+		if strings.HasPrefix(v, "[[") {
+			// Escaped literal that starts with a bracket.
+			return v[1:], true
+		} else if !strings.HasPrefix(v, "[") || !strings.HasSuffix(v, "]") {
+			return v, true
+		}
+
+		match := parameterExpression.FindStringSubmatch(v)
+		if match == nil {
+			return nil, false
+		}
+
+		for name, parameter := range r.Parameters {
+			if strings.EqualFold(name, match[1]) {
+				return parameter["value"], true
+			}
+		}
+
+		for name, parameter := range declaredParameters {
+			if strings.EqualFold(name, match[1]) {
+				if defaultValue, ok := bicep.DefaultValue(parameter); ok {
+					return r.resolveValue(defaultValue, declaredParameters)
+				}
+			}
+		}
+
+		return nil, false
+
+	case map[string]any:
+		resolved := map[string]any{}
+		for key, item := range v {
+			value, ok := r.resolveValue(item, declaredParameters)
+			if !ok {
+				return nil, false
+			}
+			resolved[key] = value
+		}
+		return resolved, true
+
+	case []any:
+		resolved := []any{}
+		for _, item := range v {
+			value, ok := r.resolveValue(item, declaredParameters)
+			if !ok {
+				return nil, false
+			}
+			resolved = append(resolved, value)
+		}
+		return resolved, true
+
+	default:
+		return v, true
+	}
+}
@@ -19,6 +19,7 @@ package update
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/cli"
@@ -31,6 +32,7 @@ import (
 	"github.com/radius-project/radius/pkg/cli/workspaces"
 	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/spf13/cobra"
 )
 
@@ -38,6 +40,7 @@ const (
 	envNotFoundErrMessageFmt = "The environment %q does not exist. Please select a new environment and try again."
 	azureScopeTemplate       = "/subscriptions/%s/resourceGroups/%s"
 	awsScopeTemplate         = "/planes/aws/aws/accounts/%s/regions/%s"
+	recipePackIDTemplate     = "%s/providers/Applications.Core/recipePacks/%s"
 )
 
 // NewCommand creates an instance of the command and runner for the `rad env update` command.
@@ -61,6 +64,7 @@ This command updates the configuration of an environment for properties that are
 		
 Properties that can be updated include:
 - providers (Azure, AWS)
+- recipe packs
 		  
 All other properties require the environment to be deleted and recreated.
 `,
@@ -77,6 +81,15 @@ rad env update myenv --clear-azure
 
 ## Remove AWS cloud provider
 rad env update myenv --clear-aws
+
+## Register the recipes of the current versions of recipe packs to the environment
+rad env update myenv --recipe-packs core-recipes,storage-recipes
+
+## Register the recipes of a version of a recipe pack to the environment
+rad env update myenv --recipe-packs core-recipes@1.2.0
+
+## Remove the recipe packs of the environment
+rad env update myenv --recipe-packs ""
 `,
 		RunE: framework.RunCommand(runner),
 	}
@@ -87,6 +100,7 @@ rad env update myenv --clear-aws
 	cmd.Flags().Bool(commonflags.ClearEnvAWSFlag, false, "Specify if aws provider needs to be cleared on env")
 	commonflags.AddAzureScopeFlags(cmd)
 	commonflags.AddAWSScopeFlags(cmd)
+	cmd.Flags().StringSlice(commonflags.RecipePacksFlag, nil, "Specify the recipe packs registered to the environment, by name (in the resource group of the environment) or resource ID, optionally followed by '@' and the version of the pack. Recipe packs without a version are registered at their current version. Replaces the recipe packs of the environment")
 	commonflags.AddOutputFlag(cmd)
 	//TODO: https://github.com/radius-project/radius/issues/5247
 	commonflags.AddEnvironmentNameFlag(cmd)
@@ -105,6 +119,7 @@ type Runner struct {
 	clearEnvAzure bool
 	clearEnvAws   bool
 	providers     *corerp.Providers
+	recipePacks   []*corerp.RecipePackReference
 	noFlagsSet    bool
 }
 
@@ -185,6 +200,35 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if cmd.Flags().Changed(commonflags.RecipePacksFlag) {
+		recipePacks, err := cmd.Flags().GetStringSlice(commonflags.RecipePacksFlag)
+		if err != nil {
+			return err
+		}
+
+		r.recipePacks = []*corerp.RecipePackReference{}
+		for _, recipePack := range recipePacks {
+			if recipePack == "" {
+				continue
+			}
+
+			reference := &corerp.RecipePackReference{}
+			if nameOrID, packVersion, found := strings.Cut(recipePack, "@"); found {
+				if packVersion == "" {
+					return clierrors.Message("The recipe pack %q must specify a version after '@'.", recipePack)
+				}
+				recipePack = nameOrID
+				reference.Version = to.Ptr(packVersion)
+			}
+
+			if !strings.HasPrefix(recipePack, resources.SegmentSeparator) {
+				recipePack = fmt.Sprintf(recipePackIDTemplate, r.Workspace.Scope, recipePack)
+			}
+			reference.ID = to.Ptr(recipePack)
+			r.recipePacks = append(r.recipePacks, reference)
+		}
+	}
+
 	return nil
 }
 
@@ -227,6 +271,27 @@ func (r *Runner) Run(ctx context.Context) error {
 		}
 		env.Properties.Providers.Aws = r.providers.Aws
 	}
+	// only update the recipe packs if user requires it.
+	if r.recipePacks != nil {
+		// Pin the recipe packs registered without a version to their current version, so publishing a new version
+		// of a pack doesn't change the recipes of the environment.
+		for _, recipePack := range r.recipePacks {
+			if recipePack.Version != nil {
+				continue
+			}
+
+			pack, err := client.GetRecipePack(ctx, to.String(recipePack.ID))
+			if clients.Is404Error(err) {
+				return clierrors.Message("The recipe pack %q does not exist.", to.String(recipePack.ID))
+			} else if err != nil {
+				return err
+			}
+			if pack.Properties != nil {
+				recipePack.Version = pack.Properties.Version
+			}
+		}
+		env.Properties.RecipePacks = r.recipePacks
+	}
 
 	r.Output.LogInfo("Updating Environment...")
 
@@ -92,6 +92,44 @@ func Test_Validate(t *testing.T) {
 				Config:         configWithWorkspace,
 			},
 		},
+		{
+			Name:          "Update Env Command with recipe packs set",
+			Input:         []string{"default", "--recipe-packs", "core-recipes,/planes/radius/local/resourceGroups/platform/providers/Applications.Core/recipePacks/storage-recipes@1.2.0"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, []*corerp.RecipePackReference{
+					{ID: to.Ptr("/planes/radius/local/resourceGroups/test-resource-group/providers/Applications.Core/recipePacks/core-recipes")},
+					{ID: to.Ptr("/planes/radius/local/resourceGroups/platform/providers/Applications.Core/recipePacks/storage-recipes"), Version: to.Ptr("1.2.0")},
+				}, r.recipePacks)
+			},
+		},
+		{
+			Name:          "Update Env Command with recipe pack missing its version",
+			Input:         []string{"default", "--recipe-packs", "core-recipes@"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Update Env Command with recipe packs cleared",
+			Input:         []string{"default", "--recipe-packs", ""},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, []*corerp.RecipePackReference{}, r.recipePacks)
+			},
+		},
 	}
 	radcli.SharedValidateValidation(t, NewCommand, testcases)
 }
@@ -546,4 +584,88 @@ func Test_Update(t *testing.T) {
 			})
 		}
 	})
+
+	t.Run("Success: Update Environment With Recipe Packs", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		recipePackID := "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/recipePacks/core-recipes"
+		storagePackID := "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/recipePacks/storage-recipes"
+		environment := corerp.EnvironmentResource{
+			Name: to.Ptr("test-env"),
+			Properties: &corerp.EnvironmentProperties{
+				RecipePacks: []*corerp.RecipePackReference{
+					{ID: to.Ptr("/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/recipePacks/old-recipes"), Version: to.Ptr("1.0.0")},
+				},
+			},
+		}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetEnvironment(gomock.Any(), "test-env").
+			Return(environment, nil).
+			Times(1)
+		// The recipe pack registered without a version is pinned to its current version.
+		appManagementClient.EXPECT().
+			GetRecipePack(gomock.Any(), recipePackID).
+			Return(corerp.RecipePackResource{
+				Properties: &corerp.RecipePackProperties{Version: to.Ptr("2.0.0")},
+			}, nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			CreateOrUpdateEnvironment(gomock.Any(), "test-env", &corerp.EnvironmentResource{
+				Location: to.Ptr(v1.LocationGlobal),
+				Properties: &corerp.EnvironmentProperties{
+					RecipePacks: []*corerp.RecipePackReference{
+						{ID: to.Ptr(recipePackID), Version: to.Ptr("2.0.0")},
+						{ID: to.Ptr(storagePackID), Version: to.Ptr("1.2.0")},
+					},
+				},
+			}).
+			Return(nil).
+			Times(1)
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:         &workspaces.Workspace{Scope: "/planes/radius/local/resourceGroups/test-group"},
+			Output:            &output.MockOutput{},
+			EnvName:           "test-env",
+			providers:         &corerp.Providers{},
+			recipePacks: []*corerp.RecipePackReference{
+				{ID: to.Ptr(recipePackID)},
+				{ID: to.Ptr(storagePackID), Version: to.Ptr("1.2.0")},
+			},
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+	})
+
+	t.Run("Failure: Recipe Pack Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		recipePackID := "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/recipePacks/core-recipes"
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetEnvironment(gomock.Any(), "test-env").
+			Return(corerp.EnvironmentResource{Name: to.Ptr("test-env"), Properties: &corerp.EnvironmentProperties{}}, nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			GetRecipePack(gomock.Any(), recipePackID).
+			Return(corerp.RecipePackResource{}, &azcore.ResponseError{ErrorCode: v1.CodeNotFound}).
+			Times(1)
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:         &workspaces.Workspace{Scope: "/planes/radius/local/resourceGroups/test-group"},
+			Output:            &output.MockOutput{},
+			EnvName:           "test-env",
+			providers:         &corerp.Providers{},
+			recipePacks:       []*corerp.RecipePackReference{{ID: to.Ptr(recipePackID)}},
+		}
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The recipe pack %q does not exist.", recipePackID), err)
+	})
 }
@@ -77,3 +77,56 @@ func RecipeParametersFormat() output.FormatterOptions {
 		},
 	}
 }
+
+// OutdatedResourceFormat returns the table format of the resources deployed with an outdated version of their recipe.
+func OutdatedResourceFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "RESOURCE",
+				JSONPath: "{ .Name }",
+			},
+			{
+				Heading:  "TYPE",
+				JSONPath: "{ .Type }",
+			},
+			{
+				Heading:  "RECIPE",
+				JSONPath: "{ .RecipeName }",
+			},
+			{
+				Heading:  "DEPLOYED",
+				JSONPath: "{ .Deployed }",
+			},
+			{
+				Heading:  "REGISTERED",
+				JSONPath: "{ .Registered }",
+			},
+		},
+	}
+}
+
+// RecipePlanFormat returns the table format of the changes the recipes of resources would make to the resources they
+// manage.
+func RecipePlanFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "RESOURCE",
+				JSONPath: "{ .Resource }",
+			},
+			{
+				Heading:  "ACTION",
+				JSONPath: "{ .Action }",
+			},
+			{
+				Heading:  "CLOUD RESOURCE",
+				JSONPath: "{ .CloudResource }",
+			},
+			{
+				Heading:  "TYPE",
+				JSONPath: "{ .Type }",
+			},
+		},
+	}
+}
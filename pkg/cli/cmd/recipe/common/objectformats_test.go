@@ -22,6 +22,7 @@ import (
 
 	types "github.com/radius-project/radius/pkg/cli/cmd/recipe"
 	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/recipeupgrade"
 	"github.com/stretchr/testify/require"
 )
 
@@ -58,3 +59,36 @@ func Test_RecipeParametersFormat(t *testing.T) {
 	expected := "PARAMETER  TYPE       DEFAULT VALUE  MIN       MAX\ntest       test-type  1              4         3\n"
 	require.Equal(t, expected, buffer.String())
 }
+
+func Test_OutdatedResourceFormat(t *testing.T) {
+	obj := recipeupgrade.OutdatedResource{
+		Name:       "redis",
+		Type:       "test-type",
+		RecipeName: "default",
+		Deployed:   "terraform redis@1.0.0",
+		Registered: "terraform redis@1.1.0",
+	}
+
+	buffer := &bytes.Buffer{}
+	err := output.Write(output.FormatTable, obj, buffer, OutdatedResourceFormat())
+	require.NoError(t, err)
+
+	expected := "RESOURCE  TYPE       RECIPE    DEPLOYED               REGISTERED\nredis     test-type  default   terraform redis@1.0.0  terraform redis@1.1.0\n"
+	require.Equal(t, expected, buffer.String())
+}
+
+func Test_RecipePlanFormat(t *testing.T) {
+	obj := types.RecipePlanChange{
+		Resource:      "redis",
+		Action:        "update",
+		CloudResource: "azurerm_redis_cache.redis",
+		Type:          "azurerm_redis_cache",
+	}
+
+	buffer := &bytes.Buffer{}
+	err := output.Write(output.FormatTable, obj, buffer, RecipePlanFormat())
+	require.NoError(t, err)
+
+	expected := "RESOURCE  ACTION    CLOUD RESOURCE             TYPE\nredis     update    azurerm_redis_cache.redis  azurerm_redis_cache\n"
+	require.Equal(t, expected, buffer.String())
+}
@@ -103,6 +103,11 @@ func Test_Run(t *testing.T) {
 							TemplatePath: to.Ptr("localhost:8000/mongodatabases:v1"),
 							PlainHTTP:    to.Ptr(true),
 						},
+						"mongo-helm": &v20231001preview.HelmRecipeProperties{
+							TemplateKind:    to.Ptr(recipes.TemplateKindHelm),
+							TemplatePath:    to.Ptr("oci://ghcr.io/testpublicrecipe/charts/mongodb"),
+							TemplateVersion: to.Ptr("15.0.0"),
+						},
 					},
 				},
 			},
@@ -129,6 +134,13 @@ func Test_Run(t *testing.T) {
 				TemplatePath: "localhost:8000/mongodatabases:v1",
 				PlainHTTP:    true,
 			},
+			{
+				Name:            "mongo-helm",
+				ResourceType:    ds_ctrl.MongoDatabasesResourceType,
+				TemplateKind:    recipes.TemplateKindHelm,
+				TemplatePath:    "oci://ghcr.io/testpublicrecipe/charts/mongodb",
+				TemplateVersion: "15.0.0",
+			},
 		}
 		sort.Slice(recipes, func(i, j int) bool {
 			return recipes[i].Name < recipes[j].Name
@@ -0,0 +1,139 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package outdated
+
+import (
+	"context"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/cmd/recipe/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/recipeupgrade"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad recipe outdated` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "outdated",
+		Short: "List resources deployed with an outdated version of their recipe",
+		Long: `List resources deployed with an outdated version of their recipe.
+
+A resource is outdated when the template of its recipe was changed since the resource was deployed, for example after
+the template version of the recipe was bumped or a new version of a recipe pack was registered to the environment.
+Outdated resources keep running the previous template until they are redeployed or upgraded with 'rad recipe upgrade'.`,
+		Example: `
+# List the outdated resources of the current environment
+rad recipe outdated
+
+# List the outdated Redis caches of an environment
+rad recipe outdated --environment prod --resource-type Applications.Datastores/redisCaches`,
+		Args: cobra.ExactArgs(0),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddOutputFlag(cmd)
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddEnvironmentNameFlag(cmd)
+	commonflags.AddResourceTypeFlag(cmd)
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad recipe outdated` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+	Format            string
+	ResourceType      string
+}
+
+// NewRunner creates a new instance of the `rad recipe outdated` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad recipe outdated` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	environment, err := cli.RequireEnvironmentName(cmd, args, *workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Environment = environment
+
+	resourceType, err := cli.GetResourceType(cmd)
+	if err != nil {
+		return err
+	}
+	r.ResourceType = resourceType
+
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+	r.Format = format
+
+	return nil
+}
+
+// Run runs the `rad recipe outdated` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	environment, err := client.GetEnvironment(ctx, r.Workspace.Environment)
+	if clients.Is404Error(err) {
+		return clierrors.Message("The environment %q does not exist. Please select a new environment and try again.", r.Workspace.Environment)
+	} else if err != nil {
+		return err
+	}
+
+	outdated, err := recipeupgrade.Find(ctx, client, environment, r.ResourceType)
+	if err != nil {
+		return err
+	}
+
+	if len(outdated) == 0 && r.Format == output.FormatTable {
+		r.Output.LogInfo("The resources of environment %q are up to date with their recipes.", r.Workspace.Environment)
+		return nil
+	}
+
+	return r.Output.WriteFormatted(r.Format, outdated, common.OutdatedResourceFormat())
+}
@@ -0,0 +1,223 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package outdated
+
+import (
+	"context"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/cmd/recipe/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/recipeupgrade"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	environmentID = "/planes/radius/local/resourcegroups/test-group/providers/Applications.Core/environments/test-env"
+	redisID       = "/planes/radius/local/resourcegroups/test-group/providers/Applications.Datastores/redisCaches/redis"
+	redisType     = "Applications.Datastores/redisCaches"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid Outdated Command",
+			Input:         []string{},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Outdated Command with resource type",
+			Input:         []string{"--resource-type", redisType},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Outdated Command with fallback workspace",
+			Input:         []string{"-e", "my-env", "-g", "my-env"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         radcli.LoadEmptyConfig(t),
+			},
+		},
+		{
+			Name:          "Outdated Command with too many args",
+			Input:         []string{"foo"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	t.Run("Outdated resources", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		redis := outdatedRedis()
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetEnvironment(gomock.Any(), "test-env").
+			Return(environment(), nil)
+		appManagementClient.EXPECT().
+			ListResourcesInEnvironment(gomock.Any(), environmentID).
+			Return([]generated.GenericResource{redis}, nil)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{Environment: "test-env"},
+			Format:            "table",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format: "table",
+				Obj: []recipeupgrade.OutdatedResource{
+					{
+						ID:         redisID,
+						Name:       "redis",
+						Type:       redisType,
+						RecipeName: "default",
+						Deployed:   "terraform Azure/redis/azurerm@1.0.0",
+						Registered: "terraform Azure/redis/azurerm@1.1.0",
+						Resource:   redis,
+					},
+				},
+				Options: common.OutdatedResourceFormat(),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Up to date", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetEnvironment(gomock.Any(), "test-env").
+			Return(environment(), nil)
+		appManagementClient.EXPECT().
+			ListResourcesOfTypeInEnvironment(gomock.Any(), environmentID, redisType).
+			Return([]generated.GenericResource{}, nil)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{Environment: "test-env"},
+			Format:            "table",
+			ResourceType:      redisType,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "The resources of environment %q are up to date with their recipes.",
+				Params: []any{"test-env"},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Environment not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetEnvironment(gomock.Any(), "test-env").
+			Return(corerp.EnvironmentResource{}, radcli.Create404Error())
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            &output.MockOutput{},
+			Workspace:         &workspaces.Workspace{Environment: "test-env"},
+			Format:            "table",
+		}
+
+		err := runner.Run(context.Background())
+		require.ErrorContains(t, err, "The environment \"test-env\" does not exist")
+	})
+}
+
+func environment() corerp.EnvironmentResource {
+	return corerp.EnvironmentResource{
+		ID:   to.Ptr(environmentID),
+		Name: to.Ptr("test-env"),
+		Properties: &corerp.EnvironmentProperties{
+			Recipes: map[string]map[string]corerp.RecipePropertiesClassification{
+				redisType: {
+					"default": &corerp.TerraformRecipeProperties{
+						TemplateKind:    to.Ptr(recipes.TemplateKindTerraform),
+						TemplatePath:    to.Ptr("Azure/redis/azurerm"),
+						TemplateVersion: to.Ptr("1.1.0"),
+					},
+				},
+			},
+		},
+	}
+}
+
+func outdatedRedis() generated.GenericResource {
+	return generated.GenericResource{
+		ID:       to.Ptr(redisID),
+		Name:     to.Ptr("redis"),
+		Type:     to.Ptr(redisType),
+		Location: to.Ptr("global"),
+		Properties: map[string]any{
+			"environment": environmentID,
+			"status": map[string]any{
+				"recipe": map[string]any{
+					"templateKind":    recipes.TemplateKindTerraform,
+					"templatePath":    "Azure/redis/azurerm",
+					"templateVersion": "1.0.0",
+				},
+			},
+		},
+	}
+}
@@ -59,6 +59,9 @@ rad recipe register cosmosdb -e env_name -w workspace --template-kind bicep --te
 		
 # specify multiple parameters using a JSON parameter file
 rad recipe register cosmosdb -e env_name -w workspace --template-kind bicep --template-path template_path --resource-type Applications.Datastores/mongoDatabases --parameters @myfile.json
+
+# Add a recipe that installs a Helm chart from an OCI registry
+rad recipe register redis -e env_name -w workspace --template-kind helm --template-path oci://ghcr.io/myregistry/charts/redis --template-version 18.0.0 --resource-type Applications.Datastores/redisCaches
 		`,
 		Args: cobra.ExactArgs(1),
 		RunE: framework.RunCommand(runner),
@@ -70,12 +73,12 @@ rad recipe register cosmosdb -e env_name -w workspace --template-kind bicep --te
 	commonflags.AddEnvironmentNameFlag(cmd)
 	cmd.Flags().String("template-kind", "", "specify the kind for the template provided by the recipe.")
 	_ = cmd.MarkFlagRequired("template-kind")
-	cmd.Flags().String("template-version", "", "specify the version for the terraform module.")
+	cmd.Flags().String("template-version", "", "specify the version for the terraform module or helm chart.")
 	cmd.Flags().String("template-path", "", "specify the path to the template provided by the recipe.")
 	_ = cmd.MarkFlagRequired("template-path")
 	cmd.Flags().String("resource-type", "", "specify the type of the portable resource this recipe can be consumed by")
 	_ = cmd.MarkFlagRequired("resource-type")
-	cmd.Flags().Bool("plain-http", false, "Connect to the Bicep registry using HTTP (not-HTTPS). This should be used when the registry is known not to support HTTPS, for example in a locally-hosted registry. Defaults to false (use HTTPS/TLS).")
+	cmd.Flags().Bool("plain-http", false, "Connect to the Bicep or Helm chart registry using HTTP (not-HTTPS). This should be used when the registry is known not to support HTTPS, for example in a locally-hosted registry. Defaults to false (use HTTPS/TLS).")
 	commonflags.AddParameterFlag(cmd)
 
 	return cmd, runner
@@ -200,6 +203,14 @@ func (r *Runner) Run(ctx context.Context) error {
 			PlainHTTP:    &r.PlainHTTP,
 			Parameters:   bicep.ConvertToMapStringInterface(r.Parameters),
 		}
+	case recipes.TemplateKindHelm:
+		properties = &corerp.HelmRecipeProperties{
+			TemplateKind:    &r.TemplateKind,
+			TemplatePath:    &r.TemplatePath,
+			TemplateVersion: &r.TemplateVersion,
+			PlainHTTP:       &r.PlainHTTP,
+			Parameters:      bicep.ConvertToMapStringInterface(r.Parameters),
+		}
 	}
 	if val, ok := envRecipes[r.ResourceType]; ok {
 		val[r.RecipeName] = properties
@@ -32,3 +32,11 @@ type RecipeParameter struct {
 	MaxValue     string      `json:"maxValue,omitempty"`
 	MinValue     string      `json:"minValue,omitempty"`
 }
+
+// RecipePlanChange is a change that the recipe of a resource would make to a resource it manages.
+type RecipePlanChange struct {
+	Resource      string `json:"resource"`
+	Action        string `json:"action"`
+	CloudResource string `json:"cloudResource,omitempty"`
+	Type          string `json:"type,omitempty"`
+}
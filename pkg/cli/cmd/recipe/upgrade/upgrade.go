@@ -0,0 +1,280 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package upgrade
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	types "github.com/radius-project/radius/pkg/cli/cmd/recipe"
+	"github.com/radius-project/radius/pkg/cli/cmd/recipe/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/prompt"
+	"github.com/radius-project/radius/pkg/cli/recipeupgrade"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	batchSizeFlag    = "batch-size"
+	previewFlag      = "preview"
+	defaultBatchSize = 5
+
+	upgradeConfirmation = "Are you sure you want to upgrade the %d resource(s) of batch %d of %d?"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad recipe upgrade` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "upgrade",
+		Short: "Upgrade resources deployed with an outdated version of their recipe",
+		Long: `Upgrade resources deployed with an outdated version of their recipe.
+
+The outdated resources of the environment, as listed by 'rad recipe outdated', are redeployed with the recipe currently
+registered to the environment. The resources are upgraded in batches: the changes the recipes would make to the cloud
+resources of a batch are shown, and the batch is upgraded once confirmed. The resources of a batch are upgraded
+concurrently, and the upgrade stops at the first batch that fails.
+
+The connection values of portable resources are computed again from the outputs of the upgraded recipe.`,
+		Example: `
+# Upgrade the outdated resources of the current environment, 5 resources at a time
+rad recipe upgrade
+
+# Show the changes the upgrade would make, without upgrading any resource
+rad recipe upgrade --preview
+
+# Upgrade the outdated Redis caches of an environment one at a time, without prompting for confirmation
+rad recipe upgrade --environment prod --resource-type Applications.Datastores/redisCaches --batch-size 1 --yes`,
+		Args: cobra.ExactArgs(0),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddEnvironmentNameFlag(cmd)
+	commonflags.AddResourceTypeFlag(cmd)
+	commonflags.AddConfirmationFlag(cmd)
+	cmd.Flags().Int(batchSizeFlag, defaultBatchSize, "The number of resources upgraded at a time")
+	cmd.Flags().Bool(previewFlag, false, "Show the changes the upgrade would make, without upgrading any resource")
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad recipe upgrade` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	InputPrompter     prompt.Interface
+	Workspace         *workspaces.Workspace
+	ResourceType      string
+	BatchSize         int
+	Preview           bool
+	Confirm           bool
+}
+
+// NewRunner creates a new instance of the `rad recipe upgrade` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Output:            factory.GetOutput(),
+		InputPrompter:     factory.GetPrompter(),
+	}
+}
+
+// Validate runs validation for the `rad recipe upgrade` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	environment, err := cli.RequireEnvironmentName(cmd, args, *workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Environment = environment
+
+	r.ResourceType, err = cli.GetResourceType(cmd)
+	if err != nil {
+		return err
+	}
+
+	r.BatchSize, err = cmd.Flags().GetInt(batchSizeFlag)
+	if err != nil {
+		return err
+	}
+	if r.BatchSize < 1 {
+		return clierrors.Message("The batch size must be at least 1.")
+	}
+
+	r.Preview, err = cmd.Flags().GetBool(previewFlag)
+	if err != nil {
+		return err
+	}
+
+	r.Confirm, err = cmd.Flags().GetBool("yes")
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Run runs the `rad recipe upgrade` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	environment, err := client.GetEnvironment(ctx, r.Workspace.Environment)
+	if clients.Is404Error(err) {
+		return clierrors.Message("The environment %q does not exist. Please select a new environment and try again.", r.Workspace.Environment)
+	} else if err != nil {
+		return err
+	}
+
+	outdated, err := recipeupgrade.Find(ctx, client, environment, r.ResourceType)
+	if err != nil {
+		return err
+	}
+
+	if len(outdated) == 0 {
+		r.Output.LogInfo("The resources of environment %q are up to date with their recipes.", r.Workspace.Environment)
+		return nil
+	}
+
+	err = r.Output.WriteFormatted(output.FormatTable, outdated, common.OutdatedResourceFormat())
+	if err != nil {
+		return err
+	}
+
+	upgraded := 0
+	batches := recipeupgrade.Batches(outdated, r.BatchSize)
+	for i, batch := range batches {
+		r.Output.LogInfo("")
+		r.Output.LogInfo("Batch %d of %d:", i+1, len(batches))
+
+		changes, err := r.plan(ctx, client, to.String(environment.ID), batch)
+		if err != nil {
+			return err
+		}
+
+		err = r.Output.WriteFormatted(output.FormatTable, changes, common.RecipePlanFormat())
+		if err != nil {
+			return err
+		}
+
+		if r.Preview {
+			continue
+		}
+
+		if !r.Confirm {
+			confirmed, err := prompt.YesOrNoPrompt(fmt.Sprintf(upgradeConfirmation, len(batch), i+1, len(batches)), prompt.ConfirmNo, r.InputPrompter)
+			if err != nil {
+				return err
+			}
+			if !confirmed {
+				r.Output.LogInfo("Upgrade cancelled. %d of %d resource(s) were upgraded.", upgraded, len(outdated))
+				return nil
+			}
+		}
+
+		err = r.upgrade(ctx, client, batch)
+		if err != nil {
+			return clierrors.MessageWithCause(err, "Failed to upgrade batch %d of %d. %d of %d resource(s) were upgraded.", i+1, len(batches), upgraded, len(outdated))
+		}
+
+		upgraded += len(batch)
+		r.Output.LogInfo("Upgraded %d of %d resource(s).", upgraded, len(outdated))
+	}
+
+	if r.Preview {
+		r.Output.LogInfo("")
+		r.Output.LogInfo("No resources were upgraded. Run the command without --preview to upgrade the resources.")
+	}
+
+	return nil
+}
+
+// plan returns the changes the recipes registered to the environment would make to the cloud resources of the
+// outdated resources.
+func (r *Runner) plan(ctx context.Context, client clients.ApplicationsManagementClient, environmentID string, batch []recipeupgrade.OutdatedResource) ([]types.RecipePlanChange, error) {
+	changes := []types.RecipePlanChange{}
+	for _, resource := range batch {
+		request := corerp.RecipePlanRequest{
+			ResourceID: to.Ptr(resource.ID),
+			RecipeName: to.Ptr(resource.RecipeName),
+			Parameters: recipeupgrade.RecipeParameters(resource.Resource),
+		}
+		if application, ok := resource.Resource.Properties["application"].(string); ok && application != "" {
+			request.ApplicationID = to.Ptr(application)
+		}
+
+		plan, err := client.PlanRecipe(ctx, environmentID, request)
+		if err != nil {
+			return nil, clierrors.MessageWithCause(err, "Failed to preview the upgrade of resource %q.", resource.Name)
+		}
+
+		if len(plan.ResourceChanges) == 0 {
+			changes = append(changes, types.RecipePlanChange{Resource: resource.Name, Action: "noChange"})
+			continue
+		}
+
+		for _, change := range plan.ResourceChanges {
+			changes = append(changes, types.RecipePlanChange{
+				Resource:      resource.Name,
+				Action:        to.String(change.Action),
+				CloudResource: to.String(change.ID),
+				Type:          to.String(change.Type),
+			})
+		}
+	}
+
+	return changes, nil
+}
+
+// upgrade redeploys the outdated resources of a batch concurrently, and waits for all of them to complete.
+func (r *Runner) upgrade(ctx context.Context, client clients.ApplicationsManagementClient, batch []recipeupgrade.OutdatedResource) error {
+	group := errgroup.Group{}
+	for _, resource := range batch {
+		group.Go(func() error {
+			_, err := client.CreateOrUpdateResource(ctx, resource.Type, resource.ID, recipeupgrade.UpgradeRequest(resource.Resource))
+			if err != nil {
+				return fmt.Errorf("failed to upgrade resource %q: %w", resource.Name, err)
+			}
+
+			return nil
+		})
+	}
+
+	return group.Wait()
+}
@@ -0,0 +1,346 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package upgrade
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	types "github.com/radius-project/radius/pkg/cli/cmd/recipe"
+	"github.com/radius-project/radius/pkg/cli/cmd/recipe/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/prompt"
+	"github.com/radius-project/radius/pkg/cli/recipeupgrade"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	environmentID = "/planes/radius/local/resourcegroups/test-group/providers/Applications.Core/environments/test-env"
+	applicationID = "/planes/radius/local/resourcegroups/test-group/providers/Applications.Core/applications/test-app"
+	redisType     = "Applications.Datastores/redisCaches"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid Upgrade Command",
+			Input:         []string{},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, defaultBatchSize, r.BatchSize)
+				require.False(t, r.Preview)
+				require.False(t, r.Confirm)
+			},
+		},
+		{
+			Name:          "Upgrade Command with flags",
+			Input:         []string{"--resource-type", redisType, "--batch-size", "2", "--preview", "--yes"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, redisType, r.ResourceType)
+				require.Equal(t, 2, r.BatchSize)
+				require.True(t, r.Preview)
+				require.True(t, r.Confirm)
+			},
+		},
+		{
+			Name:          "Upgrade Command with invalid batch size",
+			Input:         []string{"--batch-size", "0"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Upgrade Command with too many args",
+			Input:         []string{"foo"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	t.Run("Upgrade in batches", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		resources := []generated.GenericResource{outdatedRedis("redis-a"), outdatedRedis("redis-b"), outdatedRedis("redis-c")}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		expectOutdated(appManagementClient, resources)
+		for _, resource := range resources {
+			appManagementClient.EXPECT().
+				PlanRecipe(gomock.Any(), environmentID, corerp.RecipePlanRequest{
+					ResourceID:    resource.ID,
+					RecipeName:    to.Ptr("default"),
+					ApplicationID: to.Ptr(applicationID),
+				}).
+				Return(updatePlan(), nil)
+			appManagementClient.EXPECT().
+				CreateOrUpdateResource(gomock.Any(), redisType, to.String(resource.ID), recipeupgrade.UpgradeRequest(resource)).
+				Return(resource, nil)
+		}
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{Environment: "test-env"},
+			BatchSize:         2,
+			Confirm:           true,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		logs := logMessages(outputSink)
+		require.Contains(t, logs, "Batch 1 of 2:")
+		require.Contains(t, logs, "Batch 2 of 2:")
+		require.Contains(t, logs, "Upgraded 2 of 3 resource(s).")
+		require.Contains(t, logs, "Upgraded 3 of 3 resource(s).")
+
+		plans := formattedOutputs(outputSink, common.RecipePlanFormat())
+		require.Len(t, plans, 2)
+		require.Equal(t, []types.RecipePlanChange{
+			{Resource: "redis-a", Action: "update", CloudResource: "azurerm_redis_cache.redis", Type: "azurerm_redis_cache"},
+			{Resource: "redis-b", Action: "update", CloudResource: "azurerm_redis_cache.redis", Type: "azurerm_redis_cache"},
+		}, plans[0].Obj)
+	})
+
+	t.Run("Preview", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		resource := outdatedRedis("redis")
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		expectOutdated(appManagementClient, []generated.GenericResource{resource})
+		appManagementClient.EXPECT().
+			PlanRecipe(gomock.Any(), environmentID, gomock.Any()).
+			Return(corerp.RecipePlanResponse{}, nil)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{Environment: "test-env"},
+			BatchSize:         defaultBatchSize,
+			Preview:           true,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		plans := formattedOutputs(outputSink, common.RecipePlanFormat())
+		require.Len(t, plans, 1)
+		require.Equal(t, []types.RecipePlanChange{{Resource: "redis", Action: "noChange"}}, plans[0].Obj)
+		require.Contains(t, logMessages(outputSink), "No resources were upgraded. Run the command without --preview to upgrade the resources.")
+	})
+
+	t.Run("Prompt declined", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		resource := outdatedRedis("redis")
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		expectOutdated(appManagementClient, []generated.GenericResource{resource})
+		appManagementClient.EXPECT().
+			PlanRecipe(gomock.Any(), environmentID, gomock.Any()).
+			Return(updatePlan(), nil)
+
+		promptMock := prompt.NewMockInterface(ctrl)
+		promptMock.EXPECT().
+			GetListInput([]string{prompt.ConfirmNo, prompt.ConfirmYes}, fmt.Sprintf(upgradeConfirmation, 1, 1, 1)).
+			Return(prompt.ConfirmNo, nil)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            outputSink,
+			InputPrompter:     promptMock,
+			Workspace:         &workspaces.Workspace{Environment: "test-env"},
+			BatchSize:         defaultBatchSize,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+		require.Contains(t, logMessages(outputSink), "Upgrade cancelled. 0 of 1 resource(s) were upgraded.")
+	})
+
+	t.Run("Upgrade failure", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		resource := outdatedRedis("redis")
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		expectOutdated(appManagementClient, []generated.GenericResource{resource})
+		appManagementClient.EXPECT().
+			PlanRecipe(gomock.Any(), environmentID, gomock.Any()).
+			Return(updatePlan(), nil)
+		appManagementClient.EXPECT().
+			CreateOrUpdateResource(gomock.Any(), redisType, to.String(resource.ID), gomock.Any()).
+			Return(generated.GenericResource{}, errors.New("recipe failed"))
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            &output.MockOutput{},
+			Workspace:         &workspaces.Workspace{Environment: "test-env"},
+			BatchSize:         defaultBatchSize,
+			Confirm:           true,
+		}
+
+		err := runner.Run(context.Background())
+		require.ErrorContains(t, err, "Failed to upgrade batch 1 of 1. 0 of 1 resource(s) were upgraded.")
+	})
+
+	t.Run("Up to date", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		expectOutdated(appManagementClient, []generated.GenericResource{})
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{Environment: "test-env"},
+			BatchSize:         defaultBatchSize,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "The resources of environment %q are up to date with their recipes.",
+				Params: []any{"test-env"},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+}
+
+// expectOutdated sets up the calls made to find the outdated resources of the environment.
+func expectOutdated(client *clients.MockApplicationsManagementClient, resources []generated.GenericResource) {
+	client.EXPECT().
+		GetEnvironment(gomock.Any(), "test-env").
+		Return(corerp.EnvironmentResource{
+			ID:   to.Ptr(environmentID),
+			Name: to.Ptr("test-env"),
+			Properties: &corerp.EnvironmentProperties{
+				Recipes: map[string]map[string]corerp.RecipePropertiesClassification{
+					redisType: {
+						"default": &corerp.TerraformRecipeProperties{
+							TemplateKind:    to.Ptr(recipes.TemplateKindTerraform),
+							TemplatePath:    to.Ptr("Azure/redis/azurerm"),
+							TemplateVersion: to.Ptr("1.1.0"),
+						},
+					},
+				},
+			},
+		}, nil)
+	client.EXPECT().
+		ListResourcesInEnvironment(gomock.Any(), environmentID).
+		Return(resources, nil)
+}
+
+func outdatedRedis(name string) generated.GenericResource {
+	return generated.GenericResource{
+		ID:       to.Ptr("/planes/radius/local/resourcegroups/test-group/providers/Applications.Datastores/redisCaches/" + name),
+		Name:     to.Ptr(name),
+		Type:     to.Ptr(redisType),
+		Location: to.Ptr("global"),
+		Properties: map[string]any{
+			"application": applicationID,
+			"environment": environmentID,
+			"host":        name + ".redis.cache.windows.net",
+			"status": map[string]any{
+				"recipe": map[string]any{
+					"templateKind":    recipes.TemplateKindTerraform,
+					"templatePath":    "Azure/redis/azurerm",
+					"templateVersion": "1.0.0",
+				},
+			},
+		},
+	}
+}
+
+func updatePlan() corerp.RecipePlanResponse {
+	return corerp.RecipePlanResponse{
+		TemplateKind: to.Ptr(recipes.TemplateKindTerraform),
+		TemplatePath: to.Ptr("Azure/redis/azurerm"),
+		ResourceChanges: []*corerp.RecipeResourceChange{
+			{
+				ID:     to.Ptr("azurerm_redis_cache.redis"),
+				Type:   to.Ptr("azurerm_redis_cache"),
+				Action: to.Ptr("update"),
+			},
+		},
+	}
+}
+
+// logMessages returns the formatted log messages written to the output.
+func logMessages(outputSink *output.MockOutput) []string {
+	messages := []string{}
+	for _, write := range outputSink.Writes {
+		if log, ok := write.(output.LogOutput); ok {
+			messages = append(messages, fmt.Sprintf(log.Format, log.Params...))
+		}
+	}
+
+	return messages
+}
+
+// formattedOutputs returns the formatted outputs written to the output with the given format options.
+func formattedOutputs(outputSink *output.MockOutput, options output.FormatterOptions) []output.FormattedOutput {
+	outputs := []output.FormattedOutput{}
+	for _, write := range outputSink.Writes {
+		if formatted, ok := write.(output.FormattedOutput); ok && reflect.DeepEqual(formatted.Options, options) {
+			outputs = append(outputs, formatted)
+		}
+	}
+
+	return outputs
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"github.com/radius-project/radius/pkg/cli/output"
+)
+
+// RecipePackFormat returns the FormatterOptions used to display the summaries of recipe packs.
+func RecipePackFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "NAME",
+				JSONPath: "{ .Name }",
+			},
+			{
+				Heading:  "VERSION",
+				JSONPath: "{ .Version }",
+			},
+			{
+				Heading:  "RECIPES",
+				JSONPath: "{ .Recipes }",
+			},
+			{
+				Heading:  "DESCRIPTION",
+				JSONPath: "{ .Description }",
+			},
+		},
+	}
+}
+
+// RecipePackDiffFormat returns the FormatterOptions used to display the differences between the recipes of two
+// recipe packs.
+func RecipePackDiffFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "TYPE",
+				JSONPath: "{ .ResourceType }",
+			},
+			{
+				Heading:  "RECIPE",
+				JSONPath: "{ .Name }",
+			},
+			{
+				Heading:  "CHANGE",
+				JSONPath: "{ .Change }",
+			},
+			{
+				Heading:  "FROM",
+				JSONPath: "{ .From }",
+			},
+			{
+				Heading:  "TO",
+				JSONPath: "{ .To }",
+			},
+		},
+	}
+}
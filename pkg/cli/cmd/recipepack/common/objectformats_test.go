@@ -0,0 +1,60 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"bytes"
+	"testing"
+
+	types "github.com/radius-project/radius/pkg/cli/cmd/recipepack"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/recipepack"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RecipePackFormat(t *testing.T) {
+	obj := types.RecipePackSummary{
+		Name:        "core-recipes",
+		Version:     "1.2.0",
+		Recipes:     2,
+		Description: "test-description",
+	}
+
+	buffer := &bytes.Buffer{}
+	err := output.Write(output.FormatTable, obj, buffer, RecipePackFormat())
+	require.NoError(t, err)
+
+	expected := "NAME          VERSION   RECIPES   DESCRIPTION\ncore-recipes  1.2.0     2         test-description\n"
+	require.Equal(t, expected, buffer.String())
+}
+
+func Test_RecipePackDiffFormat(t *testing.T) {
+	obj := recipepack.RecipeChange{
+		ResourceType: "test-type",
+		Name:         "test",
+		Change:       recipepack.ChangeModified,
+		From:         "bicep test-path:1.0",
+		To:           "bicep test-path:1.1",
+	}
+
+	buffer := &bytes.Buffer{}
+	err := output.Write(output.FormatTable, obj, buffer, RecipePackDiffFormat())
+	require.NoError(t, err)
+
+	expected := "TYPE       RECIPE    CHANGE    FROM                 TO\ntest-type  test      Modified  bicep test-path:1.0  bicep test-path:1.1\n"
+	require.Equal(t, expected, buffer.String())
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diff
+
+import (
+	"context"
+	"os"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/cmd/recipepack/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/recipepack"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad recipe-pack diff` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "diff [from] [to]",
+		Short: "Show the differences between the recipes of two recipe packs",
+		Long: `Show the differences between the recipes of two recipe packs.
+
+Each recipe pack is either the path of a recipe pack file, or the name or resource ID of a published recipe pack.
+The recipes that are added, removed or modified by the second recipe pack are listed.`,
+		Example: `
+# Compare a published recipe pack with a local recipe pack file before publishing it
+rad recipe-pack diff core-recipes ./core-recipes.yaml
+
+# Compare two published recipe packs
+rad recipe-pack diff core-recipes /planes/radius/local/resourceGroups/platform/providers/Applications.Core/recipePacks/core-recipes`,
+		Args: cobra.ExactArgs(2),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddOutputFlag(cmd)
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad recipe-pack diff` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+	Format            string
+
+	From string
+	To   string
+}
+
+// NewRunner creates a new instance of the `rad recipe-pack diff` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad recipe-pack diff` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	// Allow '--group' to override scope
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+	r.Format = format
+
+	r.From = args[0]
+	r.To = args[1]
+
+	return nil
+}
+
+// Run runs the `rad recipe-pack diff` command.
+func (r *Runner) Run(ctx context.Context) error {
+	from, err := r.load(ctx, r.From)
+	if err != nil {
+		return err
+	}
+
+	to, err := r.load(ctx, r.To)
+	if err != nil {
+		return err
+	}
+
+	changes := recipepack.Diff(from, to)
+	if len(changes) == 0 && r.Format == output.FormatTable {
+		r.Output.LogInfo("The recipes of recipe packs %q and %q are identical.", r.From, r.To)
+		return nil
+	}
+
+	return r.Output.WriteFormatted(r.Format, changes, common.RecipePackDiffFormat())
+}
+
+// load loads a recipe pack from a recipe pack file if the argument is the path of an existing file, or from the
+// published recipe pack with the name or resource ID otherwise.
+func (r *Runner) load(ctx context.Context, recipePackFileOrNameOrID string) (*recipepack.RecipePack, error) {
+	if info, err := os.Stat(recipePackFileOrNameOrID); err == nil && !info.IsDir() {
+		pack, err := recipepack.ReadFile(recipePackFileOrNameOrID)
+		if err != nil {
+			return nil, clierrors.MessageWithCause(err, "Failed to read the recipe pack file %q.", recipePackFileOrNameOrID)
+		}
+
+		return pack, nil
+	}
+
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	resource, err := client.GetRecipePack(ctx, recipePackFileOrNameOrID)
+	if clients.Is404Error(err) {
+		return nil, clierrors.Message("The recipe pack %q could not be found in workspace %q.", recipePackFileOrNameOrID, r.Workspace.Name)
+	} else if err != nil {
+		return nil, err
+	}
+
+	return recipepack.FromResource(resource), nil
+}
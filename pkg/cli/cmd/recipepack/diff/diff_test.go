@@ -0,0 +1,182 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diff
+
+import (
+	"context"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/recipepack/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/recipepack"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid Diff Command",
+			Input:         []string{"core-recipes", "testdata/core-recipes.yaml"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Diff Command with one recipe pack",
+			Input:         []string{"core-recipes"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	published := v20231001preview.RecipePackResource{
+		Name: to.Ptr("core-recipes"),
+		Properties: &v20231001preview.RecipePackProperties{
+			Version: to.Ptr("1.1.0"),
+			Recipes: map[string]map[string]v20231001preview.RecipePropertiesClassification{
+				"Applications.Datastores/redisCaches": {
+					"default": &v20231001preview.BicepRecipeProperties{
+						TemplateKind: to.Ptr("bicep"),
+						TemplatePath: to.Ptr("ghcr.io/my-org/recipes/redis:1.1.0"),
+					},
+					"legacy": &v20231001preview.BicepRecipeProperties{
+						TemplateKind: to.Ptr("bicep"),
+						TemplatePath: to.Ptr("ghcr.io/my-org/recipes/redis-legacy:1.0.0"),
+					},
+				},
+				"Applications.Datastores/sqlDatabases": {
+					"default": &v20231001preview.TerraformRecipeProperties{
+						TemplateKind:    to.Ptr("terraform"),
+						TemplatePath:    to.Ptr("Azure/sql/azurerm"),
+						TemplateVersion: to.Ptr("1.1.0"),
+						Parameters:      map[string]any{"sku": "Basic", "capacity": float64(5)},
+					},
+				},
+			},
+		},
+	}
+
+	t.Run("Diff published recipe pack and recipe pack file", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetRecipePack(gomock.Any(), "core-recipes").
+			Return(published, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:         &workspaces.Workspace{},
+			Format:            "table",
+			Output:            outputSink,
+			From:              "core-recipes",
+			To:                "testdata/core-recipes.yaml",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format: "table",
+				Obj: []recipepack.RecipeChange{
+					{
+						ResourceType: "Applications.Datastores/redisCaches",
+						Name:         "default",
+						Change:       recipepack.ChangeModified,
+						From:         "bicep ghcr.io/my-org/recipes/redis:1.1.0",
+						To:           "bicep ghcr.io/my-org/recipes/redis:1.2.0",
+					},
+					{
+						ResourceType: "Applications.Datastores/redisCaches",
+						Name:         "legacy",
+						Change:       recipepack.ChangeRemoved,
+						From:         "bicep ghcr.io/my-org/recipes/redis-legacy:1.0.0",
+					},
+				},
+				Options: common.RecipePackDiffFormat(),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Diff identical recipe packs", func(t *testing.T) {
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			Workspace: &workspaces.Workspace{},
+			Format:    "table",
+			Output:    outputSink,
+			From:      "testdata/core-recipes.yaml",
+			To:        "testdata/core-recipes.yaml",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "The recipes of recipe packs %q and %q are identical.",
+				Params: []any{"testdata/core-recipes.yaml", "testdata/core-recipes.yaml"},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Diff missing recipe pack", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetRecipePack(gomock.Any(), "missing").
+			Return(v20231001preview.RecipePackResource{}, radcli.Create404Error()).
+			Times(1)
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:         &workspaces.Workspace{Name: radcli.TestWorkspaceName},
+			Format:            "table",
+			Output:            &output.MockOutput{},
+			From:              "missing",
+			To:                "testdata/core-recipes.yaml",
+		}
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The recipe pack %q could not be found in workspace %q.", "missing", radcli.TestWorkspaceName), err)
+	})
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package list
+
+import (
+	"context"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	types "github.com/radius-project/radius/pkg/cli/cmd/recipepack"
+	"github.com/radius-project/radius/pkg/cli/cmd/recipepack/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid List Command",
+			Input:         []string{},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "List Command with fallback workspace",
+			Input:         []string{"--group", "test-group"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         radcli.LoadEmptyConfig(t),
+			},
+		},
+		{
+			Name:          "List Command with too many args",
+			Input:         []string{"foo"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	recipePacks := []v20231001preview.RecipePackResource{
+		{
+			Name: to.Ptr("storage-recipes"),
+			Properties: &v20231001preview.RecipePackProperties{
+				Version: to.Ptr("2.0.0"),
+				Recipes: map[string]map[string]v20231001preview.RecipePropertiesClassification{
+					"Applications.Core/volumes": {
+						"default": &v20231001preview.BicepRecipeProperties{},
+					},
+				},
+			},
+		},
+		{
+			Name: to.Ptr("core-recipes"),
+			Properties: &v20231001preview.RecipePackProperties{
+				Version:     to.Ptr("1.2.0"),
+				Description: to.Ptr("Recipes shared by all environments"),
+				Recipes: map[string]map[string]v20231001preview.RecipePropertiesClassification{
+					"Applications.Datastores/redisCaches": {
+						"default": &v20231001preview.BicepRecipeProperties{},
+						"large":   &v20231001preview.BicepRecipeProperties{},
+					},
+					"Applications.Datastores/sqlDatabases": {
+						"default": &v20231001preview.TerraformRecipeProperties{},
+					},
+				},
+			},
+		},
+	}
+
+	appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+	appManagementClient.EXPECT().
+		ListRecipePacks(gomock.Any()).
+		Return(recipePacks, nil).
+		Times(1)
+
+	outputSink := &output.MockOutput{}
+	runner := &Runner{
+		ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+		Workspace:         &workspaces.Workspace{},
+		Format:            "table",
+		Output:            outputSink,
+	}
+
+	err := runner.Run(context.Background())
+	require.NoError(t, err)
+
+	expected := []any{
+		output.FormattedOutput{
+			Format: "table",
+			Obj: []types.RecipePackSummary{
+				{Name: "core-recipes", Version: "1.2.0", Recipes: 3, Description: "Recipes shared by all environments"},
+				{Name: "storage-recipes", Version: "2.0.0", Recipes: 1},
+			},
+			Options: common.RecipePackFormat(),
+		},
+	}
+	require.Equal(t, expected, outputSink.Writes)
+}
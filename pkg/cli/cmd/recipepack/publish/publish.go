@@ -0,0 +1,173 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publish
+
+import (
+	"context"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	types "github.com/radius-project/radius/pkg/cli/cmd/recipepack"
+	"github.com/radius-project/radius/pkg/cli/cmd/recipepack/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/recipepack"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad recipe-pack publish` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "publish [file]",
+		Short: "Publish a recipe pack",
+		Long: `Publish a recipe pack from a recipe pack file.
+
+A recipe pack is a named, versioned collection of recipes. Environments reference a version of a recipe pack by the
+resource ID and the version of the pack in the 'recipePacks' property, and the recipes of that version are registered
+to the environments as a unit.
+
+The recipe pack file is a YAML or JSON file defining the name, the version and the recipes of the pack:
+
+  name: core-recipes
+  version: 1.2.0
+  description: Recipes shared by all environments
+  recipes:
+    Applications.Datastores/redisCaches:
+      default:
+        templateKind: bicep
+        templatePath: ghcr.io/my-org/recipes/redis:1.2.0
+
+The recipes of a published version cannot be changed. Publish changes to the recipes as a new version of the pack.
+The published versions are kept, so environments keep using the recipes of the version they reference until they are
+updated to reference the new version.`,
+		Example: `
+# Publish a recipe pack to the current resource group
+rad recipe-pack publish ./core-recipes.yaml
+
+# Publish a recipe pack to the specified resource group
+rad recipe-pack publish ./core-recipes.yaml --group my-group`,
+		Args: cobra.ExactArgs(1),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddOutputFlag(cmd)
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad recipe-pack publish` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+	Format            string
+
+	FilePath   string
+	RecipePack *recipepack.RecipePack
+}
+
+// NewRunner creates a new instance of the `rad recipe-pack publish` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad recipe-pack publish` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	// Allow '--group' to override scope
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+	r.Format = format
+
+	r.FilePath = args[0]
+	r.RecipePack, err = recipepack.ReadFile(r.FilePath)
+	if err != nil {
+		return clierrors.MessageWithCause(err, "Failed to read the recipe pack file %q.", r.FilePath)
+	}
+
+	return nil
+}
+
+// Run runs the `rad recipe-pack publish` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	// The recipes of a published version are immutable. Check before publishing so we can show the changes
+	// that require a new version.
+	existing, err := client.GetRecipePack(ctx, r.RecipePack.Name)
+	if err != nil && !clients.Is404Error(err) {
+		return err
+	} else if err == nil && existing.Properties != nil && to.String(existing.Properties.Version) == r.RecipePack.Version {
+		changes := recipepack.Diff(recipepack.FromResource(existing), r.RecipePack)
+		if len(changes) > 0 {
+			err = r.Output.WriteFormatted(output.FormatTable, changes, common.RecipePackDiffFormat())
+			if err != nil {
+				return err
+			}
+
+			return clierrors.Message("Recipe pack %q version %q is already published with different recipes. Publish the changes as a new version of the recipe pack.", r.RecipePack.Name, r.RecipePack.Version)
+		}
+	}
+
+	r.Output.LogInfo("Publishing recipe pack %q version %q...", r.RecipePack.Name, r.RecipePack.Version)
+
+	resource := r.RecipePack.ToResource()
+	err = client.CreateOrUpdateRecipePack(ctx, r.RecipePack.Name, resource)
+	if err != nil {
+		return clierrors.MessageWithCause(err, "Failed to publish recipe pack %q.", r.RecipePack.Name)
+	}
+
+	resource.Name = to.Ptr(r.RecipePack.Name)
+	err = r.Output.WriteFormatted(r.Format, types.NewRecipePackSummary(*resource), common.RecipePackFormat())
+	if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("Successfully published recipe pack %q version %q.", r.RecipePack.Name, r.RecipePack.Version)
+
+	return nil
+}
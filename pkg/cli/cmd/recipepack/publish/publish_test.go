@@ -0,0 +1,216 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publish
+
+import (
+	"context"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	types "github.com/radius-project/radius/pkg/cli/cmd/recipepack"
+	"github.com/radius-project/radius/pkg/cli/cmd/recipepack/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/recipepack"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid Publish Command",
+			Input:         []string{"testdata/core-recipes.yaml"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "core-recipes", r.RecipePack.Name)
+				require.Equal(t, "1.2.0", r.RecipePack.Version)
+			},
+		},
+		{
+			Name:          "Publish Command with missing file",
+			Input:         []string{"testdata/missing.yaml"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Publish Command without file",
+			Input:         []string{},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	newRunner := func(t *testing.T, client clients.ApplicationsManagementClient, outputSink *output.MockOutput) *Runner {
+		pack, err := recipepack.ReadFile("testdata/core-recipes.yaml")
+		require.NoError(t, err)
+
+		return &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: client},
+			Workspace:         &workspaces.Workspace{},
+			Format:            "table",
+			Output:            outputSink,
+			RecipePack:        pack,
+		}
+	}
+
+	expectedSummary := types.RecipePackSummary{
+		Name:        "core-recipes",
+		Version:     "1.2.0",
+		Recipes:     2,
+		Description: "Recipes shared by all environments",
+	}
+
+	t.Run("Publish new recipe pack", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetRecipePack(gomock.Any(), "core-recipes").
+			Return(v20231001preview.RecipePackResource{}, radcli.Create404Error()).
+			Times(1)
+		appManagementClient.EXPECT().
+			CreateOrUpdateRecipePack(gomock.Any(), "core-recipes", gomock.Any()).
+			DoAndReturn(func(ctx context.Context, name string, resource *v20231001preview.RecipePackResource) error {
+				require.Equal(t, "1.2.0", to.String(resource.Properties.Version))
+				require.Len(t, resource.Properties.Recipes, 2)
+				return nil
+			}).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := newRunner(t, appManagementClient, outputSink)
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Publishing recipe pack %q version %q...",
+				Params: []any{"core-recipes", "1.2.0"},
+			},
+			output.FormattedOutput{
+				Format:  "table",
+				Obj:     expectedSummary,
+				Options: common.RecipePackFormat(),
+			},
+			output.LogOutput{
+				Format: "Successfully published recipe pack %q version %q.",
+				Params: []any{"core-recipes", "1.2.0"},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Publish new version of recipe pack", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetRecipePack(gomock.Any(), "core-recipes").
+			Return(v20231001preview.RecipePackResource{
+				Name: to.Ptr("core-recipes"),
+				Properties: &v20231001preview.RecipePackProperties{
+					Version: to.Ptr("1.1.0"),
+				},
+			}, nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			CreateOrUpdateRecipePack(gomock.Any(), "core-recipes", gomock.Any()).
+			Return(nil).
+			Times(1)
+
+		runner := newRunner(t, appManagementClient, &output.MockOutput{})
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+	})
+
+	t.Run("Publish published version with different recipes", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetRecipePack(gomock.Any(), "core-recipes").
+			Return(v20231001preview.RecipePackResource{
+				Name: to.Ptr("core-recipes"),
+				Properties: &v20231001preview.RecipePackProperties{
+					Version: to.Ptr("1.2.0"),
+					Recipes: map[string]map[string]v20231001preview.RecipePropertiesClassification{
+						"Applications.Datastores/redisCaches": {
+							"default": &v20231001preview.BicepRecipeProperties{
+								TemplateKind: to.Ptr("bicep"),
+								TemplatePath: to.Ptr("ghcr.io/my-org/recipes/redis:1.1.0"),
+							},
+						},
+					},
+				},
+			}, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := newRunner(t, appManagementClient, outputSink)
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("Recipe pack %q version %q is already published with different recipes. Publish the changes as a new version of the recipe pack.", "core-recipes", "1.2.0"), err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format: "table",
+				Obj: []recipepack.RecipeChange{
+					{
+						ResourceType: "Applications.Datastores/redisCaches",
+						Name:         "default",
+						Change:       recipepack.ChangeModified,
+						From:         "bicep ghcr.io/my-org/recipes/redis:1.1.0",
+						To:           "bicep ghcr.io/my-org/recipes/redis:1.2.0",
+					},
+					{
+						ResourceType: "Applications.Datastores/sqlDatabases",
+						Name:         "default",
+						Change:       recipepack.ChangeAdded,
+						To:           "terraform Azure/sql/azurerm@1.1.0",
+					},
+				},
+				Options: common.RecipePackDiffFormat(),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+}
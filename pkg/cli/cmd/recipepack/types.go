@@ -0,0 +1,46 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipepack
+
+import (
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+)
+
+// RecipePackSummary is the summary of a published recipe pack displayed by the `rad recipe-pack` commands.
+type RecipePackSummary struct {
+	Name        string `json:"name"`
+	Version     string `json:"version"`
+	Recipes     int    `json:"recipes"`
+	Description string `json:"description,omitempty"`
+}
+
+// NewRecipePackSummary returns the summary of a recipe pack resource.
+func NewRecipePackSummary(resource corerp.RecipePackResource) RecipePackSummary {
+	summary := RecipePackSummary{Name: to.String(resource.Name)}
+	if resource.Properties == nil {
+		return summary
+	}
+
+	summary.Version = to.String(resource.Properties.Version)
+	summary.Description = to.String(resource.Properties.Description)
+	for _, recipes := range resource.Properties.Recipes {
+		summary.Recipes += len(recipes)
+	}
+
+	return summary
+}
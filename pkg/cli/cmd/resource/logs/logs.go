@@ -0,0 +1,238 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logs
+
+import (
+	"context"
+	"time"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/spf13/cobra"
+)
+
+const (
+	recipeFlag    = "recipe"
+	operationFlag = "operation"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad resource logs` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "logs [resourceType] [resourceName] --recipe",
+		Short: "Show the recipe logs of a Radius resource",
+		Long: `Show the logs of the most recent recipe executions of a Radius resource.
+
+The logs include the output of Terraform, the operations of Bicep deployments and the actions of Helm, and are kept for the most recent operations on the resource. They can be used to debug a failed recipe without access to the cluster.`,
+		Example: `
+# show the recipe logs of a resource
+rad resource logs applications.datastores/rediscaches redis --recipe
+
+# show the recipe logs of a specific operation on a resource
+rad resource logs applications.datastores/rediscaches redis --recipe --operation 00000000-0000-0000-0000-000000000000
+
+# show the recipe logs of a resource in JSON format
+rad resource logs applications.datastores/rediscaches redis --recipe --output json
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddOutputFlag(cmd)
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	cmd.Flags().Bool(recipeFlag, false, "Show the logs of the recipe executions of the resource")
+	cmd.Flags().String(operationFlag, "", "The ID of the operation to show the recipe logs of")
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad resource logs` command.
+type Runner struct {
+	ConfigHolder                   *framework.ConfigHolder
+	ConnectionFactory              connections.Factory
+	Output                         output.Interface
+	Workspace                      *workspaces.Workspace
+	FullyQualifiedResourceTypeName string
+	ResourceName                   string
+	OperationID                    string
+	Format                         string
+}
+
+// NewRunner creates a new instance of the `rad resource logs` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConnectionFactory: factory.GetConnectionFactory(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad resource logs` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	resourceProviderName, resourceTypeName, resourceName, err := cli.RequireFullyQualifiedResourceTypeAndName(args)
+	if err != nil {
+		return err
+	}
+	r.FullyQualifiedResourceTypeName = resourceProviderName + "/" + resourceTypeName
+	r.ResourceName = resourceName
+
+	// Only the recipe logs are available for now. The flag leaves room for other kinds of logs.
+	recipe, err := cmd.Flags().GetBool(recipeFlag)
+	if err != nil {
+		return err
+	}
+	if !recipe {
+		return clierrors.Message("Specify the kind of logs to show. Use --recipe to show the logs of the recipe executions of the resource.")
+	}
+
+	r.OperationID, err = cmd.Flags().GetString(operationFlag)
+	if err != nil {
+		return err
+	}
+
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+	r.Format = format
+
+	return nil
+}
+
+// Run runs the `rad resource logs` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	resource, err := client.GetResource(ctx, r.FullyQualifiedResourceTypeName, r.ResourceName)
+	if err != nil {
+		return err
+	}
+
+	environmentID, _ := resource.Properties["environment"].(string)
+	if environmentID == "" {
+		return clierrors.Message("The resource %q does not belong to an environment and has no recipe logs.", r.ResourceName)
+	}
+
+	request := v20231001preview.RecipeLogsRequest{ResourceID: resource.ID}
+	if r.OperationID != "" {
+		request.OperationID = to.Ptr(r.OperationID)
+	}
+
+	logs, err := client.GetRecipeLogs(ctx, environmentID, request)
+	if clients.Is404Error(err) && r.OperationID != "" {
+		return clierrors.Message("The recipe logs of operation %q were not found for resource %q.", r.OperationID, r.ResourceName)
+	} else if err != nil {
+		return err
+	}
+
+	if r.Format != output.FormatTable {
+		return r.Output.WriteFormatted(r.Format, logs.Operations, output.FormatterOptions{})
+	}
+
+	if len(logs.Operations) == 0 {
+		r.Output.LogInfo("No recipe logs found for resource %q.", r.ResourceName)
+		return nil
+	}
+
+	for i, operation := range logs.Operations {
+		if i > 0 {
+			r.Output.LogInfo("")
+		}
+
+		r.Output.LogInfo("Operation %s (%s) of recipe %q: %s", to.String(operation.OperationID), to.String(operation.OperationType), to.String(operation.RecipeName), to.String(operation.Status))
+		if to.Bool(operation.Truncated) {
+			r.Output.LogInfo("The oldest entries were dropped because the logs exceeded their size limit.")
+		}
+
+		rows := []logEntry{}
+		for _, entry := range operation.Entries {
+			rows = append(rows, logEntry{
+				Time:    formatTime(entry.Timestamp),
+				Source:  to.String(entry.Source),
+				Message: to.String(entry.Message),
+			})
+		}
+
+		err = r.Output.WriteFormatted(output.FormatTable, rows, logEntryFormat())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// logEntry is a row of the table output of `rad resource logs --recipe`.
+type logEntry struct {
+	Time    string
+	Source  string
+	Message string
+}
+
+// logEntryFormat returns the table format of the entries of the recipe logs.
+func logEntryFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "TIME",
+				JSONPath: "{ .Time }",
+			},
+			{
+				Heading:  "SOURCE",
+				JSONPath: "{ .Source }",
+			},
+			{
+				Heading:  "MESSAGE",
+				JSONPath: "{ .Message }",
+			},
+		},
+	}
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,222 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const environmentID = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/test-env"
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid Logs Command",
+			Input:         []string{"applications.datastores/rediscaches", "redis", "--recipe"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Valid Logs Command with operation",
+			Input:         []string{"applications.datastores/rediscaches", "redis", "--recipe", "--operation", "operation-id"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Logs Command without recipe flag",
+			Input:         []string{"applications.datastores/rediscaches", "redis"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Logs Command with invalid resource type",
+			Input:         []string{"invalidResourceType", "redis", "--recipe"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Logs Command with insufficient args",
+			Input:         []string{"applications.datastores/rediscaches", "--recipe"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	timestamp := time.Date(2023, 10, 1, 10, 0, 0, 0, time.UTC)
+
+	setup := func(t *testing.T, request v20231001preview.RecipeLogsRequest, response v20231001preview.RecipeLogsResponse, err error) *clients.MockApplicationsManagementClient {
+		resource := radcli.CreateResource("applications.datastores/rediscaches", "redis")
+		resource.Properties = map[string]any{"environment": environmentID}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(gomock.NewController(t))
+		appManagementClient.EXPECT().
+			GetResource(gomock.Any(), "applications.datastores/rediscaches", "redis").
+			Return(resource, nil).Times(1)
+		request.ResourceID = resource.ID
+		appManagementClient.EXPECT().
+			GetRecipeLogs(gomock.Any(), environmentID, request).
+			Return(response, err).Times(1)
+
+		return appManagementClient
+	}
+
+	logs := v20231001preview.RecipeLogsResponse{
+		Operations: []*v20231001preview.RecipeOperationLog{
+			{
+				OperationID:   to.Ptr("operation-id"),
+				OperationType: to.Ptr("APPLICATIONS.DATASTORES/REDISCACHES|PUT"),
+				RecipeName:    to.Ptr("default"),
+				Status:        to.Ptr("Failed"),
+				Truncated:     to.Ptr(true),
+				Entries: []*v20231001preview.RecipeLogEntry{
+					{
+						Timestamp: to.Ptr(timestamp),
+						Source:    to.Ptr("stderr"),
+						Message:   to.Ptr("Error: creating ElastiCache Cluster: InvalidParameterValue"),
+					},
+				},
+			},
+		},
+	}
+
+	t.Run("table", func(t *testing.T) {
+		appManagementClient := setup(t, v20231001preview.RecipeLogsRequest{}, logs, nil)
+		outputSink := &output.MockOutput{}
+
+		runner := &Runner{
+			ConnectionFactory:              &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:                         outputSink,
+			Workspace:                      &workspaces.Workspace{},
+			FullyQualifiedResourceTypeName: "applications.datastores/rediscaches",
+			ResourceName:                   "redis",
+			Format:                         "table",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Operation %s (%s) of recipe %q: %s",
+				Params: []any{"operation-id", "APPLICATIONS.DATASTORES/REDISCACHES|PUT", "default", "Failed"},
+			},
+			output.LogOutput{
+				Format: "The oldest entries were dropped because the logs exceeded their size limit.",
+			},
+			output.FormattedOutput{
+				Format: "table",
+				Obj: []logEntry{
+					{
+						Time:    "2023-10-01T10:00:00Z",
+						Source:  "stderr",
+						Message: "Error: creating ElastiCache Cluster: InvalidParameterValue",
+					},
+				},
+				Options: logEntryFormat(),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		appManagementClient := setup(t, v20231001preview.RecipeLogsRequest{OperationID: to.Ptr("operation-id")}, logs, nil)
+		outputSink := &output.MockOutput{}
+
+		runner := &Runner{
+			ConnectionFactory:              &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:                         outputSink,
+			Workspace:                      &workspaces.Workspace{},
+			FullyQualifiedResourceTypeName: "applications.datastores/rediscaches",
+			ResourceName:                   "redis",
+			OperationID:                    "operation-id",
+			Format:                         "json",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format:  "json",
+				Obj:     logs.Operations,
+				Options: output.FormatterOptions{},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("no logs", func(t *testing.T) {
+		appManagementClient := setup(t, v20231001preview.RecipeLogsRequest{}, v20231001preview.RecipeLogsResponse{}, nil)
+		outputSink := &output.MockOutput{}
+
+		runner := &Runner{
+			ConnectionFactory:              &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:                         outputSink,
+			Workspace:                      &workspaces.Workspace{},
+			FullyQualifiedResourceTypeName: "applications.datastores/rediscaches",
+			ResourceName:                   "redis",
+			Format:                         "table",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "No recipe logs found for resource %q.",
+				Params: []any{"redis"},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+}
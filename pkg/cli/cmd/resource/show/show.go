@@ -18,6 +18,8 @@ package show
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/radius-project/radius/pkg/cli"
 	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
@@ -26,6 +28,7 @@ import (
 	"github.com/radius-project/radius/pkg/cli/objectformats"
 	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 	"github.com/spf13/cobra"
 )
 
@@ -41,7 +44,9 @@ func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 	cmd := &cobra.Command{
 		Use:   "show [resourceType] [resourceName]",
 		Short: "Show Radius resource details",
-		Long:  "Show details of the specified Radius resource",
+		Long: `Show details of the specified Radius resource.
+
+For resources provisioned by a recipe, drift of the cloud resources deployed by the recipe is reported when drift detection is enabled.`,
 		Example: `
 sample list of resourceType: Applications.Core/containers, Applications.Core/gateways, Applications.Dapr/daprPubSubBrokers, Applications.Core/extenders, Applications.Datastores/mongoDatabases, Applications.Messaging/rabbitMQMessageQueues, Applications.Datastores/redisCaches, Applications.Datastores/sqlDatabases, Applications.Dapr/daprStateStores, Applications.Dapr/daprSecretStores
 
@@ -137,5 +142,48 @@ func (r *Runner) Run(ctx context.Context) error {
 		return err
 	}
 
-	return r.Output.WriteFormatted(r.Format, resourceDetails, objectformats.GetGenericResourceTableFormat())
+	err = r.Output.WriteFormatted(r.Format, resourceDetails, objectformats.GetGenericResourceTableFormat())
+	if err != nil {
+		return err
+	}
+
+	// The JSON output already includes the conditions of the resource.
+	if r.Format == output.FormatTable {
+		if message, drifted := driftMessage(resourceDetails.Properties); drifted {
+			r.Output.LogInfo("")
+			r.Output.LogInfo("Drift detected: %s", message)
+		}
+	}
+
+	return nil
+}
+
+// driftMessage returns the message of the Drifted condition of the resource, and whether the resources deployed
+// by the recipe of the resource have drifted from the recipe.
+func driftMessage(properties map[string]any) (string, bool) {
+	status, ok := properties["status"].(map[string]any)
+	if !ok {
+		return "", false
+	}
+
+	conditions, ok := status["conditions"].([]any)
+	if !ok {
+		return "", false
+	}
+
+	for _, c := range conditions {
+		condition, ok := c.(map[string]any)
+		if !ok || !strings.EqualFold(fmt.Sprint(condition["type"]), rpv1.ConditionDrifted) {
+			continue
+		}
+
+		if !strings.EqualFold(fmt.Sprint(condition["status"]), string(rpv1.ConditionTrue)) {
+			return "", false
+		}
+
+		message, _ := condition["message"].(string)
+		return message, true
+	}
+
+	return "", false
 }
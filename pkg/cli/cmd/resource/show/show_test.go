@@ -127,4 +127,58 @@ func Test_Run(t *testing.T) {
 		}
 		require.Equal(t, expected, outputSink.Writes)
 	})
+
+	t.Run("Validate rad resource show reports drift", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		resource := radcli.CreateResource("applications.datastores/rediscaches", "redis")
+		resource.Properties = map[string]any{
+			"status": map[string]any{
+				"conditions": []any{
+					map[string]any{
+						"type":    "Drifted",
+						"status":  "True",
+						"reason":  "ResourcesChanged",
+						"message": "1 cloud resource(s) deployed by the recipe differ from the recipe: redis (update).",
+					},
+				},
+			},
+		}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetResource(gomock.Any(), "applications.datastores/rediscaches", "redis").
+			Return(resource, nil).Times(1)
+
+		outputSink := &output.MockOutput{}
+
+		runner := &Runner{
+			ConnectionFactory:              &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:                         outputSink,
+			Workspace:                      &workspaces.Workspace{},
+			FullyQualifiedResourceTypeName: "applications.datastores/rediscaches",
+			ResourceName:                   "redis",
+			Format:                         "table",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format:  "table",
+				Obj:     resource,
+				Options: objectformats.GetGenericResourceTableFormat(),
+			},
+			output.LogOutput{
+				Format: "",
+			},
+			output.LogOutput{
+				Format: "Drift detected: %s",
+				Params: []any{"1 cloud resource(s) deployed by the recipe differ from the recipe: redis (update)."},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
 }
@@ -33,7 +33,7 @@ import (
 )
 
 const (
-	deleteConfirmation = "Are you sure you want to delete resource provider %q? This will delete all resource types and resources defined by the resource provider."
+	deleteConfirmation = "Are you sure you want to delete resource provider %q? This will delete all resource types defined by the resource provider."
 )
 
 // NewCommand creates an instance of the `rad resource-provider delete` command and runner.
@@ -49,7 +49,7 @@ Resource providers are the entities that implement resource types such as 'Appli
 
 Deleting a resource provider will delete all resource types that it defines. For example, deleting 'Applications.Core' will delete 'Applications.Core/containers' and all other resource types defined by 'Applications.Core'.
 
-Deleting a resource type will delete all resources of the types. For example, deleting 'Applications.Core/containers' will delete all containers.`,
+A resource provider cannot be deleted while resources of its resource types exist. Delete the resources first, for example with 'rad resource-type delete --cascade'.`,
 		Example: `
 # Delete a resource provider
 rad resource-provider delete Applications.Core
@@ -34,6 +34,8 @@ type ResourceType struct {
 	ResourceProviderNamespace string
 	// APIVersions is the list of API versions supported by the resource type.
 	APIVersions []string
+	// Capabilities is the list of capabilities of the resource type.
+	Capabilities []string
 }
 
 // ResourceTypesForProvider returns a list of resource types for a given provider.
@@ -49,6 +51,12 @@ func ResourceTypesForProvider(provider *v20231001preview.ResourceProviderSummary
 			rt.APIVersions = append(rt.APIVersions, version)
 		}
 
+		for _, capability := range resourceType.Capabilities {
+			if capability != nil {
+				rt.Capabilities = append(rt.Capabilities, *capability)
+			}
+		}
+
 		resourceTypes = append(resourceTypes, rt)
 	}
 	return resourceTypes
@@ -74,6 +82,17 @@ func GetResourceTypeTableFormat() output.FormatterOptions {
 	}
 }
 
+// GetResourceTypeDetailsTableFormat returns the fields to output from a resource type object when
+// showing the details of a single resource type.
+func GetResourceTypeDetailsTableFormat() output.FormatterOptions {
+	options := GetResourceTypeTableFormat()
+	options.Columns = append(options.Columns, output.Column{
+		Heading:  "CAPABILITIES",
+		JSONPath: "{ .Capabilities }",
+	})
+	return options
+}
+
 // GetResourceTypeDetails fetches the details of a resource type from the resource provider.
 func GetResourceTypeDetails(ctx context.Context, resourceProviderName string, resourceTypeName string, client clients.ApplicationsManagementClient) (ResourceType, error) {
 	resourceProvider, err := client.GetResourceProviderSummary(ctx, "local", resourceProviderName)
@@ -33,7 +33,10 @@ import (
 )
 
 const (
-	deleteConfirmation = "Are you sure you want to delete resource type %q? This will delete all resources of the specified resource type."
+	deleteConfirmation        = "Are you sure you want to delete resource type %q?"
+	cascadeDeleteConfirmation = "Are you sure you want to delete resource type %q? This will delete %d resource(s) of the resource type, including the infrastructure deployed by their recipes."
+
+	cascadeFlag = "cascade"
 )
 
 // NewCommand creates an instance of the `rad resource-provider delete` command and runner.
@@ -47,13 +50,16 @@ func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 		
 Resource types are the entities that implement resource types such as 'Applications.Core/containers'. Each resource type can define multiple API versions, and each API version defines a schema that resource instances conform to. Resource providers can be created and deleted by users.
 
-Deleting a resource type will delete all resources of the specified resource type. For example, deleting 'Applications.Core/containers' will delete all containers.`,
+A resource type cannot be deleted while resources of the resource type exist. Use the --cascade flag to delete the resources first. Deleting a resource also deletes the infrastructure deployed by its recipe.`,
 		Example: `
 # Delete a resource type
 rad resource-type delete Applications.Core/containers
 
 # Delete a resource type (bypass confirmation)
-rad resource-type delete Applications.Core/containers --yes`,
+rad resource-type delete Applications.Core/containers --yes
+
+# Delete a resource type and all resources of the resource type
+rad resource-type delete Applications.Core/containers --cascade`,
 		Args: cobra.ExactArgs(1),
 		RunE: framework.RunCommand(runner),
 	}
@@ -61,6 +67,7 @@ rad resource-type delete Applications.Core/containers --yes`,
 	commonflags.AddConfirmationFlag(cmd)
 	commonflags.AddOutputFlag(cmd)
 	commonflags.AddWorkspaceFlag(cmd)
+	cmd.Flags().BoolVar(&runner.Cascade, cascadeFlag, false, "Delete all resources of the resource type before deleting the resource type")
 
 	return cmd, runner
 }
@@ -75,6 +82,7 @@ type Runner struct {
 	Workspace         *workspaces.Workspace
 
 	Confirm                   bool
+	Cascade                   bool
 	ResourceTypeName          string
 	ResourceProviderNamespace string
 	ResourceTypeSuffix        string
@@ -125,9 +133,24 @@ func (r *Runner) Run(ctx context.Context) error {
 		return err
 	}
 
+	// The resources are deleted before the resource type, because the resource type cannot be deleted while
+	// resources of the resource type exist.
+	instances := []string{}
+	if r.Cascade {
+		instances, err = r.listInstances(ctx, client)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Prompt user to confirm deletion
 	if !r.Confirm {
-		confirmed, err := prompt.YesOrNoPrompt(fmt.Sprintf(deleteConfirmation, r.ResourceTypeName), prompt.ConfirmNo, r.InputPrompter)
+		message := fmt.Sprintf(deleteConfirmation, r.ResourceTypeName)
+		if len(instances) > 0 {
+			message = fmt.Sprintf(cascadeDeleteConfirmation, r.ResourceTypeName, len(instances))
+		}
+
+		confirmed, err := prompt.YesOrNoPrompt(message, prompt.ConfirmNo, r.InputPrompter)
 		if err != nil {
 			return err
 		}
@@ -136,6 +159,14 @@ func (r *Runner) Run(ctx context.Context) error {
 		}
 	}
 
+	for _, instance := range instances {
+		r.Output.LogInfo("Deleting resource %q...", instance)
+		_, err := client.DeleteResource(ctx, r.ResourceTypeName, instance)
+		if err != nil && !clients.Is404Error(err) {
+			return clierrors.MessageWithCause(err, "Failed to delete resource %q.", instance)
+		}
+	}
+
 	deleted, err := client.DeleteResourceType(ctx, "local", r.ResourceProviderNamespace, r.ResourceTypeSuffix)
 	if clients.Is404Error(err) {
 		return clierrors.Message("The resource type %q was not found or has been deleted.", r.ResourceTypeName)
@@ -151,3 +182,35 @@ func (r *Runner) Run(ctx context.Context) error {
 
 	return nil
 }
+
+// listInstances lists the IDs of the resources of the resource type in every resource group of the plane.
+func (r *Runner) listInstances(ctx context.Context, client clients.ApplicationsManagementClient) ([]string, error) {
+	groups, err := client.ListResourceGroups(ctx, "local")
+	if err != nil {
+		return nil, err
+	}
+
+	instances := []string{}
+	for _, group := range groups {
+		workspace := *r.Workspace
+		workspace.Scope = *group.ID
+
+		groupClient, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, workspace)
+		if err != nil {
+			return nil, err
+		}
+
+		resources, err := groupClient.ListResourcesOfType(ctx, r.ResourceTypeName)
+		if clients.Is404Error(err) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		for _, resource := range resources {
+			instances = append(instances, *resource.ID)
+		}
+	}
+
+	return instances, nil
+}
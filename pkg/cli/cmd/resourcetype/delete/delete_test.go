@@ -18,15 +18,20 @@ package delete
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 
 	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
 	"github.com/radius-project/radius/pkg/cli/connections"
 	"github.com/radius-project/radius/pkg/cli/framework"
 	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/cli/prompt"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
 	"github.com/radius-project/radius/test/radcli"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -202,4 +207,125 @@ func Test_Run(t *testing.T) {
 
 		require.Equal(t, expected, outputSink.Writes)
 	})
+	t.Run("Success: Cascade", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		resourceIDs := []string{
+			"/planes/radius/local/resourceGroups/group1/providers/Applications.Test/testResources/resource1",
+			"/planes/radius/local/resourceGroups/group2/providers/Applications.Test/testResources/resource2",
+		}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			ListResourceGroups(gomock.Any(), "local").
+			Return([]v20231001preview.ResourceGroupResource{
+				{ID: to.Ptr("/planes/radius/local/resourceGroups/group1")},
+				{ID: to.Ptr("/planes/radius/local/resourceGroups/group2")},
+			}, nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			ListResourcesOfType(gomock.Any(), "Applications.Test/testResources").
+			Return([]generated.GenericResource{{ID: to.Ptr(resourceIDs[0])}}, nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			ListResourcesOfType(gomock.Any(), "Applications.Test/testResources").
+			Return([]generated.GenericResource{{ID: to.Ptr(resourceIDs[1])}}, nil).
+			Times(1)
+		for _, id := range resourceIDs {
+			appManagementClient.EXPECT().
+				DeleteResource(gomock.Any(), "Applications.Test/testResources", id).
+				Return(true, nil).
+				Times(1)
+		}
+		appManagementClient.EXPECT().
+			DeleteResourceType(gomock.Any(), "local", "Applications.Test", "testResources").
+			Return(true, nil).
+			Times(1)
+
+		promptMock := prompt.NewMockInterface(ctrl)
+		promptMock.EXPECT().
+			GetListInput([]string{prompt.ConfirmNo, prompt.ConfirmYes}, fmt.Sprintf(cascadeDeleteConfirmation, "Applications.Test/testResources", 2)).
+			Return(prompt.ConfirmYes, nil).
+			Times(1)
+
+		workspace := &workspaces.Workspace{
+			Connection: map[string]any{
+				"kind":    "kubernetes",
+				"context": "kind-kind",
+			},
+			Name:  "kind-kind",
+			Scope: "/planes/radius/local/resourceGroups/test-group",
+		}
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory:         &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			InputPrompter:             promptMock,
+			Workspace:                 workspace,
+			Format:                    "table",
+			Output:                    outputSink,
+			ResourceTypeName:          "Applications.Test/testResources",
+			ResourceProviderNamespace: "Applications.Test",
+			ResourceTypeSuffix:        "testResources",
+			Cascade:                   true,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Deleting resource %q...",
+				Params: []any{resourceIDs[0]},
+			},
+			output.LogOutput{
+				Format: "Deleting resource %q...",
+				Params: []any{resourceIDs[1]},
+			},
+			output.LogOutput{
+				Format: "Resource type %q deleted.",
+				Params: []any{"Applications.Test/testResources"},
+			},
+		}
+
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Error: Cascade fails to delete resource", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		resourceID := "/planes/radius/local/resourceGroups/group1/providers/Applications.Test/testResources/resource1"
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			ListResourceGroups(gomock.Any(), "local").
+			Return([]v20231001preview.ResourceGroupResource{
+				{ID: to.Ptr("/planes/radius/local/resourceGroups/group1")},
+			}, nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			ListResourcesOfType(gomock.Any(), "Applications.Test/testResources").
+			Return([]generated.GenericResource{{ID: to.Ptr(resourceID)}}, nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			DeleteResource(gomock.Any(), "Applications.Test/testResources", resourceID).
+			Return(false, errors.New("recipe failed")).
+			Times(1)
+
+		runner := &Runner{
+			ConnectionFactory:         &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:                 &workspaces.Workspace{Scope: "/planes/radius/local/resourceGroups/test-group"},
+			Format:                    "table",
+			Output:                    &output.MockOutput{},
+			ResourceTypeName:          "Applications.Test/testResources",
+			ResourceProviderNamespace: "Applications.Test",
+			ResourceTypeSuffix:        "testResources",
+			Confirm:                   true,
+			Cascade:                   true,
+		}
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.MessageWithCause(errors.New("recipe failed"), "Failed to delete resource %q.", resourceID), err)
+	})
 }
@@ -107,7 +107,7 @@ func (r *Runner) Run(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	err = r.Output.WriteFormatted(r.Format, resourceTypeDetails, common.GetResourceTypeTableFormat())
+	err = r.Output.WriteFormatted(r.Format, resourceTypeDetails, common.GetResourceTypeDetailsTableFormat())
 	if err != nil {
 		return err
 	}
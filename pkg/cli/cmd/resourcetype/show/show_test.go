@@ -77,6 +77,7 @@ func Test_Run(t *testing.T) {
 				APIVersions: map[string]map[string]any{
 					"2023-10-01-preview": {},
 				},
+				Capabilities: []*string{to.Ptr("SupportsRecipes"), to.Ptr("ExposesSecrets")},
 			},
 		},
 	}
@@ -89,6 +90,7 @@ func Test_Run(t *testing.T) {
 			Name:                      "Applications.Test/exampleResources",
 			ResourceProviderNamespace: "Applications.Test",
 			APIVersions:               []string{"2023-10-01-preview"},
+			Capabilities:              []string{"SupportsRecipes", "ExposesSecrets"},
 		}
 
 		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
@@ -123,7 +125,7 @@ func Test_Run(t *testing.T) {
 			output.FormattedOutput{
 				Format:  "table",
 				Obj:     resourceType,
-				Options: common.GetResourceTypeTableFormat(),
+				Options: common.GetResourceTypeDetailsTableFormat(),
 			},
 		}
 
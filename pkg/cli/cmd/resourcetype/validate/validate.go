@@ -0,0 +1,155 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"context"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/manifest"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/spf13/cobra"
+)
+
+const (
+	strictFlag = "strict"
+)
+
+// NewCommand creates an instance of the `rad resource-type validate` command and runner.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "validate",
+		Short: "Validate a resource type manifest",
+		Long: `Validate a resource type manifest without connecting to Radius.
+
+The manifest is checked using the same rules as 'rad resource-type create'. In addition, the schema of each API version must be a structural OpenAPI schema, where every field declares a single type.
+
+Changes between consecutive API versions of a resource type that can break existing resources, such as removing a required property or removing an enum value, are reported as warnings. Use the --strict flag to fail when breaking changes are found.
+`,
+		Example: `
+# Validate a resource type manifest
+rad resource-type validate --from-file /path/to/input.yaml
+
+# Validate a resource type manifest and fail on breaking changes between API versions
+rad resource-type validate --from-file /path/to/input.yaml --strict
+`,
+		Args: cobra.ExactArgs(0),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddOutputFlag(cmd)
+	commonflags.AddFromFileFlagVar(cmd, &runner.ResourceProviderManifestFilePath)
+	_ = cmd.MarkFlagRequired("from-file")
+	cmd.Flags().BoolVar(&runner.Strict, strictFlag, false, "Treat breaking changes between API versions as errors")
+
+	return cmd, runner
+}
+
+// Runner is the Runner implementation for the `rad resource-type validate` command.
+type Runner struct {
+	Output output.Interface
+	Format string
+
+	ResourceProviderManifestFilePath string
+	Strict                           bool
+}
+
+// NewRunner creates an instance of the runner for the `rad resource-type validate` command.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		Output: factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad resource-type validate` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+	r.Format = format
+
+	return nil
+}
+
+// Run runs the `rad resource-type validate` command.
+func (r *Runner) Run(ctx context.Context) error {
+	resourceProvider, err := manifest.ReadFile(r.ResourceProviderManifestFilePath)
+	if err != nil {
+		return clierrors.Message("The manifest %q is invalid: %v", r.ResourceProviderManifestFilePath, err)
+	}
+
+	diagnostics := manifest.ValidateSchemas(resourceProvider)
+	if len(diagnostics) == 0 {
+		r.Output.LogInfo("The manifest %q is valid.", r.ResourceProviderManifestFilePath)
+		return nil
+	}
+
+	err = r.Output.WriteFormatted(r.Format, diagnostics, diagnosticTableFormat())
+	if err != nil {
+		return err
+	}
+
+	errors, warnings := 0, 0
+	for _, diagnostic := range diagnostics {
+		if diagnostic.Severity == manifest.SeverityError {
+			errors++
+		} else {
+			warnings++
+		}
+	}
+
+	if errors > 0 || (r.Strict && warnings > 0) {
+		return clierrors.Message("The manifest %q is invalid: found %d error(s) and %d warning(s).", r.ResourceProviderManifestFilePath, errors, warnings)
+	}
+
+	r.Output.LogInfo("")
+	r.Output.LogInfo("The manifest %q is valid with %d warning(s).", r.ResourceProviderManifestFilePath, warnings)
+	return nil
+}
+
+func diagnosticTableFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "SEVERITY",
+				JSONPath: "{ .Severity }",
+			},
+			{
+				Heading:  "TYPE",
+				JSONPath: "{ .ResourceType }",
+			},
+			{
+				Heading:  "APIVERSION",
+				JSONPath: "{ .APIVersion }",
+			},
+			{
+				Heading:  "PATH",
+				JSONPath: "{ .Path }",
+			},
+			{
+				Heading:  "MESSAGE",
+				JSONPath: "{ .Message }",
+			},
+		},
+	}
+}
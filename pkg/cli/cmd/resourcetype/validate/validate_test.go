@@ -0,0 +1,179 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/manifest"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid",
+			Input:         []string{"--from-file", "testdata/valid.yaml"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{},
+		},
+		{
+			Name:          "Valid: strict",
+			Input:         []string{"--from-file", "testdata/valid.yaml", "--strict"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{},
+		},
+		{
+			Name:          "Invalid: missing input file",
+			Input:         []string{},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{},
+		},
+		{
+			Name:          "Invalid: too many arguments",
+			Input:         []string{"testResources", "--from-file", "testdata/valid.yaml"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{},
+		},
+	}
+
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	t.Run("Success: valid manifest", func(t *testing.T) {
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			Output:                           outputSink,
+			Format:                           "table",
+			ResourceProviderManifestFilePath: "testdata/valid.yaml",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "The manifest %q is valid.",
+				Params: []any{"testdata/valid.yaml"},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Success: breaking changes are warnings", func(t *testing.T) {
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			Output:                           outputSink,
+			Format:                           "table",
+			ResourceProviderManifestFilePath: "testdata/breaking-changes.yaml",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		require.Len(t, outputSink.Writes, 3)
+		formatted := outputSink.Writes[0].(output.FormattedOutput)
+		diagnostics := formatted.Obj.([]manifest.Diagnostic)
+		require.Len(t, diagnostics, 3)
+		for _, diagnostic := range diagnostics {
+			require.Equal(t, manifest.SeverityWarning, diagnostic.Severity)
+		}
+
+		require.Equal(t, output.LogOutput{
+			Format: "The manifest %q is valid with %d warning(s).",
+			Params: []any{"testdata/breaking-changes.yaml", 3},
+		}, outputSink.Writes[2])
+	})
+
+	t.Run("Error: breaking changes in strict mode", func(t *testing.T) {
+		runner := &Runner{
+			Output:                           &output.MockOutput{},
+			Format:                           "table",
+			ResourceProviderManifestFilePath: "testdata/breaking-changes.yaml",
+			Strict:                           true,
+		}
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The manifest %q is invalid: found %d error(s) and %d warning(s).", "testdata/breaking-changes.yaml", 0, 3), err)
+	})
+
+	t.Run("Error: schema is not structural", func(t *testing.T) {
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			Output:                           outputSink,
+			Format:                           "table",
+			ResourceProviderManifestFilePath: "testdata/invalid-schema.yaml",
+		}
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The manifest %q is invalid: found %d error(s) and %d warning(s).", "testdata/invalid-schema.yaml", 1, 0), err)
+
+		formatted := outputSink.Writes[0].(output.FormattedOutput)
+		diagnostics := formatted.Obj.([]manifest.Diagnostic)
+		require.Equal(t, []manifest.Diagnostic{
+			{
+				Severity:     manifest.SeverityError,
+				ResourceType: "postgresDatabases",
+				APIVersion:   "2025-01-01",
+				Path:         "$.properties.tags",
+				Message:      "$.properties.tags must declare the schema of its items",
+			},
+		}, diagnostics)
+	})
+
+	t.Run("Error: manifest cannot be read", func(t *testing.T) {
+		runner := &Runner{
+			Output:                           &output.MockOutput{},
+			Format:                           "table",
+			ResourceProviderManifestFilePath: "testdata/missing.yaml",
+		}
+
+		err := runner.Run(context.Background())
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "The manifest \"testdata/missing.yaml\" is invalid")
+	})
+}
+
+func Test_diagnosticTableFormat(t *testing.T) {
+	obj := []manifest.Diagnostic{
+		{
+			Severity:     manifest.SeverityError,
+			ResourceType: "postgresDatabases",
+			APIVersion:   "2025-01-01",
+			Path:         "$.properties.tags",
+			Message:      "$.properties.tags must declare the schema of its items",
+		},
+	}
+
+	buffer := &bytes.Buffer{}
+	err := output.Write(output.FormatTable, obj, buffer, diagnosticTableFormat())
+	require.NoError(t, err)
+
+	expected := "SEVERITY  TYPE               APIVERSION  PATH               MESSAGE\nError     postgresDatabases  2025-01-01  $.properties.tags  $.properties.tags must declare the schema of its items\n"
+	require.Equal(t, expected, buffer.String())
+}
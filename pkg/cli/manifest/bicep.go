@@ -0,0 +1,418 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/spec"
+	"github.com/radius-project/radius/pkg/schema"
+)
+
+const (
+	// BicepIndexFileName is the name of the index file of a Bicep extension.
+	BicepIndexFileName = "index.json"
+
+	// BicepTypesFileName is the name of the file containing the type definitions of a Bicep extension.
+	BicepTypesFileName = "types.json"
+
+	// bicepExtensionVersion is the version recorded in the settings of a generated Bicep extension.
+	bicepExtensionVersion = "0.0.1"
+)
+
+// Property flags of the Bicep type system. See https://github.com/Azure/bicep-types.
+const (
+	bicepFlagsNone               = 0
+	bicepFlagsRequired           = 1
+	bicepFlagsReadOnly           = 2
+	bicepFlagsDeployTimeConstant = 8
+	bicepFlagsIdentifier         = 16
+)
+
+// GenerateBicepExtensionIndex generates the Bicep extension index for the resource types of the resource provider
+// and writes it to the output directory. The index can be published with `bicep publish-extension`.
+//
+// The schemas of the resource types are converted to Bicep types, so that property types, required properties,
+// enums and descriptions are available in the Bicep editor.
+func GenerateBicepExtensionIndex(resourceProvider *ResourceProvider, outputDirectoryPath string) error {
+	types, index, err := generateBicepTypes(resourceProvider)
+	if err != nil {
+		return err
+	}
+
+	err = writeJSONFile(filepath.Join(outputDirectoryPath, BicepTypesFileName), types)
+	if err != nil {
+		return err
+	}
+
+	return writeJSONFile(filepath.Join(outputDirectoryPath, BicepIndexFileName), index)
+}
+
+func writeJSONFile(path string, value any) error {
+	bs, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s: %w", filepath.Base(path), err)
+	}
+
+	err = os.WriteFile(path, bs, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to write %s: %w", filepath.Base(path), err)
+	}
+
+	return nil
+}
+
+type bicepTypeReference struct {
+	Ref string `json:"$ref"`
+}
+
+type bicepIndex struct {
+	Resources         map[string]bicepTypeReference `json:"resources"`
+	ResourceFunctions map[string]any                `json:"resourceFunctions"`
+	Settings          bicepIndexSettings            `json:"settings"`
+}
+
+type bicepIndexSettings struct {
+	Name        string `json:"name"`
+	Version     string `json:"version"`
+	IsSingleton bool   `json:"isSingleton"`
+}
+
+type bicepAnyType struct {
+	Type string `json:"$type"`
+}
+
+type bicepBooleanType struct {
+	Type string `json:"$type"`
+}
+
+type bicepStringType struct {
+	Type      string `json:"$type"`
+	MinLength *int64 `json:"minLength,omitempty"`
+	MaxLength *int64 `json:"maxLength,omitempty"`
+	Pattern   string `json:"pattern,omitempty"`
+}
+
+type bicepIntegerType struct {
+	Type     string `json:"$type"`
+	MinValue *int64 `json:"minValue,omitempty"`
+	MaxValue *int64 `json:"maxValue,omitempty"`
+}
+
+type bicepStringLiteralType struct {
+	Type  string `json:"$type"`
+	Value string `json:"value"`
+}
+
+type bicepUnionType struct {
+	Type     string               `json:"$type"`
+	Elements []bicepTypeReference `json:"elements"`
+}
+
+type bicepArrayType struct {
+	Type      string             `json:"$type"`
+	ItemType  bicepTypeReference `json:"itemType"`
+	MinLength *int64             `json:"minLength,omitempty"`
+	MaxLength *int64             `json:"maxLength,omitempty"`
+}
+
+type bicepObjectType struct {
+	Type                 string                         `json:"$type"`
+	Name                 string                         `json:"name"`
+	Properties           map[string]bicepObjectProperty `json:"properties"`
+	AdditionalProperties *bicepTypeReference            `json:"additionalProperties,omitempty"`
+}
+
+type bicepObjectProperty struct {
+	Type        bicepTypeReference `json:"type"`
+	Flags       int                `json:"flags"`
+	Description string             `json:"description,omitempty"`
+}
+
+type bicepResourceType struct {
+	Type      string             `json:"$type"`
+	Name      string             `json:"name"`
+	ScopeType int                `json:"scopeType"`
+	Body      bicepTypeReference `json:"body"`
+	Flags     int                `json:"flags"`
+	Functions map[string]any     `json:"functions"`
+}
+
+// bicepTypeBuilder accumulates the types of a Bicep extension. Types without a name are deduplicated so that
+// each primitive type is only written once.
+type bicepTypeBuilder struct {
+	types []any
+	known map[string]int
+}
+
+func (b *bicepTypeBuilder) add(t any) bicepTypeReference {
+	b.types = append(b.types, t)
+	return bicepTypeReference{Ref: fmt.Sprintf("#/%d", len(b.types)-1)}
+}
+
+func (b *bicepTypeBuilder) addShared(t any) bicepTypeReference {
+	bs, _ := json.Marshal(t)
+	if index, ok := b.known[string(bs)]; ok {
+		return bicepTypeReference{Ref: fmt.Sprintf("#/%d", index)}
+	}
+
+	ref := b.add(t)
+	b.known[string(bs)] = len(b.types) - 1
+	return ref
+}
+
+// generateBicepTypes converts the resource types of the resource provider to Bicep types. Each API version of
+// each resource type is a separate Bicep resource type.
+func generateBicepTypes(resourceProvider *ResourceProvider) ([]any, *bicepIndex, error) {
+	builder := &bicepTypeBuilder{known: map[string]int{}}
+	index := &bicepIndex{
+		Resources:         map[string]bicepTypeReference{},
+		ResourceFunctions: map[string]any{},
+		Settings: bicepIndexSettings{
+			Name:        resourceProvider.Name,
+			Version:     bicepExtensionVersion,
+			IsSingleton: false,
+		},
+	}
+
+	// Sort the types and API versions so the output is stable.
+	typeNames := make([]string, 0, len(resourceProvider.Types))
+	for typeName := range resourceProvider.Types {
+		typeNames = append(typeNames, typeName)
+	}
+	sort.Strings(typeNames)
+
+	for _, typeName := range typeNames {
+		resourceType := resourceProvider.Types[typeName]
+		apiVersionNames := make([]string, 0, len(resourceType.APIVersions))
+		for apiVersionName := range resourceType.APIVersions {
+			apiVersionNames = append(apiVersionNames, apiVersionName)
+		}
+		sort.Strings(apiVersionNames)
+
+		fullyQualifiedTypeName := resourceProvider.Name + "/" + typeName
+		for _, apiVersionName := range apiVersionNames {
+			raw, err := resourceType.APIVersions[apiVersionName].schemaMap()
+			if err != nil {
+				return nil, nil, fmt.Errorf("invalid schema for resource type %s API version %s: %w", fullyQualifiedTypeName, apiVersionName, err)
+			}
+
+			s, err := schema.Parse(raw)
+			if err != nil {
+				return nil, nil, fmt.Errorf("invalid schema for resource type %s API version %s: %w", fullyQualifiedTypeName, apiVersionName, err)
+			}
+
+			ref := builder.addResourceType(fullyQualifiedTypeName, apiVersionName, s)
+			index.Resources[fullyQualifiedTypeName+"@"+apiVersionName] = bicepTypeReference{Ref: BicepTypesFileName + ref.Ref}
+		}
+	}
+
+	return builder.types, index, nil
+}
+
+func (b *bicepTypeBuilder) addResourceType(fullyQualifiedTypeName string, apiVersion string, s *spec.Schema) bicepTypeReference {
+	stringType := b.addShared(bicepStringType{Type: "StringType"})
+	typeLiteral := b.addShared(bicepStringLiteralType{Type: "StringLiteralType", Value: fullyQualifiedTypeName})
+	apiVersionLiteral := b.addShared(bicepStringLiteralType{Type: "StringLiteralType", Value: apiVersion})
+	tags := b.add(bicepObjectType{
+		Type:                 "ObjectType",
+		Name:                 "Tags",
+		Properties:           map[string]bicepObjectProperty{},
+		AdditionalProperties: &stringType,
+	})
+
+	propertiesFlags := bicepFlagsNone
+	if s != nil && len(s.Required) > 0 {
+		propertiesFlags = bicepFlagsRequired
+	}
+
+	typeName := fullyQualifiedTypeName[strings.LastIndex(fullyQualifiedTypeName, "/")+1:]
+	properties := b.addSchema(typeName+"Properties", s)
+
+	body := b.add(bicepObjectType{
+		Type: "ObjectType",
+		Name: fullyQualifiedTypeName,
+		Properties: map[string]bicepObjectProperty{
+			"id": {
+				Type:        stringType,
+				Flags:       bicepFlagsReadOnly | bicepFlagsDeployTimeConstant,
+				Description: "The resource id",
+			},
+			"name": {
+				Type:        stringType,
+				Flags:       bicepFlagsRequired | bicepFlagsDeployTimeConstant | bicepFlagsIdentifier,
+				Description: "The resource name",
+			},
+			"type": {
+				Type:        typeLiteral,
+				Flags:       bicepFlagsReadOnly | bicepFlagsDeployTimeConstant,
+				Description: "The resource type",
+			},
+			"apiVersion": {
+				Type:        apiVersionLiteral,
+				Flags:       bicepFlagsReadOnly | bicepFlagsDeployTimeConstant,
+				Description: "The resource api version",
+			},
+			"location": {
+				Type:        stringType,
+				Flags:       bicepFlagsNone,
+				Description: "The geo-location where the resource lives",
+			},
+			"tags": {
+				Type:        tags,
+				Flags:       bicepFlagsNone,
+				Description: "Resource tags.",
+			},
+			"properties": {
+				Type:        properties,
+				Flags:       propertiesFlags,
+				Description: describe(s, fmt.Sprintf("The properties of the %s resource", fullyQualifiedTypeName)),
+			},
+		},
+	})
+
+	return b.add(bicepResourceType{
+		Type:      "ResourceType",
+		Name:      fullyQualifiedTypeName + "@" + apiVersion,
+		ScopeType: 0,
+		Body:      body,
+		Flags:     0,
+		Functions: map[string]any{},
+	})
+}
+
+// addSchema adds the Bicep type for an OpenAPI schema. A nil schema, or a schema that cannot be represented in
+// Bicep, is converted to the "any" type.
+func (b *bicepTypeBuilder) addSchema(name string, s *spec.Schema) bicepTypeReference {
+	if s == nil {
+		return b.addShared(bicepAnyType{Type: "AnyType"})
+	}
+
+	if ref, ok := b.addEnum(s); ok {
+		return ref
+	}
+
+	switch {
+	case s.Type.Contains("string"):
+		return b.addShared(bicepStringType{Type: "StringType", MinLength: s.MinLength, MaxLength: s.MaxLength, Pattern: s.Pattern})
+	case s.Type.Contains("integer"):
+		return b.addShared(bicepIntegerType{Type: "IntegerType", MinValue: toInt64(s.Minimum), MaxValue: toInt64(s.Maximum)})
+	case s.Type.Contains("boolean"):
+		return b.addShared(bicepBooleanType{Type: "BooleanType"})
+	case s.Type.Contains("array"):
+		var items *spec.Schema
+		if s.Items != nil {
+			items = s.Items.Schema
+		}
+
+		itemType := b.addSchema(name+"Item", items)
+		return b.addShared(bicepArrayType{Type: "ArrayType", ItemType: itemType, MinLength: s.MinItems, MaxLength: s.MaxItems})
+	case s.Type.Contains("object") || (len(s.Type) == 0 && len(s.Properties) > 0):
+		return b.addObject(name, s)
+	default:
+		// Bicep does not have a floating point type, so numbers are also represented as "any".
+		return b.addShared(bicepAnyType{Type: "AnyType"})
+	}
+}
+
+// addEnum adds a union of string literals for a schema with a string enum.
+func (b *bicepTypeBuilder) addEnum(s *spec.Schema) (bicepTypeReference, bool) {
+	if len(s.Enum) == 0 {
+		return bicepTypeReference{}, false
+	}
+
+	elements := []bicepTypeReference{}
+	for _, value := range s.Enum {
+		str, ok := value.(string)
+		if !ok {
+			// Only string enums can be represented as literal types.
+			return bicepTypeReference{}, false
+		}
+
+		elements = append(elements, b.addShared(bicepStringLiteralType{Type: "StringLiteralType", Value: str}))
+	}
+
+	if len(elements) == 1 {
+		return elements[0], true
+	}
+
+	return b.addShared(bicepUnionType{Type: "UnionType", Elements: elements}), true
+}
+
+func (b *bicepTypeBuilder) addObject(name string, s *spec.Schema) bicepTypeReference {
+	required := map[string]bool{}
+	for _, property := range s.Required {
+		required[property] = true
+	}
+
+	properties := map[string]bicepObjectProperty{}
+	for propertyName, property := range s.Properties {
+		flags := bicepFlagsNone
+		if property.ReadOnly {
+			flags |= bicepFlagsReadOnly
+		} else if required[propertyName] {
+			flags |= bicepFlagsRequired
+		}
+
+		property := property
+		properties[propertyName] = bicepObjectProperty{
+			Type:        b.addSchema(name+strings.ToUpper(propertyName[:1])+propertyName[1:], &property),
+			Flags:       flags,
+			Description: property.Description,
+		}
+	}
+
+	object := bicepObjectType{
+		Type:       "ObjectType",
+		Name:       name,
+		Properties: properties,
+	}
+
+	if s.AdditionalProperties != nil {
+		if s.AdditionalProperties.Schema != nil {
+			ref := b.addSchema(name+"AdditionalProperties", s.AdditionalProperties.Schema)
+			object.AdditionalProperties = &ref
+		} else if s.AdditionalProperties.Allows {
+			ref := b.addShared(bicepAnyType{Type: "AnyType"})
+			object.AdditionalProperties = &ref
+		}
+	}
+
+	return b.add(object)
+}
+
+func describe(s *spec.Schema, fallback string) string {
+	if s != nil && s.Description != "" {
+		return s.Description
+	}
+
+	return fallback
+}
+
+func toInt64(value *float64) *int64 {
+	if value == nil {
+		return nil
+	}
+
+	result := int64(*value)
+	return &result
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateBicepExtensionIndex(t *testing.T) {
+	resourceProvider, err := ReadFile("testdata/valid-schema.yaml")
+	require.NoError(t, err)
+
+	directory := t.TempDir()
+	err = GenerateBicepExtensionIndex(resourceProvider, directory)
+	require.NoError(t, err)
+
+	index := map[string]any{}
+	readJSONFile(t, filepath.Join(directory, BicepIndexFileName), &index)
+
+	types := []map[string]any{}
+	readJSONFile(t, filepath.Join(directory, BicepTypesFileName), &types)
+
+	resources := index["resources"].(map[string]any)
+	require.Len(t, resources, 1)
+	ref := resources["MyCompany.Resources/postgresDatabases@2025-01-01-preview"].(map[string]any)["$ref"].(string)
+	require.True(t, strings.HasPrefix(ref, BicepTypesFileName+"#/"))
+
+	resourceType := resolve(t, types, strings.TrimPrefix(ref, BicepTypesFileName))
+	require.Equal(t, "ResourceType", resourceType["$type"])
+	require.Equal(t, "MyCompany.Resources/postgresDatabases@2025-01-01-preview", resourceType["name"])
+
+	body := resolve(t, types, resourceType["body"].(map[string]any)["$ref"].(string))
+	require.Equal(t, "ObjectType", body["$type"])
+	require.Equal(t, "MyCompany.Resources/postgresDatabases", body["name"])
+
+	bodyProperties := body["properties"].(map[string]any)
+	propertiesProperty := bodyProperties["properties"].(map[string]any)
+	require.Equal(t, float64(bicepFlagsRequired), propertiesProperty["flags"])
+	require.Equal(t, "A PostgreSQL database.", propertiesProperty["description"])
+
+	properties := resolve(t, types, propertiesProperty["type"].(map[string]any)["$ref"].(string))
+	require.Equal(t, "ObjectType", properties["$type"])
+	require.Equal(t, "postgresDatabasesProperties", properties["name"])
+
+	propertyTypes := properties["properties"].(map[string]any)
+
+	size := propertyTypes["size"].(map[string]any)
+	require.Equal(t, float64(bicepFlagsRequired), size["flags"])
+	require.Equal(t, "The size of the database.", size["description"])
+	sizeType := resolve(t, types, size["type"].(map[string]any)["$ref"].(string))
+	require.Equal(t, "UnionType", sizeType["$type"])
+	values := []string{}
+	for _, element := range sizeType["elements"].([]any) {
+		literal := resolve(t, types, element.(map[string]any)["$ref"].(string))
+		require.Equal(t, "StringLiteralType", literal["$type"])
+		values = append(values, literal["value"].(string))
+	}
+	require.Equal(t, []string{"S", "M", "L"}, values)
+
+	replicas := propertyTypes["replicas"].(map[string]any)
+	require.Equal(t, float64(bicepFlagsNone), replicas["flags"])
+	require.Equal(t, map[string]any{"$type": "IntegerType", "minValue": float64(1)}, resolve(t, types, replicas["type"].(map[string]any)["$ref"].(string)))
+
+	tags := resolve(t, types, propertyTypes["tags"].(map[string]any)["type"].(map[string]any)["$ref"].(string))
+	require.Equal(t, "ArrayType", tags["$type"])
+	require.Equal(t, map[string]any{"$type": "StringType"}, resolve(t, types, tags["itemType"].(map[string]any)["$ref"].(string)))
+
+	connection := resolve(t, types, propertyTypes["connection"].(map[string]any)["type"].(map[string]any)["$ref"].(string))
+	require.Equal(t, "ObjectType", connection["$type"])
+	require.Equal(t, "postgresDatabasesPropertiesConnection", connection["name"])
+	host := connection["properties"].(map[string]any)["host"].(map[string]any)
+	require.Equal(t, float64(bicepFlagsReadOnly), host["flags"])
+}
+
+func TestGenerateBicepTypes_EmptySchema(t *testing.T) {
+	resourceProvider, err := ReadFile("testdata/valid.yaml")
+	require.NoError(t, err)
+
+	types, index, err := generateBicepTypes(resourceProvider)
+	require.NoError(t, err)
+	require.Equal(t, "MyCompany.Resources", index.Settings.Name)
+	require.Contains(t, index.Resources, "MyCompany.Resources/testResources@2025-01-01-preview")
+
+	// A resource type without a schema accepts any properties.
+	require.Contains(t, types, bicepAnyType{Type: "AnyType"})
+}
+
+func readJSONFile(t *testing.T, path string, value any) {
+	bs, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	err = json.Unmarshal(bs, value)
+	require.NoError(t, err)
+}
+
+func resolve(t *testing.T, types []map[string]any, ref string) map[string]any {
+	index, err := strconv.Atoi(strings.TrimPrefix(ref, "#/"))
+	require.NoError(t, err)
+	require.Less(t, index, len(types))
+	return types[index]
+}
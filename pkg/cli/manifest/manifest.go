@@ -16,6 +16,11 @@ limitations under the License.
 
 package manifest
 
+import (
+	"encoding/json"
+	"fmt"
+)
+
 // ResourceProvider represents a resource provider manifest.
 type ResourceProvider struct {
 	// Name is the resource provider name. This is also the namespace of the types defined by the resource provider.
@@ -38,12 +43,70 @@ type ResourceType struct {
 
 	// APIVersions is a map of API versions for the resource type.
 	APIVersions map[string]*ResourceTypeAPIVersion `yaml:"apiVersions" validate:"dive,keys,apiVersion,endkeys,required"`
+
+	// Actions is a map of custom actions for the resource type. An action is invoked with a POST request
+	// on a resource, and is handled by a recipe.
+	Actions map[string]*ResourceTypeAction `yaml:"actions,omitempty" validate:"dive,keys,actionName,endkeys"`
+}
+
+// ResourceTypeAction represents a custom action of a resource type in a resource provider manifest.
+type ResourceTypeAction struct {
+	// Description is the description of the action.
+	Description string `yaml:"description,omitempty"`
+
+	// RecipeName is the name of the recipe that handles the action. Defaults to the name of the action.
+	RecipeName string `yaml:"recipeName,omitempty"`
 }
 
+// ResourceTypeAPIVersion represents an API version of a resource type in a resource provider manifest.
 type ResourceTypeAPIVersion struct {
-	// Schema is the schema for the resource type.
-	//
-	// TODO: this allows anything right now, and will be ignored. We'll improve this in
-	// a future pull-request.
+	// Schema is the OpenAPI v3 schema for the properties of the resource type. The dynamic-rp will
+	// reject resources whose properties do not match the schema.
 	Schema any `yaml:"schema" validate:"required"`
+
+	// Conversion is the conversion between this API version and the default API version of the resource type.
+	Conversion *ResourceTypeAPIVersionConversion `yaml:"conversion,omitempty"`
+}
+
+// ResourceTypeAPIVersionConversion represents the conversion between an API version and the default API version
+// of a resource type.
+type ResourceTypeAPIVersionConversion struct {
+	// FieldMappings is a list of fields that are moved when converting between the API versions.
+	FieldMappings []FieldMapping `yaml:"fieldMappings,omitempty" validate:"dive"`
+
+	// Webhook is a webhook that is called to convert between the API versions.
+	Webhook *ConversionWebhook `yaml:"webhook,omitempty"`
+}
+
+// FieldMapping represents a field that is moved when converting between API versions.
+type FieldMapping struct {
+	// From is the dot-separated path of the field in this API version.
+	From string `yaml:"from" validate:"required"`
+
+	// To is the dot-separated path of the field in the default API version.
+	To string `yaml:"to" validate:"required"`
+}
+
+// ConversionWebhook represents a webhook that converts the properties of a resource between API versions.
+type ConversionWebhook struct {
+	// URL is the URL of the webhook.
+	URL string `yaml:"url" validate:"required,url"`
+}
+
+// schemaMap returns the schema of the API version as a map[string]any.
+func (v *ResourceTypeAPIVersion) schemaMap() (map[string]any, error) {
+	// The schema is decoded from YAML as an untyped value. We round-trip it through JSON to
+	// normalize it to a map[string]any.
+	bs, err := json.Marshal(v.Schema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal schema: %w", err)
+	}
+
+	schema := map[string]any{}
+	err = json.Unmarshal(bs, &schema)
+	if err != nil {
+		return nil, fmt.Errorf("schema must be an object: %w", err)
+	}
+
+	return schema, nil
 }
@@ -19,6 +19,8 @@ package manifest
 import (
 	"testing"
 
+	"github.com/radius-project/radius/pkg/to"
+
 	"github.com/stretchr/testify/require"
 )
 
@@ -92,3 +94,188 @@ func TestReadFile_MissingRequiredFieldJSON(t *testing.T) {
 	require.Error(t, err)
 	require.Nil(t, result)
 }
+
+func TestReadFile_Conversion(t *testing.T) {
+	expected := &ResourceProvider{
+		Name: "MyCompany.Resources",
+		Types: map[string]*ResourceType{
+			"testResources": {
+				DefaultAPIVersion: to.Ptr("2025-01-01-preview"),
+				APIVersions: map[string]*ResourceTypeAPIVersion{
+					"2025-01-01-preview": {
+						Schema: map[string]any{},
+					},
+					"2024-01-01-preview": {
+						Schema: map[string]any{},
+						Conversion: &ResourceTypeAPIVersionConversion{
+							FieldMappings: []FieldMapping{
+								{From: "$.hostname", To: "$.host"},
+							},
+							Webhook: &ConversionWebhook{URL: "https://converter.my-namespace.svc/convert"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	result, err := ReadFile("testdata/valid-conversion.yaml")
+	require.NoError(t, err)
+	require.Equal(t, expected, result)
+}
+
+func TestReadFile_ConversionWithoutDefaultAPIVersion(t *testing.T) {
+	result, err := ReadFile("testdata/conversion-without-default.yaml")
+	require.EqualError(t, err, "resource type testResources declares a conversion for API version 2024-01-01-preview but does not declare a defaultApiVersion")
+	require.Nil(t, result)
+}
+
+func TestReadFile_ConversionWithExternalWebhook(t *testing.T) {
+	result, err := ReadFile("testdata/conversion-external-webhook.yaml")
+	require.ErrorContains(t, err, "resource type testResources declares an invalid conversion for API version 2024-01-01-preview: conversion webhook URL \"https://example.com/convert\" must address a Kubernetes service in the cluster")
+	require.Nil(t, result)
+}
+
+func TestReadBytes_Capabilities(t *testing.T) {
+	manifest := func(capabilities string) []byte {
+		return []byte(`name: MyCompany.Resources
+types:
+  testResources:
+    apiVersions:
+      '2025-01-01-preview':
+        schema: {}
+    capabilities: ` + capabilities + `
+`)
+	}
+
+	tests := []struct {
+		name         string
+		capabilities string
+		err          string
+	}{
+		{
+			name:         "recipes with secrets",
+			capabilities: `["SupportsRecipes", "ExposesSecrets", "ApplicationScopedOnly"]`,
+		},
+		{
+			name:         "manual provisioning",
+			capabilities: `["ManualResourceProvisioning", "ApplicationScopedOnly"]`,
+		},
+		{
+			name:         "unknown capability",
+			capabilities: `["MyCapability"]`,
+			err:          "resource type testResources declares the capability MyCapability which is not recognized. Supported capabilities: SupportsRecipes, ManualResourceProvisioning, ExposesSecrets, ApplicationScopedOnly",
+		},
+		{
+			name:         "duplicate capability",
+			capabilities: `["SupportsRecipes", "SupportsRecipes"]`,
+			err:          "resource type testResources declares the capability SupportsRecipes more than once",
+		},
+		{
+			name:         "recipes and manual provisioning",
+			capabilities: `["SupportsRecipes", "ManualResourceProvisioning"]`,
+			err:          "resource type testResources cannot declare both the SupportsRecipes and ManualResourceProvisioning capabilities",
+		},
+		{
+			name:         "secrets without recipes",
+			capabilities: `["ExposesSecrets"]`,
+			err:          "resource type testResources declares the ExposesSecrets capability, which requires the SupportsRecipes capability",
+		},
+		{
+			name:         "manual provisioning with secrets",
+			capabilities: `["ManualResourceProvisioning", "ExposesSecrets"]`,
+			err:          "resource type testResources declares the ExposesSecrets capability, which requires the SupportsRecipes capability",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ReadBytes(manifest(tt.capabilities))
+			if tt.err == "" {
+				require.NoError(t, err)
+				require.NotNil(t, result)
+			} else {
+				require.EqualError(t, err, tt.err)
+				require.Nil(t, result)
+			}
+		})
+	}
+}
+
+func TestReadBytes_Actions(t *testing.T) {
+	manifest := func(capabilities string, actions string) []byte {
+		return []byte(`name: MyCompany.Resources
+types:
+  testResources:
+    apiVersions:
+      '2025-01-01-preview':
+        schema: {}
+    capabilities: ` + capabilities + `
+    actions:
+` + actions)
+	}
+
+	tests := []struct {
+		name         string
+		capabilities string
+		actions      string
+		expected     map[string]*ResourceTypeAction
+		err          string
+	}{
+		{
+			name:         "valid actions",
+			capabilities: `["SupportsRecipes"]`,
+			actions: `      restart:
+        description: Restarts the resource.
+      rotateCredentials:
+        recipeName: rotate
+`,
+			expected: map[string]*ResourceTypeAction{
+				"restart":           {Description: "Restarts the resource."},
+				"rotateCredentials": {RecipeName: "rotate"},
+			},
+		},
+		{
+			name:         "actions without recipes",
+			capabilities: `["ManualResourceProvisioning"]`,
+			actions: `      restart: {}
+`,
+			err: "resource type testResources declares actions, which require the SupportsRecipes capability",
+		},
+		{
+			name:         "reserved action",
+			capabilities: `["SupportsRecipes"]`,
+			actions: `      listsecrets: {}
+`,
+			err: "resource type testResources declares the action listsecrets, which is reserved by Radius",
+		},
+		{
+			name:         "actions differ by case",
+			capabilities: `["SupportsRecipes"]`,
+			actions: `      backUp: {}
+      backup: {}
+`,
+			err: "resource type testResources declares the actions backUp and backup, which differ only by case",
+		},
+		{
+			name:         "invalid action name",
+			capabilities: `["SupportsRecipes"]`,
+			actions: `      Restart: {}
+`,
+			err: "must be a valid action name",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ReadBytes(manifest(tt.capabilities, tt.actions))
+			if tt.err == "" {
+				require.NoError(t, err)
+				require.Equal(t, tt.expected, result.Types["testResources"].Actions)
+			} else {
+				require.ErrorContains(t, err, tt.err)
+				require.Nil(t, result)
+			}
+		})
+	}
+}
@@ -94,6 +94,7 @@ func RegisterFile(ctx context.Context, clientFactory *v20231001preview.ClientFac
 				Properties: &v20231001preview.ResourceTypeProperties{
 					Capabilities:      to.SliceOfPtrs(resourceType.Capabilities...),
 					DefaultAPIVersion: resourceType.DefaultAPIVersion,
+					Actions:           convertActions(resourceType.Actions),
 				},
 			}, nil)
 			if err != nil {
@@ -113,11 +114,16 @@ func RegisterFile(ctx context.Context, clientFactory *v20231001preview.ClientFac
 			APIVersions: map[string]map[string]any{},
 		}
 
-		for apiVersionName := range resourceType.APIVersions {
+		for apiVersionName, apiVersion := range resourceType.APIVersions {
+			apiVersionProperties, err := convertAPIVersion(apiVersion)
+			if err != nil {
+				return fmt.Errorf("invalid API version %s/%s@%s: %w", resourceProvider.Name, resourceTypeName, apiVersionName, err)
+			}
+
 			logIfEnabled(logger, "Creating API Version %s/%s@%s", resourceProvider.Name, resourceTypeName, apiVersionName)
 			err = retryOperation(ctx, func() error {
 				apiVersionsPoller, err := clientFactory.NewAPIVersionsClient().BeginCreateOrUpdate(ctx, planeName, resourceProvider.Name, resourceTypeName, apiVersionName, v20231001preview.APIVersionResource{
-					Properties: &v20231001preview.APIVersionProperties{},
+					Properties: apiVersionProperties,
 				}, nil)
 				if err != nil {
 					return err
@@ -232,7 +238,9 @@ func RegisterType(ctx context.Context, clientFactory *v20231001preview.ClientFac
 	logIfEnabled(logger, "Creating resource type %s/%s", resourceProvider.Name, typeName)
 	resourceTypePoller, err := clientFactory.NewResourceTypesClient().BeginCreateOrUpdate(ctx, planeName, resourceProvider.Name, typeName, v20231001preview.ResourceTypeResource{
 		Properties: &v20231001preview.ResourceTypeProperties{
-			DefaultAPIVersion: resourceProvider.Types[typeName].DefaultAPIVersion,
+			Capabilities:      to.SliceOfPtrs(resourceType.Capabilities...),
+			DefaultAPIVersion: resourceType.DefaultAPIVersion,
+			Actions:           convertActions(resourceType.Actions),
 		},
 	}, nil)
 	if err != nil {
@@ -244,10 +252,15 @@ func RegisterType(ctx context.Context, clientFactory *v20231001preview.ClientFac
 		return err
 	}
 
-	for apiVersionName := range resourceType.APIVersions {
+	for apiVersionName, apiVersion := range resourceType.APIVersions {
+		apiVersionProperties, err := convertAPIVersion(apiVersion)
+		if err != nil {
+			return fmt.Errorf("invalid API version %s/%s@%s: %w", resourceProvider.Name, typeName, apiVersionName, err)
+		}
+
 		logIfEnabled(logger, "Creating API Version %s/%s@%s", resourceProvider.Name, typeName, apiVersionName)
 		apiVersionsPoller, err := clientFactory.NewAPIVersionsClient().BeginCreateOrUpdate(ctx, planeName, resourceProvider.Name, typeName, apiVersionName, v20231001preview.APIVersionResource{
-			Properties: &v20231001preview.APIVersionProperties{},
+			Properties: apiVersionProperties,
 		}, nil)
 		if err != nil {
 			return err
@@ -298,6 +311,36 @@ func RegisterType(ctx context.Context, clientFactory *v20231001preview.ClientFac
 	return nil
 }
 
+// convertAPIVersion converts an API version from the manifest to the UCP API representation.
+func convertAPIVersion(apiVersion *ResourceTypeAPIVersion) (*v20231001preview.APIVersionProperties, error) {
+	schema, err := apiVersion.schemaMap()
+	if err != nil {
+		return nil, err
+	}
+
+	properties := &v20231001preview.APIVersionProperties{
+		Schema: schema,
+	}
+
+	if apiVersion.Conversion != nil {
+		properties.Conversion = &v20231001preview.APIVersionConversion{}
+		for _, mapping := range apiVersion.Conversion.FieldMappings {
+			properties.Conversion.FieldMappings = append(properties.Conversion.FieldMappings, &v20231001preview.FieldMapping{
+				From: to.Ptr(mapping.From),
+				To:   to.Ptr(mapping.To),
+			})
+		}
+
+		if apiVersion.Conversion.Webhook != nil {
+			properties.Conversion.Webhook = &v20231001preview.ConversionWebhook{
+				URL: to.Ptr(apiVersion.Conversion.Webhook.URL),
+			}
+		}
+	}
+
+	return properties, nil
+}
+
 // Define an optional logger to prevent nil pointer dereference
 func logIfEnabled(logger func(format string, args ...any), format string, args ...any) {
 	if logger != nil {
@@ -344,3 +387,28 @@ func is409ConflictError(err error) bool {
 	var respErr *azcore.ResponseError
 	return errors.As(err, &respErr) && respErr.StatusCode == 409
 }
+
+// convertActions converts the custom actions of a resource type in a manifest to the UCP API model. An empty
+// recipe name is omitted so that UCP applies the default.
+func convertActions(actions map[string]*ResourceTypeAction) map[string]*v20231001preview.ResourceTypeAction {
+	if len(actions) == 0 {
+		return nil
+	}
+
+	result := map[string]*v20231001preview.ResourceTypeAction{}
+	for name, action := range actions {
+		converted := &v20231001preview.ResourceTypeAction{}
+		if action != nil {
+			if action.Description != "" {
+				converted.Description = to.Ptr(action.Description)
+			}
+			if action.RecipeName != "" {
+				converted.RecipeName = to.Ptr(action.RecipeName)
+			}
+		}
+
+		result[name] = converted
+	}
+
+	return result
+}
@@ -28,6 +28,7 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
 	"github.com/stretchr/testify/require"
 )
 
@@ -471,3 +472,68 @@ func TestIs409ConflictError(t *testing.T) {
 		})
 	}
 }
+
+func TestConvertAPIVersion(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		resourceProvider, err := ReadFile("testdata/valid.yaml")
+		require.NoError(t, err)
+
+		apiVersion := resourceProvider.Types["testResources"].APIVersions["2025-01-01-preview"]
+		apiVersion.Schema = map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"size": map[string]any{
+					"type": "string",
+				},
+			},
+		}
+
+		properties, err := convertAPIVersion(apiVersion)
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"size": map[string]any{
+					"type": "string",
+				},
+			},
+		}, properties.Schema)
+	})
+
+	t.Run("Conversion", func(t *testing.T) {
+		resourceProvider, err := ReadFile("testdata/valid-conversion.yaml")
+		require.NoError(t, err)
+
+		properties, err := convertAPIVersion(resourceProvider.Types["testResources"].APIVersions["2024-01-01-preview"])
+		require.NoError(t, err)
+		require.Equal(t, &v20231001preview.APIVersionConversion{
+			FieldMappings: []*v20231001preview.FieldMapping{
+				{From: to.Ptr("$.hostname"), To: to.Ptr("$.host")},
+			},
+			Webhook: &v20231001preview.ConversionWebhook{URL: to.Ptr("https://converter.my-namespace.svc/convert")},
+		}, properties.Conversion)
+	})
+
+	t.Run("SchemaIsNotAnObject", func(t *testing.T) {
+		_, err := convertAPIVersion(&ResourceTypeAPIVersion{Schema: []any{"invalid"}})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "schema must be an object")
+	})
+}
+
+func TestConvertActions(t *testing.T) {
+	t.Run("NoActions", func(t *testing.T) {
+		require.Nil(t, convertActions(nil))
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		actions := convertActions(map[string]*ResourceTypeAction{
+			"restart":           {Description: "Restarts the resource."},
+			"rotateCredentials": {RecipeName: "rotate"},
+		})
+		require.Equal(t, map[string]*v20231001preview.ResourceTypeAction{
+			"restart":           {Description: to.Ptr("Restarts the resource.")},
+			"rotateCredentials": {RecipeName: to.Ptr("rotate")},
+		}, actions)
+	})
+}
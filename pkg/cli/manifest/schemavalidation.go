@@ -0,0 +1,136 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/schema"
+)
+
+const (
+	// SeverityError is the severity of a diagnostic that makes the manifest invalid.
+	SeverityError = "Error"
+
+	// SeverityWarning is the severity of a diagnostic that should be reviewed, such as a breaking change
+	// between API versions.
+	SeverityWarning = "Warning"
+)
+
+// Diagnostic is a problem found when validating the schemas of a resource provider manifest.
+type Diagnostic struct {
+	// Severity is the severity of the problem, either SeverityError or SeverityWarning.
+	Severity string
+
+	// ResourceType is the name of the resource type, e.g. "postgresDatabases".
+	ResourceType string
+
+	// APIVersion is the API version of the resource type, e.g. "2025-01-01".
+	APIVersion string
+
+	// Path is the location of the field described by the schema, e.g. "$.properties.size".
+	Path string
+
+	// Message describes the problem.
+	Message string
+}
+
+// ValidateSchemas validates the schemas of a resource provider manifest without contacting UCP. It reports
+// schemas that are not structural as errors, and breaking changes between consecutive API versions of each
+// resource type as warnings. Diagnostics are sorted by resource type and API version.
+func ValidateSchemas(resourceProvider *ResourceProvider) []Diagnostic {
+	diagnostics := []Diagnostic{}
+	for _, typeName := range sortedKeys(resourceProvider.Types) {
+		resourceType := resourceProvider.Types[typeName]
+
+		var previousName string
+		var previous map[string]any
+		for _, apiVersionName := range sortAPIVersions(sortedKeys(resourceType.APIVersions)) {
+			report := func(severity string, path string, message string) {
+				diagnostics = append(diagnostics, Diagnostic{
+					Severity:     severity,
+					ResourceType: typeName,
+					APIVersion:   apiVersionName,
+					Path:         path,
+					Message:      message,
+				})
+			}
+
+			raw, err := resourceType.APIVersions[apiVersionName].schemaMap()
+			if err != nil {
+				report(SeverityError, "", err.Error())
+				previous = nil
+				continue
+			}
+
+			errs, err := schema.ValidateStructure(raw)
+			if err != nil {
+				report(SeverityError, "", err.Error())
+				previous = nil
+				continue
+			}
+
+			for _, e := range errs {
+				report(SeverityError, e.Path, e.Message)
+			}
+
+			if previous != nil {
+				changes, err := schema.FindBreakingChanges(previous, raw)
+				if err != nil {
+					report(SeverityError, "", err.Error())
+				}
+
+				for _, change := range changes {
+					message := fmt.Sprintf("%s (breaking change from API version %s)", change.Message, previousName)
+					report(SeverityWarning, change.Path, message)
+				}
+			}
+
+			previousName = apiVersionName
+			previous = raw
+		}
+	}
+
+	return diagnostics
+}
+
+// sortAPIVersions sorts API versions from oldest to newest. API versions are dates, and a preview API version
+// is older than the stable API version with the same date.
+func sortAPIVersions(apiVersions []string) []string {
+	sort.SliceStable(apiVersions, func(i, j int) bool {
+		a, aPreview := strings.CutSuffix(apiVersions[i], "-preview")
+		b, bPreview := strings.CutSuffix(apiVersions[j], "-preview")
+		if a != b {
+			return a < b
+		}
+
+		return aPreview && !bPreview
+	})
+
+	return apiVersions
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
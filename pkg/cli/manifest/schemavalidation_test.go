@@ -0,0 +1,74 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateSchemas(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		rp, err := ReadFile("testdata/valid-schema.yaml")
+		require.NoError(t, err)
+
+		require.Empty(t, ValidateSchemas(rp))
+	})
+
+	t.Run("breaking changes", func(t *testing.T) {
+		rp, err := ReadFile("testdata/breaking-changes.yaml")
+		require.NoError(t, err)
+
+		expected := []Diagnostic{
+			{
+				Severity:     SeverityError,
+				ResourceType: "postgresDatabases",
+				APIVersion:   "2025-01-01",
+				Path:         "$.properties.tags",
+				Message:      "$.properties.tags must declare the schema of its items",
+			},
+			{
+				Severity:     SeverityWarning,
+				ResourceType: "postgresDatabases",
+				APIVersion:   "2025-01-01",
+				Path:         "$.properties.database",
+				Message:      "$.properties.database was required and has been removed (breaking change from API version 2025-01-01-preview)",
+			},
+			{
+				Severity:     SeverityWarning,
+				ResourceType: "postgresDatabases",
+				APIVersion:   "2025-01-01",
+				Path:         "$.properties.size",
+				Message:      "$.properties.size removed the enum value L (breaking change from API version 2025-01-01-preview)",
+			},
+			{
+				Severity:     SeverityWarning,
+				ResourceType: "postgresDatabases",
+				APIVersion:   "2025-01-01",
+				Path:         "$.properties.size",
+				Message:      "$.properties.size is now required (breaking change from API version 2025-01-01-preview)",
+			},
+		}
+		require.Equal(t, expected, ValidateSchemas(rp))
+	})
+}
+
+func TestSortAPIVersions(t *testing.T) {
+	actual := sortAPIVersions([]string{"2025-01-01", "2024-06-01", "2025-01-01-preview", "2024-06-01-preview"})
+	require.Equal(t, []string{"2024-06-01-preview", "2024-06-01", "2025-01-01-preview", "2025-01-01"}, actual)
+}
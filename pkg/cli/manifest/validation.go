@@ -17,9 +17,11 @@ limitations under the License.
 package manifest
 
 import (
+	"fmt"
 	"regexp"
 
 	"github.com/go-playground/validator/v10"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
 )
 
 var (
@@ -27,11 +29,13 @@ var (
 	resourceTypeRegex              = regexp.MustCompile(`^[a-z][A-Za-z0-9]+$`)
 	apiVersionRegex                = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}(-preview)?$`)
 	capabilityRegex                = regexp.MustCompile(`^[A-Z][A-Za-z0-9]+$`)
+	actionNameRegex                = regexp.MustCompile(`^[a-z][A-Za-z0-9]+$`)
 
 	resourceProviderNamespaceMessage = "{0} must be a valid resource provider namespace. A resource provider namespace must contain two PascalCased segments separated by a '.'. Example: MyCompany.Resources"
 	resourceTypeMessage              = "{0} must be a valid resource type. A resource type should be camelCased. Example: myResourceType"
 	apiVersionMessage                = "{0} must be a valid API version. An API version must be a date in YYYY-MM-DD format, and may optionally have the suffix '-preview'. Example: 2025-01-01"
 	capabilityMessage                = "{0} must be a valid capability. A capability should use PascalCase. Example: MyCapability"
+	actionNameMessage                = "{0} must be a valid action name. An action name should be camelCased. Example: rotateCredentials"
 )
 
 func resourceProviderNamespace(fl validator.FieldLevel) bool {
@@ -53,3 +57,68 @@ func validateCapability(fl validator.FieldLevel) bool {
 	str := fl.Field().String()
 	return capabilityRegex.Match([]byte(str))
 }
+
+func validateActionName(fl validator.FieldLevel) bool {
+	str := fl.Field().String()
+	return actionNameRegex.Match([]byte(str))
+}
+
+// validateConversions validates the API version conversions of each resource type. Conversions are defined relative
+// to the default API version, so a resource type with conversions must declare a default API version and the
+// default API version cannot declare a conversion. Conversion webhooks must address a Kubernetes service in the
+// cluster.
+func validateConversions(resourceProvider *ResourceProvider) error {
+	for typeName, resourceType := range resourceProvider.Types {
+		for apiVersionName, apiVersion := range resourceType.APIVersions {
+			if apiVersion == nil || apiVersion.Conversion == nil {
+				continue
+			}
+
+			if resourceType.DefaultAPIVersion == nil {
+				return fmt.Errorf("resource type %s declares a conversion for API version %s but does not declare a defaultApiVersion", typeName, apiVersionName)
+			}
+
+			if *resourceType.DefaultAPIVersion == apiVersionName {
+				return fmt.Errorf("resource type %s declares a conversion for its default API version %s", typeName, apiVersionName)
+			}
+
+			if apiVersion.Conversion.Webhook != nil {
+				webhook := datamodel.ConversionWebhook{URL: apiVersion.Conversion.Webhook.URL}
+				if err := webhook.Validate(); err != nil {
+					return fmt.Errorf("resource type %s declares an invalid conversion for API version %s: %w", typeName, apiVersionName, err)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateCapabilities validates the capabilities of each resource type. Each capability must be known to Radius,
+// and the capabilities of a resource type must be consistent with each other. UCP enforces the same rules when the
+// resource type is created.
+func validateCapabilities(resourceProvider *ResourceProvider) error {
+	for _, typeName := range sortedKeys(resourceProvider.Types) {
+		err := datamodel.ValidateCapabilities(resourceProvider.Types[typeName].Capabilities)
+		if err != nil {
+			return fmt.Errorf("resource type %s %w", typeName, err)
+		}
+	}
+
+	return nil
+}
+
+// validateActions validates the custom actions of each resource type. Actions are handled by recipes, so a resource
+// type with actions must declare the SupportsRecipes capability. Action names are case-insensitive and cannot
+// conflict with the actions implemented by Radius. UCP enforces the same rules when the resource type is created.
+func validateActions(resourceProvider *ResourceProvider) error {
+	for _, typeName := range sortedKeys(resourceProvider.Types) {
+		resourceType := resourceProvider.Types[typeName]
+		err := datamodel.ValidateActions(resourceType.Capabilities, sortedKeys(resourceType.Actions))
+		if err != nil {
+			return fmt.Errorf("resource type %s %w", typeName, err)
+		}
+	}
+
+	return nil
+}
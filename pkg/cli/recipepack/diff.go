@@ -0,0 +1,122 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipepack
+
+import (
+	"encoding/json"
+	"maps"
+	"slices"
+)
+
+const (
+	// ChangeAdded is the kind of change of a recipe that is only defined by the new recipe pack.
+	ChangeAdded = "Added"
+
+	// ChangeRemoved is the kind of change of a recipe that is only defined by the old recipe pack.
+	ChangeRemoved = "Removed"
+
+	// ChangeModified is the kind of change of a recipe whose definition differs between the recipe packs.
+	ChangeModified = "Modified"
+)
+
+// RecipeChange is a difference between the recipes of two recipe packs.
+type RecipeChange struct {
+	// ResourceType is the resource type of the recipe.
+	ResourceType string `json:"resourceType"`
+
+	// Name is the name of the recipe.
+	Name string `json:"name"`
+
+	// Change is the kind of change: Added, Removed or Modified.
+	Change string `json:"change"`
+
+	// From is the template of the recipe in the old recipe pack.
+	From string `json:"from,omitempty"`
+
+	// To is the template of the recipe in the new recipe pack.
+	To string `json:"to,omitempty"`
+}
+
+// Diff returns the differences between the recipes of two recipe packs, sorted by resource type and recipe name.
+func Diff(from *RecipePack, to *RecipePack) []RecipeChange {
+	changes := []RecipeChange{}
+
+	resourceTypes := map[string]bool{}
+	for resourceType := range from.Recipes {
+		resourceTypes[resourceType] = true
+	}
+	for resourceType := range to.Recipes {
+		resourceTypes[resourceType] = true
+	}
+
+	for _, resourceType := range slices.Sorted(maps.Keys(resourceTypes)) {
+		names := map[string]bool{}
+		for name := range from.Recipes[resourceType] {
+			names[name] = true
+		}
+		for name := range to.Recipes[resourceType] {
+			names[name] = true
+		}
+
+		for _, name := range slices.Sorted(maps.Keys(names)) {
+			oldRecipe, inFrom := from.Recipes[resourceType][name]
+			newRecipe, inTo := to.Recipes[resourceType][name]
+
+			change := RecipeChange{ResourceType: resourceType, Name: name}
+			switch {
+			case !inFrom:
+				change.Change = ChangeAdded
+				change.To = newRecipe.template()
+			case !inTo:
+				change.Change = ChangeRemoved
+				change.From = oldRecipe.template()
+			case !oldRecipe.equal(newRecipe):
+				change.Change = ChangeModified
+				change.From = oldRecipe.template()
+				change.To = newRecipe.template()
+			default:
+				continue
+			}
+
+			changes = append(changes, change)
+		}
+	}
+
+	return changes
+}
+
+// template returns a short description of the template of the recipe, e.g. "terraform Azure/redis/azurerm@1.1.0".
+func (r Recipe) template() string {
+	template := r.TemplateKind + " " + r.TemplatePath
+	if r.TemplateVersion != "" {
+		template += "@" + r.TemplateVersion
+	}
+
+	return template
+}
+
+// equal compares the recipes through their JSON representation, so that parameters decoded from YAML and from the
+// API compare equal regardless of the Go types used for their numbers.
+func (r Recipe) equal(other Recipe) bool {
+	a, errA := json.Marshal(r)
+	b, errB := json.Marshal(other)
+	if errA != nil || errB != nil {
+		return false
+	}
+
+	return string(a) == string(b)
+}
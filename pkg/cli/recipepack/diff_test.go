@@ -0,0 +1,78 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipepack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Diff(t *testing.T) {
+	from := &RecipePack{
+		Name:    "core-recipes",
+		Version: "1.0.0",
+		Recipes: map[string]map[string]Recipe{
+			"Applications.Datastores/redisCaches": {
+				"default": {TemplateKind: "bicep", TemplatePath: "ghcr.io/my-org/recipes/redis:1.0.0"},
+				"legacy":  {TemplateKind: "bicep", TemplatePath: "ghcr.io/my-org/recipes/redis-legacy:1.0.0"},
+			},
+			"Applications.Datastores/sqlDatabases": {
+				"default": {TemplateKind: "terraform", TemplatePath: "Azure/sql/azurerm", TemplateVersion: "1.0.0", Parameters: map[string]any{"capacity": float64(5)}},
+			},
+		},
+	}
+	to := &RecipePack{
+		Name:    "core-recipes",
+		Version: "1.1.0",
+		Recipes: map[string]map[string]Recipe{
+			"Applications.Datastores/redisCaches": {
+				"default": {TemplateKind: "bicep", TemplatePath: "ghcr.io/my-org/recipes/redis:1.1.0"},
+			},
+			"Applications.Datastores/sqlDatabases": {
+				"default": {TemplateKind: "terraform", TemplatePath: "Azure/sql/azurerm", TemplateVersion: "1.0.0", Parameters: map[string]any{"capacity": uint64(5)}},
+			},
+			"Applications.Messaging/rabbitMQQueues": {
+				"default": {TemplateKind: "helm", TemplatePath: "oci://ghcr.io/my-org/charts/rabbitmq", TemplateVersion: "15.0.0"},
+			},
+		},
+	}
+
+	expected := []RecipeChange{
+		{
+			ResourceType: "Applications.Datastores/redisCaches",
+			Name:         "default",
+			Change:       ChangeModified,
+			From:         "bicep ghcr.io/my-org/recipes/redis:1.0.0",
+			To:           "bicep ghcr.io/my-org/recipes/redis:1.1.0",
+		},
+		{
+			ResourceType: "Applications.Datastores/redisCaches",
+			Name:         "legacy",
+			Change:       ChangeRemoved,
+			From:         "bicep ghcr.io/my-org/recipes/redis-legacy:1.0.0",
+		},
+		{
+			ResourceType: "Applications.Messaging/rabbitMQQueues",
+			Name:         "default",
+			Change:       ChangeAdded,
+			To:           "helm oci://ghcr.io/my-org/charts/rabbitmq@15.0.0",
+		},
+	}
+	require.Equal(t, expected, Diff(from, to))
+	require.Empty(t, Diff(from, from))
+}
@@ -0,0 +1,21 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// recipepack contains the parsing, validation and comparison logic for recipe packs.
+//
+// Recipe packs are versioned bundles of recipes that are published as Applications.Core/recipePacks
+// resources and registered to environments as a unit.
+package recipepack
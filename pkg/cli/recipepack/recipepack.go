@@ -0,0 +1,313 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipepack
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+
+	yaml "github.com/goccy/go-yaml"
+	"github.com/hashicorp/go-version"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/to"
+)
+
+// RecipePack is the definition of a recipe pack in a recipe pack file.
+//
+// Example:
+//
+//	name: core-recipes
+//	version: 1.2.0
+//	description: Recipes shared by all environments
+//	recipes:
+//	  Applications.Datastores/redisCaches:
+//	    default:
+//	      templateKind: bicep
+//	      templatePath: ghcr.io/my-org/recipes/redis:1.2.0
+type RecipePack struct {
+	// Name is the name of the recipe pack.
+	Name string `yaml:"name" json:"name"`
+
+	// Version is the semantic version of the recipe pack.
+	Version string `yaml:"version" json:"version"`
+
+	// Description is the description of the recipe pack.
+	Description string `yaml:"description,omitempty" json:"description,omitempty"`
+
+	// Recipes are the recipes of the recipe pack, keyed by resource type and recipe name.
+	Recipes map[string]map[string]Recipe `yaml:"recipes" json:"recipes"`
+}
+
+// Recipe is the definition of a recipe in a recipe pack.
+type Recipe struct {
+	// TemplateKind is the kind of the template of the recipe: bicep, terraform or helm.
+	TemplateKind string `yaml:"templateKind" json:"templateKind"`
+
+	// TemplatePath is the path of the template of the recipe.
+	TemplatePath string `yaml:"templatePath" json:"templatePath"`
+
+	// TemplateVersion is the version of the template of the recipe. Only supported by terraform and helm recipes.
+	TemplateVersion string `yaml:"templateVersion,omitempty" json:"templateVersion,omitempty"`
+
+	// PlainHTTP connects to the registry of the template over plain HTTP. Only supported by bicep and helm recipes.
+	PlainHTTP bool `yaml:"plainHTTP,omitempty" json:"plainHTTP,omitempty"`
+
+	// Parameters are the default parameters of the recipe.
+	Parameters map[string]any `yaml:"parameters,omitempty" json:"parameters,omitempty"`
+
+	// Outputs are the outputs the recipe must produce.
+	Outputs *RecipeOutputs `yaml:"outputs,omitempty" json:"outputs,omitempty"`
+}
+
+// RecipeOutputs is the definition of the outputs a recipe must produce in a recipe pack.
+type RecipeOutputs struct {
+	// Values are the values the recipe must output, keyed by name. The value is the expected type of the output.
+	Values map[string]string `yaml:"values,omitempty" json:"values,omitempty"`
+
+	// Secrets are the names of the secrets the recipe must output.
+	Secrets []string `yaml:"secrets,omitempty" json:"secrets,omitempty"`
+
+	// Resources requires the recipe to output the IDs of the resources it deploys.
+	Resources bool `yaml:"resources,omitempty" json:"resources,omitempty"`
+}
+
+// ReadFile reads a recipe pack from a file.
+func ReadFile(filePath string) (*RecipePack, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return ReadBytes(data)
+}
+
+// ReadBytes reads a recipe pack from a byte slice.
+func ReadBytes(data []byte) (*RecipePack, error) {
+	decoder := yaml.NewDecoder(
+		bytes.NewReader(data),
+
+		// Fail on unknown fields
+		// Prevent duplicate fields
+		yaml.Strict())
+
+	result := RecipePack{}
+	err := decoder.Decode(&result)
+	if err != nil {
+		return nil, err
+	}
+
+	err = result.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Validate returns an error if the recipe pack is not valid.
+func (p *RecipePack) Validate() error {
+	if p.Name == "" {
+		return errors.New("the name of the recipe pack is required")
+	}
+
+	if _, err := version.NewSemver(p.Version); err != nil {
+		return fmt.Errorf("invalid version %q: the version of the recipe pack must be a semantic version, e.g. '1.2.0'", p.Version)
+	}
+
+	if len(p.Recipes) == 0 {
+		return errors.New("the recipe pack must contain at least one recipe")
+	}
+
+	for resourceType, recipeNames := range p.Recipes {
+		for recipeName, recipe := range recipeNames {
+			if !slices.Contains(recipes.SupportedTemplateKind, recipe.TemplateKind) {
+				return fmt.Errorf("invalid template kind %q for recipe %q of resource type %q: the template kind must be one of %v", recipe.TemplateKind, recipeName, resourceType, recipes.SupportedTemplateKind)
+			}
+
+			if recipe.TemplatePath == "" {
+				return fmt.Errorf("the template path of recipe %q of resource type %q is required", recipeName, resourceType)
+			}
+
+			if recipe.Outputs != nil {
+				for name, outputType := range recipe.Outputs.Values {
+					if !slices.Contains(recipes.SupportedOutputTypes, outputType) {
+						return fmt.Errorf("invalid type %q of output %q of recipe %q of resource type %q: the type must be one of %v", outputType, name, recipeName, resourceType, recipes.SupportedOutputTypes)
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// ToResource converts the recipe pack to a recipe pack resource.
+func (p *RecipePack) ToResource() *corerp.RecipePackResource {
+	resource := &corerp.RecipePackResource{
+		Location: to.Ptr(v1.LocationGlobal),
+		Properties: &corerp.RecipePackProperties{
+			Version: to.Ptr(p.Version),
+			Recipes: map[string]map[string]corerp.RecipePropertiesClassification{},
+		},
+	}
+	if p.Description != "" {
+		resource.Properties.Description = to.Ptr(p.Description)
+	}
+
+	for resourceType, recipeNames := range p.Recipes {
+		resource.Properties.Recipes[resourceType] = map[string]corerp.RecipePropertiesClassification{}
+		for recipeName, recipe := range recipeNames {
+			resource.Properties.Recipes[resourceType][recipeName] = recipe.toRecipeProperties()
+		}
+	}
+
+	return resource
+}
+
+// FromResource converts a recipe pack resource to a recipe pack.
+func FromResource(resource corerp.RecipePackResource) *RecipePack {
+	pack := &RecipePack{
+		Name:    to.String(resource.Name),
+		Recipes: map[string]map[string]Recipe{},
+	}
+	if resource.Properties == nil {
+		return pack
+	}
+
+	pack.Version = to.String(resource.Properties.Version)
+	pack.Description = to.String(resource.Properties.Description)
+	for resourceType, recipeNames := range resource.Properties.Recipes {
+		pack.Recipes[resourceType] = map[string]Recipe{}
+		for recipeName, properties := range recipeNames {
+			pack.Recipes[resourceType][recipeName] = fromRecipeProperties(properties)
+		}
+	}
+
+	return pack
+}
+
+func (r Recipe) toRecipeProperties() corerp.RecipePropertiesClassification {
+	outputs := r.Outputs.toRecipeOutputContract()
+	switch r.TemplateKind {
+	case recipes.TemplateKindTerraform:
+		return &corerp.TerraformRecipeProperties{
+			TemplateKind:    to.Ptr(r.TemplateKind),
+			TemplatePath:    to.Ptr(r.TemplatePath),
+			TemplateVersion: to.Ptr(r.TemplateVersion),
+			Parameters:      r.Parameters,
+			Outputs:         outputs,
+		}
+	case recipes.TemplateKindHelm:
+		return &corerp.HelmRecipeProperties{
+			TemplateKind:    to.Ptr(r.TemplateKind),
+			TemplatePath:    to.Ptr(r.TemplatePath),
+			TemplateVersion: to.Ptr(r.TemplateVersion),
+			PlainHTTP:       to.Ptr(r.PlainHTTP),
+			Parameters:      r.Parameters,
+			Outputs:         outputs,
+		}
+	default:
+		return &corerp.BicepRecipeProperties{
+			TemplateKind: to.Ptr(r.TemplateKind),
+			TemplatePath: to.Ptr(r.TemplatePath),
+			PlainHTTP:    to.Ptr(r.PlainHTTP),
+			Parameters:   r.Parameters,
+			Outputs:      outputs,
+		}
+	}
+}
+
+func fromRecipeProperties(properties corerp.RecipePropertiesClassification) Recipe {
+	recipe := Recipe{}
+	switch c := properties.(type) {
+	case *corerp.TerraformRecipeProperties:
+		recipe = Recipe{
+			TemplateKind:    to.String(c.TemplateKind),
+			TemplatePath:    to.String(c.TemplatePath),
+			TemplateVersion: to.String(c.TemplateVersion),
+			Parameters:      c.Parameters,
+		}
+	case *corerp.HelmRecipeProperties:
+		recipe = Recipe{
+			TemplateKind:    to.String(c.TemplateKind),
+			TemplatePath:    to.String(c.TemplatePath),
+			TemplateVersion: to.String(c.TemplateVersion),
+			PlainHTTP:       to.Bool(c.PlainHTTP),
+			Parameters:      c.Parameters,
+		}
+	case *corerp.BicepRecipeProperties:
+		recipe = Recipe{
+			TemplateKind: to.String(c.TemplateKind),
+			TemplatePath: to.String(c.TemplatePath),
+			PlainHTTP:    to.Bool(c.PlainHTTP),
+			Parameters:   c.Parameters,
+		}
+	default:
+		base := properties.GetRecipeProperties()
+		recipe = Recipe{
+			TemplateKind: to.String(base.TemplateKind),
+			TemplatePath: to.String(base.TemplatePath),
+			Parameters:   base.Parameters,
+		}
+	}
+	recipe.Outputs = fromRecipeOutputContract(properties.GetRecipeProperties().Outputs)
+
+	return recipe
+}
+
+func (o *RecipeOutputs) toRecipeOutputContract() *corerp.RecipeOutputContract {
+	if o == nil {
+		return nil
+	}
+
+	contract := &corerp.RecipeOutputContract{}
+	if len(o.Values) > 0 {
+		contract.Values = map[string]*string{}
+		for name, outputType := range o.Values {
+			contract.Values[name] = to.Ptr(outputType)
+		}
+	}
+	if len(o.Secrets) > 0 {
+		contract.Secrets = to.SliceOfPtrs(o.Secrets...)
+	}
+	if o.Resources {
+		contract.Resources = to.Ptr(o.Resources)
+	}
+
+	return contract
+}
+
+func fromRecipeOutputContract(contract *corerp.RecipeOutputContract) *RecipeOutputs {
+	if contract == nil {
+		return nil
+	}
+
+	outputs := &RecipeOutputs{Resources: to.Bool(contract.Resources)}
+	if len(contract.Values) > 0 {
+		outputs.Values = to.StringMap(contract.Values)
+	}
+	for _, secret := range contract.Secrets {
+		outputs.Secrets = append(outputs.Secrets, to.String(secret))
+	}
+
+	return outputs
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipepack
+
+import (
+	"testing"
+
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ReadFile(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		pack, err := ReadFile("testdata/valid.yaml")
+		require.NoError(t, err)
+		require.Equal(t, "core-recipes", pack.Name)
+		require.Equal(t, "1.2.0", pack.Version)
+		require.Equal(t, "Recipes shared by all environments", pack.Description)
+		require.Equal(t, Recipe{
+			TemplateKind: "bicep",
+			TemplatePath: "ghcr.io/my-org/recipes/redis:1.2.0",
+		}, pack.Recipes["Applications.Datastores/redisCaches"]["default"])
+		require.Equal(t, "1.1.0", pack.Recipes["Applications.Datastores/sqlDatabases"]["default"].TemplateVersion)
+		require.Equal(t, &RecipeOutputs{
+			Values:  map[string]string{"server": "string", "port": "integer"},
+			Secrets: []string{"connectionString"},
+		}, pack.Recipes["Applications.Datastores/sqlDatabases"]["default"].Outputs)
+	})
+
+	t.Run("invalid version", func(t *testing.T) {
+		_, err := ReadFile("testdata/invalid-version.yaml")
+		require.ErrorContains(t, err, "invalid version \"latest\"")
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+		_, err := ReadFile("testdata/unknown-field.yaml")
+		require.ErrorContains(t, err, "templateVersoin")
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		_, err := ReadFile("testdata/missing.yaml")
+		require.Error(t, err)
+	})
+}
+
+func Test_Validate(t *testing.T) {
+	valid := func() *RecipePack {
+		return &RecipePack{
+			Name:    "core-recipes",
+			Version: "1.0.0",
+			Recipes: map[string]map[string]Recipe{
+				"Applications.Datastores/redisCaches": {
+					"default": {TemplateKind: "bicep", TemplatePath: "ghcr.io/my-org/recipes/redis:1.0.0"},
+				},
+			},
+		}
+	}
+
+	require.NoError(t, valid().Validate())
+
+	pack := valid()
+	pack.Name = ""
+	require.ErrorContains(t, pack.Validate(), "name of the recipe pack is required")
+
+	pack = valid()
+	pack.Recipes = nil
+	require.ErrorContains(t, pack.Validate(), "at least one recipe")
+
+	pack = valid()
+	pack.Recipes["Applications.Datastores/redisCaches"]["default"] = Recipe{TemplateKind: "pulumi", TemplatePath: "path"}
+	require.ErrorContains(t, pack.Validate(), "invalid template kind \"pulumi\"")
+
+	pack = valid()
+	pack.Recipes["Applications.Datastores/redisCaches"]["default"] = Recipe{TemplateKind: "bicep"}
+	require.ErrorContains(t, pack.Validate(), "template path of recipe \"default\"")
+
+	pack = valid()
+	pack.Recipes["Applications.Datastores/redisCaches"]["default"] = Recipe{
+		TemplateKind: "bicep",
+		TemplatePath: "path",
+		Outputs:      &RecipeOutputs{Values: map[string]string{"host": "uri"}},
+	}
+	require.ErrorContains(t, pack.Validate(), "invalid type \"uri\" of output \"host\"")
+}
+
+func Test_Resource_Roundtrip(t *testing.T) {
+	pack, err := ReadFile("testdata/valid.yaml")
+	require.NoError(t, err)
+
+	resource := pack.ToResource()
+	require.Equal(t, "1.2.0", to.String(resource.Properties.Version))
+	require.Equal(t, &corerp.BicepRecipeProperties{
+		TemplateKind: to.Ptr("bicep"),
+		TemplatePath: to.Ptr("ghcr.io/my-org/recipes/redis:1.2.0"),
+		PlainHTTP:    to.Ptr(false),
+	}, resource.Properties.Recipes["Applications.Datastores/redisCaches"]["default"])
+
+	resource.Name = to.Ptr("core-recipes")
+	require.Equal(t, pack, FromResource(*resource))
+}
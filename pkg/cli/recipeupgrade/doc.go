@@ -0,0 +1,23 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// recipeupgrade contains the logic to find the resources deployed with an outdated version of their recipe and to
+// roll them forward.
+//
+// A resource is outdated when the template recorded in the recipe status of the resource differs from the template
+// of the recipe currently registered to its environment, for example after an operator bumps the template version
+// of the recipe or publishes a new version of a recipe pack.
+package recipeupgrade
@@ -0,0 +1,251 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipeupgrade
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/recipes/recipepack"
+	"github.com/radius-project/radius/pkg/to"
+)
+
+const (
+	// defaultRecipeName is the name of the recipe used by a resource that does not specify a recipe.
+	defaultRecipeName = "default"
+)
+
+// Template is the template of a recipe.
+type Template struct {
+	// Kind is the kind of the template: bicep, terraform or helm.
+	Kind string `json:"templateKind"`
+
+	// Path is the path of the template.
+	Path string `json:"templatePath"`
+
+	// Version is the version of the template. Only set for terraform and helm recipes.
+	Version string `json:"templateVersion,omitempty"`
+}
+
+// String returns a short description of the template, e.g. "terraform Azure/redis/azurerm@1.1.0".
+func (t Template) String() string {
+	template := t.Kind + " " + t.Path
+	if t.Version != "" {
+		template += "@" + t.Version
+	}
+
+	return template
+}
+
+// equal compares the templates. Template kinds are case-insensitive.
+func (t Template) equal(other Template) bool {
+	return strings.EqualFold(t.Kind, other.Kind) && t.Path == other.Path && t.Version == other.Version
+}
+
+// OutdatedResource is a resource deployed with a template of its recipe that differs from the template of the recipe
+// registered to its environment.
+type OutdatedResource struct {
+	// ID is the resource ID of the resource.
+	ID string `json:"id"`
+
+	// Name is the name of the resource.
+	Name string `json:"name"`
+
+	// Type is the resource type of the resource.
+	Type string `json:"type"`
+
+	// RecipeName is the name of the recipe of the resource.
+	RecipeName string `json:"recipeName"`
+
+	// Deployed is the template of the recipe the resource was deployed with, e.g. "terraform Azure/redis/azurerm@1.1.0".
+	Deployed string `json:"deployed"`
+
+	// Registered is the template of the recipe registered to the environment.
+	Registered string `json:"registered"`
+
+	// Resource is the resource, as returned by the API.
+	Resource generated.GenericResource `json:"-"`
+}
+
+// Find returns the outdated resources of the environment, sorted by resource ID. If resourceType is not empty, only
+// the resources of that type are returned.
+func Find(ctx context.Context, client clients.ApplicationsManagementClient, environment corerp.EnvironmentResource, resourceType string) ([]OutdatedResource, error) {
+	registered, err := RegisteredRecipes(ctx, client, environment)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []generated.GenericResource
+	if resourceType != "" {
+		resources, err = client.ListResourcesOfTypeInEnvironment(ctx, to.String(environment.ID), resourceType)
+	} else {
+		resources, err = client.ListResourcesInEnvironment(ctx, to.String(environment.ID))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return FindOutdated(resources, registered), nil
+}
+
+// RegisteredRecipes returns the templates of the recipes registered to the environment, keyed by resource type and
+// recipe name: the recipes defined in the environment and the recipes of its recipe packs.
+func RegisteredRecipes(ctx context.Context, client clients.ApplicationsManagementClient, environment corerp.EnvironmentResource) (map[string]map[string]Template, error) {
+	if environment.Properties == nil {
+		return map[string]map[string]Template{}, nil
+	}
+
+	packs := []recipepack.Pack[corerp.RecipePropertiesClassification]{}
+	for _, recipePack := range environment.Properties.RecipePacks {
+		pack, err := client.GetRecipePack(ctx, to.String(recipePack.ID))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get recipe pack %q: %w", to.String(recipePack.ID), err)
+		}
+
+		var published *corerp.RecipePackVersion
+		if pack.Properties != nil {
+			published = pack.Properties.Versions[to.String(recipePack.Version)]
+		}
+		if published == nil {
+			return nil, fmt.Errorf("version %q of recipe pack %q is not published", to.String(recipePack.Version), to.String(recipePack.ID))
+		}
+
+		packs = append(packs, recipepack.Pack[corerp.RecipePropertiesClassification]{
+			ID:      to.String(recipePack.ID),
+			Recipes: published.Recipes,
+		})
+	}
+
+	resolved, err := recipepack.Resolve(environment.Properties.Recipes, packs)
+	if err != nil {
+		return nil, err
+	}
+
+	templates := map[string]map[string]Template{}
+	for resourceType, recipes := range resolved {
+		templates[resourceType] = map[string]Template{}
+		for recipeName, properties := range recipes {
+			templates[resourceType][recipeName] = registeredTemplate(properties)
+		}
+	}
+
+	return templates, nil
+}
+
+// FindOutdated returns the resources deployed with a template of their recipe that differs from the template of the
+// recipe registered to the environment, sorted by resource ID. Resources that are not provisioned by a recipe, and
+// resources whose recipe is not registered to the environment anymore, are ignored.
+func FindOutdated(resources []generated.GenericResource, registered map[string]map[string]Template) []OutdatedResource {
+	outdated := []OutdatedResource{}
+	for _, resource := range resources {
+		deployed, ok := deployedTemplate(resource)
+		if !ok {
+			continue
+		}
+
+		resourceType := to.String(resource.Type)
+		recipeName := RecipeName(resource)
+		template, ok := lookup(registered, resourceType, recipeName)
+		if !ok || template.equal(deployed) {
+			continue
+		}
+
+		outdated = append(outdated, OutdatedResource{
+			ID:         to.String(resource.ID),
+			Name:       to.String(resource.Name),
+			Type:       resourceType,
+			RecipeName: recipeName,
+			Deployed:   deployed.String(),
+			Registered: template.String(),
+			Resource:   resource,
+		})
+	}
+
+	slices.SortFunc(outdated, func(a, b OutdatedResource) int {
+		return strings.Compare(strings.ToLower(a.ID), strings.ToLower(b.ID))
+	})
+
+	return outdated
+}
+
+// RecipeName returns the name of the recipe of the resource.
+func RecipeName(resource generated.GenericResource) string {
+	recipe, _ := resource.Properties["recipe"].(map[string]any)
+	if name, ok := recipe["name"].(string); ok && name != "" {
+		return name
+	}
+
+	return defaultRecipeName
+}
+
+// RecipeParameters returns the parameters passed to the recipe by the resource.
+func RecipeParameters(resource generated.GenericResource) map[string]any {
+	recipe, _ := resource.Properties["recipe"].(map[string]any)
+	parameters, _ := recipe["parameters"].(map[string]any)
+	return parameters
+}
+
+// deployedTemplate returns the template recorded in the recipe status of the resource, if the resource was deployed
+// by a recipe.
+func deployedTemplate(resource generated.GenericResource) (Template, bool) {
+	status, _ := resource.Properties["status"].(map[string]any)
+	recipe, ok := status["recipe"].(map[string]any)
+	if !ok {
+		return Template{}, false
+	}
+
+	template := Template{}
+	template.Kind, _ = recipe["templateKind"].(string)
+	template.Path, _ = recipe["templatePath"].(string)
+	template.Version, _ = recipe["templateVersion"].(string)
+	if template.Path == "" {
+		return Template{}, false
+	}
+
+	return template, true
+}
+
+// registeredTemplate returns the template of a recipe registered to an environment.
+func registeredTemplate(properties corerp.RecipePropertiesClassification) Template {
+	switch c := properties.(type) {
+	case *corerp.TerraformRecipeProperties:
+		return Template{Kind: to.String(c.TemplateKind), Path: to.String(c.TemplatePath), Version: to.String(c.TemplateVersion)}
+	case *corerp.HelmRecipeProperties:
+		return Template{Kind: to.String(c.TemplateKind), Path: to.String(c.TemplatePath), Version: to.String(c.TemplateVersion)}
+	default:
+		base := properties.GetRecipeProperties()
+		return Template{Kind: to.String(base.TemplateKind), Path: to.String(base.TemplatePath)}
+	}
+}
+
+// lookup returns the template of a recipe registered to the environment. Resource types are case-insensitive.
+func lookup(registered map[string]map[string]Template, resourceType string, recipeName string) (Template, bool) {
+	for _, key := range slices.Sorted(maps.Keys(registered)) {
+		if strings.EqualFold(key, resourceType) {
+			template, ok := registered[key][recipeName]
+			return template, ok
+		}
+	}
+
+	return Template{}, false
+}
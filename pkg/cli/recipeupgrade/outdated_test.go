@@ -0,0 +1,229 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipeupgrade
+
+import (
+	"context"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	redisType = "Applications.Datastores/redisCaches"
+	mongoType = "Applications.Datastores/mongoDatabases"
+	scope     = "/planes/radius/local/resourcegroups/test-group"
+)
+
+func Test_RegisteredRecipes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	packID := scope + "/providers/Applications.Core/recipePacks/core-recipes"
+
+	packRecipes := map[string]map[string]corerp.RecipePropertiesClassification{
+		redisType: {
+			"default": &corerp.TerraformRecipeProperties{
+				TemplateKind:    to.Ptr(recipes.TemplateKindTerraform),
+				TemplatePath:    to.Ptr("Azure/redis/azurerm"),
+				TemplateVersion: to.Ptr("1.0.0"),
+			},
+		},
+		mongoType: {
+			"default": &corerp.BicepRecipeProperties{
+				TemplateKind: to.Ptr(recipes.TemplateKindBicep),
+				TemplatePath: to.Ptr("ghcr.io/radius-project/recipes/mongo:1.0.0"),
+			},
+		},
+	}
+
+	// The environment registers version 1.0.0 of the recipe pack, which is no longer its current version.
+	client := clients.NewMockApplicationsManagementClient(ctrl)
+	client.EXPECT().
+		GetRecipePack(gomock.Any(), packID).
+		Return(corerp.RecipePackResource{
+			Properties: &corerp.RecipePackProperties{
+				Version: to.Ptr("2.0.0"),
+				Recipes: map[string]map[string]corerp.RecipePropertiesClassification{},
+				Versions: map[string]*corerp.RecipePackVersion{
+					"1.0.0": {Recipes: packRecipes},
+					"2.0.0": {Recipes: map[string]map[string]corerp.RecipePropertiesClassification{}},
+				},
+			},
+		}, nil)
+
+	environment := corerp.EnvironmentResource{
+		Properties: &corerp.EnvironmentProperties{
+			RecipePacks: []*corerp.RecipePackReference{{ID: to.Ptr(packID), Version: to.Ptr("1.0.0")}},
+			Recipes: map[string]map[string]corerp.RecipePropertiesClassification{
+				redisType: {
+					"default": &corerp.TerraformRecipeProperties{
+						TemplateKind:    to.Ptr(recipes.TemplateKindTerraform),
+						TemplatePath:    to.Ptr("Azure/redis/azurerm"),
+						TemplateVersion: to.Ptr("1.1.0"),
+					},
+				},
+			},
+		},
+	}
+
+	registered, err := RegisteredRecipes(context.Background(), client, environment)
+	require.NoError(t, err)
+
+	// The recipe of the environment takes precedence over the recipe of the recipe pack.
+	expected := map[string]map[string]Template{
+		redisType: {
+			"default": {Kind: recipes.TemplateKindTerraform, Path: "Azure/redis/azurerm", Version: "1.1.0"},
+		},
+		mongoType: {
+			"default": {Kind: recipes.TemplateKindBicep, Path: "ghcr.io/radius-project/recipes/mongo:1.0.0"},
+		},
+	}
+	require.Equal(t, expected, registered)
+}
+
+func Test_FindOutdated(t *testing.T) {
+	registered := map[string]map[string]Template{
+		redisType: {
+			"default": {Kind: recipes.TemplateKindTerraform, Path: "Azure/redis/azurerm", Version: "1.1.0"},
+			"bicep":   {Kind: recipes.TemplateKindBicep, Path: "ghcr.io/radius-project/recipes/redis:2.0.0"},
+		},
+	}
+
+	resources := []generated.GenericResource{
+		// Deployed with an older template version of the default recipe.
+		recipeResource("outdated-version", redisType, "", recipes.TemplateKindTerraform, "Azure/redis/azurerm", "1.0.0"),
+		// Deployed with an older tag of the bicep template.
+		recipeResource("outdated-path", redisType, "bicep", recipes.TemplateKindBicep, "ghcr.io/radius-project/recipes/redis:1.0.0", ""),
+		// Up to date. Resource types are case-insensitive.
+		recipeResource("up-to-date", "applications.datastores/rediscaches", "default", recipes.TemplateKindTerraform, "Azure/redis/azurerm", "1.1.0"),
+		// The recipe is not registered to the environment anymore.
+		recipeResource("unregistered", redisType, "removed", recipes.TemplateKindTerraform, "Azure/redis/azurerm", "1.0.0"),
+		// Not provisioned by a recipe.
+		{
+			ID:         to.Ptr(scope + "/providers/" + redisType + "/manual"),
+			Name:       to.Ptr("manual"),
+			Type:       to.Ptr(redisType),
+			Properties: map[string]any{"resourceProvisioning": "manual"},
+		},
+	}
+
+	outdated := FindOutdated(resources, registered)
+	require.Len(t, outdated, 2)
+
+	require.Equal(t, "outdated-path", outdated[0].Name)
+	require.Equal(t, "bicep", outdated[0].RecipeName)
+	require.Equal(t, "bicep ghcr.io/radius-project/recipes/redis:1.0.0", outdated[0].Deployed)
+	require.Equal(t, "bicep ghcr.io/radius-project/recipes/redis:2.0.0", outdated[0].Registered)
+
+	require.Equal(t, "outdated-version", outdated[1].Name)
+	require.Equal(t, scope+"/providers/"+redisType+"/outdated-version", outdated[1].ID)
+	require.Equal(t, redisType, outdated[1].Type)
+	require.Equal(t, "default", outdated[1].RecipeName)
+	require.Equal(t, "terraform Azure/redis/azurerm@1.0.0", outdated[1].Deployed)
+	require.Equal(t, "terraform Azure/redis/azurerm@1.1.0", outdated[1].Registered)
+	require.Equal(t, resources[0], outdated[1].Resource)
+}
+
+func Test_Find(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	environmentID := scope + "/providers/Applications.Core/environments/test-env"
+	environment := corerp.EnvironmentResource{
+		ID: to.Ptr(environmentID),
+		Properties: &corerp.EnvironmentProperties{
+			Recipes: map[string]map[string]corerp.RecipePropertiesClassification{
+				redisType: {
+					"default": &corerp.TerraformRecipeProperties{
+						TemplateKind:    to.Ptr(recipes.TemplateKindTerraform),
+						TemplatePath:    to.Ptr("Azure/redis/azurerm"),
+						TemplateVersion: to.Ptr("1.1.0"),
+					},
+				},
+			},
+		},
+	}
+	resources := []generated.GenericResource{
+		recipeResource("redis", redisType, "", recipes.TemplateKindTerraform, "Azure/redis/azurerm", "1.0.0"),
+	}
+
+	t.Run("all resource types", func(t *testing.T) {
+		client := clients.NewMockApplicationsManagementClient(ctrl)
+		client.EXPECT().
+			ListResourcesInEnvironment(gomock.Any(), environmentID).
+			Return(resources, nil)
+
+		outdated, err := Find(context.Background(), client, environment, "")
+		require.NoError(t, err)
+		require.Len(t, outdated, 1)
+		require.Equal(t, "redis", outdated[0].Name)
+	})
+
+	t.Run("resource type", func(t *testing.T) {
+		client := clients.NewMockApplicationsManagementClient(ctrl)
+		client.EXPECT().
+			ListResourcesOfTypeInEnvironment(gomock.Any(), environmentID, redisType).
+			Return(resources, nil)
+
+		outdated, err := Find(context.Background(), client, environment, redisType)
+		require.NoError(t, err)
+		require.Len(t, outdated, 1)
+	})
+}
+
+func Test_RecipeParameters(t *testing.T) {
+	resource := generated.GenericResource{
+		Properties: map[string]any{
+			"recipe": map[string]any{
+				"name":       "default",
+				"parameters": map[string]any{"sku": "Premium"},
+			},
+		},
+	}
+	require.Equal(t, map[string]any{"sku": "Premium"}, RecipeParameters(resource))
+	require.Nil(t, RecipeParameters(generated.GenericResource{}))
+}
+
+// recipeResource returns a resource deployed by a recipe with the template recorded in its recipe status.
+func recipeResource(name string, resourceType string, recipeName string, templateKind string, templatePath string, templateVersion string) generated.GenericResource {
+	recipeStatus := map[string]any{
+		"templateKind": templateKind,
+		"templatePath": templatePath,
+	}
+	if templateVersion != "" {
+		recipeStatus["templateVersion"] = templateVersion
+	}
+
+	properties := map[string]any{
+		"environment": scope + "/providers/Applications.Core/environments/test-env",
+		"status":      map[string]any{"recipe": recipeStatus},
+	}
+	if recipeName != "" {
+		properties["recipe"] = map[string]any{"name": recipeName}
+	}
+
+	return generated.GenericResource{
+		ID:         to.Ptr(scope + "/providers/" + resourceType + "/" + name),
+		Name:       to.Ptr(name),
+		Type:       to.Ptr(resourceType),
+		Location:   to.Ptr("global"),
+		Properties: properties,
+	}
+}
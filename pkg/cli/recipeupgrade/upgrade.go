@@ -0,0 +1,95 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipeupgrade
+
+import (
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+)
+
+var (
+	// portableResourceTypePrefixes are the prefixes of the Radius portable resource types. Recipes write their outputs
+	// to the properties of these resources, such as the connection values of a Redis cache, so only the properties
+	// that configure the recipe are preserved when a portable resource is upgraded.
+	portableResourceTypePrefixes = []string{
+		"applications.datastores/",
+		"applications.messaging/",
+		"applications.dapr/",
+		"applications.core/extenders",
+	}
+
+	// portableResourceInputs are the properties of a portable resource provisioned by a recipe that are not written by
+	// the recipe.
+	portableResourceInputs = []string{"application", "environment", "recipe", "resourceProvisioning"}
+
+	// readOnlyProperties are the properties of resources that are written by Radius.
+	readOnlyProperties = []string{"status", "provisioningState"}
+)
+
+// UpgradeRequest returns the body of the request that redeploys the resource with the recipe registered to its
+// environment.
+//
+// The properties written by Radius and by the previous execution of the recipe are omitted, otherwise they would
+// be sent back as user input and take precedence over the outputs of the new version of the recipe.
+func UpgradeRequest(resource generated.GenericResource) *generated.GenericResource {
+	properties := map[string]any{}
+	if isPortableResourceType(resource.Type) {
+		for _, key := range portableResourceInputs {
+			if value, ok := resource.Properties[key]; ok {
+				properties[key] = value
+			}
+		}
+	} else {
+		properties = maps.Clone(resource.Properties)
+		for _, key := range readOnlyProperties {
+			delete(properties, key)
+		}
+	}
+
+	return &generated.GenericResource{
+		Location:   resource.Location,
+		Tags:       resource.Tags,
+		Properties: properties,
+	}
+}
+
+// Batches splits the outdated resources into batches of at most size resources. All the resources are in one batch
+// if size is not positive.
+func Batches(resources []OutdatedResource, size int) [][]OutdatedResource {
+	if size <= 0 {
+		size = len(resources)
+	}
+
+	return slices.Collect(slices.Chunk(resources, max(size, 1)))
+}
+
+func isPortableResourceType(resourceType *string) bool {
+	if resourceType == nil {
+		return false
+	}
+
+	for _, prefix := range portableResourceTypePrefixes {
+		if strings.HasPrefix(strings.ToLower(*resourceType), prefix) {
+			return true
+		}
+	}
+
+	return false
+}
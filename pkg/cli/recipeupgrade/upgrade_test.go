@@ -0,0 +1,94 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipeupgrade
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_UpgradeRequest(t *testing.T) {
+	t.Run("portable resource", func(t *testing.T) {
+		resource := generated.GenericResource{
+			ID:       to.Ptr(scope + "/providers/" + redisType + "/redis"),
+			Name:     to.Ptr("redis"),
+			Type:     to.Ptr(redisType),
+			Location: to.Ptr("global"),
+			Tags:     map[string]*string{"team": to.Ptr("platform")},
+			Properties: map[string]any{
+				"application":          "app",
+				"environment":          "env",
+				"recipe":               map[string]any{"name": "default"},
+				"resourceProvisioning": "recipe",
+				"host":                 "redis.example.com",
+				"port":                 6379,
+				"provisioningState":    "Succeeded",
+				"status":               map[string]any{"recipe": map[string]any{"templatePath": "Azure/redis/azurerm"}},
+			},
+		}
+
+		// The connection values written by the previous recipe are omitted.
+		expected := &generated.GenericResource{
+			Location: to.Ptr("global"),
+			Tags:     map[string]*string{"team": to.Ptr("platform")},
+			Properties: map[string]any{
+				"application":          "app",
+				"environment":          "env",
+				"recipe":               map[string]any{"name": "default"},
+				"resourceProvisioning": "recipe",
+			},
+		}
+		require.Equal(t, expected, UpgradeRequest(resource))
+	})
+
+	t.Run("user-defined resource type", func(t *testing.T) {
+		resource := generated.GenericResource{
+			ID:       to.Ptr(scope + "/providers/Test.Resources/userTypeAlpha/alpha"),
+			Type:     to.Ptr("Test.Resources/userTypeAlpha"),
+			Location: to.Ptr("global"),
+			Properties: map[string]any{
+				"environment":       "env",
+				"size":              "large",
+				"provisioningState": "Succeeded",
+				"status":            map[string]any{},
+			},
+		}
+
+		expected := &generated.GenericResource{
+			Location: to.Ptr("global"),
+			Properties: map[string]any{
+				"environment": "env",
+				"size":        "large",
+			},
+		}
+		require.Equal(t, expected, UpgradeRequest(resource))
+
+		// The resource is not modified.
+		require.Contains(t, resource.Properties, "status")
+	})
+}
+
+func Test_Batches(t *testing.T) {
+	resources := []OutdatedResource{{Name: "a"}, {Name: "b"}, {Name: "c"}}
+
+	require.Equal(t, [][]OutdatedResource{{{Name: "a"}, {Name: "b"}}, {{Name: "c"}}}, Batches(resources, 2))
+	require.Equal(t, [][]OutdatedResource{{{Name: "a"}, {Name: "b"}, {Name: "c"}}}, Batches(resources, 0))
+	require.Empty(t, Batches([]OutdatedResource{}, 2))
+}
@@ -46,12 +46,30 @@ const (
 	// terraformInstallVerificationDuration is the metric name for verifying the completion of a Terraform installation duration.
 	terraformInstallVerificationDuration = "recipe.tf.install.verification.duration"
 
+	// terraformCacheLookups is the metric name for the number of lookups in the Terraform plugin and module cache.
+	terraformCacheLookups = "recipe.tf.cache.lookups"
+
+	// terraformCacheEvictions is the metric name for the number of entries evicted from the Terraform plugin and module cache.
+	terraformCacheEvictions = "recipe.tf.cache.evictions"
+
+	// terraformCacheSize is the metric name for the size in bytes of the Terraform plugin and module cache.
+	terraformCacheSize = "recipe.tf.cache.size"
+
+	// recipeDriftChecks is the metric name for the number of drift checks of recipe-provisioned resources.
+	recipeDriftChecks = "recipe.drift.checks"
+
 	// RecipeEngineOperationExecute represents the Execute operation of the Recipe Engine.
 	RecipeEngineOperationExecute = "execute"
 
 	// RecipeEngineOperationDelete represents the Delete operation of the Recipe Engine.
 	RecipeEngineOperationDelete = "delete"
 
+	// RecipeEngineOperationPlan represents the Plan operation of the Recipe Engine.
+	RecipeEngineOperationPlan = "plan"
+
+	// RecipeEngineOperationDriftCheck represents the drift check of the resources deployed by a recipe.
+	RecipeEngineOperationDriftCheck = "drift.check"
+
 	// RecipeEngineOperationDownloadRecipe represents the Download Recipe operation of the Recipe Engine.
 	RecipeEngineOperationDownloadRecipe = "download.recipe"
 
@@ -61,12 +79,14 @@ const (
 
 type recipeEngineMetrics struct {
 	counters       map[string]metric.Int64Counter
+	upDownCounters map[string]metric.Int64UpDownCounter
 	valueRecorders map[string]metric.Float64Histogram
 }
 
 func newRecipeEngineMetrics() *recipeEngineMetrics {
 	return &recipeEngineMetrics{
 		counters:       make(map[string]metric.Int64Counter),
+		upDownCounters: make(map[string]metric.Int64UpDownCounter),
 		valueRecorders: make(map[string]metric.Float64Histogram),
 	}
 }
@@ -96,6 +116,26 @@ func (m *recipeEngineMetrics) Init() error {
 		return err
 	}
 
+	m.counters[terraformCacheLookups], err = meter.Int64Counter(terraformCacheLookups)
+	if err != nil {
+		return err
+	}
+
+	m.counters[terraformCacheEvictions], err = meter.Int64Counter(terraformCacheEvictions)
+	if err != nil {
+		return err
+	}
+
+	m.upDownCounters[terraformCacheSize], err = meter.Int64UpDownCounter(terraformCacheSize, metric.WithUnit("By"))
+	if err != nil {
+		return err
+	}
+
+	m.counters[recipeDriftChecks], err = meter.Int64Counter(recipeDriftChecks)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -140,6 +180,34 @@ func (m *recipeEngineMetrics) RecordTerraformInitializationDuration(ctx context.
 	}
 }
 
+// RecordTerraformCacheLookup records a lookup in the Terraform plugin and module cache with the given attributes.
+func (m *recipeEngineMetrics) RecordTerraformCacheLookup(ctx context.Context, attrs []attribute.KeyValue) {
+	if m.counters[terraformCacheLookups] != nil {
+		m.counters[terraformCacheLookups].Add(ctx, 1, metric.WithAttributes(attrs...))
+	}
+}
+
+// RecordTerraformCacheEviction records the eviction of an entry from the Terraform plugin and module cache with the given attributes.
+func (m *recipeEngineMetrics) RecordTerraformCacheEviction(ctx context.Context, attrs []attribute.KeyValue) {
+	if m.counters[terraformCacheEvictions] != nil {
+		m.counters[terraformCacheEvictions].Add(ctx, 1, metric.WithAttributes(attrs...))
+	}
+}
+
+// RecordTerraformCacheSizeChange records a change in bytes of the size of the Terraform plugin and module cache.
+func (m *recipeEngineMetrics) RecordTerraformCacheSizeChange(ctx context.Context, delta int64, attrs []attribute.KeyValue) {
+	if m.upDownCounters[terraformCacheSize] != nil {
+		m.upDownCounters[terraformCacheSize].Add(ctx, delta, metric.WithAttributes(attrs...))
+	}
+}
+
+// RecordRecipeDriftCheck records the result of a drift check of the resources deployed by a recipe with the given attributes.
+func (m *recipeEngineMetrics) RecordRecipeDriftCheck(ctx context.Context, attrs []attribute.KeyValue) {
+	if m.counters[recipeDriftChecks] != nil {
+		m.counters[recipeDriftChecks].Add(ctx, 1, metric.WithAttributes(attrs...))
+	}
+}
+
 // RecordRecipeGarbageCollectionDuration records the recipe garbage collection duration with the given attributes.
 func (m *recipeEngineMetrics) RecordRecipeGarbageCollectionDuration(ctx context.Context, startTime time.Time, attrs []attribute.KeyValue) {
 	if m.valueRecorders[recipeGCDuration] != nil {
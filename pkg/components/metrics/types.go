@@ -45,6 +45,24 @@ const (
 	// TerraformVersionAttrKey is the attribute key for the Terraform version.
 	TerraformVersionAttrKey = attribute.Key("terraform_version")
 
+	// TerraformCacheKindAttrKey is the attribute key for the kind of Terraform cache, either plugins or modules.
+	TerraformCacheKindAttrKey = attribute.Key("terraform_cache_kind")
+
+	// TerraformCacheResultAttrKey is the attribute key for the result of a Terraform cache lookup.
+	TerraformCacheResultAttrKey = attribute.Key("terraform_cache_result")
+
+	// CacheHit is the value for a cache lookup that found a populated entry.
+	CacheHit = "hit"
+
+	// CacheMiss is the value for a cache lookup that did not find a populated entry.
+	CacheMiss = "miss"
+
+	// DriftedOperationState is the value for a drift check that found changes to the resources deployed by a recipe.
+	DriftedOperationState = "drifted"
+
+	// InSyncOperationState is the value for a drift check that found no changes to the resources deployed by a recipe.
+	InSyncOperationState = "insync"
+
 	// SuccessfulOperationState is the value for a successful operation state.
 	SuccessfulOperationState = "success"
 
@@ -19,8 +19,10 @@ package v20231001preview
 import (
 	"fmt"
 	"reflect"
+	"slices"
 	"strings"
 
+	"github.com/hashicorp/go-version"
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/kubernetes"
@@ -28,11 +30,19 @@ import (
 
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
 )
 
 const (
 	EnvironmentComputeKindKubernetes = "kubernetes"
 	invalidLocalModulePathFmt        = "local module paths are not supported with Terraform Recipes. The 'templatePath' '%s' was detected as a local module path because it begins with '/' or './' or '../'."
+	invalidTerraformVersionFmt       = "invalid Terraform version %q. The version must be an exact version of Terraform, e.g. '1.7.5'."
+	invalidTerraformBackendKindFmt   = "invalid Terraform backend kind %q. Supported kinds: %s."
+	invalidTerraformDistributionFmt  = "invalid Terraform distribution %q. Supported distributions: %s."
+	invalidTerraformEncryption       = "state encryption is only supported with the 'opentofu' Terraform distribution."
+	invalidRecipeTimeoutSeconds      = "invalid recipe timeout. 'timeoutSeconds' must not be negative."
+	invalidRecipePackIDFmt           = "invalid recipe pack %q. Recipe packs must be referenced by the ID of an Applications.Core/recipePacks resource."
+	duplicateRecipePackFmt           = "recipe pack %q is registered to the environment more than once. Register a single version of each recipe pack."
 )
 
 // ConvertTo converts from the versioned Environment resource to version-agnostic datamodel.
@@ -62,32 +72,37 @@ func (src *EnvironmentResource) ConvertTo() (v1.DataModelInterface, error) {
 	}
 	converted.Properties.Compute = *envCompute
 	converted.Properties.RecipeConfig = toRecipeConfigDatamodel(src.Properties.RecipeConfig)
+	if terraformVersion := converted.Properties.RecipeConfig.Terraform.Version; terraformVersion != "" {
+		if _, err := version.NewVersion(terraformVersion); err != nil {
+			return &datamodel.Environment{}, v1.NewClientErrInvalidRequest(fmt.Sprintf(invalidTerraformVersionFmt, terraformVersion))
+		}
+	}
+	if backendKind := converted.Properties.RecipeConfig.Terraform.Backend.Kind; backendKind != "" && !slices.Contains(datamodel.SupportedTerraformBackends, backendKind) {
+		return &datamodel.Environment{}, v1.NewClientErrInvalidRequest(fmt.Sprintf(invalidTerraformBackendKindFmt, backendKind, strings.Join(datamodel.SupportedTerraformBackends, ", ")))
+	}
+	terraformConfig := converted.Properties.RecipeConfig.Terraform
+	if terraformConfig.Distribution != "" && !slices.Contains(datamodel.SupportedTerraformDistributions, terraformConfig.Distribution) {
+		return &datamodel.Environment{}, v1.NewClientErrInvalidRequest(fmt.Sprintf(invalidTerraformDistributionFmt, terraformConfig.Distribution, strings.Join(datamodel.SupportedTerraformDistributions, ", ")))
+	}
+	if terraformConfig.Encryption != nil && terraformConfig.Distribution != datamodel.TerraformDistributionOpenTofu {
+		return &datamodel.Environment{}, v1.NewClientErrInvalidRequest(invalidTerraformEncryption)
+	}
+	if converted.Properties.RecipeConfig.TimeoutSeconds < 0 {
+		return &datamodel.Environment{}, v1.NewClientErrInvalidRequest(invalidRecipeTimeoutSeconds)
+	}
 
 	if src.Properties.Recipes != nil {
-		envRecipes := make(map[string]map[string]datamodel.EnvironmentRecipeProperties)
-		for resourceType, recipes := range src.Properties.Recipes {
-			if resourceType == "" || strings.Count(resourceType, "/") != 1 {
-				return &datamodel.Environment{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid resource type: %q. Must be in the format \"ResourceProvider.Namespace/resourceType\"", resourceType))
-			}
-			envRecipes[resourceType] = map[string]datamodel.EnvironmentRecipeProperties{}
-			for recipeName, recipeDetails := range recipes {
-				if recipeDetails != nil {
-					if recipeDetails.GetRecipeProperties().TemplateKind == nil || !isValidTemplateKind(*recipeDetails.GetRecipeProperties().TemplateKind) {
-						formats := []string{}
-						for _, format := range types.SupportedTemplateKind {
-							formats = append(formats, fmt.Sprintf("%q", format))
-						}
-						return &datamodel.Environment{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid template kind. Allowed formats: %s", strings.Join(formats, ", ")))
-					}
-					envRecipes[resourceType][recipeName], err = toEnvironmentRecipeProperties(recipeDetails)
-					if err != nil {
-						return &datamodel.Environment{}, err
-					}
-				}
-			}
+		converted.Properties.Recipes, err = toRecipesDataModel(src.Properties.Recipes)
+		if err != nil {
+			return &datamodel.Environment{}, err
+		}
+	}
 
+	if src.Properties.RecipePacks != nil {
+		converted.Properties.RecipePacks, err = toRecipePacksDataModel(src.Properties.RecipePacks)
+		if err != nil {
+			return &datamodel.Environment{}, err
 		}
-		converted.Properties.Recipes = envRecipes
 	}
 
 	if src.Properties.Providers != nil {
@@ -141,14 +156,10 @@ func (dst *EnvironmentResource) ConvertFrom(src v1.DataModelInterface) error {
 	}
 
 	if env.Properties.Recipes != nil {
-		recipes := make(map[string]map[string]RecipePropertiesClassification)
-		for resourceType, recipe := range env.Properties.Recipes {
-			recipes[resourceType] = map[string]RecipePropertiesClassification{}
-			for recipeName, recipeDetails := range recipe {
-				recipes[resourceType][recipeName] = fromRecipePropertiesClassificationDatamodel(recipeDetails)
-			}
-		}
-		dst.Properties.Recipes = recipes
+		dst.Properties.Recipes = fromRecipesDataModel(env.Properties.Recipes)
+	}
+	if env.Properties.RecipePacks != nil {
+		dst.Properties.RecipePacks = fromRecipePacksDataModel(env.Properties.RecipePacks)
 	}
 	dst.Properties.RecipeConfig = fromRecipeConfigDatamodel(env.Properties.RecipeConfig)
 
@@ -200,10 +211,43 @@ func toRecipeConfigDatamodel(config *RecipeConfigProperties) datamodel.RecipeCon
 						}
 						recipeConfig.Terraform.Authentication.Git.PAT = p
 					}
+					if gitConfig.SSH != nil {
+						sshConfig := map[string]datamodel.SSHConfig{}
+						for k, v := range gitConfig.SSH {
+							sshConfig[k] = datamodel.SSHConfig{
+								Secret: to.String(v.Secret),
+							}
+						}
+						recipeConfig.Terraform.Authentication.Git.SSH = sshConfig
+					}
+				}
+				if config.Terraform.Authentication.Registry != nil {
+					registryConfig := map[string]datamodel.TerraformRegistryAuthConfig{}
+					for k, v := range config.Terraform.Authentication.Registry {
+						registryConfig[k] = datamodel.TerraformRegistryAuthConfig{
+							Secret: to.String(v.Secret),
+						}
+					}
+					recipeConfig.Terraform.Authentication.Registry = registryConfig
 				}
 			}
 
 			recipeConfig.Terraform.Providers = toRecipeConfigTerraformProvidersDatamodel(config)
+			recipeConfig.Terraform.Version = to.String(config.Terraform.Version)
+			if config.Terraform.Backend != nil {
+				recipeConfig.Terraform.Backend = datamodel.TerraformBackendConfig{
+					Kind:    to.String(config.Terraform.Backend.Kind),
+					Config:  config.Terraform.Backend.Config,
+					Secrets: toSecretReferenceDatamodel(config.Terraform.Backend.Secrets),
+				}
+			}
+			recipeConfig.Terraform.Distribution = to.String(config.Terraform.Distribution)
+			if config.Terraform.Encryption != nil {
+				recipeConfig.Terraform.Encryption = &datamodel.SecretReference{
+					Source: to.String(config.Terraform.Encryption.Source),
+					Key:    to.String(config.Terraform.Encryption.Key),
+				}
+			}
 		}
 
 		if config.Bicep != nil {
@@ -221,6 +265,7 @@ func toRecipeConfigDatamodel(config *RecipeConfigProperties) datamodel.RecipeCon
 
 		recipeConfig.Env = toRecipeConfigEnvDatamodel(config)
 		recipeConfig.EnvSecrets = toSecretReferenceDatamodel(config.EnvSecrets)
+		recipeConfig.TimeoutSeconds = to.Int32(config.TimeoutSeconds)
 
 		return recipeConfig
 	}
@@ -245,10 +290,47 @@ func fromRecipeConfigDatamodel(config datamodel.RecipeConfigProperties) *RecipeC
 							}
 						}
 					}
+					if config.Terraform.Authentication.Git.SSH != nil {
+						recipeConfig.Terraform.Authentication.Git.SSH = map[string]*SSHConfig{}
+						for k, v := range config.Terraform.Authentication.Git.SSH {
+							recipeConfig.Terraform.Authentication.Git.SSH[k] = &SSHConfig{
+								Secret: to.Ptr(v.Secret),
+							}
+						}
+					}
+				}
+				if config.Terraform.Authentication.Registry != nil {
+					recipeConfig.Terraform.Authentication.Registry = map[string]*TerraformRegistryAuthConfig{}
+					for k, v := range config.Terraform.Authentication.Registry {
+						recipeConfig.Terraform.Authentication.Registry[k] = &TerraformRegistryAuthConfig{
+							Secret: to.Ptr(v.Secret),
+						}
+					}
 				}
 			}
 
 			recipeConfig.Terraform.Providers = fromRecipeConfigTerraformProvidersDatamodel(config)
+			if config.Terraform.Version != "" {
+				recipeConfig.Terraform.Version = to.Ptr(config.Terraform.Version)
+			}
+			if !reflect.DeepEqual(config.Terraform.Backend, datamodel.TerraformBackendConfig{}) {
+				recipeConfig.Terraform.Backend = &TerraformBackendConfig{
+					Config:  config.Terraform.Backend.Config,
+					Secrets: fromSecretReferenceDatamodel(config.Terraform.Backend.Secrets),
+				}
+				if config.Terraform.Backend.Kind != "" {
+					recipeConfig.Terraform.Backend.Kind = to.Ptr(config.Terraform.Backend.Kind)
+				}
+			}
+			if config.Terraform.Distribution != "" {
+				recipeConfig.Terraform.Distribution = to.Ptr(config.Terraform.Distribution)
+			}
+			if config.Terraform.Encryption != nil {
+				recipeConfig.Terraform.Encryption = &SecretReference{
+					Source: to.Ptr(config.Terraform.Encryption.Source),
+					Key:    to.Ptr(config.Terraform.Encryption.Key),
+				}
+			}
 		}
 
 		if !reflect.DeepEqual(config.Bicep, datamodel.BicepConfigProperties{}) {
@@ -265,6 +347,9 @@ func fromRecipeConfigDatamodel(config datamodel.RecipeConfigProperties) *RecipeC
 
 		recipeConfig.Env = fromRecipeConfigEnvDatamodel(config)
 		recipeConfig.EnvSecrets = fromSecretReferenceDatamodel(config.EnvSecrets)
+		if config.TimeoutSeconds != 0 {
+			recipeConfig.TimeoutSeconds = to.Ptr(config.TimeoutSeconds)
+		}
 
 		return recipeConfig
 	}
@@ -387,7 +472,111 @@ func toEnvExtensionDataModel(e ExtensionClassification) datamodel.Extension {
 	return datamodel.Extension{}
 }
 
+// toRecipesDataModel converts the versioned recipes, keyed by resource type and recipe name, to the datamodel.
+func toRecipesDataModel(src map[string]map[string]RecipePropertiesClassification) (map[string]map[string]datamodel.EnvironmentRecipeProperties, error) {
+	converted := make(map[string]map[string]datamodel.EnvironmentRecipeProperties)
+	for resourceType, recipes := range src {
+		if resourceType == "" || strings.Count(resourceType, "/") != 1 {
+			return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid resource type: %q. Must be in the format \"ResourceProvider.Namespace/resourceType\"", resourceType))
+		}
+		converted[resourceType] = map[string]datamodel.EnvironmentRecipeProperties{}
+		for recipeName, recipeDetails := range recipes {
+			if recipeDetails != nil {
+				if recipeDetails.GetRecipeProperties().TemplateKind == nil || !isValidTemplateKind(*recipeDetails.GetRecipeProperties().TemplateKind) {
+					formats := []string{}
+					for _, format := range types.SupportedTemplateKind {
+						formats = append(formats, fmt.Sprintf("%q", format))
+					}
+					return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid template kind. Allowed formats: %s", strings.Join(formats, ", ")))
+				}
+				recipe, err := toEnvironmentRecipeProperties(recipeDetails)
+				if err != nil {
+					return nil, err
+				}
+				converted[resourceType][recipeName] = recipe
+			}
+		}
+	}
+
+	return converted, nil
+}
+
+// fromRecipesDataModel converts the datamodel recipes, keyed by resource type and recipe name, to the versioned model.
+func fromRecipesDataModel(src map[string]map[string]datamodel.EnvironmentRecipeProperties) map[string]map[string]RecipePropertiesClassification {
+	converted := make(map[string]map[string]RecipePropertiesClassification)
+	for resourceType, recipes := range src {
+		converted[resourceType] = map[string]RecipePropertiesClassification{}
+		for recipeName, recipeDetails := range recipes {
+			converted[resourceType][recipeName] = fromRecipePropertiesClassificationDatamodel(recipeDetails)
+		}
+	}
+
+	return converted
+}
+
+// isValidRecipePackID returns true if the ID is the ID of an Applications.Core/recipePacks resource.
+// toRecipePacksDataModel converts the recipe pack references of an environment to the datamodel. It returns a client
+// error if a reference does not identify a recipe pack or a semantic version, or if a recipe pack is registered twice.
+func toRecipePacksDataModel(recipePacks []*RecipePackReference) ([]datamodel.RecipePackReference, error) {
+	converted := []datamodel.RecipePackReference{}
+	seen := map[string]bool{}
+	for _, recipePack := range recipePacks {
+		if recipePack == nil {
+			continue
+		}
+
+		id := to.String(recipePack.ID)
+		if !isValidRecipePackID(id) {
+			return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf(invalidRecipePackIDFmt, id))
+		}
+
+		packVersion := to.String(recipePack.Version)
+		if _, err := version.NewSemver(packVersion); err != nil {
+			return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf(invalidRecipePackVersionFmt, packVersion))
+		}
+
+		if seen[strings.ToLower(id)] {
+			return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf(duplicateRecipePackFmt, id))
+		}
+		seen[strings.ToLower(id)] = true
+
+		converted = append(converted, datamodel.RecipePackReference{
+			ID:      id,
+			Version: packVersion,
+		})
+	}
+
+	return converted, nil
+}
+
+// fromRecipePacksDataModel converts the recipe pack references of an environment from the datamodel.
+func fromRecipePacksDataModel(recipePacks []datamodel.RecipePackReference) []*RecipePackReference {
+	converted := []*RecipePackReference{}
+	for _, recipePack := range recipePacks {
+		converted = append(converted, &RecipePackReference{
+			ID:      to.Ptr(recipePack.ID),
+			Version: to.Ptr(recipePack.Version),
+		})
+	}
+
+	return converted
+}
+
+func isValidRecipePackID(id string) bool {
+	parsed, err := resources.ParseResource(id)
+	if err != nil {
+		return false
+	}
+
+	return strings.EqualFold(parsed.Type(), datamodel.RecipePackResourceType)
+}
+
 func toEnvironmentRecipeProperties(e RecipePropertiesClassification) (datamodel.EnvironmentRecipeProperties, error) {
+	outputs, err := toRecipeOutputContractDataModel(e.GetRecipeProperties().Outputs)
+	if err != nil {
+		return datamodel.EnvironmentRecipeProperties{}, err
+	}
+
 	switch c := e.(type) {
 	case *TerraformRecipeProperties:
 		if c.TemplatePath != nil {
@@ -401,6 +590,7 @@ func toEnvironmentRecipeProperties(e RecipePropertiesClassification) (datamodel.
 			TemplateVersion: to.String(c.TemplateVersion),
 			TemplatePath:    to.String(c.TemplatePath),
 			Parameters:      c.Parameters,
+			Outputs:         outputs,
 		}, nil
 	case *BicepRecipeProperties:
 		return datamodel.EnvironmentRecipeProperties{
@@ -408,6 +598,16 @@ func toEnvironmentRecipeProperties(e RecipePropertiesClassification) (datamodel.
 			TemplatePath: to.String(c.TemplatePath),
 			PlainHTTP:    to.Bool(c.PlainHTTP),
 			Parameters:   c.Parameters,
+			Outputs:      outputs,
+		}, nil
+	case *HelmRecipeProperties:
+		return datamodel.EnvironmentRecipeProperties{
+			TemplateKind:    types.TemplateKindHelm,
+			TemplateVersion: to.String(c.TemplateVersion),
+			TemplatePath:    to.String(c.TemplatePath),
+			PlainHTTP:       to.Bool(c.PlainHTTP),
+			Parameters:      c.Parameters,
+			Outputs:         outputs,
 		}, nil
 	}
 	return datamodel.EnvironmentRecipeProperties{}, nil
@@ -421,6 +621,7 @@ func fromRecipePropertiesClassificationDatamodel(e datamodel.EnvironmentRecipePr
 			TemplateVersion: to.Ptr(e.TemplateVersion),
 			TemplatePath:    to.Ptr(e.TemplatePath),
 			Parameters:      e.Parameters,
+			Outputs:         fromRecipeOutputContractDataModel(e.Outputs),
 		}
 	case types.TemplateKindBicep:
 		return &BicepRecipeProperties{
@@ -428,12 +629,74 @@ func fromRecipePropertiesClassificationDatamodel(e datamodel.EnvironmentRecipePr
 			TemplatePath: to.Ptr(e.TemplatePath),
 			Parameters:   e.Parameters,
 			PlainHTTP:    to.Ptr(e.PlainHTTP),
+			Outputs:      fromRecipeOutputContractDataModel(e.Outputs),
+		}
+	case types.TemplateKindHelm:
+		return &HelmRecipeProperties{
+			TemplateKind:    to.Ptr(e.TemplateKind),
+			TemplateVersion: to.Ptr(e.TemplateVersion),
+			TemplatePath:    to.Ptr(e.TemplatePath),
+			Parameters:      e.Parameters,
+			PlainHTTP:       to.Ptr(e.PlainHTTP),
+			Outputs:         fromRecipeOutputContractDataModel(e.Outputs),
 		}
 	}
 
 	return nil
 }
 
+// toRecipeOutputContractDataModel converts the versioned output contract of a recipe to the datamodel, validating the
+// types of the values.
+func toRecipeOutputContractDataModel(outputs *RecipeOutputContract) (*datamodel.RecipeOutputContract, error) {
+	if outputs == nil {
+		return nil, nil
+	}
+
+	converted := &datamodel.RecipeOutputContract{
+		Resources: to.Bool(outputs.Resources),
+	}
+	if len(outputs.Values) > 0 {
+		converted.Values = map[string]string{}
+	}
+	for name, outputType := range outputs.Values {
+		if !slices.Contains(types.SupportedOutputTypes, to.String(outputType)) {
+			return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid type %q of recipe output %q. Allowed types: %s", to.String(outputType), name, strings.Join(types.SupportedOutputTypes, ", ")))
+		}
+		converted.Values[name] = to.String(outputType)
+	}
+	for _, secret := range outputs.Secrets {
+		if to.String(secret) == "" {
+			return nil, v1.NewClientErrInvalidRequest("the names of the secret outputs of a recipe must be non-empty strings")
+		}
+		converted.Secrets = append(converted.Secrets, to.String(secret))
+	}
+
+	return converted, nil
+}
+
+// fromRecipeOutputContractDataModel converts the datamodel output contract of a recipe to the versioned model.
+func fromRecipeOutputContractDataModel(outputs *datamodel.RecipeOutputContract) *RecipeOutputContract {
+	if outputs == nil {
+		return nil
+	}
+
+	converted := &RecipeOutputContract{}
+	if len(outputs.Values) > 0 {
+		converted.Values = map[string]*string{}
+		for name, outputType := range outputs.Values {
+			converted.Values[name] = to.Ptr(outputType)
+		}
+	}
+	if len(outputs.Secrets) > 0 {
+		converted.Secrets = to.SliceOfPtrs(outputs.Secrets...)
+	}
+	if outputs.Resources {
+		converted.Resources = to.Ptr(outputs.Resources)
+	}
+
+	return converted
+}
+
 func toRecipeConfigTerraformProvidersDatamodel(config *RecipeConfigProperties) map[string][]datamodel.ProviderConfigProperties {
 	if config.Terraform == nil || config.Terraform.Providers == nil {
 		return nil
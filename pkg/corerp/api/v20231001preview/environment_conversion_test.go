@@ -19,6 +19,7 @@ package v20231001preview
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 	"testing"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
@@ -137,6 +138,16 @@ func TestConvertVersionedToDataModel(t *testing.T) {
 											Secret: "/planes/radius/local/resourcegroups/default/providers/Applications.Core/secretStores/github",
 										},
 									},
+									SSH: map[string]datamodel.SSHConfig{
+										"github.com": {
+											Secret: "/planes/radius/local/resourcegroups/default/providers/Applications.Core/secretStores/deploykey",
+										},
+									},
+								},
+								Registry: map[string]datamodel.TerraformRegistryAuthConfig{
+									"app.terraform.io": {
+										Secret: "/planes/radius/local/resourcegroups/default/providers/Applications.Core/secretStores/tfregistry",
+									},
 								},
 							},
 							Providers: map[string][]datamodel.ProviderConfigProperties{
@@ -158,6 +169,30 @@ func TestConvertVersionedToDataModel(t *testing.T) {
 									},
 								},
 							},
+							Version: "1.7.5",
+							Backend: datamodel.TerraformBackendConfig{
+								Kind: datamodel.TerraformBackendS3,
+								Config: map[string]any{
+									"bucket":         "tfstate",
+									"region":         "us-east-1",
+									"use_path_style": true,
+								},
+								Secrets: map[string]datamodel.SecretReference{
+									"access_key": {
+										Source: "/planes/radius/local/resourcegroups/default/providers/Applications.Core/secretStores/minio",
+										Key:    "accessKey",
+									},
+									"secret_key": {
+										Source: "/planes/radius/local/resourcegroups/default/providers/Applications.Core/secretStores/minio",
+										Key:    "secretKey",
+									},
+								},
+							},
+							Distribution: datamodel.TerraformDistributionOpenTofu,
+							Encryption: &datamodel.SecretReference{
+								Source: "/planes/radius/local/resourcegroups/default/providers/Applications.Core/secretStores/tofu",
+								Key:    "encryption",
+							},
 						},
 						Bicep: datamodel.BicepConfigProperties{
 							Authentication: map[string]datamodel.RegistrySecretConfig{
@@ -177,6 +212,7 @@ func TestConvertVersionedToDataModel(t *testing.T) {
 								Key:    "envKey1",
 							},
 						},
+						TimeoutSeconds: 600,
 					},
 					Recipes: map[string]map[string]datamodel.EnvironmentRecipeProperties{
 						ds_ctrl.MongoDatabasesResourceType: {
@@ -202,6 +238,16 @@ func TestConvertVersionedToDataModel(t *testing.T) {
 								TemplateKind: recipes.TemplateKindBicep,
 								TemplatePath: "br:ghcr.io/sampleregistry/radius/recipes/rediscaches",
 								PlainHTTP:    true,
+								Outputs: &datamodel.RecipeOutputContract{
+									Values:    map[string]string{"host": "string", "port": "integer"},
+									Secrets:   []string{"password"},
+									Resources: true,
+								},
+							},
+							"helm-recipe": datamodel.EnvironmentRecipeProperties{
+								TemplateKind:    recipes.TemplateKindHelm,
+								TemplatePath:    "oci://ghcr.io/sampleregistry/charts/redis",
+								TemplateVersion: "18.0.0",
 							},
 						},
 						dapr_ctrl.DaprStateStoresResourceType: {
@@ -344,16 +390,40 @@ func TestConvertVersionedToDataModel(t *testing.T) {
 		},
 		{
 			filename: "environmentresource-invalid-templatekind.json",
-			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: "invalid template kind. Allowed formats: \"bicep\", \"terraform\""},
+			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: "invalid template kind. Allowed formats: \"bicep\", \"terraform\", \"helm\""},
 		},
 		{
 			filename: "environmentresource-missing-templatekind.json",
-			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: "invalid template kind. Allowed formats: \"bicep\", \"terraform\""},
+			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: "invalid template kind. Allowed formats: \"bicep\", \"terraform\", \"helm\""},
 		},
 		{
 			filename: "environmentresource-terraformrecipe-localpath.json",
 			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: fmt.Sprintf(invalidLocalModulePathFmt, "../not-allowed/")},
 		},
+		{
+			filename: "environmentresource-invalid-terraformversion.json",
+			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: fmt.Sprintf(invalidTerraformVersionFmt, ">= 1.7")},
+		},
+		{
+			filename: "environmentresource-invalid-terraformbackend.json",
+			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: fmt.Sprintf(invalidTerraformBackendKindFmt, "gcs", "kubernetes, pg, s3, http")},
+		},
+		{
+			filename: "environmentresource-invalid-terraformdistribution.json",
+			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: fmt.Sprintf(invalidTerraformDistributionFmt, "pulumi", "terraform, opentofu")},
+		},
+		{
+			filename: "environmentresource-invalid-terraformencryption.json",
+			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: invalidTerraformEncryption},
+		},
+		{
+			filename: "environmentresource-invalid-recipetimeout.json",
+			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: invalidRecipeTimeoutSeconds},
+		},
+		{
+			filename: "environmentresource-invalid-recipeoutputs.json",
+			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: "invalid type \"uri\" of recipe output \"host\". Allowed types: string, integer, number, boolean, object, array"},
+		},
 	}
 
 	for _, tt := range conversionTests {
@@ -432,7 +502,20 @@ func TestConvertDataModelToVersioned(t *testing.T) {
 					require.Equal(t, "Azure/cosmosdb/azurerm", string(*versioned.Properties.Recipes[ds_ctrl.MongoDatabasesResourceType]["terraform-recipe"].GetRecipeProperties().TemplatePath))
 					require.Equal(t, recipes.TemplateKindTerraform, string(*versioned.Properties.Recipes[ds_ctrl.MongoDatabasesResourceType]["terraform-recipe"].GetRecipeProperties().TemplateKind))
 					require.Equal(t, baseSecretStorePath+"github", string(*versioned.Properties.RecipeConfig.Terraform.Authentication.Git.Pat["dev.azure.com"].Secret))
+					require.Equal(t, baseSecretStorePath+"deploykey", string(*versioned.Properties.RecipeConfig.Terraform.Authentication.Git.SSH["github.com"].Secret))
+					require.Equal(t, baseSecretStorePath+"tfregistry", string(*versioned.Properties.RecipeConfig.Terraform.Authentication.Registry["app.terraform.io"].Secret))
 					require.Equal(t, baseSecretStorePath+"acr-secret", string(*versioned.Properties.RecipeConfig.Bicep.Authentication["test.azurecr.io"].Secret))
+					require.Equal(t, "1.7.5", string(*versioned.Properties.RecipeConfig.Terraform.Version))
+					require.Equal(t, "s3", string(*versioned.Properties.RecipeConfig.Terraform.Backend.Kind))
+					require.Equal(t, "tfstate", versioned.Properties.RecipeConfig.Terraform.Backend.Config["bucket"])
+					require.Equal(t, to.Ptr(SecretReference{Source: to.Ptr(baseSecretStorePath + "minio"), Key: to.Ptr("secretKey")}), versioned.Properties.RecipeConfig.Terraform.Backend.Secrets["secret_key"])
+					require.Equal(t, "opentofu", string(*versioned.Properties.RecipeConfig.Terraform.Distribution))
+					require.Equal(t, to.Ptr(SecretReference{Source: to.Ptr(baseSecretStorePath + "tofu"), Key: to.Ptr("encryption")}), versioned.Properties.RecipeConfig.Terraform.Encryption)
+					require.Equal(t, to.Ptr(int32(600)), versioned.Properties.RecipeConfig.TimeoutSeconds)
+					require.Equal(t, &RecipeOutputContract{
+						Values:  map[string]*string{"connectionString": to.Ptr("string")},
+						Secrets: []*string{to.Ptr("password")},
+					}, versioned.Properties.Recipes[ds_ctrl.MongoDatabasesResourceType]["cosmos-recipe"].GetRecipeProperties().Outputs)
 					switch c := recipeDetails.(type) {
 					case *TerraformRecipeProperties:
 						require.Equal(t, "1.1.0", string(*c.TemplateVersion))
@@ -1108,3 +1191,71 @@ func Test_fromSecretReferenceDatamodel(t *testing.T) {
 		})
 	}
 }
+
+func Test_toRecipePacksDataModel(t *testing.T) {
+	corePackID := "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/recipePacks/core"
+	teamPackID := "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/recipePacks/team"
+
+	tests := []struct {
+		name        string
+		recipePacks []*RecipePackReference
+		expected    []datamodel.RecipePackReference
+		err         string
+	}{
+		{
+			name: "valid recipe packs",
+			recipePacks: []*RecipePackReference{
+				{ID: to.Ptr(corePackID), Version: to.Ptr("1.2.0")},
+				{ID: to.Ptr(teamPackID), Version: to.Ptr("0.1.0")},
+			},
+			expected: []datamodel.RecipePackReference{
+				{ID: corePackID, Version: "1.2.0"},
+				{ID: teamPackID, Version: "0.1.0"},
+			},
+		},
+		{
+			name: "invalid recipe pack ID",
+			recipePacks: []*RecipePackReference{
+				{ID: to.Ptr("/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/env"), Version: to.Ptr("1.2.0")},
+			},
+			err: "Recipe packs must be referenced by the ID of an Applications.Core/recipePacks resource.",
+		},
+		{
+			name: "missing version",
+			recipePacks: []*RecipePackReference{
+				{ID: to.Ptr(corePackID)},
+			},
+			err: `invalid recipe pack version ""`,
+		},
+		{
+			name: "invalid version",
+			recipePacks: []*RecipePackReference{
+				{ID: to.Ptr(corePackID), Version: to.Ptr("latest")},
+			},
+			err: `invalid recipe pack version "latest"`,
+		},
+		{
+			name: "recipe pack registered twice",
+			recipePacks: []*RecipePackReference{
+				{ID: to.Ptr(corePackID), Version: to.Ptr("1.2.0")},
+				{ID: to.Ptr(strings.Replace(corePackID, "test-group", "TEST-GROUP", 1)), Version: to.Ptr("1.1.0")},
+			},
+			err: "is registered to the environment more than once",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := toRecipePacksDataModel(tt.recipePacks)
+			if tt.err != "" {
+				require.ErrorIs(t, err, &v1.ErrClientRP{})
+				require.Contains(t, err.Error(), tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, result)
+			require.Equal(t, tt.recipePacks, fromRecipePacksDataModel(result))
+		})
+	}
+}
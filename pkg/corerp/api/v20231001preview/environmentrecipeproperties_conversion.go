@@ -43,6 +43,9 @@ func (dst *RecipeGetMetadataResponse) ConvertFrom(src v1.DataModelInterface) err
 		dst.TemplateVersion = to.Ptr(recipe.TemplateVersion)
 	case types.TemplateKindBicep:
 		dst.PlainHTTP = to.Ptr(recipe.PlainHTTP)
+	case types.TemplateKindHelm:
+		dst.TemplateVersion = to.Ptr(recipe.TemplateVersion)
+		dst.PlainHTTP = to.Ptr(recipe.PlainHTTP)
 	}
 	dst.Parameters = recipe.Parameters
 	return nil
@@ -55,3 +58,80 @@ func (src *RecipeGetMetadata) ConvertTo() (v1.DataModelInterface, error) {
 		ResourceType: to.String(src.ResourceType),
 	}, nil
 }
+
+// ConvertTo converts from the versioned recipe plan request to version-agnostic datamodel.
+func (src *RecipePlanRequest) ConvertTo() (v1.DataModelInterface, error) {
+	return &datamodel.RecipePlanRequest{
+		ResourceID:    to.String(src.ResourceID),
+		RecipeName:    to.String(src.RecipeName),
+		ApplicationID: to.String(src.ApplicationID),
+		Parameters:    src.Parameters,
+	}, nil
+}
+
+// ConvertTo returns an error as it does not support converting the recipe plan response to a version-agnostic object.
+func (src *RecipePlanResponse) ConvertTo() (v1.DataModelInterface, error) {
+	return nil, fmt.Errorf("converting recipe plan response to a version-agnostic object is not supported")
+}
+
+// ConvertFrom converts from version-agnostic datamodel to the versioned recipe plan response.
+func (dst *RecipePlanResponse) ConvertFrom(src v1.DataModelInterface) error {
+	plan, ok := src.(*datamodel.RecipePlan)
+	if !ok {
+		return v1.ErrInvalidModelConversion
+	}
+	dst.TemplateKind = to.Ptr(plan.TemplateKind)
+	dst.TemplatePath = to.Ptr(plan.TemplatePath)
+	dst.ResourceChanges = []*RecipeResourceChange{}
+	for _, change := range plan.ResourceChanges {
+		dst.ResourceChanges = append(dst.ResourceChanges, &RecipeResourceChange{
+			ID:     to.Ptr(change.ID),
+			Type:   to.Ptr(change.Type),
+			Action: to.Ptr(change.Action),
+		})
+	}
+	return nil
+}
+
+// ConvertTo converts from the versioned recipe logs request to version-agnostic datamodel.
+func (src *RecipeLogsRequest) ConvertTo() (v1.DataModelInterface, error) {
+	return &datamodel.RecipeLogsRequest{
+		ResourceID:  to.String(src.ResourceID),
+		OperationID: to.String(src.OperationID),
+	}, nil
+}
+
+// ConvertTo returns an error as it does not support converting the recipe logs response to a version-agnostic object.
+func (src *RecipeLogsResponse) ConvertTo() (v1.DataModelInterface, error) {
+	return nil, fmt.Errorf("converting recipe logs response to a version-agnostic object is not supported")
+}
+
+// ConvertFrom converts from version-agnostic datamodel to the versioned recipe logs response.
+func (dst *RecipeLogsResponse) ConvertFrom(src v1.DataModelInterface) error {
+	logs, ok := src.(*datamodel.RecipeLogs)
+	if !ok {
+		return v1.ErrInvalidModelConversion
+	}
+	dst.Operations = []*RecipeOperationLog{}
+	for _, operation := range logs.Operations {
+		entries := []*RecipeLogEntry{}
+		for _, entry := range operation.Entries {
+			entries = append(entries, &RecipeLogEntry{
+				Timestamp: to.Ptr(entry.Timestamp),
+				Source:    to.Ptr(entry.Source),
+				Message:   to.Ptr(entry.Message),
+			})
+		}
+		dst.Operations = append(dst.Operations, &RecipeOperationLog{
+			OperationID:   to.Ptr(operation.OperationID),
+			OperationType: to.Ptr(operation.OperationType),
+			RecipeName:    to.Ptr(operation.RecipeName),
+			Status:        to.Ptr(operation.Status),
+			StartTime:     to.Ptr(operation.StartTime),
+			EndTime:       to.Ptr(operation.EndTime),
+			Truncated:     to.Ptr(operation.Truncated),
+			Entries:       entries,
+		})
+	}
+	return nil
+}
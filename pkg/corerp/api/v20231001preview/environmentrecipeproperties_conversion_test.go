@@ -122,3 +122,96 @@ func TestRecipeConvertVersionedToDataModel(t *testing.T) {
 		require.Equal(t, expected, ct)
 	})
 }
+
+func TestRecipePlanRequestConvertVersionedToDataModel(t *testing.T) {
+	rawPayload := testutil.ReadFixture("recipeplanrequest.json")
+	r := &RecipePlanRequest{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	// act
+	dm, err := r.ConvertTo()
+	require.NoError(t, err)
+
+	expected := &datamodel.RecipePlanRequest{
+		ResourceID:    "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/redis",
+		RecipeName:    "default",
+		ApplicationID: "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/applications/app",
+		Parameters:    map[string]any{"size": "C1"},
+	}
+	require.Equal(t, expected, dm.(*datamodel.RecipePlanRequest))
+}
+
+func TestRecipePlanConvertDataModelToVersioned(t *testing.T) {
+	rawPayload := testutil.ReadFixture("recipeplandatamodel.json")
+	r := &datamodel.RecipePlan{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	// act
+	versioned := &RecipePlanResponse{}
+	err = versioned.ConvertFrom(r)
+
+	// assert
+	require.NoError(t, err)
+	require.Equal(t, r.TemplateKind, *versioned.TemplateKind)
+	require.Equal(t, r.TemplatePath, *versioned.TemplatePath)
+	require.Len(t, versioned.ResourceChanges, 2)
+	for i, change := range r.ResourceChanges {
+		require.Equal(t, change.ID, *versioned.ResourceChanges[i].ID)
+		require.Equal(t, change.Type, *versioned.ResourceChanges[i].Type)
+		require.Equal(t, change.Action, *versioned.ResourceChanges[i].Action)
+	}
+
+	_, err = versioned.ConvertTo()
+	require.ErrorContains(t, err, "converting recipe plan response to a version-agnostic object is not supported")
+}
+
+func TestRecipeLogsRequestConvertVersionedToDataModel(t *testing.T) {
+	rawPayload := testutil.ReadFixture("recipelogsrequest.json")
+	r := &RecipeLogsRequest{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	// act
+	dm, err := r.ConvertTo()
+	require.NoError(t, err)
+
+	expected := &datamodel.RecipeLogsRequest{
+		ResourceID:  "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/redis",
+		OperationID: "2d9e9a1c-3a6c-4d2f-9f5b-6f3c7e1b2a10",
+	}
+	require.Equal(t, expected, dm.(*datamodel.RecipeLogsRequest))
+}
+
+func TestRecipeLogsConvertDataModelToVersioned(t *testing.T) {
+	rawPayload := testutil.ReadFixture("recipelogsdatamodel.json")
+	r := &datamodel.RecipeLogs{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	// act
+	versioned := &RecipeLogsResponse{}
+	err = versioned.ConvertFrom(r)
+
+	// assert
+	require.NoError(t, err)
+	require.Len(t, versioned.Operations, 1)
+	operation := versioned.Operations[0]
+	require.Equal(t, "2d9e9a1c-3a6c-4d2f-9f5b-6f3c7e1b2a10", *operation.OperationID)
+	require.Equal(t, "APPLICATIONS.DATASTORES/REDISCACHES|PUT", *operation.OperationType)
+	require.Equal(t, "default", *operation.RecipeName)
+	require.Equal(t, "Failed", *operation.Status)
+	require.Equal(t, r.Operations[0].StartTime, *operation.StartTime)
+	require.Equal(t, r.Operations[0].EndTime, *operation.EndTime)
+	require.True(t, *operation.Truncated)
+	require.Len(t, operation.Entries, 2)
+	for i, entry := range r.Operations[0].Entries {
+		require.Equal(t, entry.Timestamp, *operation.Entries[i].Timestamp)
+		require.Equal(t, entry.Source, *operation.Entries[i].Source)
+		require.Equal(t, entry.Message, *operation.Entries[i].Message)
+	}
+
+	_, err = versioned.ConvertTo()
+	require.ErrorContains(t, err, "converting recipe logs response to a version-agnostic object is not supported")
+}
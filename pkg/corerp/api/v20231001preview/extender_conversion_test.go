@@ -250,3 +250,55 @@ func TestExtender_ConvertFromValidation(t *testing.T) {
 		require.ErrorAs(t, tc.err, &err)
 	}
 }
+
+func TestRecipeImportDataModel(t *testing.T) {
+	resourceID := "/subscriptions/test-sub/resourceGroups/test-rg/providers/Microsoft.Cache/redis/test-cache"
+	versioned := &Recipe{
+		Name: to.Ptr("redis"),
+		Import: &RecipeImport{
+			Resources: []*RecipeImportedResource{
+				{
+					ID:      to.Ptr(resourceID),
+					Address: to.Ptr("azurerm_redis_cache.cache"),
+				},
+			},
+			Values: map[string]any{
+				"host": "test-cache.redis.cache.windows.net",
+			},
+			Secrets: map[string]*string{
+				"password": to.Ptr("test-password"),
+			},
+		},
+	}
+
+	recipe := toRecipeDataModel(versioned)
+	require.Equal(t, &portableresources.RecipeImport{
+		Resources: []portableresources.ImportedResource{
+			{
+				ID:      resourceID,
+				Address: "azurerm_redis_cache.cache",
+			},
+		},
+		Values: map[string]any{
+			"host": "test-cache.redis.cache.windows.net",
+		},
+		Secrets: map[string]any{
+			"password": "test-password",
+		},
+	}, recipe.Import)
+
+	// The secrets of the import are not returned.
+	require.Equal(t, &RecipeImport{
+		Resources: []*RecipeImportedResource{
+			{
+				ID:      to.Ptr(resourceID),
+				Address: to.Ptr("azurerm_redis_cache.cache"),
+			},
+		},
+		Values: map[string]any{
+			"host": "test-cache.redis.cache.windows.net",
+		},
+	}, fromRecipeDataModel(recipe).Import)
+
+	require.Nil(t, toRecipeDataModel(&Recipe{Name: to.Ptr("redis")}).Import)
+}
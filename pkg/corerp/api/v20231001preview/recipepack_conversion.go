@@ -0,0 +1,112 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-version"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/to"
+)
+
+const (
+	invalidRecipePackVersionFmt = "invalid recipe pack version %q. The version must be a semantic version, e.g. '1.2.0'."
+	emptyRecipePack             = "the recipe pack must contain at least one recipe."
+)
+
+// ConvertTo converts from the versioned RecipePack resource to version-agnostic datamodel.
+func (src *RecipePackResource) ConvertTo() (v1.DataModelInterface, error) {
+	// Note: SystemData conversion isn't required since this property comes ARM and datastore.
+	converted := &datamodel.RecipePack{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:       to.String(src.ID),
+				Name:     to.String(src.Name),
+				Type:     to.String(src.Type),
+				Location: to.String(src.Location),
+				Tags:     to.StringMap(src.Tags),
+			},
+			InternalMetadata: v1.InternalMetadata{
+				CreatedAPIVersion:      Version,
+				UpdatedAPIVersion:      Version,
+				AsyncProvisioningState: toProvisioningStateDataModel(src.Properties.ProvisioningState),
+			},
+		},
+		Properties: datamodel.RecipePackProperties{
+			Version:     to.String(src.Properties.Version),
+			Description: to.String(src.Properties.Description),
+		},
+	}
+
+	if _, err := version.NewSemver(converted.Properties.Version); err != nil {
+		return &datamodel.RecipePack{}, v1.NewClientErrInvalidRequest(fmt.Sprintf(invalidRecipePackVersionFmt, converted.Properties.Version))
+	}
+
+	recipes, err := toRecipesDataModel(src.Properties.Recipes)
+	if err != nil {
+		return &datamodel.RecipePack{}, err
+	}
+
+	count := 0
+	for _, resourceTypeRecipes := range recipes {
+		count += len(resourceTypeRecipes)
+	}
+	if count == 0 {
+		return &datamodel.RecipePack{}, v1.NewClientErrInvalidRequest(emptyRecipePack)
+	}
+	converted.Properties.Recipes = recipes
+
+	return converted, nil
+}
+
+// ConvertFrom converts from version-agnostic datamodel to the versioned RecipePack resource.
+func (dst *RecipePackResource) ConvertFrom(src v1.DataModelInterface) error {
+	pack, ok := src.(*datamodel.RecipePack)
+	if !ok {
+		return v1.ErrInvalidModelConversion
+	}
+
+	dst.ID = to.Ptr(pack.ID)
+	dst.Name = to.Ptr(pack.Name)
+	dst.Type = to.Ptr(pack.Type)
+	dst.SystemData = fromSystemDataModel(pack.SystemData)
+	dst.Location = to.Ptr(pack.Location)
+	dst.Tags = *to.StringMapPtr(pack.Tags)
+	dst.Properties = &RecipePackProperties{
+		ProvisioningState: fromProvisioningStateDataModel(pack.InternalMetadata.AsyncProvisioningState),
+		Version:           to.Ptr(pack.Properties.Version),
+		Recipes:           fromRecipesDataModel(pack.Properties.Recipes),
+	}
+	if pack.Properties.Description != "" {
+		dst.Properties.Description = to.Ptr(pack.Properties.Description)
+	}
+	if pack.Properties.Versions != nil {
+		dst.Properties.Versions = map[string]*RecipePackVersion{}
+		for packVersion, published := range pack.Properties.Versions {
+			dst.Properties.Versions[packVersion] = &RecipePackVersion{
+				Recipes: fromRecipesDataModel(published.Recipes),
+			}
+			if published.Description != "" {
+				dst.Properties.Versions[packVersion].Description = to.Ptr(published.Description)
+			}
+		}
+	}
+
+	return nil
+}
@@ -81,6 +81,14 @@ func (c *ClientFactory) NewOperationsClient() *OperationsClient {
 	}
 }
 
+// NewRecipePacksClient creates a new instance of RecipePacksClient.
+func (c *ClientFactory) NewRecipePacksClient() *RecipePacksClient {
+	return &RecipePacksClient{
+		rootScope: c.rootScope,
+		internal: c.internal,
+	}
+}
+
 // NewSecretStoresClient creates a new instance of SecretStoresClient.
 func (c *ClientFactory) NewSecretStoresClient() *SecretStoresClient {
 	return &SecretStoresClient{
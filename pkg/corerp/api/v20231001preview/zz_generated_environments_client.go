@@ -257,6 +257,66 @@ func (client *EnvironmentsClient) getMetadataHandleResponse(resp *http.Response)
 	return result, nil
 }
 
+// GetRecipeLogs - Gets the logs of the recent recipe executions for a portable resource, including the output of Terraform
+// and the operations of Bicep deployments.
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - environmentName - environment name
+//   - body - The content of the action request
+//   - options - EnvironmentsClientGetRecipeLogsOptions contains the optional parameters for the EnvironmentsClient.GetRecipeLogs
+//     method.
+func (client *EnvironmentsClient) GetRecipeLogs(ctx context.Context, environmentName string, body RecipeLogsRequest, options *EnvironmentsClientGetRecipeLogsOptions) (EnvironmentsClientGetRecipeLogsResponse, error) {
+	var err error
+	ctx, endSpan := runtime.StartSpan(ctx, "EnvironmentsClient.GetRecipeLogs", client.internal.Tracer(), nil)
+	defer func() { endSpan(err) }()
+	req, err := client.getRecipeLogsCreateRequest(ctx, environmentName, body, options)
+	if err != nil {
+		return EnvironmentsClientGetRecipeLogsResponse{}, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return EnvironmentsClientGetRecipeLogsResponse{}, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK) {
+		err = runtime.NewResponseError(httpResp)
+		return EnvironmentsClientGetRecipeLogsResponse{}, err
+	}
+	resp, err := client.getRecipeLogsHandleResponse(httpResp)
+	return resp, err
+}
+
+// getRecipeLogsCreateRequest creates the GetRecipeLogs request.
+func (client *EnvironmentsClient) getRecipeLogsCreateRequest(ctx context.Context, environmentName string, body RecipeLogsRequest, _ *EnvironmentsClientGetRecipeLogsOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/Applications.Core/environments/{environmentName}/getRecipeLogs"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	if environmentName == "" {
+		return nil, errors.New("parameter environmentName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{environmentName}", url.PathEscape(environmentName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, body); err != nil {
+	return nil, err
+}
+;	return req, nil
+}
+
+// getRecipeLogsHandleResponse handles the GetRecipeLogs response.
+func (client *EnvironmentsClient) getRecipeLogsHandleResponse(resp *http.Response) (EnvironmentsClientGetRecipeLogsResponse, error) {
+	result := EnvironmentsClientGetRecipeLogsResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.RecipeLogsResponse); err != nil {
+		return EnvironmentsClientGetRecipeLogsResponse{}, err
+	}
+	return result, nil
+}
+
 // NewListByScopePager - List EnvironmentResource resources by Scope
 //
 // Generated from API version 2023-10-01-preview
@@ -308,6 +368,66 @@ func (client *EnvironmentsClient) listByScopeHandleResponse(resp *http.Response)
 	return result, nil
 }
 
+// PlanRecipe - Plans the deployment of a recipe for a portable resource, returning the changes the recipe would make to
+// the resources it manages without making them.
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - environmentName - environment name
+//   - body - The content of the action request
+//   - options - EnvironmentsClientPlanRecipeOptions contains the optional parameters for the EnvironmentsClient.PlanRecipe
+//     method.
+func (client *EnvironmentsClient) PlanRecipe(ctx context.Context, environmentName string, body RecipePlanRequest, options *EnvironmentsClientPlanRecipeOptions) (EnvironmentsClientPlanRecipeResponse, error) {
+	var err error
+	ctx, endSpan := runtime.StartSpan(ctx, "EnvironmentsClient.PlanRecipe", client.internal.Tracer(), nil)
+	defer func() { endSpan(err) }()
+	req, err := client.planRecipeCreateRequest(ctx, environmentName, body, options)
+	if err != nil {
+		return EnvironmentsClientPlanRecipeResponse{}, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return EnvironmentsClientPlanRecipeResponse{}, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK) {
+		err = runtime.NewResponseError(httpResp)
+		return EnvironmentsClientPlanRecipeResponse{}, err
+	}
+	resp, err := client.planRecipeHandleResponse(httpResp)
+	return resp, err
+}
+
+// planRecipeCreateRequest creates the PlanRecipe request.
+func (client *EnvironmentsClient) planRecipeCreateRequest(ctx context.Context, environmentName string, body RecipePlanRequest, _ *EnvironmentsClientPlanRecipeOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/Applications.Core/environments/{environmentName}/planRecipe"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	if environmentName == "" {
+		return nil, errors.New("parameter environmentName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{environmentName}", url.PathEscape(environmentName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, body); err != nil {
+	return nil, err
+}
+;	return req, nil
+}
+
+// planRecipeHandleResponse handles the PlanRecipe response.
+func (client *EnvironmentsClient) planRecipeHandleResponse(resp *http.Response) (EnvironmentsClientPlanRecipeResponse, error) {
+	result := EnvironmentsClientPlanRecipeResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.RecipePlanResponse); err != nil {
+		return EnvironmentsClientPlanRecipeResponse{}, err
+	}
+	return result, nil
+}
+
 // Update - Update a EnvironmentResource
 // If the operation fails it returns an *azcore.ResponseError type.
 //
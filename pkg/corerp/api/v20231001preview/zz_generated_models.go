@@ -121,10 +121,15 @@ type ApplicationResourceUpdate struct {
 	Type *string
 }
 
-// AuthConfig - Authentication information used to access private Terraform module sources. Supported module sources: Git.
+// AuthConfig - Authentication information used to access private Terraform module sources. Supported module sources: Git
+// and Terraform module registries.
 type AuthConfig struct {
 // Authentication information used to access private Terraform modules from Git repository sources.
 	Git *GitAuthConfig
+
+// Authentication information used to access private Terraform module registries, which is a map of registry hostname to the
+// registry configuration.
+	Registry map[string]*TerraformRegistryAuthConfig
 }
 
 // AzureKeyVaultVolumeProperties - Represents Azure Key Vault Volume properties
@@ -203,6 +208,9 @@ type BicepRecipeProperties struct {
 // REQUIRED; Path to the template provided by the recipe. Currently only link to Azure Container Registry is supported.
 	TemplatePath *string
 
+// The outputs the recipe must produce. A recipe execution fails if its outputs do not satisfy the contract.
+	Outputs *RecipeOutputContract
+
 // Key/value parameters to pass to the recipe template at deployment.
 	Parameters map[string]any
 
@@ -214,6 +222,7 @@ type BicepRecipeProperties struct {
 // GetRecipeProperties implements the RecipePropertiesClassification interface for type BicepRecipeProperties.
 func (b *BicepRecipeProperties) GetRecipeProperties() *RecipeProperties {
 	return &RecipeProperties{
+		Outputs: b.Outputs,
 		Parameters: b.Parameters,
 		TemplateKind: b.TemplateKind,
 		TemplatePath: b.TemplatePath,
@@ -447,6 +456,11 @@ type EnvironmentProperties struct {
 // Configuration for Recipes. Defines how each type of Recipe should be configured and run.
 	RecipeConfig *RecipeConfigProperties
 
+// The versions of the Applications.Core/recipePacks resources whose recipes are registered to the Environment. Recipes specified
+// in the recipes property take precedence over the recipes of the packs. A recipe with the same name and resource type in
+// several packs is a conflict.
+	RecipePacks []*RecipePackReference
+
 // Specifies Recipes linked to the Environment.
 	Recipes map[string]map[string]RecipePropertiesClassification
 
@@ -821,6 +835,9 @@ type GatewayTLS struct {
 type GitAuthConfig struct {
 // Personal Access Token (PAT) configuration used to authenticate to Git platforms.
 	Pat map[string]*SecretConfig
+
+// SSH deploy key configuration used to authenticate to Git platforms, which is a map of Git platform hostname to the SSH configuration.
+	SSH map[string]*SSHConfig
 }
 
 // HTTPGetHealthProbeProperties - Specifies the properties for readiness/liveness probe using HTTP Get
@@ -882,6 +899,39 @@ type HealthProbeProperties struct {
 // GetHealthProbeProperties implements the HealthProbePropertiesClassification interface for type HealthProbeProperties.
 func (h *HealthProbeProperties) GetHealthProbeProperties() *HealthProbeProperties { return h }
 
+// HelmRecipeProperties - Represents Helm recipe properties.
+type HelmRecipeProperties struct {
+// REQUIRED; Discriminator property for RecipeProperties.
+	TemplateKind *string
+
+// REQUIRED; Path to the template provided by the recipe. Currently only link to Azure Container Registry is supported.
+	TemplatePath *string
+
+// The outputs the recipe must produce. A recipe execution fails if its outputs do not satisfy the contract.
+	Outputs *RecipeOutputContract
+
+// Key/value parameters to pass to the recipe template at deployment.
+	Parameters map[string]any
+
+// Connect to the OCI registry storing the Helm chart using HTTP (not-HTTPS). This should be used when the registry is known
+// not to support HTTPS, for example in a locally-hosted registry. Defaults to false (use HTTPS/TLS).
+	PlainHTTP *bool
+
+// Version of the Helm chart to deploy. The latest version of the chart is used if not specified. Must be omitted for charts
+// stored in a local path.
+	TemplateVersion *string
+}
+
+// GetRecipeProperties implements the RecipePropertiesClassification interface for type HelmRecipeProperties.
+func (h *HelmRecipeProperties) GetRecipeProperties() *RecipeProperties {
+	return &RecipeProperties{
+		Outputs: h.Outputs,
+		Parameters: h.Parameters,
+		TemplateKind: h.TemplateKind,
+		TemplatePath: h.TemplatePath,
+	}
+}
+
 // IamProperties - IAM properties
 type IamProperties struct {
 // REQUIRED; The kind of IAM provider to configure
@@ -1123,6 +1173,10 @@ type Recipe struct {
 // REQUIRED; The name of the recipe within the environment to use
 	Name *string
 
+// Existing cloud resources to adopt instead of deploying new resources. The resources are imported the first time the
+// recipe is executed for the resource, and are then managed by the recipe when the resource is updated or deleted.
+	Import *RecipeImport
+
 // Key/value parameters to pass into the recipe at deployment
 	Parameters map[string]any
 }
@@ -1142,6 +1196,10 @@ type RecipeConfigProperties struct {
 
 // Configuration for Terraform Recipes. Controls how Terraform plans and applies templates as part of Recipe deployment.
 	Terraform *TerraformConfigProperties
+
+// Maximum number of seconds a recipe execution can run in the environment before it is canceled. If not specified, recipe
+// executions are only bounded by the operation timeout.
+	TimeoutSeconds *int32
 }
 
 // RecipeGetMetadata - Represents the request body of the getmetadata action.
@@ -1158,7 +1216,7 @@ type RecipeGetMetadataResponse struct {
 // REQUIRED; The key/value parameters to pass to the recipe template at deployment.
 	Parameters map[string]any
 
-// REQUIRED; The format of the template provided by the recipe. Allowed values: bicep, terraform.
+// REQUIRED; The format of the template provided by the recipe. Allowed values: bicep, terraform, helm.
 	TemplateKind *string
 
 // REQUIRED; The path to the template provided by the recipe. Currently only link to Azure Container Registry is supported.
@@ -1173,7 +1231,216 @@ type RecipeGetMetadataResponse struct {
 	TemplateVersion *string
 }
 
-// RecipeProperties - Format of the template provided by the recipe. Allowed values: bicep, terraform.
+// RecipeImport - Existing cloud resources adopted by the recipe of a portable resource
+type RecipeImport struct {
+// REQUIRED; The existing cloud resources to adopt
+	Resources []*RecipeImportedResource
+
+// The secret values of the adopted resources, as the recipe would output them. They are stored as secrets of the resource
+// and are not returned with the recipe.
+	Secrets map[string]*string
+
+// The values of the adopted resources, as the recipe would output them
+	Values map[string]any
+}
+
+// RecipeImportedResource - An existing cloud resource adopted by the recipe of a portable resource
+type RecipeImportedResource struct {
+// REQUIRED; The ID of the cloud resource. For Terraform recipes, it is the ID used to import the resource, as documented
+// by the Terraform provider.
+	ID *string
+
+// The address of the resource in the Terraform module of the recipe, for example azurerm_redis_cache.cache. Required for
+// Terraform recipes.
+	Address *string
+}
+
+// RecipeLogEntry - An entry of the logs of a recipe execution.
+type RecipeLogEntry struct {
+// REQUIRED; The content of the entry.
+	Message *string
+
+// REQUIRED; The source of the entry. Allowed values: engine, stdout, stderr, deployment, helm.
+	Source *string
+
+// REQUIRED; The time the entry was recorded.
+	Timestamp *time.Time
+}
+
+// RecipeLogsRequest - Represents the request body of the getRecipeLogs action.
+type RecipeLogsRequest struct {
+// REQUIRED; The ID of the portable resource the recipe was executed for.
+	ResourceID *string
+
+// The ID of the operation to get the recipe logs of. The logs of all the retained operations are returned if not
+// set.
+	OperationID *string
+}
+
+// RecipeLogsResponse - The logs of the recipe executions of a portable resource.
+type RecipeLogsResponse struct {
+// REQUIRED; The logs of the most recent operations that executed the recipe of the resource, oldest first.
+	Operations []*RecipeOperationLog
+}
+
+// RecipeOperationLog - The logs of the recipe execution of an operation on a portable resource.
+type RecipeOperationLog struct {
+// REQUIRED; The time the recipe execution completed.
+	EndTime *time.Time
+
+// REQUIRED; The log entries, oldest first.
+	Entries []*RecipeLogEntry
+
+// REQUIRED; The ID of the operation that executed the recipe.
+	OperationID *string
+
+// REQUIRED; The time the recipe execution started.
+	StartTime *time.Time
+
+// REQUIRED; The status of the recipe execution. Allowed values: Succeeded, Failed.
+	Status *string
+
+// The type of the operation that executed the recipe.
+	OperationType *string
+
+// The name of the recipe.
+	RecipeName *string
+
+// Whether the oldest log entries were dropped because the logs exceeded their size limit.
+	Truncated *bool
+}
+
+// RecipeOutputContract - The outputs a recipe must produce.
+type RecipeOutputContract struct {
+// Whether the recipe must output the IDs of the resources it deploys.
+	Resources *bool
+
+// The names of the secrets the recipe must output. Secrets must be non-empty strings.
+	Secrets []*string
+
+// The values the recipe must output, keyed by name. The value is the expected type of the output: string, integer, number,
+// boolean, object or array.
+	Values map[string]*string
+}
+
+// RecipePackProperties - Recipe pack properties
+type RecipePackProperties struct {
+// REQUIRED; The recipes of the pack, which is a map of resource type to a map of recipe name to the recipe.
+	Recipes map[string]map[string]RecipePropertiesClassification
+
+// REQUIRED; The version of the recipe pack, e.g. 1.2.0. The recipes of a published version can only be changed by publishing
+// a new version.
+	Version *string
+
+// The description of the recipe pack.
+	Description *string
+
+// READ-ONLY; The status of the asynchronous operation.
+	ProvisioningState *ProvisioningState
+
+// READ-ONLY; The published versions of the recipe pack, keyed by version. Publishing a new version keeps the recipes of
+// the previous versions so environments can keep using them.
+	Versions map[string]*RecipePackVersion
+}
+
+// RecipePackReference - A version of a recipe pack registered to the Environment.
+type RecipePackReference struct {
+// REQUIRED; The ID of the Applications.Core/recipePacks resource.
+	ID *string
+
+// REQUIRED; The version of the recipe pack whose recipes are registered to the Environment.
+	Version *string
+}
+
+// RecipePackResource - The recipe pack resource
+type RecipePackResource struct {
+// REQUIRED; The geo-location where the resource lives
+	Location *string
+
+// REQUIRED; The resource-specific properties for this resource.
+	Properties *RecipePackProperties
+
+// Resource tags.
+	Tags map[string]*string
+
+// READ-ONLY; Fully qualified resource ID for the resource. Ex - /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}
+	ID *string
+
+// READ-ONLY; The name of the resource
+	Name *string
+
+// READ-ONLY; Azure Resource Manager metadata containing createdBy and modifiedBy information.
+	SystemData *SystemData
+
+// READ-ONLY; The type of the resource. E.g. "Microsoft.Compute/virtualMachines" or "Microsoft.Storage/storageAccounts"
+	Type *string
+}
+
+// RecipePackResourceListResult - The response of a RecipePackResource list operation.
+type RecipePackResourceListResult struct {
+// REQUIRED; The RecipePackResource items on this page
+	Value []*RecipePackResource
+
+// The link to the next page of items
+	NextLink *string
+}
+
+// RecipePackResourceUpdate - The recipe pack resource
+type RecipePackResourceUpdate struct {
+// Resource tags.
+	Tags map[string]*string
+
+// READ-ONLY; Fully qualified resource ID for the resource. Ex - /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}
+	ID *string
+
+// READ-ONLY; The name of the resource
+	Name *string
+
+// READ-ONLY; Azure Resource Manager metadata containing createdBy and modifiedBy information.
+	SystemData *SystemData
+
+// READ-ONLY; The type of the resource. E.g. "Microsoft.Compute/virtualMachines" or "Microsoft.Storage/storageAccounts"
+	Type *string
+}
+
+// RecipePackVersion - A published version of a recipe pack
+type RecipePackVersion struct {
+// REQUIRED; The recipes of the recipe pack version, which is a map of resource type to a map of recipe name to the recipe.
+	Recipes map[string]map[string]RecipePropertiesClassification
+
+// The description of the recipe pack version.
+	Description *string
+}
+
+// RecipePlanRequest - Represents the request body of the planRecipe action.
+type RecipePlanRequest struct {
+// REQUIRED; The ID of the portable resource that would be deployed using the recipe. The type of the resource selects the
+// recipes of the environment.
+	ResourceID *string
+
+// The ID of the application of the portable resource, if the application exists.
+	ApplicationID *string
+
+// The key/value parameters passed to the recipe by the portable resource.
+	Parameters map[string]any
+
+// The name of the recipe registered to the environment. Defaults to 'default'.
+	RecipeName *string
+}
+
+// RecipePlanResponse - The changes that a recipe would make to the resources it manages.
+type RecipePlanResponse struct {
+// REQUIRED; The changes to the resources managed by the recipe.
+	ResourceChanges []*RecipeResourceChange
+
+// REQUIRED; The format of the template provided by the recipe. Allowed values: bicep, terraform, helm.
+	TemplateKind *string
+
+// REQUIRED; The path to the template provided by the recipe.
+	TemplatePath *string
+}
+
+// RecipeProperties - Format of the template provided by the recipe. Allowed values: bicep, terraform, helm.
 type RecipeProperties struct {
 // REQUIRED; Discriminator property for RecipeProperties.
 	TemplateKind *string
@@ -1181,6 +1448,9 @@ type RecipeProperties struct {
 // REQUIRED; Path to the template provided by the recipe. Currently only link to Azure Container Registry is supported.
 	TemplatePath *string
 
+// The outputs the recipe must produce. A recipe execution fails if its outputs do not satisfy the contract.
+	Outputs *RecipeOutputContract
+
 // Key/value parameters to pass to the recipe template at deployment.
 	Parameters map[string]any
 }
@@ -1188,6 +1458,18 @@ type RecipeProperties struct {
 // GetRecipeProperties implements the RecipePropertiesClassification interface for type RecipeProperties.
 func (r *RecipeProperties) GetRecipeProperties() *RecipeProperties { return r }
 
+// RecipeResourceChange - A change that a recipe would make to a resource it manages.
+type RecipeResourceChange struct {
+// REQUIRED; The change to the resource. Allowed values: create, update, delete, replace, noChange.
+	Action *string
+
+// REQUIRED; The ID of the resource. For Terraform Recipes, this is the address of the resource in the Terraform configuration.
+	ID *string
+
+// The type of the resource.
+	Type *string
+}
+
 // RecipeStatus - Recipe status at deployment time for a resource.
 type RecipeStatus struct {
 // REQUIRED; TemplateKind is the kind of the recipe template used by the portable resource upon deployment.
@@ -1222,6 +1504,24 @@ type Resource struct {
 	Type *string
 }
 
+// ResourceCondition - An observation of the state of a resource.
+type ResourceCondition struct {
+// REQUIRED; The status of the condition, one of True, False or Unknown.
+	Status *string
+
+// REQUIRED; The type of the condition, for example Drifted.
+	Type *string
+
+// The last time the status of the condition changed.
+	LastTransitionTime *time.Time
+
+// A human-readable message with details about the condition.
+	Message *string
+
+// A machine-readable reason for the last transition of the condition.
+	Reason *string
+}
+
 // ResourceReference - Describes a reference to an existing resource
 type ResourceReference struct {
 // REQUIRED; Resource id of an existing resource
@@ -1236,6 +1536,9 @@ type ResourceStatus struct {
 // Properties of an output resource
 	OutputResources []*OutputResource
 
+// READ-ONLY; The latest observations of the state of the resource
+	Conditions []*ResourceCondition
+
 // READ-ONLY; The recipe data at the time of deployment
 	Recipe *RecipeStatus
 }
@@ -1246,6 +1549,14 @@ type RuntimesProperties struct {
 	Kubernetes *KubernetesRuntimeProperties
 }
 
+// SSHConfig - SSH deploy key configuration used to authenticate to Git platforms.
+type SSHConfig struct {
+// The ID of an Applications.Core/SecretStore resource containing the SSH private key used to authenticate to the Git platform.
+// The secret store must have a secret named 'privateKey', containing the private key, and a secret named 'knownHosts',
+// containing the known_hosts entries used to verify the host key of the Git platform.
+	Secret *string
+}
+
 // SecretConfig - Personal Access Token (PAT) configuration used to authenticate to Git platforms.
 type SecretConfig struct {
 // The ID of an Applications.Core/SecretStore resource containing the Git platform personal access token (PAT). The secret
@@ -1429,16 +1740,48 @@ func (t *TCPHealthProbeProperties) GetHealthProbeProperties() *HealthProbeProper
 	}
 }
 
+// TerraformBackendConfig - Configuration for the backend that stores the Terraform state of Terraform Recipes. For more
+// information, please see: https://developer.hashicorp.com/terraform/language/settings/backends/configuration.
+type TerraformBackendConfig struct {
+// The configuration arguments of the backend, e.g. the bucket and endpoint of an s3 backend. The key or address of the state
+// of each recipe is set by Radius.
+	Config map[string]any
+
+// The kind of the backend. Supported kinds: kubernetes, pg, s3, http. Defaults to kubernetes.
+	Kind *string
+
+// Sensitive configuration arguments of the backend, e.g. the connection string of a pg backend. The keys are the names of
+// the configuration arguments, and the values are stored in Applications.Core/SecretStores resources.
+	Secrets map[string]*SecretReference
+}
+
 // TerraformConfigProperties - Configuration for Terraform Recipes. Controls how Terraform plans and applies templates as
 // part of Recipe deployment.
 type TerraformConfigProperties struct {
-// Authentication information used to access private Terraform module sources. Supported module sources: Git.
+// Authentication information used to access private Terraform module sources. Supported module sources: Git and Terraform
+// module registries.
 	Authentication *AuthConfig
 
+// Configuration for the backend that stores the Terraform state of Terraform Recipes. The state is stored in Kubernetes
+// secrets if not specified.
+	Backend *TerraformBackendConfig
+
+// The distribution of Terraform used to execute Terraform Recipes. Supported distributions: terraform, opentofu. Defaults
+// to terraform.
+	Distribution *string
+
+// The state and plan encryption configuration of OpenTofu, stored in an Applications.Core/SecretStores resource. Only supported
+// with the opentofu distribution. For more information, please see: https://opentofu.org/docs/language/state/encryption/.
+	Encryption *SecretReference
+
 // Configuration for Terraform Recipe Providers. Controls how Terraform interacts with cloud providers, SaaS providers, and
 // other APIs. For more information, please see:
 // https://developer.hashicorp.com/terraform/language/providers/configuration.
 	Providers map[string][]*ProviderConfigProperties
+
+// The exact version of Terraform used to execute Terraform Recipes, e.g. 1.7.5. The latest version of Terraform is used if
+// not specified.
+	Version *string
 }
 
 // TerraformRecipeProperties - Represents Terraform recipe properties.
@@ -1449,6 +1792,9 @@ type TerraformRecipeProperties struct {
 // REQUIRED; Path to the template provided by the recipe. Currently only link to Azure Container Registry is supported.
 	TemplatePath *string
 
+// The outputs the recipe must produce. A recipe execution fails if its outputs do not satisfy the contract.
+	Outputs *RecipeOutputContract
+
 // Key/value parameters to pass to the recipe template at deployment.
 	Parameters map[string]any
 
@@ -1460,12 +1806,20 @@ type TerraformRecipeProperties struct {
 // GetRecipeProperties implements the RecipePropertiesClassification interface for type TerraformRecipeProperties.
 func (t *TerraformRecipeProperties) GetRecipeProperties() *RecipeProperties {
 	return &RecipeProperties{
+		Outputs: t.Outputs,
 		Parameters: t.Parameters,
 		TemplateKind: t.TemplateKind,
 		TemplatePath: t.TemplatePath,
 	}
 }
 
+// TerraformRegistryAuthConfig - Authentication information used to access a private Terraform module registry.
+type TerraformRegistryAuthConfig struct {
+// The ID of an Applications.Core/SecretStore resource containing the API token used to authenticate to the private Terraform
+// module registry. The secret store must have a secret named 'token', containing the token value.
+	Secret *string
+}
+
 // TrackedResource - The resource model definition for an Azure Resource Manager tracked top level resource which has 'tags'
 // and a 'location'
 type TrackedResource struct {
@@ -319,6 +319,7 @@ func (a *ApplicationResourceUpdate) UnmarshalJSON(data []byte) error {
 func (a AuthConfig) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
 	populate(objectMap, "git", a.Git)
+	populate(objectMap, "registry", a.Registry)
 	return json.Marshal(objectMap)
 }
 
@@ -334,6 +335,9 @@ func (a *AuthConfig) UnmarshalJSON(data []byte) error {
 		case "git":
 				err = unpopulate(val, "Git", &a.Git)
 			delete(rawMsg, key)
+		case "registry":
+				err = unpopulate(val, "Registry", &a.Registry)
+			delete(rawMsg, key)
 		}
 		if err != nil {
 			return fmt.Errorf("unmarshalling type %T: %v", a, err)
@@ -474,6 +478,7 @@ func (b *BicepConfigProperties) UnmarshalJSON(data []byte) error {
 // MarshalJSON implements the json.Marshaller interface for type BicepRecipeProperties.
 func (b BicepRecipeProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
+	populate(objectMap, "outputs", b.Outputs)
 	populate(objectMap, "parameters", b.Parameters)
 	populate(objectMap, "plainHttp", b.PlainHTTP)
 	objectMap["templateKind"] = "bicep"
@@ -490,6 +495,9 @@ func (b *BicepRecipeProperties) UnmarshalJSON(data []byte) error {
 	for key, val := range rawMsg {
 		var err error
 		switch key {
+		case "outputs":
+				err = unpopulate(val, "Outputs", &b.Outputs)
+			delete(rawMsg, key)
 		case "parameters":
 				err = unpopulate(val, "Parameters", &b.Parameters)
 			delete(rawMsg, key)
@@ -976,6 +984,7 @@ func (e EnvironmentProperties) MarshalJSON() ([]byte, error) {
 	populate(objectMap, "providers", e.Providers)
 	populate(objectMap, "provisioningState", e.ProvisioningState)
 	populate(objectMap, "recipeConfig", e.RecipeConfig)
+	populate(objectMap, "recipePacks", e.RecipePacks)
 	populate(objectMap, "recipes", e.Recipes)
 	populate(objectMap, "simulated", e.Simulated)
 	return json.Marshal(objectMap)
@@ -1005,6 +1014,9 @@ func (e *EnvironmentProperties) UnmarshalJSON(data []byte) error {
 		case "recipeConfig":
 				err = unpopulate(val, "RecipeConfig", &e.RecipeConfig)
 			delete(rawMsg, key)
+		case "recipePacks":
+				err = unpopulate(val, "RecipePacks", &e.RecipePacks)
+			delete(rawMsg, key)
 		case "recipes":
 			var recipesRaw map[string]json.RawMessage
 			if err = json.Unmarshal(val, &recipesRaw); err != nil {
@@ -1907,6 +1919,7 @@ func (g *GatewayTLS) UnmarshalJSON(data []byte) error {
 func (g GitAuthConfig) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
 	populate(objectMap, "pat", g.Pat)
+	populate(objectMap, "ssh", g.SSH)
 	return json.Marshal(objectMap)
 }
 
@@ -1922,6 +1935,9 @@ func (g *GitAuthConfig) UnmarshalJSON(data []byte) error {
 		case "pat":
 				err = unpopulate(val, "Pat", &g.Pat)
 			delete(rawMsg, key)
+		case "ssh":
+				err = unpopulate(val, "SSH", &g.SSH)
+			delete(rawMsg, key)
 		}
 		if err != nil {
 			return fmt.Errorf("unmarshalling type %T: %v", g, err)
@@ -2028,6 +2044,53 @@ func (h *HealthProbeProperties) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type HelmRecipeProperties.
+func (h HelmRecipeProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "outputs", h.Outputs)
+	populate(objectMap, "parameters", h.Parameters)
+	populate(objectMap, "plainHttp", h.PlainHTTP)
+	objectMap["templateKind"] = "helm"
+	populate(objectMap, "templatePath", h.TemplatePath)
+	populate(objectMap, "templateVersion", h.TemplateVersion)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type HelmRecipeProperties.
+func (h *HelmRecipeProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", h, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "outputs":
+				err = unpopulate(val, "Outputs", &h.Outputs)
+			delete(rawMsg, key)
+		case "parameters":
+				err = unpopulate(val, "Parameters", &h.Parameters)
+			delete(rawMsg, key)
+		case "plainHttp":
+				err = unpopulate(val, "PlainHTTP", &h.PlainHTTP)
+			delete(rawMsg, key)
+		case "templateKind":
+				err = unpopulate(val, "TemplateKind", &h.TemplateKind)
+			delete(rawMsg, key)
+		case "templatePath":
+				err = unpopulate(val, "TemplatePath", &h.TemplatePath)
+			delete(rawMsg, key)
+		case "templateVersion":
+				err = unpopulate(val, "TemplateVersion", &h.TemplateVersion)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", h, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type IamProperties.
 func (i IamProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -2613,6 +2676,7 @@ func (p *ProvidersAzure) UnmarshalJSON(data []byte) error {
 // MarshalJSON implements the json.Marshaller interface for type Recipe.
 func (r Recipe) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
+	populate(objectMap, "import", r.Import)
 	populate(objectMap, "name", r.Name)
 	populate(objectMap, "parameters", r.Parameters)
 	return json.Marshal(objectMap)
@@ -2627,6 +2691,9 @@ func (r *Recipe) UnmarshalJSON(data []byte) error {
 	for key, val := range rawMsg {
 		var err error
 		switch key {
+		case "import":
+				err = unpopulate(val, "Import", &r.Import)
+			delete(rawMsg, key)
 		case "name":
 				err = unpopulate(val, "Name", &r.Name)
 			delete(rawMsg, key)
@@ -2648,6 +2715,7 @@ func (r RecipeConfigProperties) MarshalJSON() ([]byte, error) {
 	populate(objectMap, "env", r.Env)
 	populate(objectMap, "envSecrets", r.EnvSecrets)
 	populate(objectMap, "terraform", r.Terraform)
+	populate(objectMap, "timeoutSeconds", r.TimeoutSeconds)
 	return json.Marshal(objectMap)
 }
 
@@ -2672,6 +2740,9 @@ func (r *RecipeConfigProperties) UnmarshalJSON(data []byte) error {
 		case "terraform":
 				err = unpopulate(val, "Terraform", &r.Terraform)
 			delete(rawMsg, key)
+		case "timeoutSeconds":
+				err = unpopulate(val, "TimeoutSeconds", &r.TimeoutSeconds)
+			delete(rawMsg, key)
 		}
 		if err != nil {
 			return fmt.Errorf("unmarshalling type %T: %v", r, err)
@@ -2754,17 +2825,17 @@ func (r *RecipeGetMetadataResponse) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// MarshalJSON implements the json.Marshaller interface for type RecipeProperties.
-func (r RecipeProperties) MarshalJSON() ([]byte, error) {
+// MarshalJSON implements the json.Marshaller interface for type RecipeImport.
+func (r RecipeImport) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
-	populate(objectMap, "parameters", r.Parameters)
-	objectMap["templateKind"] = r.TemplateKind
-	populate(objectMap, "templatePath", r.TemplatePath)
+	populate(objectMap, "resources", r.Resources)
+	populate(objectMap, "secrets", r.Secrets)
+	populate(objectMap, "values", r.Values)
 	return json.Marshal(objectMap)
 }
 
-// UnmarshalJSON implements the json.Unmarshaller interface for type RecipeProperties.
-func (r *RecipeProperties) UnmarshalJSON(data []byte) error {
+// UnmarshalJSON implements the json.Unmarshaller interface for type RecipeImport.
+func (r *RecipeImport) UnmarshalJSON(data []byte) error {
 	var rawMsg map[string]json.RawMessage
 	if err := json.Unmarshal(data, &rawMsg); err != nil {
 		return fmt.Errorf("unmarshalling type %T: %v", r, err)
@@ -2772,14 +2843,14 @@ func (r *RecipeProperties) UnmarshalJSON(data []byte) error {
 	for key, val := range rawMsg {
 		var err error
 		switch key {
-		case "parameters":
-				err = unpopulate(val, "Parameters", &r.Parameters)
+		case "resources":
+				err = unpopulate(val, "Resources", &r.Resources)
 			delete(rawMsg, key)
-		case "templateKind":
-				err = unpopulate(val, "TemplateKind", &r.TemplateKind)
+		case "secrets":
+				err = unpopulate(val, "Secrets", &r.Secrets)
 			delete(rawMsg, key)
-		case "templatePath":
-				err = unpopulate(val, "TemplatePath", &r.TemplatePath)
+		case "values":
+				err = unpopulate(val, "Values", &r.Values)
 			delete(rawMsg, key)
 		}
 		if err != nil {
@@ -2789,17 +2860,16 @@ func (r *RecipeProperties) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// MarshalJSON implements the json.Marshaller interface for type RecipeStatus.
-func (r RecipeStatus) MarshalJSON() ([]byte, error) {
+// MarshalJSON implements the json.Marshaller interface for type RecipeImportedResource.
+func (r RecipeImportedResource) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
-	populate(objectMap, "templateKind", r.TemplateKind)
-	populate(objectMap, "templatePath", r.TemplatePath)
-	populate(objectMap, "templateVersion", r.TemplateVersion)
+	populate(objectMap, "address", r.Address)
+	populate(objectMap, "id", r.ID)
 	return json.Marshal(objectMap)
 }
 
-// UnmarshalJSON implements the json.Unmarshaller interface for type RecipeStatus.
-func (r *RecipeStatus) UnmarshalJSON(data []byte) error {
+// UnmarshalJSON implements the json.Unmarshaller interface for type RecipeImportedResource.
+func (r *RecipeImportedResource) UnmarshalJSON(data []byte) error {
 	var rawMsg map[string]json.RawMessage
 	if err := json.Unmarshal(data, &rawMsg); err != nil {
 		return fmt.Errorf("unmarshalling type %T: %v", r, err)
@@ -2807,14 +2877,11 @@ func (r *RecipeStatus) UnmarshalJSON(data []byte) error {
 	for key, val := range rawMsg {
 		var err error
 		switch key {
-		case "templateKind":
-				err = unpopulate(val, "TemplateKind", &r.TemplateKind)
-			delete(rawMsg, key)
-		case "templatePath":
-				err = unpopulate(val, "TemplatePath", &r.TemplatePath)
+		case "address":
+				err = unpopulate(val, "Address", &r.Address)
 			delete(rawMsg, key)
-		case "templateVersion":
-				err = unpopulate(val, "TemplateVersion", &r.TemplateVersion)
+		case "id":
+				err = unpopulate(val, "ID", &r.ID)
 			delete(rawMsg, key)
 		}
 		if err != nil {
@@ -2824,15 +2891,17 @@ func (r *RecipeStatus) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// MarshalJSON implements the json.Marshaller interface for type RegistrySecretConfig.
-func (r RegistrySecretConfig) MarshalJSON() ([]byte, error) {
+// MarshalJSON implements the json.Marshaller interface for type RecipeLogEntry.
+func (r RecipeLogEntry) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
-	populate(objectMap, "secret", r.Secret)
+	populate(objectMap, "message", r.Message)
+	populate(objectMap, "source", r.Source)
+	populateDateTimeRFC3339(objectMap, "timestamp", r.Timestamp)
 	return json.Marshal(objectMap)
 }
 
-// UnmarshalJSON implements the json.Unmarshaller interface for type RegistrySecretConfig.
-func (r *RegistrySecretConfig) UnmarshalJSON(data []byte) error {
+// UnmarshalJSON implements the json.Unmarshaller interface for type RecipeLogEntry.
+func (r *RecipeLogEntry) UnmarshalJSON(data []byte) error {
 	var rawMsg map[string]json.RawMessage
 	if err := json.Unmarshal(data, &rawMsg); err != nil {
 		return fmt.Errorf("unmarshalling type %T: %v", r, err)
@@ -2840,8 +2909,14 @@ func (r *RegistrySecretConfig) UnmarshalJSON(data []byte) error {
 	for key, val := range rawMsg {
 		var err error
 		switch key {
-		case "secret":
-				err = unpopulate(val, "Secret", &r.Secret)
+		case "message":
+				err = unpopulate(val, "Message", &r.Message)
+			delete(rawMsg, key)
+		case "source":
+				err = unpopulate(val, "Source", &r.Source)
+			delete(rawMsg, key)
+		case "timestamp":
+				err = unpopulateDateTimeRFC3339(val, "Timestamp", &r.Timestamp)
 			delete(rawMsg, key)
 		}
 		if err != nil {
@@ -2851,18 +2926,16 @@ func (r *RegistrySecretConfig) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// MarshalJSON implements the json.Marshaller interface for type Resource.
-func (r Resource) MarshalJSON() ([]byte, error) {
+// MarshalJSON implements the json.Marshaller interface for type RecipeLogsRequest.
+func (r RecipeLogsRequest) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
-	populate(objectMap, "id", r.ID)
-	populate(objectMap, "name", r.Name)
-	populate(objectMap, "systemData", r.SystemData)
-	populate(objectMap, "type", r.Type)
+	populate(objectMap, "operationId", r.OperationID)
+	populate(objectMap, "resourceId", r.ResourceID)
 	return json.Marshal(objectMap)
 }
 
-// UnmarshalJSON implements the json.Unmarshaller interface for type Resource.
-func (r *Resource) UnmarshalJSON(data []byte) error {
+// UnmarshalJSON implements the json.Unmarshaller interface for type RecipeLogsRequest.
+func (r *RecipeLogsRequest) UnmarshalJSON(data []byte) error {
 	var rawMsg map[string]json.RawMessage
 	if err := json.Unmarshal(data, &rawMsg); err != nil {
 		return fmt.Errorf("unmarshalling type %T: %v", r, err)
@@ -2870,17 +2943,11 @@ func (r *Resource) UnmarshalJSON(data []byte) error {
 	for key, val := range rawMsg {
 		var err error
 		switch key {
-		case "id":
-				err = unpopulate(val, "ID", &r.ID)
-			delete(rawMsg, key)
-		case "name":
-				err = unpopulate(val, "Name", &r.Name)
-			delete(rawMsg, key)
-		case "systemData":
-				err = unpopulate(val, "SystemData", &r.SystemData)
+		case "operationId":
+				err = unpopulate(val, "OperationID", &r.OperationID)
 			delete(rawMsg, key)
-		case "type":
-				err = unpopulate(val, "Type", &r.Type)
+		case "resourceId":
+				err = unpopulate(val, "ResourceID", &r.ResourceID)
 			delete(rawMsg, key)
 		}
 		if err != nil {
@@ -2890,15 +2957,15 @@ func (r *Resource) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// MarshalJSON implements the json.Marshaller interface for type ResourceReference.
-func (r ResourceReference) MarshalJSON() ([]byte, error) {
+// MarshalJSON implements the json.Marshaller interface for type RecipeLogsResponse.
+func (r RecipeLogsResponse) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
-	populate(objectMap, "id", r.ID)
+	populate(objectMap, "operations", r.Operations)
 	return json.Marshal(objectMap)
 }
 
-// UnmarshalJSON implements the json.Unmarshaller interface for type ResourceReference.
-func (r *ResourceReference) UnmarshalJSON(data []byte) error {
+// UnmarshalJSON implements the json.Unmarshaller interface for type RecipeLogsResponse.
+func (r *RecipeLogsResponse) UnmarshalJSON(data []byte) error {
 	var rawMsg map[string]json.RawMessage
 	if err := json.Unmarshal(data, &rawMsg); err != nil {
 		return fmt.Errorf("unmarshalling type %T: %v", r, err)
@@ -2906,8 +2973,8 @@ func (r *ResourceReference) UnmarshalJSON(data []byte) error {
 	for key, val := range rawMsg {
 		var err error
 		switch key {
-		case "id":
-				err = unpopulate(val, "ID", &r.ID)
+		case "operations":
+				err = unpopulate(val, "Operations", &r.Operations)
 			delete(rawMsg, key)
 		}
 		if err != nil {
@@ -2917,17 +2984,22 @@ func (r *ResourceReference) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// MarshalJSON implements the json.Marshaller interface for type ResourceStatus.
-func (r ResourceStatus) MarshalJSON() ([]byte, error) {
+// MarshalJSON implements the json.Marshaller interface for type RecipeOperationLog.
+func (r RecipeOperationLog) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
-	populate(objectMap, "compute", r.Compute)
-	populate(objectMap, "outputResources", r.OutputResources)
-	populate(objectMap, "recipe", r.Recipe)
+	populateDateTimeRFC3339(objectMap, "endTime", r.EndTime)
+	populate(objectMap, "entries", r.Entries)
+	populate(objectMap, "operationId", r.OperationID)
+	populate(objectMap, "operationType", r.OperationType)
+	populate(objectMap, "recipeName", r.RecipeName)
+	populateDateTimeRFC3339(objectMap, "startTime", r.StartTime)
+	populate(objectMap, "status", r.Status)
+	populate(objectMap, "truncated", r.Truncated)
 	return json.Marshal(objectMap)
 }
 
-// UnmarshalJSON implements the json.Unmarshaller interface for type ResourceStatus.
-func (r *ResourceStatus) UnmarshalJSON(data []byte) error {
+// UnmarshalJSON implements the json.Unmarshaller interface for type RecipeOperationLog.
+func (r *RecipeOperationLog) UnmarshalJSON(data []byte) error {
 	var rawMsg map[string]json.RawMessage
 	if err := json.Unmarshal(data, &rawMsg); err != nil {
 		return fmt.Errorf("unmarshalling type %T: %v", r, err)
@@ -2935,14 +3007,29 @@ func (r *ResourceStatus) UnmarshalJSON(data []byte) error {
 	for key, val := range rawMsg {
 		var err error
 		switch key {
-		case "compute":
-			r.Compute, err = unmarshalEnvironmentComputeClassification(val)
+		case "endTime":
+				err = unpopulateDateTimeRFC3339(val, "EndTime", &r.EndTime)
 			delete(rawMsg, key)
-		case "outputResources":
-				err = unpopulate(val, "OutputResources", &r.OutputResources)
+		case "entries":
+				err = unpopulate(val, "Entries", &r.Entries)
 			delete(rawMsg, key)
-		case "recipe":
-				err = unpopulate(val, "Recipe", &r.Recipe)
+		case "operationId":
+				err = unpopulate(val, "OperationID", &r.OperationID)
+			delete(rawMsg, key)
+		case "operationType":
+				err = unpopulate(val, "OperationType", &r.OperationType)
+			delete(rawMsg, key)
+		case "recipeName":
+				err = unpopulate(val, "RecipeName", &r.RecipeName)
+			delete(rawMsg, key)
+		case "startTime":
+				err = unpopulateDateTimeRFC3339(val, "StartTime", &r.StartTime)
+			delete(rawMsg, key)
+		case "status":
+				err = unpopulate(val, "Status", &r.Status)
+			delete(rawMsg, key)
+		case "truncated":
+				err = unpopulate(val, "Truncated", &r.Truncated)
 			delete(rawMsg, key)
 		}
 		if err != nil {
@@ -2952,15 +3039,17 @@ func (r *ResourceStatus) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// MarshalJSON implements the json.Marshaller interface for type RuntimesProperties.
-func (r RuntimesProperties) MarshalJSON() ([]byte, error) {
+// MarshalJSON implements the json.Marshaller interface for type RecipeOutputContract.
+func (r RecipeOutputContract) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
-	populate(objectMap, "kubernetes", r.Kubernetes)
+	populate(objectMap, "resources", r.Resources)
+	populate(objectMap, "secrets", r.Secrets)
+	populate(objectMap, "values", r.Values)
 	return json.Marshal(objectMap)
 }
 
-// UnmarshalJSON implements the json.Unmarshaller interface for type RuntimesProperties.
-func (r *RuntimesProperties) UnmarshalJSON(data []byte) error {
+// UnmarshalJSON implements the json.Unmarshaller interface for type RecipeOutputContract.
+func (r *RecipeOutputContract) UnmarshalJSON(data []byte) error {
 	var rawMsg map[string]json.RawMessage
 	if err := json.Unmarshal(data, &rawMsg); err != nil {
 		return fmt.Errorf("unmarshalling type %T: %v", r, err)
@@ -2968,8 +3057,14 @@ func (r *RuntimesProperties) UnmarshalJSON(data []byte) error {
 	for key, val := range rawMsg {
 		var err error
 		switch key {
-		case "kubernetes":
-				err = unpopulate(val, "Kubernetes", &r.Kubernetes)
+		case "resources":
+				err = unpopulate(val, "Resources", &r.Resources)
+			delete(rawMsg, key)
+		case "secrets":
+				err = unpopulate(val, "Secrets", &r.Secrets)
+			delete(rawMsg, key)
+		case "values":
+				err = unpopulate(val, "Values", &r.Values)
 			delete(rawMsg, key)
 		}
 		if err != nil {
@@ -2979,152 +3074,816 @@ func (r *RuntimesProperties) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// MarshalJSON implements the json.Marshaller interface for type SecretConfig.
-func (s SecretConfig) MarshalJSON() ([]byte, error) {
+// MarshalJSON implements the json.Marshaller interface for type RecipePackProperties.
+func (r RecipePackProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
-	populate(objectMap, "secret", s.Secret)
+	populate(objectMap, "description", r.Description)
+	populate(objectMap, "provisioningState", r.ProvisioningState)
+	populate(objectMap, "recipes", r.Recipes)
+	populate(objectMap, "version", r.Version)
+	populate(objectMap, "versions", r.Versions)
 	return json.Marshal(objectMap)
 }
 
-// UnmarshalJSON implements the json.Unmarshaller interface for type SecretConfig.
-func (s *SecretConfig) UnmarshalJSON(data []byte) error {
+// UnmarshalJSON implements the json.Unmarshaller interface for type RecipePackProperties.
+func (r *RecipePackProperties) UnmarshalJSON(data []byte) error {
 	var rawMsg map[string]json.RawMessage
 	if err := json.Unmarshal(data, &rawMsg); err != nil {
-		return fmt.Errorf("unmarshalling type %T: %v", s, err)
+		return fmt.Errorf("unmarshalling type %T: %v", r, err)
 	}
 	for key, val := range rawMsg {
 		var err error
 		switch key {
-		case "secret":
-				err = unpopulate(val, "Secret", &s.Secret)
+		case "description":
+				err = unpopulate(val, "Description", &r.Description)
+			delete(rawMsg, key)
+		case "provisioningState":
+				err = unpopulate(val, "ProvisioningState", &r.ProvisioningState)
+			delete(rawMsg, key)
+		case "recipes":
+			var recipesRaw map[string]json.RawMessage
+			if err = json.Unmarshal(val, &recipesRaw); err != nil {
+				return err
+			}
+			recipes := map[string]map[string]RecipePropertiesClassification{}
+			for k1, v1 := range recipesRaw {
+				recipes[k1], err = unmarshalRecipePropertiesClassificationMap(v1)
+				if err != nil {
+					return fmt.Errorf("unmarshalling type %T: %v", r, err)
+				}
+			}
+			r.Recipes = recipes
+			delete(rawMsg, key)
+		case "version":
+				err = unpopulate(val, "Version", &r.Version)
+			delete(rawMsg, key)
+		case "versions":
+				err = unpopulate(val, "Versions", &r.Versions)
 			delete(rawMsg, key)
 		}
 		if err != nil {
-			return fmt.Errorf("unmarshalling type %T: %v", s, err)
+			return fmt.Errorf("unmarshalling type %T: %v", r, err)
 		}
 	}
 	return nil
 }
 
-// MarshalJSON implements the json.Marshaller interface for type SecretObjectProperties.
-func (s SecretObjectProperties) MarshalJSON() ([]byte, error) {
+// MarshalJSON implements the json.Marshaller interface for type RecipePackReference.
+func (r RecipePackReference) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
-	populate(objectMap, "alias", s.Alias)
-	populate(objectMap, "encoding", s.Encoding)
-	populate(objectMap, "name", s.Name)
-	populate(objectMap, "version", s.Version)
+	populate(objectMap, "id", r.ID)
+	populate(objectMap, "version", r.Version)
 	return json.Marshal(objectMap)
 }
 
-// UnmarshalJSON implements the json.Unmarshaller interface for type SecretObjectProperties.
-func (s *SecretObjectProperties) UnmarshalJSON(data []byte) error {
+// UnmarshalJSON implements the json.Unmarshaller interface for type RecipePackReference.
+func (r *RecipePackReference) UnmarshalJSON(data []byte) error {
 	var rawMsg map[string]json.RawMessage
 	if err := json.Unmarshal(data, &rawMsg); err != nil {
-		return fmt.Errorf("unmarshalling type %T: %v", s, err)
+		return fmt.Errorf("unmarshalling type %T: %v", r, err)
 	}
 	for key, val := range rawMsg {
 		var err error
 		switch key {
-		case "alias":
-				err = unpopulate(val, "Alias", &s.Alias)
-			delete(rawMsg, key)
-		case "encoding":
-				err = unpopulate(val, "Encoding", &s.Encoding)
-			delete(rawMsg, key)
-		case "name":
-				err = unpopulate(val, "Name", &s.Name)
+		case "id":
+				err = unpopulate(val, "ID", &r.ID)
 			delete(rawMsg, key)
 		case "version":
-				err = unpopulate(val, "Version", &s.Version)
+				err = unpopulate(val, "Version", &r.Version)
 			delete(rawMsg, key)
 		}
 		if err != nil {
-			return fmt.Errorf("unmarshalling type %T: %v", s, err)
+			return fmt.Errorf("unmarshalling type %T: %v", r, err)
 		}
 	}
 	return nil
 }
 
-// MarshalJSON implements the json.Marshaller interface for type SecretReference.
-func (s SecretReference) MarshalJSON() ([]byte, error) {
+// MarshalJSON implements the json.Marshaller interface for type RecipePackResource.
+func (r RecipePackResource) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
-	populate(objectMap, "key", s.Key)
-	populate(objectMap, "source", s.Source)
+	populate(objectMap, "id", r.ID)
+	populate(objectMap, "location", r.Location)
+	populate(objectMap, "name", r.Name)
+	populate(objectMap, "properties", r.Properties)
+	populate(objectMap, "systemData", r.SystemData)
+	populate(objectMap, "tags", r.Tags)
+	populate(objectMap, "type", r.Type)
 	return json.Marshal(objectMap)
 }
 
-// UnmarshalJSON implements the json.Unmarshaller interface for type SecretReference.
-func (s *SecretReference) UnmarshalJSON(data []byte) error {
+// UnmarshalJSON implements the json.Unmarshaller interface for type RecipePackResource.
+func (r *RecipePackResource) UnmarshalJSON(data []byte) error {
 	var rawMsg map[string]json.RawMessage
 	if err := json.Unmarshal(data, &rawMsg); err != nil {
-		return fmt.Errorf("unmarshalling type %T: %v", s, err)
+		return fmt.Errorf("unmarshalling type %T: %v", r, err)
 	}
 	for key, val := range rawMsg {
 		var err error
 		switch key {
-		case "key":
-				err = unpopulate(val, "Key", &s.Key)
+		case "id":
+				err = unpopulate(val, "ID", &r.ID)
 			delete(rawMsg, key)
-		case "source":
-				err = unpopulate(val, "Source", &s.Source)
+		case "location":
+				err = unpopulate(val, "Location", &r.Location)
+			delete(rawMsg, key)
+		case "name":
+				err = unpopulate(val, "Name", &r.Name)
+			delete(rawMsg, key)
+		case "properties":
+				err = unpopulate(val, "Properties", &r.Properties)
+			delete(rawMsg, key)
+		case "systemData":
+				err = unpopulate(val, "SystemData", &r.SystemData)
+			delete(rawMsg, key)
+		case "tags":
+				err = unpopulate(val, "Tags", &r.Tags)
+			delete(rawMsg, key)
+		case "type":
+				err = unpopulate(val, "Type", &r.Type)
 			delete(rawMsg, key)
 		}
 		if err != nil {
-			return fmt.Errorf("unmarshalling type %T: %v", s, err)
+			return fmt.Errorf("unmarshalling type %T: %v", r, err)
 		}
 	}
 	return nil
 }
 
-// MarshalJSON implements the json.Marshaller interface for type SecretStoreListSecretsResult.
-func (s SecretStoreListSecretsResult) MarshalJSON() ([]byte, error) {
+// MarshalJSON implements the json.Marshaller interface for type RecipePackResourceListResult.
+func (r RecipePackResourceListResult) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
-	populate(objectMap, "data", s.Data)
-	populate(objectMap, "type", s.Type)
+	populate(objectMap, "nextLink", r.NextLink)
+	populate(objectMap, "value", r.Value)
 	return json.Marshal(objectMap)
 }
 
-// UnmarshalJSON implements the json.Unmarshaller interface for type SecretStoreListSecretsResult.
-func (s *SecretStoreListSecretsResult) UnmarshalJSON(data []byte) error {
+// UnmarshalJSON implements the json.Unmarshaller interface for type RecipePackResourceListResult.
+func (r *RecipePackResourceListResult) UnmarshalJSON(data []byte) error {
 	var rawMsg map[string]json.RawMessage
 	if err := json.Unmarshal(data, &rawMsg); err != nil {
-		return fmt.Errorf("unmarshalling type %T: %v", s, err)
+		return fmt.Errorf("unmarshalling type %T: %v", r, err)
 	}
 	for key, val := range rawMsg {
 		var err error
 		switch key {
-		case "data":
-				err = unpopulate(val, "Data", &s.Data)
+		case "nextLink":
+				err = unpopulate(val, "NextLink", &r.NextLink)
 			delete(rawMsg, key)
-		case "type":
-				err = unpopulate(val, "Type", &s.Type)
+		case "value":
+				err = unpopulate(val, "Value", &r.Value)
 			delete(rawMsg, key)
 		}
 		if err != nil {
-			return fmt.Errorf("unmarshalling type %T: %v", s, err)
+			return fmt.Errorf("unmarshalling type %T: %v", r, err)
 		}
 	}
 	return nil
 }
 
-// MarshalJSON implements the json.Marshaller interface for type SecretStoreProperties.
-func (s SecretStoreProperties) MarshalJSON() ([]byte, error) {
+// MarshalJSON implements the json.Marshaller interface for type RecipePackResourceUpdate.
+func (r RecipePackResourceUpdate) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
-	populate(objectMap, "application", s.Application)
-	populate(objectMap, "data", s.Data)
-	populate(objectMap, "environment", s.Environment)
-	populate(objectMap, "provisioningState", s.ProvisioningState)
-	populate(objectMap, "resource", s.Resource)
-	populate(objectMap, "status", s.Status)
-	populate(objectMap, "type", s.Type)
+	populate(objectMap, "id", r.ID)
+	populate(objectMap, "name", r.Name)
+	populate(objectMap, "systemData", r.SystemData)
+	populate(objectMap, "tags", r.Tags)
+	populate(objectMap, "type", r.Type)
 	return json.Marshal(objectMap)
 }
 
-// UnmarshalJSON implements the json.Unmarshaller interface for type SecretStoreProperties.
-func (s *SecretStoreProperties) UnmarshalJSON(data []byte) error {
+// UnmarshalJSON implements the json.Unmarshaller interface for type RecipePackResourceUpdate.
+func (r *RecipePackResourceUpdate) UnmarshalJSON(data []byte) error {
 	var rawMsg map[string]json.RawMessage
 	if err := json.Unmarshal(data, &rawMsg); err != nil {
-		return fmt.Errorf("unmarshalling type %T: %v", s, err)
+		return fmt.Errorf("unmarshalling type %T: %v", r, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "id":
+				err = unpopulate(val, "ID", &r.ID)
+			delete(rawMsg, key)
+		case "name":
+				err = unpopulate(val, "Name", &r.Name)
+			delete(rawMsg, key)
+		case "systemData":
+				err = unpopulate(val, "SystemData", &r.SystemData)
+			delete(rawMsg, key)
+		case "tags":
+				err = unpopulate(val, "Tags", &r.Tags)
+			delete(rawMsg, key)
+		case "type":
+				err = unpopulate(val, "Type", &r.Type)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", r, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type RecipePackVersion.
+func (r RecipePackVersion) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "description", r.Description)
+	populate(objectMap, "recipes", r.Recipes)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type RecipePackVersion.
+func (r *RecipePackVersion) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", r, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "description":
+				err = unpopulate(val, "Description", &r.Description)
+			delete(rawMsg, key)
+		case "recipes":
+			var recipesRaw map[string]json.RawMessage
+			if err = json.Unmarshal(val, &recipesRaw); err != nil {
+				return err
+			}
+			recipes := map[string]map[string]RecipePropertiesClassification{}
+			for k1, v1 := range recipesRaw {
+				recipes[k1], err = unmarshalRecipePropertiesClassificationMap(v1)
+				if err != nil {
+					return fmt.Errorf("unmarshalling type %T: %v", r, err)
+				}
+			}
+			r.Recipes = recipes
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", r, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type RecipePlanRequest.
+func (r RecipePlanRequest) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "applicationId", r.ApplicationID)
+	populate(objectMap, "parameters", r.Parameters)
+	populate(objectMap, "recipeName", r.RecipeName)
+	populate(objectMap, "resourceId", r.ResourceID)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type RecipePlanRequest.
+func (r *RecipePlanRequest) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", r, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "applicationId":
+				err = unpopulate(val, "ApplicationID", &r.ApplicationID)
+			delete(rawMsg, key)
+		case "parameters":
+				err = unpopulate(val, "Parameters", &r.Parameters)
+			delete(rawMsg, key)
+		case "recipeName":
+				err = unpopulate(val, "RecipeName", &r.RecipeName)
+			delete(rawMsg, key)
+		case "resourceId":
+				err = unpopulate(val, "ResourceID", &r.ResourceID)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", r, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type RecipePlanResponse.
+func (r RecipePlanResponse) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "resourceChanges", r.ResourceChanges)
+	populate(objectMap, "templateKind", r.TemplateKind)
+	populate(objectMap, "templatePath", r.TemplatePath)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type RecipePlanResponse.
+func (r *RecipePlanResponse) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", r, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "resourceChanges":
+				err = unpopulate(val, "ResourceChanges", &r.ResourceChanges)
+			delete(rawMsg, key)
+		case "templateKind":
+				err = unpopulate(val, "TemplateKind", &r.TemplateKind)
+			delete(rawMsg, key)
+		case "templatePath":
+				err = unpopulate(val, "TemplatePath", &r.TemplatePath)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", r, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type RecipeProperties.
+func (r RecipeProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "outputs", r.Outputs)
+	populate(objectMap, "parameters", r.Parameters)
+	objectMap["templateKind"] = r.TemplateKind
+	populate(objectMap, "templatePath", r.TemplatePath)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type RecipeProperties.
+func (r *RecipeProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", r, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "outputs":
+				err = unpopulate(val, "Outputs", &r.Outputs)
+			delete(rawMsg, key)
+		case "parameters":
+				err = unpopulate(val, "Parameters", &r.Parameters)
+			delete(rawMsg, key)
+		case "templateKind":
+				err = unpopulate(val, "TemplateKind", &r.TemplateKind)
+			delete(rawMsg, key)
+		case "templatePath":
+				err = unpopulate(val, "TemplatePath", &r.TemplatePath)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", r, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type RecipeResourceChange.
+func (r RecipeResourceChange) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "action", r.Action)
+	populate(objectMap, "id", r.ID)
+	populate(objectMap, "type", r.Type)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type RecipeResourceChange.
+func (r *RecipeResourceChange) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", r, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "action":
+				err = unpopulate(val, "Action", &r.Action)
+			delete(rawMsg, key)
+		case "id":
+				err = unpopulate(val, "ID", &r.ID)
+			delete(rawMsg, key)
+		case "type":
+				err = unpopulate(val, "Type", &r.Type)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", r, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type RecipeStatus.
+func (r RecipeStatus) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "templateKind", r.TemplateKind)
+	populate(objectMap, "templatePath", r.TemplatePath)
+	populate(objectMap, "templateVersion", r.TemplateVersion)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type RecipeStatus.
+func (r *RecipeStatus) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", r, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "templateKind":
+				err = unpopulate(val, "TemplateKind", &r.TemplateKind)
+			delete(rawMsg, key)
+		case "templatePath":
+				err = unpopulate(val, "TemplatePath", &r.TemplatePath)
+			delete(rawMsg, key)
+		case "templateVersion":
+				err = unpopulate(val, "TemplateVersion", &r.TemplateVersion)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", r, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type RegistrySecretConfig.
+func (r RegistrySecretConfig) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "secret", r.Secret)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type RegistrySecretConfig.
+func (r *RegistrySecretConfig) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", r, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "secret":
+				err = unpopulate(val, "Secret", &r.Secret)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", r, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type Resource.
+func (r Resource) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "id", r.ID)
+	populate(objectMap, "name", r.Name)
+	populate(objectMap, "systemData", r.SystemData)
+	populate(objectMap, "type", r.Type)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type Resource.
+func (r *Resource) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", r, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "id":
+				err = unpopulate(val, "ID", &r.ID)
+			delete(rawMsg, key)
+		case "name":
+				err = unpopulate(val, "Name", &r.Name)
+			delete(rawMsg, key)
+		case "systemData":
+				err = unpopulate(val, "SystemData", &r.SystemData)
+			delete(rawMsg, key)
+		case "type":
+				err = unpopulate(val, "Type", &r.Type)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", r, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type ResourceCondition.
+func (r ResourceCondition) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populateDateTimeRFC3339(objectMap, "lastTransitionTime", r.LastTransitionTime)
+	populate(objectMap, "message", r.Message)
+	populate(objectMap, "reason", r.Reason)
+	populate(objectMap, "status", r.Status)
+	populate(objectMap, "type", r.Type)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type ResourceCondition.
+func (r *ResourceCondition) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", r, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "lastTransitionTime":
+				err = unpopulateDateTimeRFC3339(val, "LastTransitionTime", &r.LastTransitionTime)
+			delete(rawMsg, key)
+		case "message":
+				err = unpopulate(val, "Message", &r.Message)
+			delete(rawMsg, key)
+		case "reason":
+				err = unpopulate(val, "Reason", &r.Reason)
+			delete(rawMsg, key)
+		case "status":
+				err = unpopulate(val, "Status", &r.Status)
+			delete(rawMsg, key)
+		case "type":
+				err = unpopulate(val, "Type", &r.Type)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", r, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type ResourceReference.
+func (r ResourceReference) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "id", r.ID)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type ResourceReference.
+func (r *ResourceReference) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", r, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "id":
+				err = unpopulate(val, "ID", &r.ID)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", r, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type ResourceStatus.
+func (r ResourceStatus) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "compute", r.Compute)
+	populate(objectMap, "conditions", r.Conditions)
+	populate(objectMap, "outputResources", r.OutputResources)
+	populate(objectMap, "recipe", r.Recipe)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type ResourceStatus.
+func (r *ResourceStatus) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", r, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "compute":
+			r.Compute, err = unmarshalEnvironmentComputeClassification(val)
+			delete(rawMsg, key)
+		case "conditions":
+				err = unpopulate(val, "Conditions", &r.Conditions)
+			delete(rawMsg, key)
+		case "outputResources":
+				err = unpopulate(val, "OutputResources", &r.OutputResources)
+			delete(rawMsg, key)
+		case "recipe":
+				err = unpopulate(val, "Recipe", &r.Recipe)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", r, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type RuntimesProperties.
+func (r RuntimesProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "kubernetes", r.Kubernetes)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type RuntimesProperties.
+func (r *RuntimesProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", r, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "kubernetes":
+				err = unpopulate(val, "Kubernetes", &r.Kubernetes)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", r, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type SSHConfig.
+func (s SSHConfig) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "secret", s.Secret)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type SSHConfig.
+func (s *SSHConfig) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", s, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "secret":
+				err = unpopulate(val, "Secret", &s.Secret)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", s, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type SecretConfig.
+func (s SecretConfig) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "secret", s.Secret)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type SecretConfig.
+func (s *SecretConfig) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", s, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "secret":
+				err = unpopulate(val, "Secret", &s.Secret)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", s, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type SecretObjectProperties.
+func (s SecretObjectProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "alias", s.Alias)
+	populate(objectMap, "encoding", s.Encoding)
+	populate(objectMap, "name", s.Name)
+	populate(objectMap, "version", s.Version)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type SecretObjectProperties.
+func (s *SecretObjectProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", s, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "alias":
+				err = unpopulate(val, "Alias", &s.Alias)
+			delete(rawMsg, key)
+		case "encoding":
+				err = unpopulate(val, "Encoding", &s.Encoding)
+			delete(rawMsg, key)
+		case "name":
+				err = unpopulate(val, "Name", &s.Name)
+			delete(rawMsg, key)
+		case "version":
+				err = unpopulate(val, "Version", &s.Version)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", s, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type SecretReference.
+func (s SecretReference) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "key", s.Key)
+	populate(objectMap, "source", s.Source)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type SecretReference.
+func (s *SecretReference) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", s, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "key":
+				err = unpopulate(val, "Key", &s.Key)
+			delete(rawMsg, key)
+		case "source":
+				err = unpopulate(val, "Source", &s.Source)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", s, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type SecretStoreListSecretsResult.
+func (s SecretStoreListSecretsResult) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "data", s.Data)
+	populate(objectMap, "type", s.Type)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type SecretStoreListSecretsResult.
+func (s *SecretStoreListSecretsResult) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", s, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "data":
+				err = unpopulate(val, "Data", &s.Data)
+			delete(rawMsg, key)
+		case "type":
+				err = unpopulate(val, "Type", &s.Type)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", s, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type SecretStoreProperties.
+func (s SecretStoreProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "application", s.Application)
+	populate(objectMap, "data", s.Data)
+	populate(objectMap, "environment", s.Environment)
+	populate(objectMap, "provisioningState", s.ProvisioningState)
+	populate(objectMap, "resource", s.Resource)
+	populate(objectMap, "status", s.Status)
+	populate(objectMap, "type", s.Type)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type SecretStoreProperties.
+func (s *SecretStoreProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", s, err)
 	}
 	for key, val := range rawMsg {
 		var err error
@@ -3412,11 +4171,50 @@ func (t *TCPHealthProbeProperties) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type TerraformBackendConfig.
+func (t TerraformBackendConfig) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "config", t.Config)
+	populate(objectMap, "kind", t.Kind)
+	populate(objectMap, "secrets", t.Secrets)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type TerraformBackendConfig.
+func (t *TerraformBackendConfig) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", t, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "config":
+				err = unpopulate(val, "Config", &t.Config)
+			delete(rawMsg, key)
+		case "kind":
+				err = unpopulate(val, "Kind", &t.Kind)
+			delete(rawMsg, key)
+		case "secrets":
+				err = unpopulate(val, "Secrets", &t.Secrets)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", t, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type TerraformConfigProperties.
 func (t TerraformConfigProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
 	populate(objectMap, "authentication", t.Authentication)
+	populate(objectMap, "backend", t.Backend)
+	populate(objectMap, "distribution", t.Distribution)
+	populate(objectMap, "encryption", t.Encryption)
 	populate(objectMap, "providers", t.Providers)
+	populate(objectMap, "version", t.Version)
 	return json.Marshal(objectMap)
 }
 
@@ -3432,9 +4230,21 @@ func (t *TerraformConfigProperties) UnmarshalJSON(data []byte) error {
 		case "authentication":
 				err = unpopulate(val, "Authentication", &t.Authentication)
 			delete(rawMsg, key)
+		case "backend":
+				err = unpopulate(val, "Backend", &t.Backend)
+			delete(rawMsg, key)
+		case "distribution":
+				err = unpopulate(val, "Distribution", &t.Distribution)
+			delete(rawMsg, key)
+		case "encryption":
+				err = unpopulate(val, "Encryption", &t.Encryption)
+			delete(rawMsg, key)
 		case "providers":
 				err = unpopulate(val, "Providers", &t.Providers)
 			delete(rawMsg, key)
+		case "version":
+				err = unpopulate(val, "Version", &t.Version)
+			delete(rawMsg, key)
 		}
 		if err != nil {
 			return fmt.Errorf("unmarshalling type %T: %v", t, err)
@@ -3446,6 +4256,7 @@ func (t *TerraformConfigProperties) UnmarshalJSON(data []byte) error {
 // MarshalJSON implements the json.Marshaller interface for type TerraformRecipeProperties.
 func (t TerraformRecipeProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
+	populate(objectMap, "outputs", t.Outputs)
 	populate(objectMap, "parameters", t.Parameters)
 	objectMap["templateKind"] = "terraform"
 	populate(objectMap, "templatePath", t.TemplatePath)
@@ -3462,6 +4273,9 @@ func (t *TerraformRecipeProperties) UnmarshalJSON(data []byte) error {
 	for key, val := range rawMsg {
 		var err error
 		switch key {
+		case "outputs":
+				err = unpopulate(val, "Outputs", &t.Outputs)
+			delete(rawMsg, key)
 		case "parameters":
 				err = unpopulate(val, "Parameters", &t.Parameters)
 			delete(rawMsg, key)
@@ -3482,6 +4296,33 @@ func (t *TerraformRecipeProperties) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type TerraformRegistryAuthConfig.
+func (t TerraformRegistryAuthConfig) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "secret", t.Secret)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type TerraformRegistryAuthConfig.
+func (t *TerraformRegistryAuthConfig) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", t, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "secret":
+				err = unpopulate(val, "Secret", &t.Secret)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", t, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type TrackedResource.
 func (t TrackedResource) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
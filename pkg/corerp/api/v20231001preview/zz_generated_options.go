@@ -83,11 +83,21 @@ type EnvironmentsClientGetOptions struct {
 	// placeholder for future optional parameters
 }
 
+// EnvironmentsClientGetRecipeLogsOptions contains the optional parameters for the EnvironmentsClient.GetRecipeLogs method.
+type EnvironmentsClientGetRecipeLogsOptions struct {
+	// placeholder for future optional parameters
+}
+
 // EnvironmentsClientListByScopeOptions contains the optional parameters for the EnvironmentsClient.NewListByScopePager method.
 type EnvironmentsClientListByScopeOptions struct {
 	// placeholder for future optional parameters
 }
 
+// EnvironmentsClientPlanRecipeOptions contains the optional parameters for the EnvironmentsClient.PlanRecipe method.
+type EnvironmentsClientPlanRecipeOptions struct {
+	// placeholder for future optional parameters
+}
+
 // EnvironmentsClientUpdateOptions contains the optional parameters for the EnvironmentsClient.Update method.
 type EnvironmentsClientUpdateOptions struct {
 	// placeholder for future optional parameters
@@ -160,6 +170,31 @@ type OperationsClientListOptions struct {
 	// placeholder for future optional parameters
 }
 
+// RecipePacksClientCreateOrUpdateOptions contains the optional parameters for the RecipePacksClient.CreateOrUpdate method.
+type RecipePacksClientCreateOrUpdateOptions struct {
+	// placeholder for future optional parameters
+}
+
+// RecipePacksClientDeleteOptions contains the optional parameters for the RecipePacksClient.Delete method.
+type RecipePacksClientDeleteOptions struct {
+	// placeholder for future optional parameters
+}
+
+// RecipePacksClientGetOptions contains the optional parameters for the RecipePacksClient.Get method.
+type RecipePacksClientGetOptions struct {
+	// placeholder for future optional parameters
+}
+
+// RecipePacksClientListByScopeOptions contains the optional parameters for the RecipePacksClient.NewListByScopePager method.
+type RecipePacksClientListByScopeOptions struct {
+	// placeholder for future optional parameters
+}
+
+// RecipePacksClientUpdateOptions contains the optional parameters for the RecipePacksClient.Update method.
+type RecipePacksClientUpdateOptions struct {
+	// placeholder for future optional parameters
+}
+
 // SecretStoresClientBeginCreateOrUpdateOptions contains the optional parameters for the SecretStoresClient.BeginCreateOrUpdate
 // method.
 type SecretStoresClientBeginCreateOrUpdateOptions struct {
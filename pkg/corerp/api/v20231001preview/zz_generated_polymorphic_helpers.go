@@ -110,6 +110,8 @@ func unmarshalRecipePropertiesClassification(rawMsg json.RawMessage) (RecipeProp
 	switch m["templateKind"] {
 	case "bicep":
 		b = &BicepRecipeProperties{}
+	case "helm":
+		b = &HelmRecipeProperties{}
 	case "terraform":
 		b = &TerraformRecipeProperties{}
 	default:
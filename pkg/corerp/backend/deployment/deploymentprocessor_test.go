@@ -301,7 +301,7 @@ func Test_Render(t *testing.T) {
 
 	t.Run("verify render success", func(t *testing.T) {
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{appmodel: mocks.model, databaseClient: mocks.databaseClient}
 
 		testResource := getTestResource()
 		testRendererOutput := getTestRendererOutput()
@@ -375,7 +375,7 @@ func Test_Render(t *testing.T) {
 
 	t.Run("verify render success lowercase resourcetype", func(t *testing.T) {
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{appmodel: mocks.model, databaseClient: mocks.databaseClient}
 
 		testResource := getLowerCaseTestResource()
 		testRendererOutput := getTestRendererOutput()
@@ -425,7 +425,7 @@ func Test_Render(t *testing.T) {
 
 	t.Run("verify render success uppercase resourcetype", func(t *testing.T) {
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{appmodel: mocks.model, databaseClient: mocks.databaseClient}
 
 		testResource := getUpperCaseTestResource()
 		testRendererOutput := getTestRendererOutput()
@@ -475,7 +475,7 @@ func Test_Render(t *testing.T) {
 
 	t.Run("verify render error", func(t *testing.T) {
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{appmodel: mocks.model, databaseClient: mocks.databaseClient}
 
 		testResource := getTestResource()
 		resourceID := getTestResourceID(testResource.ID)
@@ -523,7 +523,7 @@ func Test_Render(t *testing.T) {
 
 	t.Run("Resource not found in data store", func(t *testing.T) {
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{appmodel: mocks.model, databaseClient: mocks.databaseClient}
 
 		testResource := getTestResource()
 		resourceID := getTestResourceID(testResource.ID)
@@ -538,7 +538,7 @@ func Test_Render(t *testing.T) {
 
 	t.Run("Data store access error", func(t *testing.T) {
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{appmodel: mocks.model, databaseClient: mocks.databaseClient}
 
 		testResource := getTestResource()
 		resourceID := getTestResourceID(testResource.ID)
@@ -552,7 +552,7 @@ func Test_Render(t *testing.T) {
 
 	t.Run("Invalid resource type", func(t *testing.T) {
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{appmodel: mocks.model, databaseClient: mocks.databaseClient}
 
 		testInvalidResourceID := "/subscriptions/test-sub/resourceGroups/test-group/providers/Applications.foo/foo/foo"
 		testResource := getTestResource()
@@ -564,7 +564,7 @@ func Test_Render(t *testing.T) {
 
 	t.Run("Invalid application id", func(t *testing.T) {
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{appmodel: mocks.model, databaseClient: mocks.databaseClient}
 
 		testResource := getTestResource()
 		resourceID := getTestResourceID(testResource.ID)
@@ -586,7 +586,7 @@ func Test_Render(t *testing.T) {
 
 	t.Run("Missing application id", func(t *testing.T) {
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{appmodel: mocks.model, databaseClient: mocks.databaseClient}
 
 		testResource := getTestResource()
 		resourceID := getTestResourceID(testResource.ID)
@@ -607,7 +607,7 @@ func Test_Render(t *testing.T) {
 
 	t.Run("Invalid application resource type", func(t *testing.T) {
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{appmodel: mocks.model, databaseClient: mocks.databaseClient}
 
 		testResource := getTestResource()
 		resourceID := getTestResourceID(testResource.ID)
@@ -629,7 +629,7 @@ func Test_Render(t *testing.T) {
 
 	t.Run("Missing output resource provider", func(t *testing.T) {
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{appmodel: mocks.model, databaseClient: mocks.databaseClient}
 
 		testResource := getTestResource()
 		testRendererOutput := getTestRendererOutput()
@@ -680,7 +680,7 @@ func Test_Render(t *testing.T) {
 
 	t.Run("Unsupported output resource provider", func(t *testing.T) {
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{appmodel: mocks.model, databaseClient: mocks.databaseClient}
 
 		testResource := getTestResource()
 		testRendererOutput := getTestRendererOutput()
@@ -797,7 +797,7 @@ func Test_Deploy(t *testing.T) {
 	t.Run("Verify deploy success", func(t *testing.T) {
 		ctx := testcontext.New(t)
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{appmodel: mocks.model, databaseClient: mocks.databaseClient}
 
 		testResource := getTestResource()
 		testRendererOutput := getTestRendererOutput()
@@ -837,7 +837,7 @@ func Test_Deploy(t *testing.T) {
 	t.Run("Verify deploy success with simulated env", func(t *testing.T) {
 		ctx := testcontext.New(t)
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{appmodel: mocks.model, databaseClient: mocks.databaseClient}
 
 		testResource := getTestResource()
 		testRendererOutput := getTestRendererOutput()
@@ -855,7 +855,7 @@ func Test_Deploy(t *testing.T) {
 	t.Run("Verify deploy failure", func(t *testing.T) {
 		ctx := testcontext.New(t)
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{appmodel: mocks.model, databaseClient: mocks.databaseClient}
 
 		testResource := getTestResource()
 		testRendererOutput := getTestRendererOutput()
@@ -872,7 +872,7 @@ func Test_Deploy(t *testing.T) {
 	t.Run("Output resource dependency missing local ID", func(t *testing.T) {
 		ctx := testcontext.New(t)
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{appmodel: mocks.model, databaseClient: mocks.databaseClient}
 
 		testResource := getTestResource()
 		testRendererOutput := getTestRendererOutput()
@@ -890,7 +890,7 @@ func Test_Deploy(t *testing.T) {
 	t.Run("Invalid output resource type", func(t *testing.T) {
 		ctx := testcontext.New(t)
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{appmodel: mocks.model, databaseClient: mocks.databaseClient}
 
 		testResource := getTestResource()
 		testRendererOutput := getTestRendererOutput()
@@ -908,7 +908,7 @@ func Test_Deploy(t *testing.T) {
 	t.Run("Missing output resource identity", func(t *testing.T) {
 		ctx := testcontext.New(t)
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{appmodel: mocks.model, databaseClient: mocks.databaseClient}
 
 		testResource := getTestResource()
 		testRendererOutput := getTestRendererOutput()
@@ -934,7 +934,7 @@ func Test_Delete(t *testing.T) {
 	t.Run("Verify delete success", func(t *testing.T) {
 		ctx := testcontext.New(t)
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{appmodel: mocks.model, databaseClient: mocks.databaseClient}
 
 		testResource := getTestResource()
 		resourceID := getTestResourceID(testResource.ID)
@@ -948,7 +948,7 @@ func Test_Delete(t *testing.T) {
 	t.Run("Verify delete failure", func(t *testing.T) {
 		ctx := testcontext.New(t)
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{appmodel: mocks.model, databaseClient: mocks.databaseClient}
 
 		testResource := getTestResource()
 		resourceID := getTestResourceID(testResource.ID)
@@ -962,7 +962,7 @@ func Test_Delete(t *testing.T) {
 	t.Run("Verify delete with no output resources", func(t *testing.T) {
 		ctx := testcontext.New(t)
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{appmodel: mocks.model, databaseClient: mocks.databaseClient}
 
 		testResource := getTestResource()
 		resourceID := getTestResourceID(testResource.ID)
@@ -978,7 +978,7 @@ func Test_Delete(t *testing.T) {
 func Test_getEnvOptions_PublicEndpointOverride(t *testing.T) {
 	ctx := testcontext.New(t)
 	mocks := setup(t)
-	dp := deploymentProcessor{mocks.model, nil, nil, nil}
+	dp := deploymentProcessor{appmodel: mocks.model}
 
 	env := &datamodel.Environment{
 		Properties: datamodel.EnvironmentProperties{
@@ -1037,7 +1037,7 @@ func Test_getEnvOptions_PublicEndpointOverride(t *testing.T) {
 func Test_getResourceDataByID(t *testing.T) {
 	ctx := testcontext.New(t)
 	mocks := setup(t)
-	dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+	dp := deploymentProcessor{appmodel: mocks.model, databaseClient: mocks.databaseClient}
 
 	t.Run("Get recipe data from connected mongoDB resources", func(t *testing.T) {
 		depId, _ := resources.ParseResource("/subscriptions/test-subscription/resourceGroups/test-resource-group/providers/Applications.Datastores/mongoDatabases/test-mongo")
@@ -1062,7 +1062,7 @@ func Test_fetchSecrets(t *testing.T) {
 	ctx := testcontext.New(t)
 
 	mocks := setup(t)
-	dp := deploymentProcessor{mocks.model, nil, nil, nil}
+	dp := deploymentProcessor{appmodel: mocks.model}
 
 	t.Run("Get secrets from recipe data when resource has associated recipe", func(t *testing.T) {
 		mongoResource := buildMongoDBResourceDataWithRecipeAndSecrets()
@@ -0,0 +1,80 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	dynamicrp_dm "github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/dynamicrp/resourcetype"
+	"github.com/radius-project/radius/pkg/portableresources"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/schema"
+	ucp_dm "github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// buildDynamicResourceDependency builds the dependency data for a resource of a user-defined resource type. The
+// resource type determines the values that are provided to connected resources:
+//
+//   - The read-only properties declared by the schema are the outputs of the resource and are provided as computed
+//     values. Nested read-only properties are provided under their path joined by underscores, for example
+//     "network_address" for the "address" property of "network". When the resource type does not declare a schema
+//     the recipe outputs are provided instead.
+//   - The secret outputs of the resource are provided as secret values when the resource type has the ExposesSecrets
+//     capability.
+func (dp *deploymentProcessor) buildDynamicResourceDependency(ctx context.Context, resourceID resources.ID, resource *dynamicrp_dm.DynamicResource) (ResourceData, error) {
+	resourceTypes := resourcetype.NewClient(dp.ucp)
+	resourceType, err := resourceTypes.Get(ctx, resourceID)
+	if err != nil {
+		return ResourceData{}, fmt.Errorf("failed to fetch resource type for %q: %w", resourceID.String(), err)
+	}
+
+	raw, err := resourceTypes.GetDefaultAPIVersionSchema(ctx, resourceID)
+	if err != nil {
+		return ResourceData{}, fmt.Errorf("failed to fetch schema for %q: %w", resourceID.String(), err)
+	}
+
+	computedValues := map[string]any{}
+	if len(raw) > 0 {
+		readOnly, err := schema.ReadOnlyProperties(raw)
+		if err != nil {
+			return ResourceData{}, fmt.Errorf("failed to read schema for %q: %w", resourceID.String(), err)
+		}
+
+		for _, path := range readOnly {
+			if value, ok := schema.GetPath(resource.Properties, path); ok {
+				computedValues[strings.ReplaceAll(path, ".", "_")] = value
+			}
+		}
+	} else if binding, ok := resource.Status()["binding"].(map[string]any); ok {
+		for key, value := range binding {
+			computedValues[key] = value
+		}
+	}
+
+	secretValues := map[string]rpv1.SecretValueReference{}
+	if resourcetype.HasCapability(resourceType, ucp_dm.CapabilityExposesSecrets) {
+		for key, value := range resource.SecretValues {
+			secretValues[key] = rpv1.SecretValueReference{Value: value}
+		}
+	}
+
+	return dp.buildResourceDependency(resourceID, resource.ResourceMetadata().ApplicationID(), resource, resource.OutputResources(), computedValues, secretValues, portableresources.RecipeData{})
+}
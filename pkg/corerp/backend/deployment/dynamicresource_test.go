@@ -0,0 +1,245 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	armpolicy "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/policy"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/components/database"
+	dynamicrp_dm "github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview/fake"
+	ucp_dm "github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testSchemaResourceType   = "Applications.Test/schemaResources"
+	testSecretsResourceType  = "Applications.Test/secretResources"
+	testNoSchemaResourceType = "Applications.Test/noSchemaResources"
+	testDynamicAPIVersion    = "2024-01-01"
+)
+
+func Test_getResourceDataByID_DynamicResource(t *testing.T) {
+	resource := func(resourceType string) *dynamicrp_dm.DynamicResource {
+		return &dynamicrp_dm.DynamicResource{
+			BaseResource: v1.BaseResource{
+				TrackedResource: v1.TrackedResource{
+					ID:   "/planes/radius/local/resourceGroups/test-group/providers/" + resourceType + "/test-resource",
+					Name: "test-resource",
+					Type: resourceType,
+				},
+			},
+			Properties: map[string]any{
+				"application": "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/test-app",
+				"host":        "db.example.com",
+				"port":        5432,
+				"database":    "orders",
+				"network": map[string]any{
+					"address":  "10.0.0.4",
+					"protocol": "tcp",
+				},
+				"status": map[string]any{
+					"binding": map[string]any{
+						"url": "postgres://db.example.com:5432",
+					},
+				},
+			},
+			SecretValues: map[string]string{
+				"password": "p@ssw0rd",
+			},
+		}
+	}
+
+	tests := []struct {
+		name                   string
+		resourceType           string
+		expectedComputedValues map[string]any
+		expectedSecretValues   map[string]rpv1.SecretValueReference
+	}{
+		{
+			name:         "read-only schema properties",
+			resourceType: testSchemaResourceType,
+			expectedComputedValues: map[string]any{
+				"host":            "db.example.com",
+				"port":            5432,
+				"network_address": "10.0.0.4",
+			},
+			expectedSecretValues: map[string]rpv1.SecretValueReference{},
+		},
+		{
+			name:         "exposes secrets",
+			resourceType: testSecretsResourceType,
+			expectedComputedValues: map[string]any{
+				"host":            "db.example.com",
+				"port":            5432,
+				"network_address": "10.0.0.4",
+			},
+			expectedSecretValues: map[string]rpv1.SecretValueReference{
+				"password": {Value: "p@ssw0rd"},
+			},
+		},
+		{
+			name:         "no schema falls back to binding",
+			resourceType: testNoSchemaResourceType,
+			expectedComputedValues: map[string]any{
+				"url": "postgres://db.example.com:5432",
+			},
+			expectedSecretValues: map[string]rpv1.SecretValueReference{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := testcontext.New(t)
+			mocks := setup(t)
+
+			ucp, err := testDynamicUCPClientFactory()
+			require.NoError(t, err)
+
+			dp := deploymentProcessor{appmodel: mocks.model, databaseClient: mocks.databaseClient, ucp: ucp}
+
+			r := resource(tt.resourceType)
+			mocks.databaseClient.EXPECT().
+				Get(gomock.Any(), gomock.Any()).
+				Return(&database.Object{Metadata: database.Metadata{ID: r.ID}, Data: r}, nil).
+				Times(1)
+
+			id := resources.MustParse(r.ID)
+			resourceData, err := dp.getResourceDataByID(ctx, id)
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedComputedValues, resourceData.ComputedValues)
+			require.Equal(t, tt.expectedSecretValues, resourceData.SecretValues)
+			require.NotNil(t, resourceData.AppID)
+			require.Equal(t, "test-app", resourceData.AppID.Name())
+		})
+	}
+
+	t.Run("unsupported without UCP client", func(t *testing.T) {
+		ctx := testcontext.New(t)
+		mocks := setup(t)
+		dp := deploymentProcessor{appmodel: mocks.model, databaseClient: mocks.databaseClient}
+
+		r := resource(testSchemaResourceType)
+		mocks.databaseClient.EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			Return(&database.Object{Metadata: database.Metadata{ID: r.ID}, Data: r}, nil).
+			Times(1)
+
+		_, err := dp.getResourceDataByID(ctx, resources.MustParse(r.ID))
+		require.ErrorContains(t, err, "unsupported resource type")
+	})
+}
+
+func testDynamicUCPClientFactory() (*v20231001preview.ClientFactory, error) {
+	schema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"host": map[string]any{
+				"type":     "string",
+				"readOnly": true,
+			},
+			"port": map[string]any{
+				"type":     "integer",
+				"readOnly": true,
+			},
+			"database": map[string]any{
+				"type": "string",
+			},
+			"network": map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"address": map[string]any{
+						"type":     "string",
+						"readOnly": true,
+					},
+					"protocol": map[string]any{
+						"type": "string",
+					},
+				},
+			},
+		},
+	}
+
+	resourceTypesServer := fake.ResourceTypesServer{
+		Get: func(ctx context.Context, planeName, resourceProviderName, resourceTypeName string, options *v20231001preview.ResourceTypesClientGetOptions) (resp azfake.Responder[v20231001preview.ResourceTypesClientGetResponse], errResp azfake.ErrorResponder) {
+			properties := &v20231001preview.ResourceTypeProperties{
+				DefaultAPIVersion: to.Ptr(testDynamicAPIVersion),
+			}
+
+			switch resourceProviderName + resources.SegmentSeparator + resourceTypeName {
+			case testSchemaResourceType, testNoSchemaResourceType:
+			case testSecretsResourceType:
+				properties.Capabilities = []*string{to.Ptr(ucp_dm.CapabilityExposesSecrets)}
+			default:
+				errResp.SetResponseError(http.StatusNotFound, v1.CodeNotFound)
+				return
+			}
+
+			response := v20231001preview.ResourceTypesClientGetResponse{
+				ResourceTypeResource: v20231001preview.ResourceTypeResource{
+					Name:       to.Ptr(resourceTypeName),
+					Properties: properties,
+				},
+			}
+			resp.SetResponse(http.StatusOK, response, nil)
+			return
+		},
+	}
+
+	apiVersionsServer := fake.APIVersionsServer{
+		Get: func(ctx context.Context, planeName, resourceProviderName, resourceTypeName, apiVersionName string, options *v20231001preview.APIVersionsClientGetOptions) (resp azfake.Responder[v20231001preview.APIVersionsClientGetResponse], errResp azfake.ErrorResponder) {
+			resourceType := resourceProviderName + resources.SegmentSeparator + resourceTypeName
+			if resourceType == testNoSchemaResourceType || apiVersionName != testDynamicAPIVersion {
+				errResp.SetResponseError(http.StatusNotFound, v1.CodeNotFound)
+				return
+			}
+
+			response := v20231001preview.APIVersionsClientGetResponse{
+				APIVersionResource: v20231001preview.APIVersionResource{
+					Name: to.Ptr(apiVersionName),
+					Properties: &v20231001preview.APIVersionProperties{
+						Schema: schema,
+					},
+				},
+			}
+			resp.SetResponse(http.StatusOK, response, nil)
+			return
+		},
+	}
+
+	return v20231001preview.NewClientFactory(&aztoken.AnonymousCredential{}, &armpolicy.ClientOptions{
+		ClientOptions: policy.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				APIVersionsServer:   apiVersionsServer,
+				ResourceTypesServer: resourceTypesServer,
+			}),
+		},
+	})
+}
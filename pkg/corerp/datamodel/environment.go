@@ -17,6 +17,8 @@ limitations under the License.
 package datamodel
 
 import (
+	"time"
+
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 )
@@ -40,6 +42,7 @@ func (e *Environment) ResourceTypeName() string {
 type EnvironmentProperties struct {
 	Compute      rpv1.EnvironmentCompute                           `json:"compute,omitempty"`
 	Recipes      map[string]map[string]EnvironmentRecipeProperties `json:"recipes,omitempty"`
+	RecipePacks  []RecipePackReference                             `json:"recipePacks,omitempty"`
 	Providers    Providers                                         `json:"providers,omitempty"`
 	RecipeConfig RecipeConfigProperties                            `json:"recipeConfig,omitempty"`
 	Extensions   []Extension                                       `json:"extensions,omitempty"`
@@ -48,11 +51,24 @@ type EnvironmentProperties struct {
 
 // EnvironmentRecipeProperties represents the properties of environment's recipe.
 type EnvironmentRecipeProperties struct {
-	TemplateKind    string         `json:"templateKind"`
-	TemplatePath    string         `json:"templatePath"`
-	TemplateVersion string         `json:"templateVersion,omitempty"`
-	Parameters      map[string]any `json:"parameters,omitempty"`
-	PlainHTTP       bool           `json:"plainHttp,omitempty"`
+	TemplateKind    string                `json:"templateKind"`
+	TemplatePath    string                `json:"templatePath"`
+	TemplateVersion string                `json:"templateVersion,omitempty"`
+	Parameters      map[string]any        `json:"parameters,omitempty"`
+	PlainHTTP       bool                  `json:"plainHttp,omitempty"`
+	Outputs         *RecipeOutputContract `json:"outputs,omitempty"`
+}
+
+// RecipeOutputContract represents the outputs a recipe must produce.
+type RecipeOutputContract struct {
+	// Values represents the values the recipe must output, keyed by name. The value is the expected type of the output.
+	Values map[string]string `json:"values,omitempty"`
+
+	// Secrets represents the names of the secrets the recipe must output.
+	Secrets []string `json:"secrets,omitempty"`
+
+	// Resources represents whether the recipe must output the IDs of the resources it deploys.
+	Resources bool `json:"resources,omitempty"`
 }
 
 // Recipe represents input properties for recipe getMetadata api.
@@ -74,6 +90,119 @@ func (e *EnvironmentRecipeProperties) ResourceTypeName() string {
 	return "Applications.Core/environments"
 }
 
+// RecipePlanRequest represents input properties for recipe planRecipe api.
+type RecipePlanRequest struct {
+	// ResourceID is the ID of the portable resource the recipe would be deployed for.
+	ResourceID string `json:"resourceId,omitempty"`
+
+	// RecipeName is the name of the recipe registered to the environment.
+	RecipeName string `json:"recipeName,omitempty"`
+
+	// ApplicationID is the ID of the application of the portable resource.
+	ApplicationID string `json:"applicationId,omitempty"`
+
+	// Parameters are the recipe parameters set by the portable resource.
+	Parameters map[string]any `json:"parameters,omitempty"`
+}
+
+// ResourceTypeName returns the resource type of the RecipePlanRequest instance.
+func (e *RecipePlanRequest) ResourceTypeName() string {
+	return "Applications.Core/environments"
+}
+
+// RecipePlan represents the output of recipe planRecipe api.
+type RecipePlan struct {
+	// TemplateKind is the format of the template of the recipe.
+	TemplateKind string `json:"templateKind,omitempty"`
+
+	// TemplatePath is the path of the template of the recipe.
+	TemplatePath string `json:"templatePath,omitempty"`
+
+	// ResourceChanges are the changes the recipe would make to the resources it manages.
+	ResourceChanges []RecipeResourceChange `json:"resourceChanges,omitempty"`
+}
+
+// RecipeResourceChange represents a change a recipe would make to a resource it manages.
+type RecipeResourceChange struct {
+	// ID is the ID of the resource.
+	ID string `json:"id,omitempty"`
+
+	// Type is the type of the resource.
+	Type string `json:"type,omitempty"`
+
+	// Action is the change to the resource.
+	Action string `json:"action,omitempty"`
+}
+
+// ResourceTypeName returns the resource type of the RecipePlan instance.
+func (e *RecipePlan) ResourceTypeName() string {
+	return "Applications.Core/environments"
+}
+
+// RecipeLogsRequest represents input properties for recipe getRecipeLogs api.
+type RecipeLogsRequest struct {
+	// ResourceID is the ID of the portable resource the recipe was executed for.
+	ResourceID string `json:"resourceId,omitempty"`
+
+	// OperationID is the optional ID of the operation to get the recipe logs of.
+	OperationID string `json:"operationId,omitempty"`
+}
+
+// ResourceTypeName returns the resource type of the RecipeLogsRequest instance.
+func (e *RecipeLogsRequest) ResourceTypeName() string {
+	return "Applications.Core/environments"
+}
+
+// RecipeLogs represents the output of recipe getRecipeLogs api.
+type RecipeLogs struct {
+	// Operations are the logs of the most recent operations that executed the recipe of the resource, oldest first.
+	Operations []RecipeOperationLog `json:"operations,omitempty"`
+}
+
+// RecipeOperationLog represents the logs of the recipe execution of an operation on a portable resource.
+type RecipeOperationLog struct {
+	// OperationID is the ID of the operation that executed the recipe.
+	OperationID string `json:"operationId,omitempty"`
+
+	// OperationType is the type of the operation that executed the recipe.
+	OperationType string `json:"operationType,omitempty"`
+
+	// RecipeName is the name of the recipe.
+	RecipeName string `json:"recipeName,omitempty"`
+
+	// Status is the status of the recipe execution.
+	Status string `json:"status,omitempty"`
+
+	// StartTime is the time the recipe execution started.
+	StartTime time.Time `json:"startTime,omitempty"`
+
+	// EndTime is the time the recipe execution completed.
+	EndTime time.Time `json:"endTime,omitempty"`
+
+	// Truncated is true if the oldest entries were dropped because the logs exceeded their size limit.
+	Truncated bool `json:"truncated,omitempty"`
+
+	// Entries are the log entries, oldest first.
+	Entries []RecipeLogEntry `json:"entries,omitempty"`
+}
+
+// RecipeLogEntry represents an entry of the logs of a recipe execution.
+type RecipeLogEntry struct {
+	// Timestamp is the time the entry was recorded.
+	Timestamp time.Time `json:"timestamp,omitempty"`
+
+	// Source is the source of the entry.
+	Source string `json:"source,omitempty"`
+
+	// Message is the content of the entry.
+	Message string `json:"message,omitempty"`
+}
+
+// ResourceTypeName returns the resource type of the RecipeLogs instance.
+func (e *RecipeLogs) ResourceTypeName() string {
+	return "Applications.Core/environments"
+}
+
 // Providers represents configs for providers for the environment, eg azure,aws
 type Providers struct {
 	// Azure provider information
@@ -30,16 +30,75 @@ type RecipeConfigProperties struct {
 	// EnvSecrets represents the environment secrets for the recipe.
 	// The keys of the map are the names of the secrets, and the values are the references to the secrets.
 	EnvSecrets map[string]SecretReference `json:"envSecrets,omitempty"`
+
+	// TimeoutSeconds is the maximum number of seconds a recipe execution can run before it is canceled.
+	// A value of zero means recipe executions are only bounded by the operation timeout.
+	TimeoutSeconds int32 `json:"timeoutSeconds,omitempty"`
 }
 
 // TerraformConfigProperties - Configuration for Terraform Recipes. Controls how Terraform plans and applies templates as
 // part of Recipe deployment.
 type TerraformConfigProperties struct {
-	// Authentication information used to access private Terraform module sources. Supported module sources: Git.
+	// Authentication information used to access private Terraform module sources. Supported module sources: Git and Terraform module registries.
 	Authentication AuthConfig `json:"authentication,omitempty"`
 
 	// Providers specifies the Terraform provider configurations. Controls how Terraform interacts with cloud providers, SaaS providers, and other APIs: https://developer.hashicorp.com/terraform/language/providers/configuration.// Providers specifies the Terraform provider configurations.
 	Providers map[string][]ProviderConfigProperties `json:"providers,omitempty"`
+
+	// Version is the exact version of Terraform used to execute Terraform recipes, e.g. "1.7.5". The latest version of
+	// Terraform is used if empty.
+	Version string `json:"version,omitempty"`
+
+	// Backend is the backend that stores the Terraform state of recipes. The state is stored in Kubernetes secrets if empty.
+	Backend TerraformBackendConfig `json:"backend,omitempty"`
+
+	// Distribution is the distribution of Terraform used to execute Terraform recipes. Terraform is used if empty.
+	Distribution string `json:"distribution,omitempty"`
+
+	// Encryption is the reference to the secret containing the state and plan encryption configuration of OpenTofu.
+	// It is only supported with the OpenTofu distribution.
+	Encryption *SecretReference `json:"encryption,omitempty"`
+}
+
+const (
+	// TerraformDistributionTerraform is the distribution of Terraform released by HashiCorp.
+	TerraformDistributionTerraform = "terraform"
+
+	// TerraformDistributionOpenTofu is the OpenTofu distribution of Terraform.
+	TerraformDistributionOpenTofu = "opentofu"
+)
+
+// SupportedTerraformDistributions is the list of the supported distributions of Terraform.
+var SupportedTerraformDistributions = []string{TerraformDistributionTerraform, TerraformDistributionOpenTofu}
+
+const (
+	// TerraformBackendKubernetes is the kind of the backend that stores the Terraform state in Kubernetes secrets.
+	TerraformBackendKubernetes = "kubernetes"
+
+	// TerraformBackendPostgres is the kind of the backend that stores the Terraform state in a Postgres database.
+	TerraformBackendPostgres = "pg"
+
+	// TerraformBackendS3 is the kind of the backend that stores the Terraform state in an S3-compatible bucket.
+	TerraformBackendS3 = "s3"
+
+	// TerraformBackendHTTP is the kind of the backend that stores the Terraform state using a REST client.
+	TerraformBackendHTTP = "http"
+)
+
+// SupportedTerraformBackends is the list of the supported kinds of Terraform backends.
+var SupportedTerraformBackends = []string{TerraformBackendKubernetes, TerraformBackendPostgres, TerraformBackendS3, TerraformBackendHTTP}
+
+// TerraformBackendConfig - Configuration for the backend that stores the Terraform state of Terraform Recipes.
+type TerraformBackendConfig struct {
+	// Kind is the kind of the backend. The Kubernetes backend is used if empty.
+	Kind string `json:"kind,omitempty"`
+
+	// Config represents the non-sensitive configuration arguments of the backend.
+	Config map[string]any `json:"config,omitempty"`
+
+	// Secrets represents the sensitive configuration arguments of the backend. The keys of the map are the names
+	// of the arguments, and the values are the references to the secrets.
+	Secrets map[string]SecretReference `json:"secrets,omitempty"`
 }
 
 // BicepConfigProperties - Configuration for Bicep Recipes. Controls how Bicep plans and applies templates as part of Recipe
@@ -57,16 +116,24 @@ type RegistrySecretConfig struct {
 	Secret string
 }
 
-// AuthConfig - Authentication information used to access private Terraform module sources. Supported module sources: Git.
+// AuthConfig - Authentication information used to access private Terraform module sources. Supported module sources: Git and Terraform module registries.
 type AuthConfig struct {
 	// Authentication information used to access private Terraform modules from Git repository sources.
 	Git GitAuthConfig `json:"git,omitempty"`
+
+	// Authentication information used to access private Terraform module registries. The keys of the map are the
+	// hostnames of the registries.
+	Registry map[string]TerraformRegistryAuthConfig `json:"registry,omitempty"`
 }
 
 // GitAuthConfig - Authentication information used to access private Terraform modules from Git repository sources.
 type GitAuthConfig struct {
 	// Personal Access Token (PAT) configuration used to authenticate to Git platforms.
 	PAT map[string]SecretConfig `json:"pat,omitempty"`
+
+	// SSH deploy key configuration used to authenticate to Git platforms. The keys of the map are the hostnames of
+	// the Git platforms.
+	SSH map[string]SSHConfig `json:"ssh,omitempty"`
 }
 
 // SecretConfig - Personal Access Token (PAT) configuration used to authenticate to Git platforms.
@@ -77,6 +144,21 @@ type SecretConfig struct {
 	Secret string `json:"secret,omitempty"`
 }
 
+// SSHConfig - SSH deploy key configuration used to authenticate to Git platforms.
+type SSHConfig struct {
+	// The ID of an Applications.Core/SecretStore resource containing the SSH private key. The secret store must have a
+	// secret named 'privateKey', containing the private key, and a secret named 'knownHosts', containing the
+	// known_hosts entries used to verify the host key of the Git platform.
+	Secret string `json:"secret,omitempty"`
+}
+
+// TerraformRegistryAuthConfig - Authentication information used to access a private Terraform module registry.
+type TerraformRegistryAuthConfig struct {
+	// The ID of an Applications.Core/SecretStore resource containing the API token of the registry. The secret store
+	// must have a secret named 'token', containing the token value.
+	Secret string `json:"secret,omitempty"`
+}
+
 // EnvironmentVariables represents the environment variables to be set for the recipe execution.
 type EnvironmentVariables struct {
 	// AdditionalProperties represents the non-sensitive environment variables to be set for the recipe execution.
@@ -0,0 +1,82 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datamodel
+
+import (
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+)
+
+const RecipePackResourceType = "Applications.Core/recipePacks"
+
+var _ v1.DataModelInterface = (*RecipePack)(nil)
+
+// RecipePack represents a versioned collection of recipes that can be registered to environments as a unit.
+type RecipePack struct {
+	v1.BaseResource
+
+	// Properties is the properties of the resource.
+	Properties RecipePackProperties `json:"properties"`
+}
+
+// ResourceTypeName returns the resource type of the RecipePack instance.
+func (r *RecipePack) ResourceTypeName() string {
+	return RecipePackResourceType
+}
+
+// RecipePackProperties represents the properties of RecipePack.
+type RecipePackProperties struct {
+	// Version is the version of the recipe pack.
+	Version string `json:"version"`
+
+	// Description is the description of the recipe pack.
+	Description string `json:"description,omitempty"`
+
+	// Recipes are the recipes of the pack, keyed by resource type and recipe name.
+	Recipes map[string]map[string]EnvironmentRecipeProperties `json:"recipes,omitempty"`
+
+	// Versions are the published versions of the pack, including the current version, keyed by version. Publishing a
+	// new version keeps the recipes of the previous versions so environments can keep using them.
+	Versions map[string]RecipePackVersion `json:"versions,omitempty"`
+}
+
+// RecipePackVersion represents a published version of a recipe pack.
+type RecipePackVersion struct {
+	// Description is the description of the recipe pack version.
+	Description string `json:"description,omitempty"`
+
+	// Recipes are the recipes of the recipe pack version, keyed by resource type and recipe name.
+	Recipes map[string]map[string]EnvironmentRecipeProperties `json:"recipes,omitempty"`
+}
+
+// RecipePackReference represents a version of a recipe pack registered to an environment.
+type RecipePackReference struct {
+	// ID is the resource ID of the recipe pack.
+	ID string `json:"id"`
+
+	// Version is the version of the recipe pack whose recipes are registered to the environment.
+	Version string `json:"version"`
+}
+
+// RecipesOfVersion returns the recipes of the given version of the recipe pack, and whether the version is published.
+func (r *RecipePack) RecipesOfVersion(version string) (map[string]map[string]EnvironmentRecipeProperties, bool) {
+	published, ok := r.Properties.Versions[version]
+	if !ok {
+		return nil, false
+	}
+
+	return published.Recipes, true
+}
@@ -69,6 +69,11 @@ func (e *CreateOrUpdateEnvironment) Run(ctx context.Context, w http.ResponseWrit
 		return rest.NewBadRequestResponse(err.Error()), nil
 	}
 
+	// Validate that the recipe packs exist and that their recipes don't conflict.
+	if _, err := resolveRecipes(ctx, e.DatabaseClient(), newResource); err != nil {
+		return nil, err
+	}
+
 	// Create Query filter to query kubernetes namespace used by the other environment resources.
 	namespace := newResource.Properties.Compute.KubernetesCompute.Namespace
 	result, err := util.FindResources(ctx, serviceCtx.ResourceID.RootScope(), serviceCtx.ResourceID.Type(), "properties.compute.kubernetes.namespace", namespace, e.DatabaseClient())
@@ -0,0 +1,124 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package environments
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/datamodel/converter"
+	"github.com/radius-project/radius/pkg/recipes/recipelogs"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+var _ ctrl.Controller = (*GetRecipeLogs)(nil)
+
+// GetRecipeLogs is the controller implementation to get the logs of the recipe executions of a portable resource.
+type GetRecipeLogs struct {
+	ctrl.Operation[*datamodel.Environment, datamodel.Environment]
+}
+
+// NewGetRecipeLogs creates a new controller for retrieving the recipe logs of a resource in an environment.
+func NewGetRecipeLogs(opts ctrl.Options) (ctrl.Controller, error) {
+	return &GetRecipeLogs{
+		ctrl.NewOperation(opts,
+			ctrl.ResourceOptions[datamodel.Environment]{
+				RequestConverter:  converter.EnvironmentDataModelFromVersioned,
+				ResponseConverter: converter.EnvironmentDataModelToVersioned,
+			},
+		),
+	}, nil
+}
+
+// Run returns the persisted logs of the most recent recipe executions of a portable resource in the environment. If
+// an operation ID is specified, only the logs of that operation are returned.
+func (r *GetRecipeLogs) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	resource, _, err := r.GetResource(ctx, serviceCtx.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+	if resource == nil {
+		return rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	}
+	content, err := ctrl.ReadJSONBody(req)
+	if err != nil {
+		return nil, err
+	}
+	request, err := converter.RecipeLogsRequestDataModelFromVersioned(content, serviceCtx.APIVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceID, err := resources.ParseResource(request.ResourceID)
+	if err != nil {
+		return rest.NewBadRequestResponse(fmt.Sprintf("invalid resource id %q: %s", request.ResourceID, err.Error())), nil
+	}
+
+	logs, err := recipelogs.NewStore(r.DatabaseClient()).Get(ctx, resourceID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	// The logs are compared against the environment they were recorded in rather than the environment of the
+	// resource, since the resource might have been deleted.
+	if len(logs.Operations) > 0 && !strings.EqualFold(logs.EnvironmentID, resource.ID) {
+		return rest.NewBadRequestResponse(fmt.Sprintf("resource %q does not belong to environment %q", resourceID.String(), resource.ID)), nil
+	}
+
+	ret := datamodel.RecipeLogs{Operations: []datamodel.RecipeOperationLog{}}
+	for _, operation := range logs.Operations {
+		if request.OperationID != "" && !strings.EqualFold(operation.OperationID, request.OperationID) {
+			continue
+		}
+
+		log := datamodel.RecipeOperationLog{
+			OperationID:   operation.OperationID,
+			OperationType: operation.OperationType,
+			RecipeName:    operation.RecipeName,
+			Status:        operation.Status,
+			StartTime:     operation.StartTime,
+			EndTime:       operation.EndTime,
+			Truncated:     operation.Truncated,
+			Entries:       []datamodel.RecipeLogEntry{},
+		}
+		for _, entry := range operation.Entries {
+			log.Entries = append(log.Entries, datamodel.RecipeLogEntry{
+				Timestamp: entry.Timestamp,
+				Source:    entry.Source,
+				Message:   entry.Message,
+			})
+		}
+		ret.Operations = append(ret.Operations, log)
+	}
+
+	if request.OperationID != "" && len(ret.Operations) == 0 {
+		return rest.NewNotFoundMessageResponse(fmt.Sprintf("recipe logs of operation %q not found for resource %q", request.OperationID, resourceID.String())), nil
+	}
+
+	versioned, err := converter.RecipeLogsDataModelToVersioned(&ret, serviceCtx.APIVersion)
+	if err != nil {
+		return nil, err
+	}
+	return rest.NewOKResponse(versioned), nil
+}
@@ -0,0 +1,198 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package environments
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes/recipelogs"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const getRecipeLogsResourceID = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Datastores/mongoDatabases/mongo"
+
+// testRecipeLogs returns the persisted recipe logs matching environmentgetrecipelogs20231001preview_output.json.
+func testRecipeLogs() *recipelogs.ResourceLogs {
+	at := func(value string) time.Time {
+		t, _ := time.Parse(time.RFC3339, value)
+		return t
+	}
+
+	return &recipelogs.ResourceLogs{
+		ResourceID: getRecipeLogsResourceID,
+		Operations: []recipelogs.OperationLog{
+			{
+				OperationID:   "00000000-0000-0000-0000-000000000001",
+				OperationType: "APPLICATIONS.DATASTORES/MONGODATABASES|PUT",
+				RecipeName:    "mongo-parameters",
+				Status:        recipelogs.StatusFailed,
+				StartTime:     at("2023-10-01T10:00:00Z"),
+				EndTime:       at("2023-10-01T10:01:00Z"),
+				Entries: []recipelogs.Entry{
+					{Timestamp: at("2023-10-01T10:00:00Z"), Source: recipelogs.SourceEngine, Message: "Executing recipe \"mongo-parameters\""},
+					{Timestamp: at("2023-10-01T10:00:30Z"), Source: recipelogs.SourceDeployment, Message: "Failed: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Microsoft.DocumentDB/databaseAccounts/mongo: Conflict: The account name is already in use."},
+				},
+			},
+			{
+				OperationID:   "00000000-0000-0000-0000-000000000002",
+				OperationType: "APPLICATIONS.DATASTORES/MONGODATABASES|PUT",
+				RecipeName:    "mongo-parameters",
+				Status:        recipelogs.StatusSucceeded,
+				StartTime:     at("2023-10-01T11:00:00Z"),
+				EndTime:       at("2023-10-01T11:02:00Z"),
+				Entries: []recipelogs.Entry{
+					{Timestamp: at("2023-10-01T11:00:00Z"), Source: recipelogs.SourceEngine, Message: "Executing recipe \"mongo-parameters\""},
+				},
+			},
+		},
+	}
+}
+
+// setupGetRecipeLogsDatabase sets up the database to return the environment, and the recipe logs of the resource
+// recorded in the given environment if logs is not nil.
+func setupGetRecipeLogsDatabase(databaseClient *database.MockClient, envDataModel *datamodel.Environment, logsEnvironmentID string, logs *recipelogs.ResourceLogs) {
+	databaseClient.
+		EXPECT().
+		Get(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id string, _ ...database.GetOptions) (*database.Object, error) {
+			switch {
+			case strings.Contains(strings.ToLower(id), "applications.core/environments"):
+				return &database.Object{Metadata: database.Metadata{ID: id}, Data: envDataModel}, nil
+			case strings.EqualFold(id, recipelogs.ID(getRecipeLogsResourceID)):
+				if logs == nil {
+					return nil, &database.ErrNotFound{ID: id}
+				}
+				logs.EnvironmentID = logsEnvironmentID
+				return &database.Object{Metadata: database.Metadata{ID: id, ETag: "etag"}, Data: logs}, nil
+			default:
+				return nil, &database.ErrNotFound{ID: id}
+			}
+		}).
+		AnyTimes()
+}
+
+func TestGetRecipeLogsRun_20231001Preview(t *testing.T) {
+	ctx := context.Background()
+
+	run := func(t *testing.T, databaseClient *database.MockClient, input *v20231001preview.RecipeLogsRequest) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req, err := rpctest.NewHTTPRequestFromJSON(ctx, v1.OperationPost.HTTPMethod(), testHeaderfilegetrecipelogs, input)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(req)
+
+		ctl, err := NewGetRecipeLogs(ctrl.Options{DatabaseClient: databaseClient})
+		require.NoError(t, err)
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		return w
+	}
+
+	t.Run("get recipe logs", func(t *testing.T) {
+		mctrl := gomock.NewController(t)
+		databaseClient := database.NewMockClient(mctrl)
+
+		logsInput, envDataModel, expectedOutput := getTestModelsGetRecipeLogs20231001preview()
+		setupGetRecipeLogsDatabase(databaseClient, envDataModel, envDataModel.ID, testRecipeLogs())
+
+		w := run(t, databaseClient, logsInput)
+		require.Equal(t, 200, w.Result().StatusCode)
+
+		actualOutput := &v20231001preview.RecipeLogsResponse{}
+		_ = json.Unmarshal(w.Body.Bytes(), actualOutput)
+		require.Equal(t, expectedOutput, actualOutput)
+	})
+
+	t.Run("get recipe logs of operation", func(t *testing.T) {
+		mctrl := gomock.NewController(t)
+		databaseClient := database.NewMockClient(mctrl)
+
+		logsInput, envDataModel, expectedOutput := getTestModelsGetRecipeLogs20231001preview()
+		logsInput.OperationID = to.Ptr("00000000-0000-0000-0000-000000000001")
+		setupGetRecipeLogsDatabase(databaseClient, envDataModel, envDataModel.ID, testRecipeLogs())
+
+		w := run(t, databaseClient, logsInput)
+		require.Equal(t, 200, w.Result().StatusCode)
+
+		actualOutput := &v20231001preview.RecipeLogsResponse{}
+		_ = json.Unmarshal(w.Body.Bytes(), actualOutput)
+		require.Equal(t, expectedOutput.Operations[:1], actualOutput.Operations)
+	})
+
+	t.Run("get recipe logs of unknown operation", func(t *testing.T) {
+		mctrl := gomock.NewController(t)
+		databaseClient := database.NewMockClient(mctrl)
+
+		logsInput, envDataModel, _ := getTestModelsGetRecipeLogs20231001preview()
+		logsInput.OperationID = to.Ptr("00000000-0000-0000-0000-000000000003")
+		setupGetRecipeLogsDatabase(databaseClient, envDataModel, envDataModel.ID, testRecipeLogs())
+
+		w := run(t, databaseClient, logsInput)
+		require.Equal(t, 404, w.Result().StatusCode)
+	})
+
+	t.Run("get recipe logs without logs", func(t *testing.T) {
+		mctrl := gomock.NewController(t)
+		databaseClient := database.NewMockClient(mctrl)
+
+		logsInput, envDataModel, _ := getTestModelsGetRecipeLogs20231001preview()
+		setupGetRecipeLogsDatabase(databaseClient, envDataModel, envDataModel.ID, nil)
+
+		w := run(t, databaseClient, logsInput)
+		require.Equal(t, 200, w.Result().StatusCode)
+
+		actualOutput := &v20231001preview.RecipeLogsResponse{}
+		_ = json.Unmarshal(w.Body.Bytes(), actualOutput)
+		require.Empty(t, actualOutput.Operations)
+	})
+
+	t.Run("get recipe logs recorded in another environment", func(t *testing.T) {
+		mctrl := gomock.NewController(t)
+		databaseClient := database.NewMockClient(mctrl)
+
+		logsInput, envDataModel, _ := getTestModelsGetRecipeLogs20231001preview()
+		setupGetRecipeLogsDatabase(databaseClient, envDataModel, "/planes/radius/local/resourceGroups/test/providers/Applications.Core/environments/other", testRecipeLogs())
+
+		w := run(t, databaseClient, logsInput)
+		require.Equal(t, 400, w.Result().StatusCode)
+	})
+
+	t.Run("get recipe logs invalid resource id", func(t *testing.T) {
+		mctrl := gomock.NewController(t)
+		databaseClient := database.NewMockClient(mctrl)
+
+		logsInput, envDataModel, _ := getTestModelsGetRecipeLogs20231001preview()
+		logsInput.ResourceID = to.Ptr("mongo")
+		setupGetRecipeLogsDatabase(databaseClient, envDataModel, envDataModel.ID, nil)
+
+		w := run(t, databaseClient, logsInput)
+		require.Equal(t, 400, w.Result().StatusCode)
+	})
+}
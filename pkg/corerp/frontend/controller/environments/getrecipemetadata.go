@@ -73,8 +73,13 @@ func (r *GetRecipeMetadata) Run(ctx context.Context, w http.ResponseWriter, req
 	if err != nil {
 		return nil, err
 	}
+	envRecipes, err := resolveRecipes(ctx, r.DatabaseClient(), resource)
+	if err != nil {
+		return nil, err
+	}
+
 	var recipeProperties datamodel.EnvironmentRecipeProperties
-	recipe, exists := resource.Properties.Recipes[recipeDatamodel.ResourceType]
+	recipe, exists := envRecipes[recipeDatamodel.ResourceType]
 	if exists {
 		recipeProperties, exists = recipe[recipeDatamodel.Name]
 	}
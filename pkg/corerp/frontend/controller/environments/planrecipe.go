@@ -0,0 +1,173 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package environments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/datamodel/converter"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// defaultRecipeName is the name of the recipe used by a portable resource that does not specify a recipe.
+	defaultRecipeName = "default"
+)
+
+var _ ctrl.Controller = (*PlanRecipe)(nil)
+
+// PlanRecipe is the controller implementation to preview the changes a recipe would make to the resources it manages
+// if it was deployed for a portable resource.
+type PlanRecipe struct {
+	ctrl.Operation[*datamodel.Environment, datamodel.Environment]
+	engine.Engine
+}
+
+// NewPlanRecipe creates a new controller for planning a recipe deployment in an environment.
+func NewPlanRecipe(opts ctrl.Options, engine engine.Engine) (ctrl.Controller, error) {
+	return &PlanRecipe{
+		ctrl.NewOperation(opts,
+			ctrl.ResourceOptions[datamodel.Environment]{
+				RequestConverter:  converter.EnvironmentDataModelFromVersioned,
+				ResponseConverter: converter.EnvironmentDataModelToVersioned,
+			},
+		),
+		engine,
+	}, nil
+}
+
+// Run plans the deployment of the recipe of a portable resource in the environment, and returns a response containing
+// the changes the recipe would make. Nothing is deployed.
+func (r *PlanRecipe) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	resource, _, err := r.GetResource(ctx, serviceCtx.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+	if resource == nil {
+		return rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	}
+	content, err := ctrl.ReadJSONBody(req)
+	if err != nil {
+		return nil, err
+	}
+	request, err := converter.RecipePlanRequestDataModelFromVersioned(content, serviceCtx.APIVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceID, err := resources.ParseResource(request.ResourceID)
+	if err != nil {
+		return rest.NewBadRequestResponse(fmt.Sprintf("invalid resource id %q: %s", request.ResourceID, err.Error())), nil
+	}
+
+	recipeName := request.RecipeName
+	if recipeName == "" {
+		recipeName = defaultRecipeName
+	}
+
+	envRecipes, err := resolveRecipes(ctx, r.DatabaseClient(), resource)
+	if err != nil {
+		return nil, err
+	}
+
+	recipe, exists := envRecipes[resourceID.Type()]
+	if exists {
+		_, exists = recipe[recipeName]
+	}
+	if !exists {
+		return rest.NewNotFoundMessageResponse(fmt.Sprintf("Either recipe with name %q or resource type %q not found on environment with id %q", recipeName, resourceID.Type(), serviceCtx.ResourceID)), nil
+	}
+
+	prevState, err := r.previousState(ctx, resourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := r.Engine.Plan(ctx, engine.PlanOptions{
+		BaseOptions: engine.BaseOptions{
+			Recipe: recipes.ResourceMetadata{
+				Name:          recipeName,
+				EnvironmentID: resource.ID,
+				ApplicationID: request.ApplicationID,
+				ResourceID:    resourceID.String(),
+				Parameters:    request.Parameters,
+			},
+		},
+		PreviousState: prevState,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The plan is empty for simulated environments.
+	ret := datamodel.RecipePlan{}
+	if plan != nil {
+		ret.TemplateKind = plan.TemplateKind
+		ret.TemplatePath = plan.TemplatePath
+		for _, change := range plan.ResourceChanges {
+			ret.ResourceChanges = append(ret.ResourceChanges, datamodel.RecipeResourceChange{
+				ID:     change.ID,
+				Type:   change.Type,
+				Action: string(change.Action),
+			})
+		}
+	}
+
+	versioned, err := converter.RecipePlanDataModelToVersioned(&ret, serviceCtx.APIVersion)
+	if err != nil {
+		return nil, err
+	}
+	return rest.NewOKResponse(versioned), nil
+}
+
+// previousState returns the IDs of the output resources of the portable resource if it was already deployed.
+func (r *PlanRecipe) previousState(ctx context.Context, resourceID resources.ID) ([]string, error) {
+	obj, err := r.DatabaseClient().Get(ctx, resourceID.String())
+	if errors.Is(err, &database.ErrNotFound{}) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	deployed := struct {
+		Properties struct {
+			Status rpv1.ResourceStatus `json:"status"`
+		} `json:"properties"`
+	}{}
+	if err := obj.As(&deployed); err != nil {
+		return nil, err
+	}
+
+	prevState := []string{}
+	for _, outputResource := range deployed.Properties.Status.OutputResources {
+		prevState = append(prevState, outputResource.ID.String())
+	}
+
+	return prevState, nil
+}
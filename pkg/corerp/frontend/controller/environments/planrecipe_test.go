@@ -0,0 +1,233 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package environments
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	planRecipeEnvironmentID = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/applications.core/environments/env0"
+	planRecipeDatabaseID    = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Microsoft.DocumentDB/databaseAccounts/mongo"
+)
+
+// setupPlanRecipeDatabase sets up the database to return the environment and, if deployed is not nil, the
+// portable resource that was deployed before.
+func setupPlanRecipeDatabase(databaseClient *database.MockClient, envDataModel *datamodel.Environment, deployed map[string]any) {
+	databaseClient.
+		EXPECT().
+		Get(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id string, _ ...database.GetOptions) (*database.Object, error) {
+			if strings.Contains(strings.ToLower(id), "applications.core/environments") {
+				return &database.Object{
+					Metadata: database.Metadata{ID: id, ETag: "etag"},
+					Data:     envDataModel,
+				}, nil
+			}
+			if deployed == nil {
+				return nil, &database.ErrNotFound{ID: id}
+			}
+			return &database.Object{
+				Metadata: database.Metadata{ID: id, ETag: "etag"},
+				Data:     deployed,
+			}, nil
+		}).
+		AnyTimes()
+}
+
+func TestPlanRecipeRun_20231001Preview(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("plan recipe", func(t *testing.T) {
+		mctrl := gomock.NewController(t)
+		databaseClient := database.NewMockClient(mctrl)
+		mEngine := engine.NewMockEngine(mctrl)
+
+		planInput, envDataModel, expectedOutput := getTestModelsPlanRecipe20231001preview()
+		setupPlanRecipeDatabase(databaseClient, envDataModel, map[string]any{
+			"properties": map[string]any{
+				"status": map[string]any{
+					"outputResources": []any{
+						map[string]any{"id": planRecipeDatabaseID},
+					},
+				},
+			},
+		})
+
+		w := httptest.NewRecorder()
+		req, err := rpctest.NewHTTPRequestFromJSON(ctx, v1.OperationPost.HTTPMethod(), testHeaderfileplanrecipe, planInput)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(req)
+
+		mEngine.EXPECT().Plan(ctx, engine.PlanOptions{
+			BaseOptions: engine.BaseOptions{
+				Recipe: recipes.ResourceMetadata{
+					Name:          "mongo-parameters",
+					EnvironmentID: planRecipeEnvironmentID,
+					ApplicationID: *planInput.ApplicationID,
+					ResourceID:    *planInput.ResourceID,
+					Parameters:    map[string]any{"mongodbName": "mongo"},
+				},
+			},
+			PreviousState: []string{planRecipeDatabaseID},
+		}).Return(&recipes.RecipePlan{
+			TemplateKind: recipes.TemplateKindBicep,
+			TemplatePath: "ghcr.io/radius-project/dev/recipes/functionaltest/parameters/mongodatabases/azure:1.0",
+			ResourceChanges: []recipes.ResourceChange{
+				{
+					ID:     planRecipeDatabaseID,
+					Type:   "Microsoft.DocumentDB/databaseAccounts",
+					Action: recipes.ResourceChangeUpdate,
+				},
+				{
+					ID:     planRecipeDatabaseID + "/mongodbDatabases/mongo",
+					Type:   "Microsoft.DocumentDB/databaseAccounts/mongodbDatabases",
+					Action: recipes.ResourceChangeCreate,
+				},
+			},
+		}, nil)
+
+		ctl, err := NewPlanRecipe(ctrl.Options{DatabaseClient: databaseClient}, mEngine)
+		require.NoError(t, err)
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, 200, w.Result().StatusCode)
+
+		actualOutput := &v20231001preview.RecipePlanResponse{}
+		_ = json.Unmarshal(w.Body.Bytes(), actualOutput)
+		require.Equal(t, expectedOutput, actualOutput)
+	})
+
+	t.Run("plan recipe of new resource in simulated environment", func(t *testing.T) {
+		mctrl := gomock.NewController(t)
+		databaseClient := database.NewMockClient(mctrl)
+		mEngine := engine.NewMockEngine(mctrl)
+
+		planInput, envDataModel, _ := getTestModelsPlanRecipe20231001preview()
+		setupPlanRecipeDatabase(databaseClient, envDataModel, nil)
+
+		w := httptest.NewRecorder()
+		req, err := rpctest.NewHTTPRequestFromJSON(ctx, v1.OperationPost.HTTPMethod(), testHeaderfileplanrecipe, planInput)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(req)
+
+		mEngine.EXPECT().Plan(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, opts engine.PlanOptions) (*recipes.RecipePlan, error) {
+			require.Empty(t, opts.PreviousState)
+			return nil, nil
+		})
+
+		ctl, err := NewPlanRecipe(ctrl.Options{DatabaseClient: databaseClient}, mEngine)
+		require.NoError(t, err)
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, 200, w.Result().StatusCode)
+
+		actualOutput := &v20231001preview.RecipePlanResponse{}
+		_ = json.Unmarshal(w.Body.Bytes(), actualOutput)
+		require.Empty(t, actualOutput.ResourceChanges)
+	})
+
+	t.Run("plan recipe non existing recipe", func(t *testing.T) {
+		mctrl := gomock.NewController(t)
+		databaseClient := database.NewMockClient(mctrl)
+		mEngine := engine.NewMockEngine(mctrl)
+
+		planInput, envDataModel, _ := getTestModelsPlanRecipe20231001preview()
+		planInput.RecipeName = nil
+		setupPlanRecipeDatabase(databaseClient, envDataModel, nil)
+
+		w := httptest.NewRecorder()
+		req, err := rpctest.NewHTTPRequestFromJSON(ctx, v1.OperationPost.HTTPMethod(), testHeaderfileplanrecipe, planInput)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(req)
+
+		ctl, err := NewPlanRecipe(ctrl.Options{DatabaseClient: databaseClient}, mEngine)
+		require.NoError(t, err)
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, 404, w.Result().StatusCode)
+
+		armerr := v1.ErrorResponse{}
+		_ = json.Unmarshal(w.Body.Bytes(), &armerr)
+		require.Equal(t, v1.CodeNotFound, armerr.Error.Code)
+		require.Contains(t, armerr.Error.Message, "Either recipe with name \"default\" or resource type \"Applications.Datastores/mongoDatabases\" not found on environment with id")
+	})
+
+	t.Run("plan recipe invalid resource id", func(t *testing.T) {
+		mctrl := gomock.NewController(t)
+		databaseClient := database.NewMockClient(mctrl)
+		mEngine := engine.NewMockEngine(mctrl)
+
+		planInput, envDataModel, _ := getTestModelsPlanRecipe20231001preview()
+		planInput.ResourceID = to.Ptr("mongo")
+		setupPlanRecipeDatabase(databaseClient, envDataModel, nil)
+
+		w := httptest.NewRecorder()
+		req, err := rpctest.NewHTTPRequestFromJSON(ctx, v1.OperationPost.HTTPMethod(), testHeaderfileplanrecipe, planInput)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(req)
+
+		ctl, err := NewPlanRecipe(ctrl.Options{DatabaseClient: databaseClient}, mEngine)
+		require.NoError(t, err)
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, 400, w.Result().StatusCode)
+	})
+
+	t.Run("plan recipe engine failure", func(t *testing.T) {
+		mctrl := gomock.NewController(t)
+		databaseClient := database.NewMockClient(mctrl)
+		mEngine := engine.NewMockEngine(mctrl)
+
+		planInput, envDataModel, _ := getTestModelsPlanRecipe20231001preview()
+		setupPlanRecipeDatabase(databaseClient, envDataModel, nil)
+
+		w := httptest.NewRecorder()
+		req, err := rpctest.NewHTTPRequestFromJSON(ctx, v1.OperationPost.HTTPMethod(), testHeaderfileplanrecipe, planInput)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(req)
+
+		engineErr := errors.New("terraform plan failure")
+		mEngine.EXPECT().Plan(ctx, gomock.Any()).Return(nil, engineErr)
+
+		ctl, err := NewPlanRecipe(ctrl.Options{DatabaseClient: databaseClient}, mEngine)
+		require.NoError(t, err)
+		_, err = ctl.Run(ctx, w, req)
+		require.Equal(t, engineErr, err)
+	})
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package environments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes/recipepack"
+	rp_util "github.com/radius-project/radius/pkg/rp/util"
+)
+
+// resolveRecipes returns the recipes registered to the environment: the recipes defined in the environment and the
+// recipes of the versions of its recipe packs. It returns a client error if a recipe pack or its version does not
+// exist or if several recipe packs define the same recipe.
+func resolveRecipes(ctx context.Context, databaseClient database.Client, environment *datamodel.Environment) (map[string]map[string]datamodel.EnvironmentRecipeProperties, error) {
+	if len(environment.Properties.RecipePacks) == 0 {
+		return environment.Properties.Recipes, nil
+	}
+
+	packs := []recipepack.Pack[datamodel.EnvironmentRecipeProperties]{}
+	for _, recipePack := range environment.Properties.RecipePacks {
+		pack := &datamodel.RecipePack{}
+		if err := rp_util.FetchScopeResource(ctx, databaseClient, recipePack.ID, pack); err != nil {
+			return nil, err
+		}
+
+		recipes, ok := pack.RecipesOfVersion(recipePack.Version)
+		if !ok {
+			return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("version %q of recipe pack %q is not published", recipePack.Version, recipePack.ID))
+		}
+
+		packs = append(packs, recipepack.Pack[datamodel.EnvironmentRecipeProperties]{
+			ID:      recipePack.ID,
+			Recipes: recipes,
+		})
+	}
+
+	recipes, err := recipepack.Resolve(environment.Properties.Recipes, packs)
+	var conflictErr *recipepack.ConflictError
+	if errors.As(err, &conflictErr) {
+		return nil, v1.NewClientErrInvalidRequest(conflictErr.Error())
+	} else if err != nil {
+		return nil, err
+	}
+
+	return recipes, nil
+}
@@ -0,0 +1,179 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package environments
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestResolveRecipes(t *testing.T) {
+	corePackID := "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/recipePacks/core"
+	teamPackID := "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/recipePacks/team"
+	redisType := "Applications.Datastores/redisCaches"
+	envRecipe := datamodel.EnvironmentRecipeProperties{TemplateKind: recipes.TemplateKindBicep, TemplatePath: "ghcr.io/env/redis:1.0"}
+	coreRecipe := datamodel.EnvironmentRecipeProperties{TemplateKind: recipes.TemplateKindBicep, TemplatePath: "ghcr.io/core/redis:1.0"}
+	newCoreRecipe := datamodel.EnvironmentRecipeProperties{TemplateKind: recipes.TemplateKindBicep, TemplatePath: "ghcr.io/core/redis:1.1"}
+	teamRecipe := datamodel.EnvironmentRecipeProperties{TemplateKind: recipes.TemplateKindTerraform, TemplatePath: "team/redis/azurerm", TemplateVersion: "1.0.0"}
+
+	packs := map[string]*datamodel.RecipePack{
+		corePackID: {
+			Properties: datamodel.RecipePackProperties{
+				Version: "1.1.0",
+				Recipes: map[string]map[string]datamodel.EnvironmentRecipeProperties{
+					redisType: {"default": newCoreRecipe},
+				},
+				Versions: map[string]datamodel.RecipePackVersion{
+					"1.0.0": {
+						Recipes: map[string]map[string]datamodel.EnvironmentRecipeProperties{
+							redisType: {"default": coreRecipe, "small": coreRecipe},
+						},
+					},
+					"1.1.0": {
+						Recipes: map[string]map[string]datamodel.EnvironmentRecipeProperties{
+							redisType: {"default": newCoreRecipe},
+						},
+					},
+				},
+			},
+		},
+		teamPackID: {
+			Properties: datamodel.RecipePackProperties{
+				Version: "2.1.0",
+				Recipes: map[string]map[string]datamodel.EnvironmentRecipeProperties{
+					redisType: {"default": teamRecipe},
+				},
+				Versions: map[string]datamodel.RecipePackVersion{
+					"2.1.0": {
+						Recipes: map[string]map[string]datamodel.EnvironmentRecipeProperties{
+							redisType: {"default": teamRecipe},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	newDatabaseClient := func(t *testing.T) database.Client {
+		databaseClient := database.NewMockClient(gomock.NewController(t))
+		databaseClient.EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, id string, _ ...database.GetOptions) (*database.Object, error) {
+				pack, ok := packs[id]
+				if !ok {
+					return nil, &database.ErrNotFound{ID: id}
+				}
+				return &database.Object{Data: pack}, nil
+			}).
+			AnyTimes()
+		return databaseClient
+	}
+
+	t.Run("no recipe packs", func(t *testing.T) {
+		env := &datamodel.Environment{
+			Properties: datamodel.EnvironmentProperties{
+				Recipes: map[string]map[string]datamodel.EnvironmentRecipeProperties{redisType: {"default": envRecipe}},
+			},
+		}
+
+		resolved, err := resolveRecipes(context.Background(), database.NewMockClient(gomock.NewController(t)), env)
+		require.NoError(t, err)
+		require.Equal(t, env.Properties.Recipes, resolved)
+	})
+
+	t.Run("environment recipes take precedence over pack recipes", func(t *testing.T) {
+		env := &datamodel.Environment{
+			Properties: datamodel.EnvironmentProperties{
+				Recipes: map[string]map[string]datamodel.EnvironmentRecipeProperties{redisType: {"default": envRecipe}},
+				RecipePacks: []datamodel.RecipePackReference{
+					{ID: corePackID, Version: "1.0.0"},
+					{ID: teamPackID, Version: "2.1.0"},
+				},
+			},
+		}
+
+		resolved, err := resolveRecipes(context.Background(), newDatabaseClient(t), env)
+		require.NoError(t, err)
+		require.Equal(t, map[string]map[string]datamodel.EnvironmentRecipeProperties{
+			redisType: {"default": envRecipe, "small": coreRecipe},
+		}, resolved)
+	})
+
+	t.Run("recipes of the registered version", func(t *testing.T) {
+		for version, expected := range map[string]map[string]map[string]datamodel.EnvironmentRecipeProperties{
+			"1.0.0": {redisType: {"default": coreRecipe, "small": coreRecipe}},
+			"1.1.0": {redisType: {"default": newCoreRecipe}},
+		} {
+			env := &datamodel.Environment{
+				Properties: datamodel.EnvironmentProperties{
+					RecipePacks: []datamodel.RecipePackReference{{ID: corePackID, Version: version}},
+				},
+			}
+
+			resolved, err := resolveRecipes(context.Background(), newDatabaseClient(t), env)
+			require.NoError(t, err)
+			require.Equal(t, expected, resolved)
+		}
+	})
+
+	t.Run("version not published", func(t *testing.T) {
+		env := &datamodel.Environment{
+			Properties: datamodel.EnvironmentProperties{
+				RecipePacks: []datamodel.RecipePackReference{{ID: corePackID, Version: "2.0.0"}},
+			},
+		}
+
+		_, err := resolveRecipes(context.Background(), newDatabaseClient(t), env)
+		require.ErrorIs(t, err, &v1.ErrClientRP{})
+		require.Contains(t, err.Error(), `version "2.0.0" of recipe pack`)
+	})
+
+	t.Run("conflicting recipe packs", func(t *testing.T) {
+		env := &datamodel.Environment{
+			Properties: datamodel.EnvironmentProperties{
+				RecipePacks: []datamodel.RecipePackReference{
+					{ID: corePackID, Version: "1.0.0"},
+					{ID: teamPackID, Version: "2.1.0"},
+				},
+			},
+		}
+
+		_, err := resolveRecipes(context.Background(), newDatabaseClient(t), env)
+		require.ErrorIs(t, err, &v1.ErrClientRP{})
+		require.Contains(t, err.Error(), "is defined by multiple recipe packs")
+	})
+
+	t.Run("recipe pack not found", func(t *testing.T) {
+		missingPackID := "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/recipePacks/missing"
+		env := &datamodel.Environment{
+			Properties: datamodel.EnvironmentProperties{
+				RecipePacks: []datamodel.RecipePackReference{{ID: missingPackID, Version: "1.0.0"}},
+			},
+		}
+
+		_, err := resolveRecipes(context.Background(), newDatabaseClient(t), env)
+		require.ErrorIs(t, err, &v1.ErrClientRP{})
+		require.Contains(t, err.Error(), "does not exist")
+	})
+}
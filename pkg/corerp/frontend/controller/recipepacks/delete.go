@@ -0,0 +1,66 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipepacks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+)
+
+// ValidateDelete is a delete filter that rejects the deletion of a recipe pack while environments register it.
+// Deleting the recipe pack would remove the recipes of these environments, so the recipe pack must be removed from
+// the environments first. If environments register the recipe pack, it returns a ConflictResponse.
+func ValidateDelete(ctx context.Context, oldResource *datamodel.RecipePack, options *controller.Options) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	result, err := options.DatabaseClient.Query(ctx, database.Query{
+		RootScope:      serviceCtx.ResourceID.PlaneScope(),
+		ScopeRecursive: true,
+		ResourceType:   datamodel.EnvironmentResourceType,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list environments: %w", err)
+	}
+
+	environments := []string{}
+	for _, item := range result.Items {
+		environment := &datamodel.Environment{}
+		if err := item.As(environment); err != nil {
+			return nil, err
+		}
+
+		for _, recipePack := range environment.Properties.RecipePacks {
+			if strings.EqualFold(recipePack.ID, serviceCtx.ResourceID.String()) {
+				environments = append(environments, item.ID)
+				break
+			}
+		}
+	}
+
+	if len(environments) == 0 {
+		return nil, nil
+	}
+
+	return rest.NewConflictResponse(fmt.Sprintf("The recipe pack is registered to the environment(s) %s. Remove the recipe pack from the environments before deleting it.", strings.Join(environments, ", "))), nil
+}
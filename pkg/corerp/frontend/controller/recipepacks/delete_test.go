@@ -0,0 +1,117 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipepacks
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestValidateDelete(t *testing.T) {
+	packID := "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/recipePacks/core"
+	newEnvironment := func(name string, packIDs ...string) database.Object {
+		environment := &datamodel.Environment{}
+		environment.ID = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/environments/" + name
+		for _, id := range packIDs {
+			environment.Properties.RecipePacks = append(environment.Properties.RecipePacks, datamodel.RecipePackReference{ID: id, Version: "1.0.0"})
+		}
+		return database.Object{
+			Metadata: database.Metadata{ID: environment.ID},
+			Data:     environment,
+		}
+	}
+
+	tests := []struct {
+		desc         string
+		environments []database.Object
+		queryErr     error
+		conflict     string
+	}{
+		{
+			desc: "no environments",
+		},
+		{
+			desc: "environments register other recipe packs",
+			environments: []database.Object{
+				newEnvironment("dev", "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/recipePacks/team"),
+				newEnvironment("test"),
+			},
+		},
+		{
+			desc: "environments register the recipe pack",
+			environments: []database.Object{
+				newEnvironment("dev", "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/recipePacks/team", packID),
+				newEnvironment("test"),
+				newEnvironment("prod", "/planes/radius/local/resourceGroups/TEST-RG/providers/Applications.Core/recipePacks/CORE"),
+			},
+			conflict: "The recipe pack is registered to the environment(s) /planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/environments/dev, /planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/environments/prod.",
+		},
+		{
+			desc:     "query error",
+			queryErr: errors.New("database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			databaseClient := database.NewMockClient(gomock.NewController(t))
+			databaseClient.EXPECT().
+				Query(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, query database.Query, options ...database.QueryOptions) (*database.ObjectQueryResult, error) {
+					require.Equal(t, "/planes/radius/local", query.RootScope)
+					require.True(t, query.ScopeRecursive)
+					require.Equal(t, datamodel.EnvironmentResourceType, query.ResourceType)
+					if tt.queryErr != nil {
+						return nil, tt.queryErr
+					}
+					return &database.ObjectQueryResult{Items: tt.environments}, nil
+				})
+
+			ctx := v1.WithARMRequestContext(context.Background(), &v1.ARMRequestContext{
+				ResourceID: resources.MustParse(packID),
+				HTTPMethod: http.MethodDelete,
+			})
+
+			resp, err := ValidateDelete(ctx, &datamodel.RecipePack{}, &controller.Options{DatabaseClient: databaseClient})
+			if tt.queryErr != nil {
+				require.ErrorIs(t, err, tt.queryErr)
+				return
+			}
+			require.NoError(t, err)
+
+			if tt.conflict == "" {
+				require.Nil(t, resp)
+				return
+			}
+
+			res, ok := resp.(*rest.ConflictResponse)
+			require.True(t, ok)
+			require.Contains(t, res.Body.Error.Message, tt.conflict)
+		})
+	}
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipepacks
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"reflect"
+
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+)
+
+// ValidateRequest checks that the recipes of a published version of the recipe pack are not changed. A recipe pack
+// can be registered to many environments, so changing its recipes requires publishing a new version, which makes the
+// change visible to the operators of these environments. If the recipes of the version are changed, it returns a
+// BadRequestResponse.
+func ValidateRequest(ctx context.Context, newResource *datamodel.RecipePack, oldResource *datamodel.RecipePack, options *controller.Options) (rest.Response, error) {
+	if oldResource == nil {
+		return nil, nil
+	}
+
+	published, ok := oldResource.RecipesOfVersion(newResource.Properties.Version)
+	if ok && !reflect.DeepEqual(published, newResource.Properties.Recipes) {
+		return rest.NewBadRequestResponse(fmt.Sprintf("version %q of recipe pack %q is already published with different recipes. Publish a new version of the recipe pack to change its recipes.", newResource.Properties.Version, newResource.Name)), nil
+	}
+
+	return nil, nil
+}
+
+// RecordVersion adds the version of the recipe pack to its published versions. The versions published before are
+// kept, so the environments that registered them keep using the same recipes until they register the new version.
+func RecordVersion(ctx context.Context, newResource *datamodel.RecipePack, oldResource *datamodel.RecipePack, options *controller.Options) (rest.Response, error) {
+	versions := map[string]datamodel.RecipePackVersion{}
+	if oldResource != nil {
+		maps.Copy(versions, oldResource.Properties.Versions)
+	}
+
+	versions[newResource.Properties.Version] = datamodel.RecipePackVersion{
+		Description: newResource.Properties.Description,
+		Recipes:     newResource.Properties.Recipes,
+	}
+	newResource.Properties.Versions = versions
+
+	return nil, nil
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipepacks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateRequest(t *testing.T) {
+	published := func(version string, templatePath string) *datamodel.RecipePack {
+		pack := newTestRecipePack(version, templatePath)
+		_, err := RecordVersion(context.Background(), pack, nil, nil)
+		require.NoError(t, err)
+		return pack
+	}
+
+	tests := []struct {
+		desc        string
+		newResource *datamodel.RecipePack
+		oldResource *datamodel.RecipePack
+		badRequest  bool
+	}{
+		{
+			desc:        "new recipe pack",
+			newResource: newTestRecipePack("1.0.0", "ghcr.io/recipes/redis:1.0"),
+		},
+		{
+			desc:        "same version with the same recipes",
+			newResource: newTestRecipePack("1.0.0", "ghcr.io/recipes/redis:1.0"),
+			oldResource: published("1.0.0", "ghcr.io/recipes/redis:1.0"),
+		},
+		{
+			desc:        "new version with different recipes",
+			newResource: newTestRecipePack("1.1.0", "ghcr.io/recipes/redis:1.1"),
+			oldResource: published("1.0.0", "ghcr.io/recipes/redis:1.0"),
+		},
+		{
+			desc:        "same version with different recipes",
+			newResource: newTestRecipePack("1.0.0", "ghcr.io/recipes/redis:1.1"),
+			oldResource: published("1.0.0", "ghcr.io/recipes/redis:1.0"),
+			badRequest:  true,
+		},
+		{
+			desc:        "previous version with different recipes",
+			newResource: newTestRecipePack("1.0.0", "ghcr.io/recipes/redis:1.2"),
+			oldResource: func() *datamodel.RecipePack {
+				pack := published("1.1.0", "ghcr.io/recipes/redis:1.1")
+				_, err := RecordVersion(context.Background(), pack, published("1.0.0", "ghcr.io/recipes/redis:1.0"), nil)
+				require.NoError(t, err)
+				return pack
+			}(),
+			badRequest: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			resp, err := ValidateRequest(context.Background(), tt.newResource, tt.oldResource, nil)
+			require.NoError(t, err)
+			if !tt.badRequest {
+				require.Nil(t, resp)
+				return
+			}
+
+			res, ok := resp.(*rest.BadRequestResponse)
+			require.True(t, ok)
+			require.Contains(t, res.Body.Error.Message, `version "1.0.0" of recipe pack "core" is already published with different recipes`)
+		})
+	}
+}
+
+func TestRecordVersion(t *testing.T) {
+	t.Run("new recipe pack", func(t *testing.T) {
+		pack := newTestRecipePack("1.0.0", "ghcr.io/recipes/redis:1.0")
+		resp, err := RecordVersion(context.Background(), pack, nil, nil)
+		require.NoError(t, err)
+		require.Nil(t, resp)
+		require.Equal(t, map[string]datamodel.RecipePackVersion{
+			"1.0.0": {Description: "Core recipes", Recipes: pack.Properties.Recipes},
+		}, pack.Properties.Versions)
+	})
+
+	t.Run("new version keeps the previous versions", func(t *testing.T) {
+		oldPack := newTestRecipePack("1.0.0", "ghcr.io/recipes/redis:1.0")
+		_, err := RecordVersion(context.Background(), oldPack, nil, nil)
+		require.NoError(t, err)
+
+		pack := newTestRecipePack("1.1.0", "ghcr.io/recipes/redis:1.1")
+		resp, err := RecordVersion(context.Background(), pack, oldPack, nil)
+		require.NoError(t, err)
+		require.Nil(t, resp)
+		require.Equal(t, map[string]datamodel.RecipePackVersion{
+			"1.0.0": {Description: "Core recipes", Recipes: oldPack.Properties.Recipes},
+			"1.1.0": {Description: "Core recipes", Recipes: pack.Properties.Recipes},
+		}, pack.Properties.Versions)
+
+		recipes, ok := pack.RecipesOfVersion("1.0.0")
+		require.True(t, ok)
+		require.Equal(t, "ghcr.io/recipes/redis:1.0", recipes["Applications.Datastores/redisCaches"]["default"].TemplatePath)
+
+		_, ok = pack.RecipesOfVersion("2.0.0")
+		require.False(t, ok)
+	})
+}
+
+func newTestRecipePack(version string, templatePath string) *datamodel.RecipePack {
+	pack := &datamodel.RecipePack{
+		Properties: datamodel.RecipePackProperties{
+			Version:     version,
+			Description: "Core recipes",
+			Recipes: map[string]map[string]datamodel.EnvironmentRecipeProperties{
+				"Applications.Datastores/redisCaches": {
+					"default": {
+						TemplateKind: recipes.TemplateKindBicep,
+						TemplatePath: templatePath,
+					},
+				},
+			},
+		},
+	}
+	pack.Name = "core"
+	return pack
+}
@@ -755,6 +755,9 @@ func getEnvVarsAndSecretData(resource *datamodel.ContainerResource, dependencies
 				case int:
 					secretData[name] = []byte(strconv.Itoa(v))
 					env[name] = corev1.EnvVar{Name: name, ValueFrom: &source}
+				case bool:
+					secretData[name] = []byte(strconv.FormatBool(v))
+					env[name] = corev1.EnvVar{Name: name, ValueFrom: &source}
 				}
 			}
 		}
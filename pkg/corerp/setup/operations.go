@@ -69,6 +69,26 @@ var operationList = []v1.Operation{
 		},
 		IsDataAction: false,
 	},
+	{
+		Name: "Applications.Core/environments/planrecipe/action",
+		Display: &v1.OperationDisplayProperties{
+			Provider:    "Applications.Core",
+			Resource:    "environments",
+			Operation:   "Plan recipe",
+			Description: "Preview the changes a recipe would make.",
+		},
+		IsDataAction: false,
+	},
+	{
+		Name: "Applications.Core/environments/getrecipelogs/action",
+		Display: &v1.OperationDisplayProperties{
+			Provider:    "Applications.Core",
+			Resource:    "environments",
+			Operation:   "Get recipe logs",
+			Description: "Get the logs of the recipe executions of a resource.",
+		},
+		IsDataAction: false,
+	},
 	{
 		Name: "Applications.Core/environments/join/action",
 		Display: &v1.OperationDisplayProperties{
@@ -229,4 +249,34 @@ var operationList = []v1.Operation{
 		},
 		IsDataAction: false,
 	},
+	{
+		Name: "Applications.Core/recipePacks/read",
+		Display: &v1.OperationDisplayProperties{
+			Provider:    "Applications.Core",
+			Resource:    "recipePacks",
+			Operation:   "List recipe packs",
+			Description: "Get the list of recipe packs.",
+		},
+		IsDataAction: false,
+	},
+	{
+		Name: "Applications.Core/recipePacks/write",
+		Display: &v1.OperationDisplayProperties{
+			Provider:    "Applications.Core",
+			Resource:    "recipePacks",
+			Operation:   "Create/Update recipe pack",
+			Description: "Create or update a recipe pack.",
+		},
+		IsDataAction: false,
+	},
+	{
+		Name: "Applications.Core/recipePacks/delete",
+		Display: &v1.OperationDisplayProperties{
+			Provider:    "Applications.Core",
+			Resource:    "recipePacks",
+			Operation:   "Delete recipe pack",
+			Description: "Delete a recipe pack.",
+		},
+		IsDataAction: false,
+	},
 }
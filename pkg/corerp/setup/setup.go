@@ -30,6 +30,7 @@ import (
 	env_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/environments"
 	ext_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/extenders"
 	gw_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/gateways"
+	rp_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/recipepacks"
 	secret_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/secretstores"
 	vol_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/volumes"
 	ext_processor "github.com/radius-project/radius/pkg/corerp/processors/extenders"
@@ -63,6 +64,16 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 					return env_ctrl.NewGetRecipeMetadata(opt, recipeControllerConfig.Engine)
 				},
 			},
+			"planrecipe": {
+				APIController: func(opt apictrl.Options) (apictrl.Controller, error) {
+					return env_ctrl.NewPlanRecipe(opt, recipeControllerConfig.Engine)
+				},
+			},
+			"getrecipelogs": {
+				APIController: func(opt apictrl.Options) (apictrl.Controller, error) {
+					return env_ctrl.NewGetRecipeLogs(opt)
+				},
+			},
 		},
 	})
 
@@ -237,6 +248,29 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 		},
 	})
 
+	_ = ns.AddResource("recipePacks", &builder.ResourceOption[*datamodel.RecipePack, datamodel.RecipePack]{
+		RequestConverter:  converter.RecipePackDataModelFromVersioned,
+		ResponseConverter: converter.RecipePackDataModelToVersioned,
+
+		Put: builder.Operation[datamodel.RecipePack]{
+			UpdateFilters: []apictrl.UpdateFilter[datamodel.RecipePack]{
+				rp_ctrl.ValidateRequest,
+				rp_ctrl.RecordVersion,
+			},
+		},
+		Patch: builder.Operation[datamodel.RecipePack]{
+			UpdateFilters: []apictrl.UpdateFilter[datamodel.RecipePack]{
+				rp_ctrl.ValidateRequest,
+				rp_ctrl.RecordVersion,
+			},
+		},
+		Delete: builder.Operation[datamodel.RecipePack]{
+			DeleteFilters: []apictrl.DeleteFilter[datamodel.RecipePack]{
+				rp_ctrl.ValidateDelete,
+			},
+		},
+	})
+
 	// Optional
 	ns.SetAvailableOperations(operationList)
 
@@ -38,6 +38,7 @@ import (
 	ctr_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/containers"
 	env_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/environments"
 	gtwy_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/gateways"
+	rp_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/recipepacks"
 	secret_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/secretstores"
 	vol_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/volumes"
 )
@@ -67,6 +68,30 @@ var handlerTests = []rpctest.HandlerTestSpec{
 		OperationType: v1.OperationType{Type: app_ctrl.ResourceTypeName, Method: v1.OperationDelete},
 		Path:          "/resourcegroups/testrg/providers/applications.core/applications/app0",
 		Method:        http.MethodDelete,
+	}, {
+		OperationType: v1.OperationType{Type: rp_ctrl.ResourceTypeName, Method: v1.OperationPlaneScopeList},
+		Path:          "/providers/applications.core/recipepacks",
+		Method:        http.MethodGet,
+	}, {
+		OperationType: v1.OperationType{Type: rp_ctrl.ResourceTypeName, Method: v1.OperationList},
+		Path:          "/resourcegroups/testrg/providers/applications.core/recipepacks",
+		Method:        http.MethodGet,
+	}, {
+		OperationType: v1.OperationType{Type: rp_ctrl.ResourceTypeName, Method: v1.OperationGet},
+		Path:          "/resourcegroups/testrg/providers/applications.core/recipepacks/pack0",
+		Method:        http.MethodGet,
+	}, {
+		OperationType: v1.OperationType{Type: rp_ctrl.ResourceTypeName, Method: v1.OperationPut},
+		Path:          "/resourcegroups/testrg/providers/applications.core/recipepacks/pack0",
+		Method:        http.MethodPut,
+	}, {
+		OperationType: v1.OperationType{Type: rp_ctrl.ResourceTypeName, Method: v1.OperationPatch},
+		Path:          "/resourcegroups/testrg/providers/applications.core/recipepacks/pack0",
+		Method:        http.MethodPatch,
+	}, {
+		OperationType: v1.OperationType{Type: rp_ctrl.ResourceTypeName, Method: v1.OperationDelete},
+		Path:          "/resourcegroups/testrg/providers/applications.core/recipepacks/pack0",
+		Method:        http.MethodDelete,
 	}, {
 		OperationType: v1.OperationType{Type: ctr_ctrl.ResourceTypeName, Method: v1.OperationPlaneScopeList},
 		Path:          "/providers/applications.core/containers",
@@ -119,6 +144,14 @@ var handlerTests = []rpctest.HandlerTestSpec{
 		OperationType: v1.OperationType{Type: env_ctrl.ResourceTypeName, Method: "ACTIONGETMETADATA"},
 		Path:          "/resourcegroups/testrg/providers/applications.core/environments/env0/getmetadata",
 		Method:        http.MethodPost,
+	}, {
+		OperationType: v1.OperationType{Type: env_ctrl.ResourceTypeName, Method: "ACTIONPLANRECIPE"},
+		Path:          "/resourcegroups/testrg/providers/applications.core/environments/env0/planrecipe",
+		Method:        http.MethodPost,
+	}, {
+		OperationType: v1.OperationType{Type: env_ctrl.ResourceTypeName, Method: "ACTIONGETRECIPELOGS"},
+		Path:          "/resourcegroups/testrg/providers/applications.core/environments/env0/getrecipelogs",
+		Method:        http.MethodPost,
 	}, {
 		OperationType: v1.OperationType{Type: gtwy_ctrl.ResourceTypeName, Method: v1.OperationPlaneScopeList},
 		Path:          "/providers/applications.core/gateways",
@@ -199,6 +232,10 @@ var handlerTests = []rpctest.HandlerTestSpec{
 		OperationType: v1.OperationType{Type: "Applications.Core/operationStatuses", Method: v1.OperationGet},
 		Path:          "/providers/applications.core/locations/global/operationstatuses/00000000-0000-0000-0000-000000000000",
 		Method:        http.MethodGet,
+	}, {
+		OperationType: v1.OperationType{Type: "Applications.Core/operationStatuses", Method: "ACTIONCANCEL"},
+		Path:          "/providers/applications.core/locations/global/operationstatuses/00000000-0000-0000-0000-000000000000/cancel",
+		Method:        http.MethodPost,
 	}, {
 		OperationType: v1.OperationType{Type: "Applications.Core/operationStatuses", Method: v1.OperationGet},
 		Path:          "/providers/applications.core/locations/global/operationresults/00000000-0000-0000-0000-000000000000",
@@ -115,6 +115,32 @@ func fromRecipeStatus(recipeStatus *rpv1.RecipeStatus) *RecipeStatus {
 	return status
 }
 
+func fromResourceConditions(conditions []rpv1.Condition) []*ResourceCondition {
+	if len(conditions) == 0 {
+		return nil
+	}
+
+	converted := []*ResourceCondition{}
+	for _, condition := range conditions {
+		c := &ResourceCondition{
+			Type:   to.Ptr(condition.Type),
+			Status: to.Ptr(string(condition.Status)),
+		}
+		if condition.Reason != "" {
+			c.Reason = to.Ptr(condition.Reason)
+		}
+		if condition.Message != "" {
+			c.Message = to.Ptr(condition.Message)
+		}
+		if !condition.LastTransitionTime.IsZero() {
+			c.LastTransitionTime = to.Ptr(condition.LastTransitionTime)
+		}
+		converted = append(converted, c)
+	}
+
+	return converted
+}
+
 func fromSystemDataModel(s v1.SystemData) *SystemData {
 	return &SystemData{
 		CreatedBy:          to.Ptr(s.CreatedBy),
@@ -141,13 +167,62 @@ func toRecipeDataModel(r *Recipe) portableresources.ResourceRecipe {
 	if r.Parameters != nil {
 		recipe.Parameters = r.Parameters
 	}
+	recipe.Import = toRecipeImportDataModel(r.Import)
 	return recipe
 }
 
+func toRecipeImportDataModel(i *RecipeImport) *portableresources.RecipeImport {
+	if i == nil {
+		return nil
+	}
+	recipeImport := &portableresources.RecipeImport{
+		Values: i.Values,
+	}
+	for _, resource := range i.Resources {
+		if resource == nil {
+			continue
+		}
+		recipeImport.Resources = append(recipeImport.Resources, portableresources.ImportedResource{
+			ID:      to.String(resource.ID),
+			Address: to.String(resource.Address),
+		})
+	}
+	if i.Secrets != nil {
+		recipeImport.Secrets = map[string]any{}
+		for key, value := range i.Secrets {
+			recipeImport.Secrets[key] = to.String(value)
+		}
+	}
+	return recipeImport
+}
+
+// fromRecipeImportDataModel converts the imported resources of the recipe to the versioned model. The secrets of the
+// import are never returned.
+func fromRecipeImportDataModel(i *portableresources.RecipeImport) *RecipeImport {
+	if i == nil {
+		return nil
+	}
+	recipeImport := &RecipeImport{
+		Resources: []*RecipeImportedResource{},
+		Values:    i.Values,
+	}
+	for _, resource := range i.Resources {
+		imported := &RecipeImportedResource{
+			ID: to.Ptr(resource.ID),
+		}
+		if resource.Address != "" {
+			imported.Address = to.Ptr(resource.Address)
+		}
+		recipeImport.Resources = append(recipeImport.Resources, imported)
+	}
+	return recipeImport
+}
+
 func fromRecipeDataModel(r portableresources.ResourceRecipe) *Recipe {
 	return &Recipe{
 		Name:       to.Ptr(r.Name),
 		Parameters: r.Parameters,
+		Import:     fromRecipeImportDataModel(r.Import),
 	}
 }
 
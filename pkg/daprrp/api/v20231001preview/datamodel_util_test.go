@@ -19,6 +19,7 @@ package v20231001preview
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/portableresources"
@@ -249,6 +250,48 @@ func Test_fromRecipeStatus(t *testing.T) {
 	}
 }
 
+func Test_fromResourceConditions(t *testing.T) {
+	transitionTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		conditions []rpv1.Condition
+		expected   []*ResourceCondition
+	}{
+		{nil, nil},
+		{
+			[]rpv1.Condition{
+				{
+					Type:               rpv1.ConditionDrifted,
+					Status:             rpv1.ConditionTrue,
+					Reason:             "ResourcesChanged",
+					Message:            "1 cloud resource(s) differ from the recipe",
+					LastTransitionTime: transitionTime,
+				},
+				{
+					Type:   "Ready",
+					Status: rpv1.ConditionUnknown,
+				},
+			},
+			[]*ResourceCondition{
+				{
+					Type:               to.Ptr(rpv1.ConditionDrifted),
+					Status:             to.Ptr("True"),
+					Reason:             to.Ptr("ResourcesChanged"),
+					Message:            to.Ptr("1 cloud resource(s) differ from the recipe"),
+					LastTransitionTime: to.Ptr(transitionTime),
+				},
+				{
+					Type:   to.Ptr("Ready"),
+					Status: to.Ptr("Unknown"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		require.Equal(t, tt.expected, fromResourceConditions(tt.conditions))
+	}
+}
+
 func TestToRecipeDataModel(t *testing.T) {
 	testset := []struct {
 		versioned *Recipe
@@ -446,3 +489,55 @@ func TestFromAuthDataModel(t *testing.T) {
 		require.Equal(t, tt.expected, actual)
 	}
 }
+
+func TestRecipeImportDataModel(t *testing.T) {
+	resourceID := "/subscriptions/test-sub/resourceGroups/test-rg/providers/Microsoft.Cache/redis/test-cache"
+	versioned := &Recipe{
+		Name: to.Ptr("redis"),
+		Import: &RecipeImport{
+			Resources: []*RecipeImportedResource{
+				{
+					ID:      to.Ptr(resourceID),
+					Address: to.Ptr("azurerm_redis_cache.cache"),
+				},
+			},
+			Values: map[string]any{
+				"host": "test-cache.redis.cache.windows.net",
+			},
+			Secrets: map[string]*string{
+				"password": to.Ptr("test-password"),
+			},
+		},
+	}
+
+	recipe := toRecipeDataModel(versioned)
+	require.Equal(t, &portableresources.RecipeImport{
+		Resources: []portableresources.ImportedResource{
+			{
+				ID:      resourceID,
+				Address: "azurerm_redis_cache.cache",
+			},
+		},
+		Values: map[string]any{
+			"host": "test-cache.redis.cache.windows.net",
+		},
+		Secrets: map[string]any{
+			"password": "test-password",
+		},
+	}, recipe.Import)
+
+	// The secrets of the import are not returned.
+	require.Equal(t, &RecipeImport{
+		Resources: []*RecipeImportedResource{
+			{
+				ID:      to.Ptr(resourceID),
+				Address: to.Ptr("azurerm_redis_cache.cache"),
+			},
+		},
+		Values: map[string]any{
+			"host": "test-cache.redis.cache.windows.net",
+		},
+	}, fromRecipeDataModel(recipe).Import)
+
+	require.Nil(t, toRecipeDataModel(&Recipe{Name: to.Ptr("redis")}).Import)
+}
@@ -131,6 +131,7 @@ func (dst *DaprPubSubBrokerResource) ConvertFrom(src v1.DataModelInterface) erro
 		Status: &ResourceStatus{
 			OutputResources: toOutputResources(daprPubSub.Properties.Status.OutputResources),
 			Recipe:          fromRecipeStatus(daprPubSub.Properties.Status.Recipe),
+			Conditions:      fromResourceConditions(daprPubSub.Properties.Status.Conditions),
 		},
 		Auth: fromAuthDataModel(daprPubSub.Properties.Auth),
 	}
@@ -109,6 +109,7 @@ func (dst *DaprStateStoreResource) ConvertFrom(src v1.DataModelInterface) error
 		Status: &ResourceStatus{
 			OutputResources: toOutputResources(daprStateStore.Properties.Status.OutputResources),
 			Recipe:          fromRecipeStatus(daprStateStore.Properties.Status.Recipe),
+			Conditions:      fromResourceConditions(daprStateStore.Properties.Status.Conditions),
 		},
 		ProvisioningState:    fromProvisioningStateDataModel(daprStateStore.InternalMetadata.AsyncProvisioningState),
 		Environment:          to.Ptr(daprStateStore.Properties.Environment),
@@ -100,6 +100,7 @@ func (dst *MongoDatabaseResource) ConvertFrom(src v1.DataModelInterface) error {
 		Status: &ResourceStatus{
 			OutputResources: toOutputResources(mongo.Properties.Status.OutputResources),
 			Recipe:          fromRecipeStatus(mongo.Properties.Status.Recipe),
+			Conditions:      fromResourceConditions(mongo.Properties.Status.Conditions),
 		},
 		ProvisioningState:    fromProvisioningStateDataModel(mongo.InternalMetadata.AsyncProvisioningState),
 		Environment:          to.Ptr(mongo.Properties.Environment),
@@ -102,6 +102,7 @@ func (dst *RedisCacheResource) ConvertFrom(src v1.DataModelInterface) error {
 		Status: &ResourceStatus{
 			OutputResources: toOutputResources(redis.Properties.Status.OutputResources),
 			Recipe:          fromRecipeStatus(redis.Properties.Status.Recipe),
+			Conditions:      fromResourceConditions(redis.Properties.Status.Conditions),
 		},
 		ProvisioningState: fromProvisioningStateDataModel(redis.InternalMetadata.AsyncProvisioningState),
 		Environment:       to.Ptr(redis.Properties.Environment),
@@ -92,10 +92,29 @@ func (d *DynamicResource) ConvertFrom(src v1.DataModelInterface) error {
 	d.SystemData = fromSystemDataDataModel(dm.SystemData)
 	d.Properties = properties
 	d.Properties["provisioningState"] = fromProvisioningStateDataModel(dm.AsyncProvisioningState)
+	removeImportSecrets(d.Properties)
 
 	return nil
 }
 
+// removeImportSecrets removes the secrets of the resources imported by the recipe, and the names of the secrets kept
+// by the backend, from the properties. The secrets are provided by the client when the resource is created, and are
+// never returned.
+func removeImportSecrets(properties map[string]any) {
+	recipe, ok := properties["recipe"].(map[string]any)
+	if !ok {
+		return
+	}
+
+	recipeImport, ok := recipe["import"].(map[string]any)
+	if !ok {
+		return
+	}
+
+	delete(recipeImport, "secrets")
+	delete(recipeImport, "secretNames")
+}
+
 func fromSystemDataDataModel(input v1.SystemData) map[string]any {
 	bs, err := json.Marshal(input)
 	if err != nil {
@@ -100,6 +100,32 @@ func Test_DynamicResource_ConvertDataModelToVersioned(t *testing.T) {
 				},
 			},
 		},
+		{
+			// The secrets of the imported resources and their names are never returned.
+			filename: "dynamicresource-datamodel-import.json",
+			expected: &DynamicResource{
+				ID:       to.Ptr("/planes/radius/local/resourceGroups/test/providers/Applications.Test/testResources/testResource"),
+				Name:     to.Ptr("testResource"),
+				Type:     to.Ptr("Applications.Test/testResources"),
+				Location: to.Ptr("global"),
+				Tags: map[string]*string{
+					"env": to.Ptr("dev"),
+				},
+				Properties: map[string]any{
+					"provisioningState": fromProvisioningStateDataModel(v1.ProvisioningStateSucceeded),
+					"message":           "Hello, world!",
+					"recipe": map[string]any{
+						"name": "default",
+						"import": map[string]any{
+							"resources": []any{
+								map[string]any{"id": "/subscriptions/test-sub/resourceGroups/test-rg/providers/Microsoft.Cache/redis/test-cache"},
+							},
+							"values": map[string]any{"host": "test-cache.redis.cache.windows.net"},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range conversionTests {
@@ -0,0 +1,107 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	"github.com/radius-project/radius/pkg/recipes/recipelogs"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// RecipeActionController is the async operation controller to process the custom actions of dynamic resources.
+//
+// An action is handled by executing the recipe of the action with the context of the resource. The recipe runs
+// with a throwaway Terraform state that is discarded after each run, and the output resources of the recipe are not
+// tracked by the resource, so action recipes should perform operations on existing infrastructure rather than deploy
+// new infrastructure.
+type RecipeActionController struct {
+	ctrl.BaseController
+	engine     engine.Engine
+	actionName string
+	recipeName string
+}
+
+// NewRecipeActionController creates a new RecipeActionController for the given action and the name of the recipe
+// that handles it.
+func NewRecipeActionController(opts ctrl.Options, engine engine.Engine, actionName string, recipeName string) (ctrl.Controller, error) {
+	return &RecipeActionController{
+		BaseController: ctrl.NewBaseAsyncController(opts),
+		engine:         engine,
+		actionName:     actionName,
+		recipeName:     recipeName,
+	}, nil
+}
+
+// Run processes a custom action by executing its recipe. The resource is not modified by the action.
+func (c *RecipeActionController) Run(ctx context.Context, request *ctrl.Request) (ctrl.Result, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	// The operation is queued with the ID of the action, which has the name of the action as suffix.
+	actionID, err := resources.Parse(request.ResourceID)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	id := actionID.Truncate()
+
+	obj, err := c.DatabaseClient().Get(ctx, id.String())
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	resource := &datamodel.DynamicResource{}
+	if err = obj.As(resource); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// The parameters of the resource's recipe are not passed to the action recipe. The action recipe
+	// declares its own parameters, and receives the resource through the recipe context.
+	recorder := recipelogs.NewRecorder(recipelogs.DefaultMaxSize)
+	_, err = c.engine.Execute(recipelogs.WithRecorder(ctx, recorder), engine.ExecuteOptions{
+		BaseOptions: engine.BaseOptions{
+			Recipe: recipes.ResourceMetadata{
+				Name:          c.recipeName,
+				EnvironmentID: resource.ResourceMetadata().EnvironmentID(),
+				ApplicationID: resource.ResourceMetadata().ApplicationID(),
+				ResourceID:    resource.ID,
+				Action:        c.actionName,
+			},
+		},
+	})
+
+	// The logs of the action are saved with the logs of the resource, so a failed action can be investigated.
+	if saveErr := recipelogs.NewStore(c.DatabaseClient()).SaveRecorded(ctx, id.String(), resource.ResourceMetadata().EnvironmentID(), c.recipeName, recorder, err); saveErr != nil {
+		logger.Error(saveErr, "failed to save recipe logs")
+	}
+
+	if err != nil {
+		if recipeError, ok := err.(*recipes.RecipeError); ok {
+			logger.Error(err, fmt.Sprintf("failed to execute recipe %q for action %q", c.recipeName, c.actionName))
+			return ctrl.NewFailedResult(recipeError.ErrorDetails), nil
+		}
+
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
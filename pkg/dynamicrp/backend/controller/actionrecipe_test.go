@@ -0,0 +1,146 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"errors"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_RecipeActionController_Run(t *testing.T) {
+	resourceID := "/planes/radius/local/resourceGroups/test-group/providers/" + recipeResourceType + "/test-resource"
+	environmentID := "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/test-env"
+	applicationID := "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/test-app"
+	actionID := resourceID + "/rotateCredentials"
+
+	setup := func(t *testing.T, runs int) (*RecipeActionController, *engine.MockEngine) {
+		mctrl := gomock.NewController(t)
+		databaseClient := database.NewMockClient(mctrl)
+		eng := engine.NewMockEngine(mctrl)
+
+		resource := &datamodel.DynamicResource{
+			BaseResource: v1.BaseResource{
+				TrackedResource: v1.TrackedResource{
+					ID:   resourceID,
+					Name: "test-resource",
+					Type: recipeResourceType,
+				},
+			},
+			Properties: map[string]any{
+				"environment": environmentID,
+				"application": applicationID,
+				"recipe": map[string]any{
+					"name":       "default",
+					"parameters": map[string]any{"size": "large"},
+				},
+			},
+		}
+		databaseClient.EXPECT().
+			Get(gomock.Any(), resourceID).
+			Return(&database.Object{Metadata: database.Metadata{ID: resourceID}, Data: resource}, nil).
+			Times(runs)
+
+		controller, err := NewRecipeActionController(ctrl.Options{DatabaseClient: databaseClient}, eng, "rotateCredentials", "rotate")
+		require.NoError(t, err)
+		return controller.(*RecipeActionController), eng
+	}
+
+	t.Run("success", func(t *testing.T) {
+		controller, eng := setup(t, 1)
+
+		eng.EXPECT().
+			Execute(gomock.Any(), engine.ExecuteOptions{
+				BaseOptions: engine.BaseOptions{
+					Recipe: recipes.ResourceMetadata{
+						Name:          "rotate",
+						EnvironmentID: environmentID,
+						ApplicationID: applicationID,
+						ResourceID:    resourceID,
+						Action:        "rotateCredentials",
+					},
+				},
+			}).
+			Return(&recipes.RecipeOutput{}, nil).
+			Times(1)
+
+		result, err := controller.Run(testcontext.New(t), &ctrl.Request{ResourceID: actionID})
+		require.NoError(t, err)
+		require.Equal(t, ctrl.Result{}, result)
+	})
+
+	t.Run("run twice", func(t *testing.T) {
+		controller, eng := setup(t, 2)
+
+		// The recipe of the action runs with a throwaway state, so each run executes the recipe the same way.
+		eng.EXPECT().
+			Execute(gomock.Any(), engine.ExecuteOptions{
+				BaseOptions: engine.BaseOptions{
+					Recipe: recipes.ResourceMetadata{
+						Name:          "rotate",
+						EnvironmentID: environmentID,
+						ApplicationID: applicationID,
+						ResourceID:    resourceID,
+						Action:        "rotateCredentials",
+					},
+				},
+			}).
+			Return(&recipes.RecipeOutput{}, nil).
+			Times(2)
+
+		for range 2 {
+			result, err := controller.Run(testcontext.New(t), &ctrl.Request{ResourceID: actionID})
+			require.NoError(t, err)
+			require.Equal(t, ctrl.Result{}, result)
+		}
+	})
+
+	t.Run("recipe failure", func(t *testing.T) {
+		controller, eng := setup(t, 1)
+
+		details := v1.ErrorDetails{Code: recipes.RecipeDeploymentFailed, Message: "failed to rotate credentials"}
+		eng.EXPECT().
+			Execute(gomock.Any(), gomock.Any()).
+			Return(nil, &recipes.RecipeError{ErrorDetails: details}).
+			Times(1)
+
+		result, err := controller.Run(testcontext.New(t), &ctrl.Request{ResourceID: actionID})
+		require.NoError(t, err)
+		require.Equal(t, ctrl.NewFailedResult(details), result)
+	})
+
+	t.Run("engine failure", func(t *testing.T) {
+		controller, eng := setup(t, 1)
+
+		eng.EXPECT().
+			Execute(gomock.Any(), gomock.Any()).
+			Return(nil, errors.New("engine failed")).
+			Times(1)
+
+		_, err := controller.Run(testcontext.New(t), &ctrl.Request{ResourceID: actionID})
+		require.EqualError(t, err, "engine failed")
+	})
+}
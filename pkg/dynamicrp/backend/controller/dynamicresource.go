@@ -23,6 +23,7 @@ import (
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/dynamicrp/resourcetype"
 	"github.com/radius-project/radius/pkg/recipes/configloader"
 	"github.com/radius-project/radius/pkg/recipes/engine"
 	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
@@ -30,6 +31,11 @@ import (
 	"github.com/radius-project/radius/pkg/ucp/resources"
 )
 
+const (
+	// operationCustomAction is the operation method prefix of custom actions declared by a resource type.
+	operationCustomAction = "ACTION"
+)
+
 // DynamicResourceController is the async operation controller to perform processing on dynamic resources.
 //
 // This controller will use the capabilities and the operation to determine the correct controller to use.
@@ -71,12 +77,23 @@ func (c *DynamicResourceController) selectController(ctx context.Context, reques
 		return nil, fmt.Errorf("invalid operation type: %q", request.OperationType)
 	}
 
-	id, err := resources.ParseResource(request.ResourceID)
+	id, err := resources.Parse(request.ResourceID)
 	if err != nil {
 		return nil, fmt.Errorf("invalid resource ID: %q", request.ResourceID)
 	}
 
-	resourceType, err := c.fetchResourceTypeDetails(ctx, id)
+	// Custom actions are queued with the ID of the action, which has the name of the action as suffix.
+	actionName, isAction := strings.CutPrefix(string(ot.Method), operationCustomAction)
+	if isAction {
+		id = id.Truncate()
+	}
+
+	if !id.IsResource() {
+		return nil, fmt.Errorf("invalid resource ID: %q", request.ResourceID)
+	}
+
+	resourceTypes := resourcetype.NewClient(c.ucp)
+	resourceType, err := resourceTypes.Get(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch resource type for ID %q: %w", id.String(), err)
 	}
@@ -88,49 +105,57 @@ func (c *DynamicResourceController) selectController(ctx context.Context, reques
 
 	switch ot.Method {
 	case v1.OperationDelete:
-		if hasCapability(resourceType, datamodel.CapabilitySupportsRecipes) {
+		// Manually provisioned resources are not deployed by Radius, so they use the inert lifecycle.
+		if resourcetype.HasCapability(resourceType, datamodel.CapabilityManualResourceProvisioning) {
+			return NewInertDeleteController(options)
+		}
+		if resourcetype.HasCapability(resourceType, datamodel.CapabilitySupportsRecipes) {
 			return NewRecipeDeleteController(options, c.engine, c.configurationLoader)
 		}
 		return NewInertDeleteController(options)
 
 	case v1.OperationPut:
-		if hasCapability(resourceType, datamodel.CapabilitySupportsRecipes) {
-			return NewRecipePutController(options, c.engine, c.configurationLoader)
+		if resourcetype.HasCapability(resourceType, datamodel.CapabilityManualResourceProvisioning) {
+			return NewInertPutController(options)
+		}
+		if resourcetype.HasCapability(resourceType, datamodel.CapabilitySupportsRecipes) {
+			// Resources are stored using the default API version of their resource type, so the stored properties
+			// are processed with its schema rather than the schema of the API version of the request.
+			schema, err := resourceTypes.GetDefaultAPIVersionSchema(ctx, id)
+			if err != nil {
+				return nil, fmt.Errorf("failed to fetch schema for ID %q: %w", id.String(), err)
+			}
+			return NewRecipePutController(options, c.engine, c.configurationLoader, schema)
 		}
 		return NewInertPutController(options)
 
 	default:
-		return nil, fmt.Errorf("unsupported operation type: %q", request.OperationType)
-	}
-}
+		// Custom actions declared by the resource type are handled by recipes.
+		if isAction {
+			name, action, ok := findAction(resourceType, actionName)
+			if !ok || !resourcetype.HasCapability(resourceType, datamodel.CapabilitySupportsRecipes) {
+				return nil, fmt.Errorf("resource type %q does not support the action %q", id.Type(), actionName)
+			}
+
+			recipeName := name
+			if action != nil && action.RecipeName != nil && *action.RecipeName != "" {
+				recipeName = *action.RecipeName
+			}
+			return NewRecipeActionController(options, c.engine, name, recipeName)
+		}
 
-// fetchResourceTypeDetails fetches the resource type details from the UCP API for the given resource ID.
-func (c *DynamicResourceController) fetchResourceTypeDetails(ctx context.Context, id resources.ID) (*v20231001preview.ResourceTypeResource, error) {
-	providerNamespace := id.ProviderNamespace()
-	planeName := id.ScopeSegments()[0].Name
-	resourceTypeName := strings.TrimPrefix(id.Type(), providerNamespace+resources.SegmentSeparator)
-	response, err := c.ucp.NewResourceTypesClient().Get(
-		ctx,
-		planeName,
-		providerNamespace,
-		resourceTypeName,
-		nil)
-	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("unsupported operation type: %q", request.OperationType)
 	}
-
-	return &response.ResourceTypeResource, nil
 }
 
-// hasCapability determines if a resource type has a specific capability.
-// It returns true when the given input capability string exists in the resource type's
-// capabilities list, false otherwise.
-func hasCapability(resourceType *v20231001preview.ResourceTypeResource, capability string) bool {
-	for _, c := range resourceType.Properties.Capabilities {
-		if c != nil && *c == capability {
-			return true
+// findAction finds a custom action declared by a resource type. Action names are matched case-insensitively because
+// operation methods are upper-cased. It returns the declared name of the action and the action.
+func findAction(resourceType *v20231001preview.ResourceTypeResource, actionName string) (string, *v20231001preview.ResourceTypeAction, bool) {
+	for name, action := range resourceType.Properties.Actions {
+		if strings.EqualFold(name, actionName) {
+			return name, action, true
 		}
 	}
 
-	return false
+	return "", nil, false
 }
@@ -39,8 +39,22 @@ import (
 const (
 	inertResourceType  = "Applications.Test/testInertResources"
 	recipeResourceType = "Applications.Test/testRecipeResources"
+	manualResourceType = "Applications.Test/testManualResources"
+	schemaResourceType = "Applications.Test/testSchemaResources"
+	testAPIVersion     = "2024-01-01"
+	olderAPIVersion    = "2023-01-01"
 )
 
+var testSchema = map[string]any{
+	"type": "object",
+	"properties": map[string]any{
+		"host": map[string]any{
+			"type":     "string",
+			"readOnly": true,
+		},
+	},
+}
+
 func Test_DynamicResourceController_selectController(t *testing.T) {
 	setup := func() *DynamicResourceController {
 		ucp, err := testUCPClientFactory()
@@ -89,6 +103,24 @@ func Test_DynamicResourceController_selectController(t *testing.T) {
 		require.NoError(t, err)
 
 		require.IsType(t, &RecipePutController{}, selected)
+		require.Nil(t, selected.(*RecipePutController).schema)
+	})
+
+	t.Run("recipe PUT with schema", func(t *testing.T) {
+		controller := setup()
+
+		// The schema of the default API version is used, whatever the API version of the request.
+		request := &ctrl.Request{
+			ResourceID:    "/planes/radius/local/resourceGroups/test-group/providers/" + schemaResourceType + "/test-resource",
+			OperationType: v1.OperationType{Type: schemaResourceType, Method: v1.OperationPut}.String(),
+			APIVersion:    olderAPIVersion,
+		}
+
+		selected, err := controller.selectController(context.Background(), request)
+		require.NoError(t, err)
+
+		require.IsType(t, &RecipePutController{}, selected)
+		require.Equal(t, testSchema, selected.(*RecipePutController).schema)
 	})
 
 	t.Run("recipe DELETE", func(t *testing.T) {
@@ -104,6 +136,59 @@ func Test_DynamicResourceController_selectController(t *testing.T) {
 		require.IsType(t, &RecipeDeleteController{}, selected)
 	})
 
+	t.Run("manual PUT", func(t *testing.T) {
+		controller := setup()
+		request := &ctrl.Request{
+			ResourceID:    "/planes/radius/local/resourceGroups/test-group/providers/" + manualResourceType + "/test-resource",
+			OperationType: v1.OperationType{Type: manualResourceType, Method: v1.OperationPut}.String(),
+		}
+
+		selected, err := controller.selectController(context.Background(), request)
+		require.NoError(t, err)
+
+		require.IsType(t, &InertPutController{}, selected)
+	})
+
+	t.Run("manual DELETE", func(t *testing.T) {
+		controller := setup()
+		request := &ctrl.Request{
+			ResourceID:    "/planes/radius/local/resourceGroups/test-group/providers/" + manualResourceType + "/test-resource",
+			OperationType: v1.OperationType{Type: manualResourceType, Method: v1.OperationDelete}.String(),
+		}
+
+		selected, err := controller.selectController(context.Background(), request)
+		require.NoError(t, err)
+
+		require.IsType(t, &InertDeleteController{}, selected)
+	})
+
+	t.Run("recipe action", func(t *testing.T) {
+		controller := setup()
+		request := &ctrl.Request{
+			ResourceID:    "/planes/radius/local/resourceGroups/test-group/providers/" + recipeResourceType + "/test-resource/rotateCredentials",
+			OperationType: v1.OperationType{Type: recipeResourceType, Method: "ACTIONROTATECREDENTIALS"}.String(),
+		}
+
+		selected, err := controller.selectController(context.Background(), request)
+		require.NoError(t, err)
+
+		require.IsType(t, &RecipeActionController{}, selected)
+		require.Equal(t, "rotateCredentials", selected.(*RecipeActionController).actionName)
+		require.Equal(t, "rotate", selected.(*RecipeActionController).recipeName)
+	})
+
+	t.Run("undeclared action", func(t *testing.T) {
+		controller := setup()
+		request := &ctrl.Request{
+			ResourceID:    "/planes/radius/local/resourceGroups/test-group/providers/" + recipeResourceType + "/test-resource/backup",
+			OperationType: v1.OperationType{Type: recipeResourceType, Method: "ACTIONBACKUP"}.String(),
+		}
+
+		selected, err := controller.selectController(context.Background(), request)
+		require.EqualError(t, err, "resource type \"Applications.Test/testRecipeResources\" does not support the action \"BACKUP\"")
+		require.Nil(t, selected)
+	})
+
 	t.Run("unknown operation", func(t *testing.T) {
 		controller := setup()
 		request := &ctrl.Request{
@@ -136,6 +221,22 @@ func testUCPClientFactory() (*v20231001preview.ClientFactory, error) {
 			case recipeResourceType:
 				response.Properties = &v20231001preview.ResourceTypeProperties{
 					Capabilities: []*string{to.Ptr(datamodel.CapabilitySupportsRecipes)},
+					Actions: map[string]*v20231001preview.ResourceTypeAction{
+						"rotateCredentials": {RecipeName: to.Ptr("rotate")},
+					},
+				}
+				resp.SetResponse(http.StatusOK, response, nil)
+				return
+			case schemaResourceType:
+				response.Properties = &v20231001preview.ResourceTypeProperties{
+					Capabilities:      []*string{to.Ptr(datamodel.CapabilitySupportsRecipes)},
+					DefaultAPIVersion: to.Ptr(testAPIVersion),
+				}
+				resp.SetResponse(http.StatusOK, response, nil)
+				return
+			case manualResourceType:
+				response.Properties = &v20231001preview.ResourceTypeProperties{
+					Capabilities: []*string{to.Ptr(datamodel.CapabilityManualResourceProvisioning)},
 				}
 				resp.SetResponse(http.StatusOK, response, nil)
 				return
@@ -146,121 +247,33 @@ func testUCPClientFactory() (*v20231001preview.ClientFactory, error) {
 		},
 	}
 
+	apiVersionsServer := fake.APIVersionsServer{
+		Get: func(ctx context.Context, planeName, resourceProviderName, resourceTypeName, apiVersionName string, options *v20231001preview.APIVersionsClientGetOptions) (resp azfake.Responder[v20231001preview.APIVersionsClientGetResponse], errResp azfake.ErrorResponder) {
+			resourceType := resourceProviderName + resources.SegmentSeparator + resourceTypeName
+			if resourceType != schemaResourceType || apiVersionName != testAPIVersion {
+				errResp.SetResponseError(http.StatusNotFound, v1.CodeNotFound)
+				return
+			}
+
+			response := v20231001preview.APIVersionsClientGetResponse{
+				APIVersionResource: v20231001preview.APIVersionResource{
+					Name: to.Ptr(apiVersionName),
+					Properties: &v20231001preview.APIVersionProperties{
+						Schema: testSchema,
+					},
+				},
+			}
+			resp.SetResponse(http.StatusOK, response, nil)
+			return
+		},
+	}
+
 	return v20231001preview.NewClientFactory(&aztoken.AnonymousCredential{}, &armpolicy.ClientOptions{
 		ClientOptions: policy.ClientOptions{
 			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				APIVersionsServer:   apiVersionsServer,
 				ResourceTypesServer: resourceTypesServer,
 			}),
 		},
 	})
 }
-
-func Test_hasCapability(t *testing.T) {
-	tests := []struct {
-		name         string
-		resourceType *v20231001preview.ResourceTypeResource
-		capability   string
-		want         bool
-	}{
-		{
-			name: "has capability",
-			resourceType: &v20231001preview.ResourceTypeResource{
-				Properties: &v20231001preview.ResourceTypeProperties{
-					Capabilities: []*string{to.Ptr("capability1"), to.Ptr("capability2")},
-				},
-			},
-			capability: "capability1",
-			want:       true,
-		},
-		{
-			name: "does not have capability",
-			resourceType: &v20231001preview.ResourceTypeResource{
-				Properties: &v20231001preview.ResourceTypeProperties{
-					Capabilities: []*string{to.Ptr("capability1"), to.Ptr("capability2")},
-				},
-			},
-			capability: "capability3",
-			want:       false,
-		},
-		{
-			name: "nil capabilities",
-			resourceType: &v20231001preview.ResourceTypeResource{
-				Properties: &v20231001preview.ResourceTypeProperties{
-					Capabilities: nil,
-				},
-			},
-			capability: "capability1",
-			want:       false,
-		},
-		{
-			name: "empty capabilities",
-			resourceType: &v20231001preview.ResourceTypeResource{
-				Properties: &v20231001preview.ResourceTypeProperties{
-					Capabilities: []*string{},
-				},
-			},
-			capability: "capability1",
-			want:       false,
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			got := hasCapability(tt.resourceType, tt.capability)
-			require.Equal(t, tt.want, got)
-		})
-	}
-}
-
-func Test_DynamicResourceController_fetchResourceTypeDetails(t *testing.T) {
-	setup := func() *DynamicResourceController {
-		ucp, err := testUCPClientFactory()
-		require.NoError(t, err)
-		controller, err := NewDynamicResourceController(ctrl.Options{}, ucp, nil, nil)
-		require.NoError(t, err)
-		return controller.(*DynamicResourceController)
-	}
-
-	tests := []struct {
-		name       string
-		resourceID string
-		wantErr    bool
-		errMessage string
-	}{
-		{
-			name:       "inert resource type found",
-			resourceID: "/planes/radius/local/resourceGroups/test-group/providers/" + inertResourceType + "/test-resource",
-			wantErr:    false,
-		},
-		{
-			name:       "recipe resource type found",
-			resourceID: "/planes/radius/local/resourceGroups/test-group/providers/" + recipeResourceType + "/test-resource",
-			wantErr:    false,
-		},
-		{
-			name:       "unknown resource type",
-			resourceID: "/planes/radius/local/resourceGroups/test-group/providers/Applications.Test/unknownType/test-resource",
-			wantErr:    true,
-			errMessage: "resource type Applications.Test/unknownType not recognized",
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			controller := setup()
-			id, err := resources.ParseResource(tt.resourceID)
-			require.NoError(t, err)
-
-			resourceType, err := controller.fetchResourceTypeDetails(context.Background(), id)
-			if tt.wantErr {
-				require.Error(t, err)
-				require.Contains(t, err.Error(), tt.errMessage)
-				return
-			}
-
-			require.NoError(t, err)
-			require.NotNil(t, resourceType)
-			require.NotNil(t, resourceType.Properties)
-		})
-	}
-}
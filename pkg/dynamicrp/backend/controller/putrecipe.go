@@ -32,22 +32,25 @@ type RecipePutController struct {
 	opts                ctrl.Options
 	engine              engine.Engine
 	configurationLoader configloader.ConfigurationLoader
+	schema              map[string]any
 }
 
-// NewRecipePutController creates a new RecipePutController.
-func NewRecipePutController(opts ctrl.Options, engine engine.Engine, configurationLoader configloader.ConfigurationLoader) (ctrl.Controller, error) {
+// NewRecipePutController creates a new RecipePutController. The schema is the OpenAPI schema of the default
+// API version of the resource type, and may be nil.
+func NewRecipePutController(opts ctrl.Options, engine engine.Engine, configurationLoader configloader.ConfigurationLoader, schema map[string]any) (ctrl.Controller, error) {
 	return &RecipePutController{
 		BaseController:      ctrl.NewBaseAsyncController(opts),
 		opts:                opts,
 		engine:              engine,
 		configurationLoader: configurationLoader,
+		schema:              schema,
 	}, nil
 }
 
 // Run processes PUT operations for dynamic resources deployed using recipes.
 // It creates and delegates the request to CreateOrUpdateResource controller to handle the operation.
 func (c *RecipePutController) Run(ctx context.Context, request *ctrl.Request) (ctrl.Result, error) {
-	putController, err := recipecontroller.NewCreateOrUpdateResource(c.opts, &processor.DynamicProcessor{}, c.engine, c.configurationLoader)
+	putController, err := recipecontroller.NewCreateOrUpdateResource(c.opts, &processor.DynamicProcessor{Schema: c.schema}, c.engine, c.configurationLoader)
 	if err != nil {
 		return ctrl.Result{}, err
 	}
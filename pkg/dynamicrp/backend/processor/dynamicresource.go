@@ -22,12 +22,15 @@ import (
 	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
 	"github.com/radius-project/radius/pkg/portableresources/processors"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/schema"
 )
 
 var _ processors.ResourceProcessor[*datamodel.DynamicResource, datamodel.DynamicResource] = (*DynamicProcessor)(nil)
 
 // DynamicProcessor is a processor for dynamic resources. It implements the processors.ResourceProcessor interface.
 type DynamicProcessor struct {
+	// Schema is the OpenAPI schema of the default API version of the resource type. May be nil.
+	Schema map[string]any
 }
 
 // Delete implements the processors.Processor interface for dynamic resources.
@@ -45,7 +48,6 @@ func (d *DynamicProcessor) Process(ctx context.Context, resource *datamodel.Dyna
 
 	validator := processors.NewValidator(&computedValues, &secretValues, &outputResources, &status)
 
-	// TODO: loop over schema and add to validator - right now this bypasses validation.
 	for key, value := range options.RecipeOutput.Values {
 		value := value
 		validator.AddOptionalAnyField(key, &value)
@@ -65,5 +67,24 @@ func (d *DynamicProcessor) Process(ctx context.Context, resource *datamodel.Dyna
 		return err
 	}
 
+	// Recipe outputs are the only writer of read-only properties declared by the schema. Nested read-only properties
+	// are read from the same path in the recipe outputs.
+	readOnly, err := schema.ReadOnlyProperties(d.Schema)
+	if err != nil {
+		return err
+	}
+
+	for _, path := range readOnly {
+		value, ok := schema.GetPath(options.RecipeOutput.Values, path)
+		if !ok {
+			continue
+		}
+
+		if resource.Properties == nil {
+			resource.Properties = map[string]any{}
+		}
+		schema.SetPath(resource.Properties, path, value)
+	}
+
 	return nil
 }
@@ -0,0 +1,136 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package processor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/portableresources/processors"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_DynamicProcessor_Process(t *testing.T) {
+	schema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"size": map[string]any{
+				"type": "string",
+			},
+			"host": map[string]any{
+				"type":     "string",
+				"readOnly": true,
+			},
+			"port": map[string]any{
+				"type":     "integer",
+				"readOnly": true,
+			},
+			"connection": map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"protocol": map[string]any{
+						"type": "string",
+					},
+					"host": map[string]any{
+						"type":     "string",
+						"readOnly": true,
+					},
+				},
+			},
+		},
+	}
+
+	options := processors.Options{
+		RecipeOutput: &recipes.RecipeOutput{
+			Values: map[string]any{
+				"host": "example.com",
+				"size": "L",
+				"connection": map[string]any{
+					"protocol": "https",
+					"host":     "connection.example.com",
+				},
+			},
+			Secrets: map[string]any{
+				"password": "v3ryS3cr3t",
+			},
+		},
+	}
+
+	t.Run("without schema", func(t *testing.T) {
+		resource := &datamodel.DynamicResource{
+			Properties: map[string]any{
+				"size":   "M",
+				"status": map[string]any{},
+			},
+		}
+
+		processor := &DynamicProcessor{}
+		err := processor.Process(context.Background(), resource, options)
+		require.NoError(t, err)
+
+		require.Equal(t, "M", resource.Properties["size"])
+		require.NotContains(t, resource.Properties, "host")
+		require.Contains(t, resource.Status(), "binding")
+
+		// Secrets are not stored in the properties.
+		require.Equal(t, map[string]string{"password": "v3ryS3cr3t"}, resource.SecretValues)
+		require.NotContains(t, resource.Status()["binding"], "password")
+	})
+
+	t.Run("with schema", func(t *testing.T) {
+		resource := &datamodel.DynamicResource{
+			Properties: map[string]any{
+				"size":   "M",
+				"status": map[string]any{},
+			},
+		}
+
+		processor := &DynamicProcessor{Schema: schema}
+		err := processor.Process(context.Background(), resource, options)
+		require.NoError(t, err)
+
+		// Only read-only properties are written from recipe outputs.
+		require.Equal(t, "M", resource.Properties["size"])
+		require.Equal(t, "example.com", resource.Properties["host"])
+		require.NotContains(t, resource.Properties, "port")
+		require.NotContains(t, resource.Properties, "password")
+	})
+
+	t.Run("with nested read-only properties", func(t *testing.T) {
+		resource := &datamodel.DynamicResource{
+			Properties: map[string]any{
+				"size": "M",
+				"connection": map[string]any{
+					"protocol": "http",
+				},
+				"status": map[string]any{},
+			},
+		}
+
+		processor := &DynamicProcessor{Schema: schema}
+		err := processor.Process(context.Background(), resource, options)
+		require.NoError(t, err)
+
+		// Nested read-only properties are written from the recipe outputs, other nested properties are kept.
+		require.Equal(t, map[string]any{
+			"protocol": "http",
+			"host":     "connection.example.com",
+		}, resource.Properties["connection"])
+	})
+}
@@ -18,6 +18,7 @@ package backend
 
 import (
 	"context"
+	"time"
 
 	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
 	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/worker"
@@ -60,6 +61,12 @@ func (w *Service) Run(ctx context.Context) error {
 	if w.options.Config.Worker.MaxOperationRetryCount != nil {
 		w.Service.Options.MaxOperationRetryCount = *w.options.Config.Worker.MaxOperationRetryCount
 	}
+	if w.options.Config.Worker.CancellationPollIntervalSeconds != nil {
+		w.Service.Options.CancellationPollInterval = time.Duration(*w.options.Config.Worker.CancellationPollIntervalSeconds) * time.Second
+	}
+	if w.options.Config.Worker.MaxCancellationPollIntervalSeconds != nil {
+		w.Service.Options.MaxCancellationPollInterval = time.Duration(*w.options.Config.Worker.MaxCancellationPollIntervalSeconds) * time.Second
+	}
 
 	e, err := w.options.RecipeEngine()
 	if err != nil {
@@ -0,0 +1,218 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conversion
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/radius-project/radius/pkg/dynamicrp/resourcetype"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// webhookTimeout is the timeout for a call to a conversion webhook.
+	webhookTimeout = 30 * time.Second
+
+	// maxWebhookResponseSize is the maximum size of the response of a conversion webhook in bytes.
+	maxWebhookResponseSize = 4 * 1024 * 1024
+)
+
+// Converter converts the properties of dynamic resources between the API versions of their resource type.
+type Converter struct {
+	resourceTypes *resourcetype.Client
+	httpClient    *http.Client
+}
+
+// NewConverter creates a new Converter that fetches resource types with the given client. If httpClient is nil then
+// a client with a timeout that does not follow redirects is used to call webhooks.
+func NewConverter(resourceTypes *resourcetype.Client, httpClient *http.Client) *Converter {
+	if httpClient == nil {
+		httpClient = &http.Client{
+			Timeout: webhookTimeout,
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		}
+	}
+
+	return &Converter{resourceTypes: resourceTypes, httpClient: httpClient}
+}
+
+// WebhookRequest is the payload sent to a conversion webhook.
+type WebhookRequest struct {
+	// ResourceType is the fully-qualified resource type, e.g. "MyCompany.Resources/postgresDatabases".
+	ResourceType string `json:"resourceType"`
+
+	// FromAPIVersion is the API version of the properties in the request.
+	FromAPIVersion string `json:"fromApiVersion"`
+
+	// ToAPIVersion is the API version that the properties should be converted to.
+	ToAPIVersion string `json:"toApiVersion"`
+
+	// Properties is the properties of the resource.
+	Properties map[string]any `json:"properties"`
+}
+
+// WebhookResponse is the payload returned by a conversion webhook.
+type WebhookResponse struct {
+	// Properties is the converted properties of the resource.
+	Properties map[string]any `json:"properties"`
+}
+
+// ToDefault converts the properties of the resource with the given ID from apiVersion to the default API version
+// of its resource type. The properties are returned unmodified if the API version does not declare a conversion.
+func (c *Converter) ToDefault(ctx context.Context, id resources.ID, apiVersion string, properties map[string]any) (map[string]any, error) {
+	defaultAPIVersion, conversion, err := c.fetchConversion(ctx, id, apiVersion)
+	if err != nil {
+		return nil, err
+	} else if conversion == nil {
+		return properties, nil
+	}
+
+	result, err := ApplyFieldMappings(properties, conversion.FieldMappings, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if conversion.Webhook != nil {
+		result, err = c.callWebhook(ctx, to.String(conversion.Webhook.URL), WebhookRequest{
+			ResourceType:   id.Type(),
+			FromAPIVersion: apiVersion,
+			ToAPIVersion:   defaultAPIVersion,
+			Properties:     result,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// FromDefault converts the properties of the resource with the given ID from the default API version of its resource
+// type to apiVersion. The properties are returned unmodified if the API version does not declare a conversion.
+func (c *Converter) FromDefault(ctx context.Context, id resources.ID, apiVersion string, properties map[string]any) (map[string]any, error) {
+	defaultAPIVersion, conversion, err := c.fetchConversion(ctx, id, apiVersion)
+	if err != nil {
+		return nil, err
+	} else if conversion == nil {
+		return properties, nil
+	}
+
+	result := properties
+	if conversion.Webhook != nil {
+		result, err = c.callWebhook(ctx, to.String(conversion.Webhook.URL), WebhookRequest{
+			ResourceType:   id.Type(),
+			FromAPIVersion: defaultAPIVersion,
+			ToAPIVersion:   apiVersion,
+			Properties:     result,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return ApplyFieldMappings(result, conversion.FieldMappings, true)
+}
+
+// fetchConversion fetches the default API version of the resource type and the conversion declared by apiVersion.
+// The conversion is nil if apiVersion is the default API version or does not declare a conversion.
+func (c *Converter) fetchConversion(ctx context.Context, id resources.ID, apiVersion string) (string, *v20231001preview.APIVersionConversion, error) {
+	resourceType, err := c.resourceTypes.Get(ctx, id)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to fetch resource type %q: %w", id.Type(), err)
+	}
+
+	if resourceType.Properties == nil || resourceType.Properties.DefaultAPIVersion == nil {
+		return "", nil, nil
+	}
+
+	defaultAPIVersion := *resourceType.Properties.DefaultAPIVersion
+	if strings.EqualFold(defaultAPIVersion, apiVersion) {
+		return defaultAPIVersion, nil, nil
+	}
+
+	version, err := c.resourceTypes.GetAPIVersion(ctx, id, apiVersion)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to fetch API version %q of resource type %q: %w", apiVersion, id.Type(), err)
+	}
+
+	if version.Properties == nil {
+		return defaultAPIVersion, nil, nil
+	}
+
+	return defaultAPIVersion, version.Properties.Conversion, nil
+}
+
+func (c *Converter) callWebhook(ctx context.Context, url string, request WebhookRequest) (map[string]any, error) {
+	// The URL is validated when the API version is stored. It is validated again here because it is read from UCP.
+	err := (&datamodel.ConversionWebhook{URL: url}).Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal conversion request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create conversion request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("conversion webhook %q failed: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	// The response of the webhook is not included in errors because errors are returned to the caller of the API.
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("conversion webhook %q returned status code %d", url, resp.StatusCode)
+	}
+
+	content, err := io.ReadAll(io.LimitReader(resp.Body, maxWebhookResponseSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response from conversion webhook %q: %w", url, err)
+	} else if len(content) > maxWebhookResponseSize {
+		return nil, fmt.Errorf("response from conversion webhook %q exceeds the maximum size of %d bytes", url, maxWebhookResponseSize)
+	}
+
+	response := WebhookResponse{}
+	err = json.Unmarshal(content, &response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response from conversion webhook %q: %w", url, err)
+	}
+
+	if response.Properties == nil {
+		response.Properties = map[string]any{}
+	}
+
+	return response.Properties, nil
+}
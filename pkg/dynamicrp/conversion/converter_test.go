@@ -0,0 +1,238 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package conversion
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	armpolicy "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/policy"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/dynamicrp/resourcetype"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview/fake"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testResourceID    = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Test/testResources/my-resource"
+	defaultAPIVersion = "2025-01-01"
+	mappedAPIVersion  = "2024-01-01"
+	webhookAPIVersion = "2023-01-01"
+
+	// testWebhookURL is the URL of the conversion webhook. Requests are sent to the test server by the client
+	// returned from testWebhookServer.
+	testWebhookURL = "http://converter.test-namespace.svc/convert"
+)
+
+func Test_Converter_DefaultAPIVersion(t *testing.T) {
+	converter := NewConverter(resourcetype.NewClient(testUCPClientFactory(t, "")), nil)
+	id := resources.MustParse(testResourceID)
+	properties := map[string]any{"hostname": "example.com"}
+
+	result, err := converter.ToDefault(context.Background(), id, defaultAPIVersion, properties)
+	require.NoError(t, err)
+	require.Equal(t, properties, result)
+
+	result, err = converter.FromDefault(context.Background(), id, defaultAPIVersion, properties)
+	require.NoError(t, err)
+	require.Equal(t, properties, result)
+}
+
+func Test_Converter_FieldMappings(t *testing.T) {
+	converter := NewConverter(resourcetype.NewClient(testUCPClientFactory(t, "")), nil)
+	id := resources.MustParse(testResourceID)
+
+	stored, err := converter.ToDefault(context.Background(), id, mappedAPIVersion, map[string]any{"hostname": "example.com"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"host": "example.com"}, stored)
+
+	result, err := converter.FromDefault(context.Background(), id, mappedAPIVersion, stored)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"hostname": "example.com"}, result)
+}
+
+func Test_Converter_Webhook(t *testing.T) {
+	requests := []WebhookRequest{}
+	client := testWebhookServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request := WebhookRequest{}
+		err := json.NewDecoder(r.Body).Decode(&request)
+		require.NoError(t, err)
+		requests = append(requests, request)
+
+		response := WebhookResponse{Properties: map[string]any{"converted": request.ToAPIVersion}}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+
+	converter := NewConverter(resourcetype.NewClient(testUCPClientFactory(t, testWebhookURL)), client)
+	id := resources.MustParse(testResourceID)
+
+	result, err := converter.ToDefault(context.Background(), id, webhookAPIVersion, map[string]any{"value": "a"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"converted": defaultAPIVersion}, result)
+
+	result, err = converter.FromDefault(context.Background(), id, webhookAPIVersion, map[string]any{"value": "b"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"converted": webhookAPIVersion}, result)
+
+	expected := []WebhookRequest{
+		{
+			ResourceType:   "Applications.Test/testResources",
+			FromAPIVersion: webhookAPIVersion,
+			ToAPIVersion:   defaultAPIVersion,
+			Properties:     map[string]any{"value": "a"},
+		},
+		{
+			ResourceType:   "Applications.Test/testResources",
+			FromAPIVersion: defaultAPIVersion,
+			ToAPIVersion:   webhookAPIVersion,
+			Properties:     map[string]any{"value": "b"},
+		},
+	}
+	require.Equal(t, expected, requests)
+}
+
+func Test_Converter_WebhookFailure(t *testing.T) {
+	client := testWebhookServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte("oops"))
+	}))
+
+	converter := NewConverter(resourcetype.NewClient(testUCPClientFactory(t, testWebhookURL)), client)
+	id := resources.MustParse(testResourceID)
+
+	_, err := converter.ToDefault(context.Background(), id, webhookAPIVersion, map[string]any{})
+	require.EqualError(t, err, `conversion webhook "http://converter.test-namespace.svc/convert" returned status code 500`)
+}
+
+func Test_Converter_WebhookResponseTooLarge(t *testing.T) {
+	client := testWebhookServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"properties":{"value":"` + strings.Repeat("a", maxWebhookResponseSize) + `"}}`))
+	}))
+
+	converter := NewConverter(resourcetype.NewClient(testUCPClientFactory(t, testWebhookURL)), client)
+	id := resources.MustParse(testResourceID)
+
+	_, err := converter.ToDefault(context.Background(), id, webhookAPIVersion, map[string]any{})
+	require.ErrorContains(t, err, "exceeds the maximum size")
+}
+
+func Test_Converter_WebhookURLNotAllowed(t *testing.T) {
+	called := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer server.Close()
+
+	converter := NewConverter(resourcetype.NewClient(testUCPClientFactory(t, server.URL)), server.Client())
+	id := resources.MustParse(testResourceID)
+
+	_, err := converter.ToDefault(context.Background(), id, webhookAPIVersion, map[string]any{})
+	require.ErrorContains(t, err, "must address a Kubernetes service in the cluster")
+	require.False(t, called)
+}
+
+func Test_Converter_APIVersionNotFound(t *testing.T) {
+	converter := NewConverter(resourcetype.NewClient(testUCPClientFactory(t, "")), nil)
+	id := resources.MustParse(testResourceID)
+
+	_, err := converter.ToDefault(context.Background(), id, "2000-01-01", map[string]any{})
+	require.Error(t, err)
+}
+
+// testWebhookServer starts a test server with the given handler and returns a client that sends all requests to it.
+func testWebhookServer(t *testing.T, handler http.Handler) *http.Client {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	transport := server.Client().Transport.(*http.Transport).Clone()
+	transport.DialContext = func(ctx context.Context, network, addr string) (net.Conn, error) {
+		return (&net.Dialer{}).DialContext(ctx, network, server.Listener.Addr().String())
+	}
+
+	return &http.Client{Transport: transport}
+}
+
+func testUCPClientFactory(t *testing.T, webhookURL string) *v20231001preview.ClientFactory {
+	resourceTypesServer := fake.ResourceTypesServer{
+		Get: func(ctx context.Context, planeName, resourceProviderName, resourceTypeName string, options *v20231001preview.ResourceTypesClientGetOptions) (resp azfake.Responder[v20231001preview.ResourceTypesClientGetResponse], errResp azfake.ErrorResponder) {
+			response := v20231001preview.ResourceTypesClientGetResponse{
+				ResourceTypeResource: v20231001preview.ResourceTypeResource{
+					Name: to.Ptr(resourceTypeName),
+					Properties: &v20231001preview.ResourceTypeProperties{
+						DefaultAPIVersion: to.Ptr(defaultAPIVersion),
+					},
+				},
+			}
+			resp.SetResponse(http.StatusOK, response, nil)
+			return
+		},
+	}
+
+	apiVersionsServer := fake.APIVersionsServer{
+		Get: func(ctx context.Context, planeName, resourceProviderName, resourceTypeName, apiVersionName string, options *v20231001preview.APIVersionsClientGetOptions) (resp azfake.Responder[v20231001preview.APIVersionsClientGetResponse], errResp azfake.ErrorResponder) {
+			properties := &v20231001preview.APIVersionProperties{}
+			switch apiVersionName {
+			case defaultAPIVersion:
+			case mappedAPIVersion:
+				properties.Conversion = &v20231001preview.APIVersionConversion{
+					FieldMappings: []*v20231001preview.FieldMapping{
+						{From: to.Ptr("$.hostname"), To: to.Ptr("$.host")},
+					},
+				}
+			case webhookAPIVersion:
+				properties.Conversion = &v20231001preview.APIVersionConversion{
+					Webhook: &v20231001preview.ConversionWebhook{URL: to.Ptr(webhookURL)},
+				}
+			default:
+				errResp.SetResponseError(http.StatusNotFound, v1.CodeNotFound)
+				return
+			}
+
+			response := v20231001preview.APIVersionsClientGetResponse{
+				APIVersionResource: v20231001preview.APIVersionResource{
+					Name:       to.Ptr(apiVersionName),
+					Properties: properties,
+				},
+			}
+			resp.SetResponse(http.StatusOK, response, nil)
+			return
+		},
+	}
+
+	ucp, err := v20231001preview.NewClientFactory(&aztoken.AnonymousCredential{}, &armpolicy.ClientOptions{
+		ClientOptions: policy.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				APIVersionsServer:   apiVersionsServer,
+				ResourceTypesServer: resourceTypesServer,
+			}),
+		},
+	})
+	require.NoError(t, err)
+
+	return ucp
+}
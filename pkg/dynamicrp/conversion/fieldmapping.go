@@ -0,0 +1,132 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conversion
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+)
+
+// ApplyFieldMappings moves the fields described by the mappings and returns the result. The input properties are
+// not modified.
+//
+// Each mapping moves the value at the "from" path to the "to" path. When reverse is true the value at the "to" path
+// is moved to the "from" path instead. Fields that are not described by a mapping are preserved.
+func ApplyFieldMappings(properties map[string]any, mappings []*v20231001preview.FieldMapping, reverse bool) (map[string]any, error) {
+	result, err := deepCopy(properties)
+	if err != nil {
+		return nil, err
+	}
+
+	// Remove all of the values first so that mappings can swap fields.
+	values := make([]any, len(mappings))
+	found := make([]bool, len(mappings))
+	for i, mapping := range mappings {
+		if mapping == nil {
+			continue
+		}
+
+		source := to.String(mapping.From)
+		if reverse {
+			source = to.String(mapping.To)
+		}
+
+		values[i], found[i] = removePath(result, splitPath(source))
+	}
+
+	for i, mapping := range mappings {
+		if mapping == nil || !found[i] {
+			continue
+		}
+
+		destination := to.String(mapping.To)
+		if reverse {
+			destination = to.String(mapping.From)
+		}
+
+		err := setPath(result, splitPath(destination), values[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func splitPath(path string) []string {
+	return strings.Split(strings.TrimPrefix(path, "$."), ".")
+}
+
+func removePath(properties map[string]any, segments []string) (any, bool) {
+	current := properties
+	for _, segment := range segments[:len(segments)-1] {
+		next, ok := current[segment].(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		current = next
+	}
+
+	last := segments[len(segments)-1]
+	value, ok := current[last]
+	if ok {
+		delete(current, last)
+	}
+
+	return value, ok
+}
+
+func setPath(properties map[string]any, segments []string, value any) error {
+	current := properties
+	for i, segment := range segments[:len(segments)-1] {
+		obj, ok := current[segment]
+		if !ok {
+			next := map[string]any{}
+			current[segment] = next
+			current = next
+			continue
+		}
+
+		next, ok := obj.(map[string]any)
+		if !ok {
+			return fmt.Errorf("cannot set field %q: %q is not an object", strings.Join(segments, "."), strings.Join(segments[:i+1], "."))
+		}
+		current = next
+	}
+
+	current[segments[len(segments)-1]] = value
+	return nil
+}
+
+func deepCopy(properties map[string]any) (map[string]any, error) {
+	bs, err := json.Marshal(properties)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal properties: %w", err)
+	}
+
+	result := map[string]any{}
+	err = json.Unmarshal(bs, &result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal properties: %w", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package conversion
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ApplyFieldMappings(t *testing.T) {
+	mappings := []*v20231001preview.FieldMapping{
+		{From: to.Ptr("$.hostname"), To: to.Ptr("$.connection.host")},
+		{From: to.Ptr("$.size"), To: to.Ptr("$.capacity")},
+	}
+
+	tests := []struct {
+		name       string
+		properties map[string]any
+		reverse    bool
+		expected   map[string]any
+	}{
+		{
+			name: "forward",
+			properties: map[string]any{
+				"hostname": "example.com",
+				"size":     "small",
+				"other":    "value",
+			},
+			expected: map[string]any{
+				"connection": map[string]any{"host": "example.com"},
+				"capacity":   "small",
+				"other":      "value",
+			},
+		},
+		{
+			name: "reverse",
+			properties: map[string]any{
+				"connection": map[string]any{"host": "example.com"},
+				"capacity":   "small",
+				"other":      "value",
+			},
+			reverse: true,
+			expected: map[string]any{
+				"connection": map[string]any{},
+				"hostname":   "example.com",
+				"size":       "small",
+				"other":      "value",
+			},
+		},
+		{
+			name: "missing source is ignored",
+			properties: map[string]any{
+				"size": "small",
+			},
+			expected: map[string]any{
+				"capacity": "small",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ApplyFieldMappings(tt.properties, mappings, tt.reverse)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_ApplyFieldMappings_Swap(t *testing.T) {
+	mappings := []*v20231001preview.FieldMapping{
+		{From: to.Ptr("a"), To: to.Ptr("b")},
+		{From: to.Ptr("b"), To: to.Ptr("a")},
+	}
+
+	result, err := ApplyFieldMappings(map[string]any{"a": "1", "b": "2"}, mappings, false)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"a": "2", "b": "1"}, result)
+}
+
+func Test_ApplyFieldMappings_DoesNotModifyInput(t *testing.T) {
+	mappings := []*v20231001preview.FieldMapping{
+		{From: to.Ptr("a"), To: to.Ptr("b")},
+	}
+
+	properties := map[string]any{"a": "1"}
+	_, err := ApplyFieldMappings(properties, mappings, false)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"a": "1"}, properties)
+}
+
+func Test_ApplyFieldMappings_DestinationNotObject(t *testing.T) {
+	mappings := []*v20231001preview.FieldMapping{
+		{From: to.Ptr("a"), To: to.Ptr("b.c")},
+	}
+
+	_, err := ApplyFieldMappings(map[string]any{"a": "1", "b": "2"}, mappings, false)
+	require.EqualError(t, err, "cannot set field \"b.c\": \"b\" is not an object")
+}
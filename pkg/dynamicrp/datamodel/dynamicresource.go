@@ -39,6 +39,10 @@ type DynamicResource struct {
 
 	// Properties stores the properties of the resource being tracked.
 	Properties map[string]any `json:"properties"`
+
+	// SecretValues stores the secret outputs of the resource. Secrets are returned by the listSecrets action
+	// and are never returned as part of the resource body.
+	SecretValues map[string]string `json:"secretValues,omitempty"`
 }
 
 func (d *DynamicResource) Status() map[string]any {
@@ -148,14 +152,19 @@ func (d *DynamicResource) ApplyDeploymentOutput(deploymentOutput rpv1.Deployment
 		delete(status, "outputResources")
 	}
 
-	// We store computed values and secrets in the status under "binding".
-	//
+	// We store computed values in the status under "binding".
 	binding := map[string]any{}
 	for key, value := range deploymentOutput.ComputedValues {
 		binding[key] = value
 	}
+
+	// Secrets are stored separately from the properties so they are not returned as part of the resource body.
+	d.SecretValues = map[string]string{}
 	for key, value := range deploymentOutput.SecretValues {
-		binding[key] = value.Value
+		d.SecretValues[key] = value.Value
+	}
+	if len(d.SecretValues) == 0 {
+		d.SecretValues = nil
 	}
 
 	status["binding"] = binding
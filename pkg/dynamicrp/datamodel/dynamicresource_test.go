@@ -135,6 +135,31 @@ func Test_DynamicResource_GetRecipe(t *testing.T) {
 				DeploymentStatus: "Succeeded",
 			},
 		},
+		{
+			name: "recipe with imported resources returns import",
+			resource: DynamicResource{
+				Properties: map[string]any{
+					"recipe": map[string]any{
+						"name": "test-recipe",
+						"import": map[string]any{
+							"resources": []any{
+								map[string]any{"id": "test-id", "address": "test_resource.test"},
+							},
+							"values":  map[string]any{"host": "test-host"},
+							"secrets": map[string]any{"password": "test-password"},
+						},
+					},
+				},
+			},
+			want: &portableresources.ResourceRecipe{
+				Name: "test-recipe",
+				Import: &portableresources.RecipeImport{
+					Resources: []portableresources.ImportedResource{{ID: "test-id", Address: "test_resource.test"}},
+					Values:    map[string]any{"host": "test-host"},
+					Secrets:   map[string]any{"password": "test-password"},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -184,6 +209,24 @@ func Test_DynamicResource_SetRecipe(t *testing.T) {
 				"name": "test-recipe",
 			},
 		},
+		{
+			name:     "recipe without import secrets",
+			resource: DynamicResource{},
+			recipe: &portableresources.ResourceRecipe{
+				Name: "test-recipe",
+				Import: &portableresources.RecipeImport{
+					Resources: []portableresources.ImportedResource{{ID: "test-id"}},
+				},
+			},
+			want: map[string]any{
+				"name": "test-recipe",
+				"import": map[string]any{
+					"resources": []any{
+						map[string]any{"id": "test-id"},
+					},
+				},
+			},
+		},
 		{
 			name:     "setting nil recipe initializes empty recipe map",
 			resource: DynamicResource{},
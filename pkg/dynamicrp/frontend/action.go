@@ -0,0 +1,158 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	sm "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/dynamicrp/resourcetype"
+	"github.com/radius-project/radius/pkg/middleware"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	ucpdatamodel "github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// operationCustomAction is the operation method prefix of custom actions declared by a resource type. The
+	// operation method of a custom action is the prefix followed by the upper-cased action name, e.g. ACTIONRESTART.
+	operationCustomAction v1.OperationMethod = "ACTION"
+)
+
+var _ controller.Controller = (*Action)(nil)
+
+// Action is the controller implementation of the custom actions declared by the resource type of a dynamic
+// resource. Actions are processed asynchronously by executing the recipe of the action.
+type Action struct {
+	controller.Operation[*datamodel.DynamicResource, datamodel.DynamicResource]
+
+	resourceTypes *resourcetype.Client
+	retryAfter    time.Duration
+}
+
+// NewAction creates a new instance of Action.
+func NewAction(opts controller.Options, resourceOptions controller.ResourceOptions[datamodel.DynamicResource], resourceTypes *resourcetype.Client) (controller.Controller, error) {
+	return &Action{
+		Operation:     controller.NewOperation(opts, resourceOptions),
+		resourceTypes: resourceTypes,
+		retryAfter:    resourceOptions.AsyncOperationRetryAfter,
+	}, nil
+}
+
+// Run validates that the action is declared by the resource type and queues an async operation to process it.
+//
+// The async operation is queued with the ID of the action rather than the ID of the resource, so the
+// provisioningState of the resource is not changed by the action.
+func (c *Action) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	// Request route for actions has name of the action as suffix which should be removed to get the resource id.
+	id := serviceCtx.ResourceID.Truncate()
+	actionName := path.Base(req.URL.Path)
+
+	resourceType, err := c.resourceTypes.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !hasAction(resourceType, actionName) {
+		return rest.NewBadRequestARMResponse(v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code:    v1.CodeInvalid,
+				Target:  id.String(),
+				Message: fmt.Sprintf("Resource type '%s' does not support the action '%s'.", id.Type(), actionName),
+			},
+		}), nil
+	}
+
+	// Actions are handled by executing recipes.
+	if !resourcetype.HasCapability(resourceType, ucpdatamodel.CapabilitySupportsRecipes) {
+		return rest.NewBadRequestARMResponse(v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code:    v1.CodeInvalid,
+				Target:  id.String(),
+				Message: fmt.Sprintf("Resource type '%s' does not support recipes, which are required to handle the action '%s'.", id.Type(), actionName),
+			},
+		}), nil
+	}
+
+	resource, _, err := c.GetResource(ctx, id)
+	if errors.Is(&database.ErrNotFound{ID: id.String()}, err) {
+		return rest.NewNotFoundResponse(id), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if resource == nil {
+		return rest.NewNotFoundResponse(id), nil
+	}
+
+	if state := resource.ProvisioningState(); !state.IsTerminal() {
+		return rest.NewConflictResponse(fmt.Sprintf(controller.InProgressStateMessageFormat, state)), nil
+	}
+
+	options := sm.QueueOperationOptions{
+		OperationTimeout: c.AsyncOperationTimeout(),
+		RetryAfter:       v1.DefaultRetryAfterDuration,
+	}
+	if c.retryAfter != 0 {
+		options.RetryAfter = c.retryAfter
+	}
+
+	// The ID of the request context is the ID of the resource, so the ID of the action is parsed from the URL.
+	actionID, err := resources.Parse(middleware.GetRelativePath(c.Options().PathBase, req.URL.Path))
+	if err != nil {
+		return nil, err
+	}
+
+	actionCtx := *serviceCtx
+	actionCtx.ResourceID = actionID
+	err = c.StatusManager().QueueAsyncOperation(ctx, &actionCtx, options)
+	if err != nil {
+		return nil, err
+	}
+
+	response := rest.NewAsyncOperationResponse(map[string]any{}, serviceCtx.Location, http.StatusAccepted, id, serviceCtx.OperationID, serviceCtx.APIVersion, "", "")
+	response.RetryAfter = options.RetryAfter
+	return response, nil
+}
+
+// hasAction determines if a resource type declares a custom action. Action names are matched case-insensitively.
+func hasAction(resourceType *v20231001preview.ResourceTypeResource, actionName string) bool {
+	if resourceType.Properties == nil {
+		return false
+	}
+
+	for name := range resourceType.Properties.Actions {
+		if strings.EqualFold(name, actionName) {
+			return true
+		}
+	}
+
+	return false
+}
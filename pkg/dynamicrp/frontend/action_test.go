@@ -0,0 +1,153 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	ucpdatamodel "github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_Action(t *testing.T) {
+	actions := map[string]*v20231001preview.ResourceTypeAction{
+		"restart": {Description: to.Ptr("Restarts the resource.")},
+	}
+
+	tests := []struct {
+		name         string
+		action       string
+		capabilities []string
+		actions      map[string]*v20231001preview.ResourceTypeAction
+		resource     *datamodel.DynamicResource
+		statusCode   int
+	}{
+		{
+			name:         "queues the action",
+			action:       "restart",
+			capabilities: []string{ucpdatamodel.CapabilitySupportsRecipes},
+			actions:      actions,
+			resource:     &datamodel.DynamicResource{},
+			statusCode:   http.StatusAccepted,
+		},
+		{
+			name:         "action names are case-insensitive",
+			action:       "Restart",
+			capabilities: []string{ucpdatamodel.CapabilitySupportsRecipes},
+			actions:      actions,
+			resource:     &datamodel.DynamicResource{},
+			statusCode:   http.StatusAccepted,
+		},
+		{
+			name:         "unknown action",
+			action:       "stop",
+			capabilities: []string{ucpdatamodel.CapabilitySupportsRecipes},
+			actions:      actions,
+			statusCode:   http.StatusBadRequest,
+		},
+		{
+			name:         "resource type without actions",
+			action:       "restart",
+			capabilities: []string{ucpdatamodel.CapabilitySupportsRecipes},
+			statusCode:   http.StatusBadRequest,
+		},
+		{
+			name:       "resource type does not support recipes",
+			action:     "restart",
+			actions:    actions,
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name:         "resource not found",
+			action:       "restart",
+			capabilities: []string{ucpdatamodel.CapabilitySupportsRecipes},
+			actions:      actions,
+			statusCode:   http.StatusNotFound,
+		},
+		{
+			name:         "operation in progress",
+			action:       "restart",
+			capabilities: []string{ucpdatamodel.CapabilitySupportsRecipes},
+			actions:      actions,
+			resource: &datamodel.DynamicResource{
+				BaseResource: v1.BaseResource{
+					InternalMetadata: v1.InternalMetadata{AsyncProvisioningState: v1.ProvisioningStateUpdating},
+				},
+			},
+			statusCode: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mctrl := gomock.NewController(t)
+			actionID := testResourceID + "/" + tt.action
+
+			databaseClient := database.NewMockClient(mctrl)
+			if tt.statusCode != http.StatusBadRequest {
+				databaseClient.EXPECT().
+					Get(gomock.Any(), testResourceID).
+					DoAndReturn(func(ctx context.Context, id string, _ ...database.GetOptions) (*database.Object, error) {
+						if tt.resource == nil {
+							return nil, &database.ErrNotFound{ID: id}
+						}
+						return &database.Object{Metadata: database.Metadata{ID: id}, Data: tt.resource}, nil
+					})
+			}
+
+			statusManager := statusmanager.NewMockStatusManager(mctrl)
+			if tt.statusCode == http.StatusAccepted {
+				statusManager.EXPECT().
+					QueueAsyncOperation(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, sCtx *v1.ARMRequestContext, options statusmanager.QueueOperationOptions) error {
+						// The operation is queued for the action so the provisioning state of the resource is unchanged.
+						require.Equal(t, actionID, sCtx.ResourceID.String())
+						return nil
+					})
+			}
+
+			resourceTypes := newTestResourceTypes(t, testResourceType{capabilities: tt.capabilities, actions: tt.actions})
+			ctl, err := NewAction(controller.Options{DatabaseClient: databaseClient, StatusManager: statusManager}, dynamicResourceOptions, resourceTypes)
+			require.NoError(t, err)
+
+			ctx := v1.WithARMRequestContext(testcontext.New(t), &v1.ARMRequestContext{
+				ResourceID: resources.MustParse(actionID),
+				APIVersion: defaultAPIVersion,
+			})
+			req := httptest.NewRequest(http.MethodPost, actionID+"?api-version="+defaultAPIVersion, nil)
+			w := httptest.NewRecorder()
+
+			response, err := ctl.Run(ctx, w, req)
+			require.NoError(t, err)
+			require.NoError(t, response.Apply(ctx, w, req))
+			require.Equal(t, tt.statusCode, w.Code)
+		})
+	}
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/dynamicrp/resourcetype"
+	ucpdatamodel "github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+// validateCapabilities returns an UpdateFilter that enforces the capabilities of the resource type of a dynamic
+// resource.
+//
+// - ApplicationScopedOnly: the resource must belong to an application.
+func validateCapabilities(resourceTypes *resourcetype.Client) controller.UpdateFilter[datamodel.DynamicResource] {
+	return func(ctx context.Context, newResource *datamodel.DynamicResource, oldResource *datamodel.DynamicResource, options *controller.Options) (rest.Response, error) {
+		serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+		resourceType, err := resourceTypes.Get(ctx, serviceCtx.ResourceID)
+		if err != nil {
+			return nil, err
+		}
+
+		if resourcetype.HasCapability(resourceType, ucpdatamodel.CapabilityApplicationScopedOnly) && newResource.ResourceMetadata().ApplicationID() == "" {
+			return rest.NewBadRequestARMResponse(v1.ErrorResponse{
+				Error: &v1.ErrorDetails{
+					Code:    v1.CodeInvalidProperties,
+					Target:  "$.properties.application",
+					Message: fmt.Sprintf("Resources of type '%s' must belong to an application. Please specify '.properties.application'.", serviceCtx.ResourceID.Type()),
+				},
+			}), nil
+		}
+
+		return nil, nil
+	}
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	ucpdatamodel "github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ValidateCapabilities(t *testing.T) {
+	tests := []struct {
+		name         string
+		capabilities []string
+		properties   map[string]any
+		statusCode   int
+	}{
+		{
+			name:       "no capabilities",
+			properties: map[string]any{},
+		},
+		{
+			name:         "application scoped resource with an application",
+			capabilities: []string{ucpdatamodel.CapabilityApplicationScopedOnly},
+			properties:   map[string]any{"application": "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/my-app"},
+		},
+		{
+			name:         "application scoped resource without an application",
+			capabilities: []string{ucpdatamodel.CapabilityApplicationScopedOnly},
+			properties:   map[string]any{"environment": "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/my-env"},
+			statusCode:   http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resourceTypes := newTestResourceTypes(t, testResourceType{capabilities: tt.capabilities})
+			newResource := &datamodel.DynamicResource{Properties: tt.properties}
+
+			response, err := validateCapabilities(resourceTypes)(testContext(t, defaultAPIVersion), newResource, nil, &controller.Options{})
+			require.NoError(t, err)
+
+			if tt.statusCode != 0 {
+				require.NotNil(t, response)
+				require.Equal(t, tt.statusCode, responseStatusCode(t, response))
+				return
+			}
+
+			require.Nil(t, response)
+		})
+	}
+}
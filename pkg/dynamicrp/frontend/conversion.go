@@ -0,0 +1,83 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/dynamicrp/conversion"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel/converter"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// responseConversionTimeout is the timeout for converting a resource to the API version of the response.
+	responseConversionTimeout = 30 * time.Second
+)
+
+// convertRequest returns an UpdateFilter that converts the properties of a dynamic resource from the API version
+// of the request to the default API version of its resource type.
+func convertRequest(conv *conversion.Converter) controller.UpdateFilter[datamodel.DynamicResource] {
+	return func(ctx context.Context, newResource *datamodel.DynamicResource, oldResource *datamodel.DynamicResource, options *controller.Options) (rest.Response, error) {
+		serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+		properties, err := conv.ToDefault(ctx, serviceCtx.ResourceID, serviceCtx.APIVersion, newResource.Properties)
+		if err != nil {
+			return rest.NewBadRequestARMResponse(v1.ErrorResponse{
+				Error: &v1.ErrorDetails{
+					Code:    v1.CodeInvalidRequestContent,
+					Target:  serviceCtx.ResourceID.String(),
+					Message: fmt.Sprintf("failed to convert resource from API version '%s': %s", serviceCtx.APIVersion, err.Error()),
+				},
+			}), nil
+		}
+
+		newResource.Properties = properties
+		return nil, nil
+	}
+}
+
+// convertResponse returns a response converter that converts the properties of a dynamic resource from the
+// default API version of its resource type to the API version of the request. The response converter does not have
+// access to the request context, so it is created for each request with ctx.
+func convertResponse(ctx context.Context, conv *conversion.Converter) v1.ConvertToAPIModel[datamodel.DynamicResource] {
+	return func(model *datamodel.DynamicResource, version string) (v1.VersionedModelInterface, error) {
+		id, err := resources.ParseResource(model.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, responseConversionTimeout)
+		defer cancel()
+
+		properties, err := conv.FromDefault(ctx, id, version, model.Properties)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert resource to API version %q: %w", version, err)
+		}
+
+		// Convert a copy so the stored resource is not modified.
+		copy := *model
+		copy.Properties = properties
+		return converter.DynamicResourceDataModelToVersioned(&copy, version)
+	}
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"net/http"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/dynamicrp/api"
+	"github.com/radius-project/radius/pkg/dynamicrp/conversion"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ConvertRequest(t *testing.T) {
+	tests := []struct {
+		name       string
+		apiVersion string
+		properties map[string]any
+		expected   map[string]any
+		statusCode int
+	}{
+		{
+			name:       "default API version",
+			apiVersion: defaultAPIVersion,
+			properties: map[string]any{"size": "M", "host": "example.com"},
+			expected:   map[string]any{"size": "M", "host": "example.com"},
+		},
+		{
+			name:       "converted API version",
+			apiVersion: renamedAPIVersion,
+			properties: map[string]any{"size": "M", "hostname": "example.com"},
+			expected:   map[string]any{"size": "M", "host": "example.com"},
+		},
+		{
+			name:       "unknown API version",
+			apiVersion: "2000-01-01",
+			properties: map[string]any{"size": "M"},
+			statusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conv := conversion.NewConverter(newTestResourceTypes(t, testResourceType{apiVersions: testSchemas()}), nil)
+			newResource := &datamodel.DynamicResource{Properties: tt.properties}
+
+			response, err := convertRequest(conv)(testContext(t, tt.apiVersion), newResource, nil, &controller.Options{})
+			require.NoError(t, err)
+
+			if tt.statusCode != 0 {
+				require.NotNil(t, response)
+				require.Equal(t, tt.statusCode, responseStatusCode(t, response))
+				return
+			}
+
+			require.Nil(t, response)
+			require.Equal(t, tt.expected, newResource.Properties)
+		})
+	}
+}
+
+func Test_ConvertResponse(t *testing.T) {
+	tests := []struct {
+		name       string
+		apiVersion string
+		expected   map[string]any
+		err        string
+	}{
+		{
+			name:       "default API version",
+			apiVersion: defaultAPIVersion,
+			expected:   map[string]any{"size": "M", "host": "example.com"},
+		},
+		{
+			name:       "converted API version",
+			apiVersion: renamedAPIVersion,
+			expected:   map[string]any{"size": "M", "hostname": "example.com"},
+		},
+		{
+			name:       "unknown API version",
+			apiVersion: "2000-01-01",
+			err:        "failed to convert resource to API version \"2000-01-01\"",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conv := conversion.NewConverter(newTestResourceTypes(t, testResourceType{apiVersions: testSchemas()}), nil)
+			resource := &datamodel.DynamicResource{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:   testResourceID,
+						Name: "my-resource",
+						Type: "Applications.Test/testResources",
+					},
+				},
+				Properties: map[string]any{"size": "M", "host": "example.com"},
+			}
+
+			versioned, err := convertResponse(testcontext.New(t), conv)(resource, tt.apiVersion)
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+
+			// The stored resource is not modified.
+			require.Equal(t, map[string]any{"size": "M", "host": "example.com"}, resource.Properties)
+
+			properties := versioned.(*api.DynamicResource).Properties
+			delete(properties, "provisioningState")
+			require.Equal(t, tt.expected, properties)
+		})
+	}
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/dynamicrp/resourcetype"
+	ucpdatamodel "github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+const (
+	// operationListSecrets is the operation method of the listSecrets action.
+	operationListSecrets v1.OperationMethod = "ACTIONLISTSECRETS"
+)
+
+var _ controller.Controller = (*ListSecrets)(nil)
+
+// ListSecrets is the controller implementation of the listSecrets action for dynamic resources. It returns the
+// secret outputs of the resource's recipe.
+type ListSecrets struct {
+	controller.Operation[*datamodel.DynamicResource, datamodel.DynamicResource]
+
+	resourceTypes *resourcetype.Client
+}
+
+// NewListSecrets creates a new instance of ListSecrets.
+func NewListSecrets(opts controller.Options, resourceOptions controller.ResourceOptions[datamodel.DynamicResource], resourceTypes *resourcetype.Client) (controller.Controller, error) {
+	return &ListSecrets{
+		Operation:     controller.NewOperation(opts, resourceOptions),
+		resourceTypes: resourceTypes,
+	}, nil
+}
+
+// Run returns the secret values of the specified dynamic resource.
+func (c *ListSecrets) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	// Request route for listSecrets has name of the operation as suffix which should be removed to get the resource id.
+	id := serviceCtx.ResourceID.Truncate()
+
+	resourceType, err := c.resourceTypes.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !resourcetype.HasCapability(resourceType, ucpdatamodel.CapabilityExposesSecrets) {
+		return rest.NewBadRequestARMResponse(v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code:    v1.CodeInvalid,
+				Target:  id.String(),
+				Message: fmt.Sprintf("Resource type '%s' does not support the listSecrets action because it does not have the '%s' capability.", id.Type(), ucpdatamodel.CapabilityExposesSecrets),
+			},
+		}), nil
+	}
+
+	resource, _, err := c.GetResource(ctx, id)
+	if errors.Is(&database.ErrNotFound{ID: id.String()}, err) {
+		return rest.NewNotFoundResponse(id), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if resource == nil {
+		return rest.NewNotFoundResponse(id), nil
+	}
+
+	secrets := map[string]string{}
+	for key, value := range resource.SecretValues {
+		secrets[key] = value
+	}
+
+	return rest.NewOKResponse(secrets), nil
+}
+
+// preserveSecretValues is an UpdateFilter that copies the secret values of the existing resource to the new resource.
+// Secrets are never part of the request body. They are written when the resource is provisioned, so the existing
+// values remain available until provisioning completes.
+func preserveSecretValues(ctx context.Context, newResource *datamodel.DynamicResource, oldResource *datamodel.DynamicResource, options *controller.Options) (rest.Response, error) {
+	if oldResource != nil {
+		newResource.SecretValues = oldResource.SecretValues
+	}
+
+	return nil, nil
+}
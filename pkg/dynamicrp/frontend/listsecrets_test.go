@@ -0,0 +1,108 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	ucpdatamodel "github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_ListSecrets(t *testing.T) {
+	tests := []struct {
+		name         string
+		capabilities []string
+		resource     *datamodel.DynamicResource
+		statusCode   int
+		expected     map[string]string
+	}{
+		{
+			name:         "secrets",
+			capabilities: []string{ucpdatamodel.CapabilityExposesSecrets},
+			resource:     &datamodel.DynamicResource{SecretValues: map[string]string{"password": "s3cr3t"}},
+			statusCode:   http.StatusOK,
+			expected:     map[string]string{"password": "s3cr3t"},
+		},
+		{
+			name:         "no secrets",
+			capabilities: []string{ucpdatamodel.CapabilityExposesSecrets},
+			resource:     &datamodel.DynamicResource{},
+			statusCode:   http.StatusOK,
+			expected:     map[string]string{},
+		},
+		{
+			name:         "resource not found",
+			capabilities: []string{ucpdatamodel.CapabilityExposesSecrets},
+			statusCode:   http.StatusNotFound,
+		},
+		{
+			name:       "resource type does not expose secrets",
+			resource:   &datamodel.DynamicResource{SecretValues: map[string]string{"password": "s3cr3t"}},
+			statusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			databaseClient := database.NewMockClient(gomock.NewController(t))
+			if tt.expected != nil || tt.statusCode == http.StatusNotFound {
+				databaseClient.EXPECT().
+					Get(gomock.Any(), testResourceID).
+					DoAndReturn(func(ctx context.Context, id string, _ ...database.GetOptions) (*database.Object, error) {
+						if tt.resource == nil {
+							return nil, &database.ErrNotFound{ID: id}
+						}
+						return &database.Object{Metadata: database.Metadata{ID: id}, Data: tt.resource}, nil
+					})
+			}
+
+			resourceTypes := newTestResourceTypes(t, testResourceType{capabilities: tt.capabilities})
+			ctl, err := NewListSecrets(controller.Options{DatabaseClient: databaseClient}, dynamicResourceOptions, resourceTypes)
+			require.NoError(t, err)
+
+			ctx := v1.WithARMRequestContext(testcontext.New(t), &v1.ARMRequestContext{
+				ResourceID: resources.MustParse(testResourceID + "/listSecrets"),
+				APIVersion: defaultAPIVersion,
+			})
+			req := httptest.NewRequest(http.MethodPost, testResourceID+"/listSecrets?api-version="+defaultAPIVersion, nil)
+			w := httptest.NewRecorder()
+
+			response, err := ctl.Run(ctx, w, req)
+			require.NoError(t, err)
+			require.NoError(t, response.Apply(ctx, w, req))
+			require.Equal(t, tt.statusCode, w.Code)
+
+			if tt.expected != nil {
+				secrets := map[string]string{}
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &secrets))
+				require.Equal(t, tt.expected, secrets)
+			}
+		})
+	}
+}
@@ -17,7 +17,9 @@ limitations under the License.
 package frontend
 
 import (
+	"context"
 	"net/http"
+	"path"
 	"strings"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
@@ -45,7 +47,10 @@ import (
 // Resource Type: Applications.Example/customService
 //
 // This code ensures that the controller will be provided with the correct resource type.
-func dynamicOperationHandler(method v1.OperationMethod, baseOptions controller.Options, factory func(opts controller.Options) (controller.Controller, error)) http.HandlerFunc {
+//
+// The factory is called for each request with the context of the request, so the controller can be configured for a
+// single request.
+func dynamicOperationHandler(method v1.OperationMethod, baseOptions controller.Options, factory func(ctx context.Context, opts controller.Options) (controller.Controller, error)) http.HandlerFunc {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		id, err := resources.Parse(r.URL.Path)
 		if err != nil {
@@ -58,7 +63,20 @@ func dynamicOperationHandler(method v1.OperationMethod, baseOptions controller.O
 			return
 		}
 
-		operationType := v1.OperationType{Type: strings.ToUpper(id.Type()), Method: method}
+		// Custom actions declared by the resource type include the action name in the operation method,
+		// e.g. ACTIONRESTART.
+		operationMethod := method
+		if method == operationCustomAction {
+			operationMethod = v1.OperationMethod(string(operationCustomAction) + strings.ToUpper(path.Base(r.URL.Path)))
+		}
+
+		// Custom actions like listSecrets are appended to the resource ID. The resource type is the type of the resource
+		// that the action applies to.
+		if strings.HasPrefix(string(operationMethod), string(operationCustomAction)) {
+			id = id.Truncate()
+		}
+
+		operationType := v1.OperationType{Type: strings.ToUpper(id.Type()), Method: operationMethod}
 
 		// Copy the options and initalize them dynamically for this type.
 		opts := baseOptions
@@ -71,7 +89,7 @@ func dynamicOperationHandler(method v1.OperationMethod, baseOptions controller.O
 			opts.ResourceType = id.ProviderNamespace() + "/operationstatuses"
 		}
 
-		ctrl, err := factory(opts)
+		ctrl, err := factory(r.Context(), opts)
 		if err != nil {
 			result := rest.NewBadRequestResponse(err.Error())
 			err = result.Apply(r.Context(), w, r)
@@ -17,6 +17,7 @@ limitations under the License.
 package frontend
 
 import (
+	"context"
 	"strings"
 	"time"
 
@@ -24,12 +25,15 @@ import (
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/defaultoperation"
+	"github.com/radius-project/radius/pkg/dynamicrp/conversion"
 	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
 	"github.com/radius-project/radius/pkg/dynamicrp/datamodel/converter"
+	"github.com/radius-project/radius/pkg/dynamicrp/resourcetype"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/validator"
 )
 
-func (s *Service) registerRoutes(r *chi.Mux, controllerOptions controller.Options) error {
+func (s *Service) registerRoutes(r *chi.Mux, controllerOptions controller.Options, ucp *v20231001preview.ClientFactory) error {
 	// Return ARM errors for invalid requests.
 	r.NotFound(validator.APINotFoundHandler())
 	r.MethodNotAllowed(validator.APIMethodNotAllowedHandler())
@@ -53,7 +57,7 @@ func (s *Service) registerRoutes(r *chi.Mux, controllerOptions controller.Option
 		r.Route("/providers/{providerNamespace}", func(r chi.Router) {
 
 			// Plane-scoped LIST operation
-			r.Get("/{resourceType}", dynamicOperationHandler(v1.OperationPlaneScopeList, controllerOptions, makeListResourceAtPlaneScopeController))
+			r.Get("/{resourceType}", dynamicOperationHandler(v1.OperationPlaneScopeList, controllerOptions, makeListResourceAtPlaneScopeController(ucp)))
 
 			// Async operation status/results
 			r.Route("/locations/{locationName}", func(r chi.Router) {
@@ -64,10 +68,12 @@ func (s *Service) registerRoutes(r *chi.Mux, controllerOptions controller.Option
 
 		// Resource-group-scoped
 		r.Route("/{rg:resource[gG]roups}/{resourceGroupName}/providers/{providerNamespace}/{resourceType}", func(r chi.Router) {
-			r.Get("/", dynamicOperationHandler(v1.OperationList, controllerOptions, makeListResourceAtResourceGroupScopeController))
-			r.Get("/{resourceName}", dynamicOperationHandler(v1.OperationGet, controllerOptions, makeGetResourceController))
-			r.Put("/{resourceName}", dynamicOperationHandler(v1.OperationPut, controllerOptions, makePutResourceController))
-			r.Delete("/{resourceName}", dynamicOperationHandler(v1.OperationDelete, controllerOptions, makeDeleteResourceController))
+			r.Get("/", dynamicOperationHandler(v1.OperationList, controllerOptions, makeListResourceAtResourceGroupScopeController(ucp)))
+			r.Get("/{resourceName}", dynamicOperationHandler(v1.OperationGet, controllerOptions, makeGetResourceController(ucp)))
+			r.Put("/{resourceName}", dynamicOperationHandler(v1.OperationPut, controllerOptions, makePutResourceController(ucp)))
+			r.Delete("/{resourceName}", dynamicOperationHandler(v1.OperationDelete, controllerOptions, makeDeleteResourceController(ucp)))
+			r.Post("/{resourceName}/{action:list[Ss]ecrets}", dynamicOperationHandler(operationListSecrets, controllerOptions, makeListSecretsController(ucp)))
+			r.Post("/{resourceName}/{action}", dynamicOperationHandler(operationCustomAction, controllerOptions, makeActionController(ucp)))
 		})
 	})
 
@@ -81,33 +87,77 @@ var dynamicResourceOptions = controller.ResourceOptions[datamodel.DynamicResourc
 	AsyncOperationTimeout:    time.Hour * 24,
 }
 
-func makeListResourceAtPlaneScopeController(opts controller.Options) (controller.Controller, error) {
-	// At plane scope we list resources recursively to include all resource groups.
-	copy := dynamicResourceOptions
-	copy.ListRecursiveQuery = true
-	return defaultoperation.NewListResources(opts, copy)
+// newResourceOptions creates the options of the controllers of dynamic resources for a single request. Resources are
+// stored using the default API version of their resource type, and converted to the API version of the request.
+//
+// The controller factories create a resourcetype.Client for each request, so the resource type is fetched from UCP
+// once per request and shared by the filters, the converters and the controller.
+func newResourceOptions(ctx context.Context, resourceTypes *resourcetype.Client) controller.ResourceOptions[datamodel.DynamicResource] {
+	resourceOptions := dynamicResourceOptions
+	resourceOptions.ResponseConverter = convertResponse(ctx, conversion.NewConverter(resourceTypes, nil))
+	return resourceOptions
 }
 
-func makeListResourceAtResourceGroupScopeController(opts controller.Options) (controller.Controller, error) {
-	return defaultoperation.NewListResources(opts, dynamicResourceOptions)
+func makeListResourceAtPlaneScopeController(ucp *v20231001preview.ClientFactory) func(ctx context.Context, opts controller.Options) (controller.Controller, error) {
+	return func(ctx context.Context, opts controller.Options) (controller.Controller, error) {
+		// At plane scope we list resources recursively to include all resource groups.
+		resourceOptions := newResourceOptions(ctx, resourcetype.NewClient(ucp))
+		resourceOptions.ListRecursiveQuery = true
+		return defaultoperation.NewListResources(opts, resourceOptions)
+	}
 }
 
-func makeGetResourceController(opts controller.Options) (controller.Controller, error) {
-	return defaultoperation.NewGetResource(opts, dynamicResourceOptions)
+func makeListResourceAtResourceGroupScopeController(ucp *v20231001preview.ClientFactory) func(ctx context.Context, opts controller.Options) (controller.Controller, error) {
+	return func(ctx context.Context, opts controller.Options) (controller.Controller, error) {
+		return defaultoperation.NewListResources(opts, newResourceOptions(ctx, resourcetype.NewClient(ucp)))
+	}
 }
 
-func makePutResourceController(opts controller.Options) (controller.Controller, error) {
-	return defaultoperation.NewDefaultAsyncPut(opts, dynamicResourceOptions)
+func makeGetResourceController(ucp *v20231001preview.ClientFactory) func(ctx context.Context, opts controller.Options) (controller.Controller, error) {
+	return func(ctx context.Context, opts controller.Options) (controller.Controller, error) {
+		return defaultoperation.NewGetResource(opts, newResourceOptions(ctx, resourcetype.NewClient(ucp)))
+	}
 }
 
-func makeDeleteResourceController(opts controller.Options) (controller.Controller, error) {
-	return defaultoperation.NewDefaultAsyncDelete(opts, dynamicResourceOptions)
+func makePutResourceController(ucp *v20231001preview.ClientFactory) func(ctx context.Context, opts controller.Options) (controller.Controller, error) {
+	return func(ctx context.Context, opts controller.Options) (controller.Controller, error) {
+		resourceTypes := resourcetype.NewClient(ucp)
+		resourceOptions := newResourceOptions(ctx, resourceTypes)
+		resourceOptions.UpdateFilters = []controller.UpdateFilter[datamodel.DynamicResource]{
+			validateCapabilities(resourceTypes),
+			validateResourceSchema(resourceTypes),
+			convertRequest(conversion.NewConverter(resourceTypes, nil)),
+			preserveReadOnlyProperties(resourceTypes),
+			preserveSecretValues,
+		}
+		return defaultoperation.NewDefaultAsyncPut(opts, resourceOptions)
+	}
+}
+
+func makeDeleteResourceController(ucp *v20231001preview.ClientFactory) func(ctx context.Context, opts controller.Options) (controller.Controller, error) {
+	return func(ctx context.Context, opts controller.Options) (controller.Controller, error) {
+		return defaultoperation.NewDefaultAsyncDelete(opts, newResourceOptions(ctx, resourcetype.NewClient(ucp)))
+	}
+}
+
+func makeListSecretsController(ucp *v20231001preview.ClientFactory) func(ctx context.Context, opts controller.Options) (controller.Controller, error) {
+	return func(ctx context.Context, opts controller.Options) (controller.Controller, error) {
+		resourceTypes := resourcetype.NewClient(ucp)
+		return NewListSecrets(opts, newResourceOptions(ctx, resourceTypes), resourceTypes)
+	}
+}
+
+func makeActionController(ucp *v20231001preview.ClientFactory) func(ctx context.Context, opts controller.Options) (controller.Controller, error) {
+	return func(ctx context.Context, opts controller.Options) (controller.Controller, error) {
+		resourceTypes := resourcetype.NewClient(ucp)
+		return NewAction(opts, newResourceOptions(ctx, resourceTypes), resourceTypes)
+	}
 }
 
-func makeGetOperationResultController(opts controller.Options) (controller.Controller, error) {
+func makeGetOperationResultController(ctx context.Context, opts controller.Options) (controller.Controller, error) {
 	return defaultoperation.NewGetOperationResult(opts)
 }
 
-func makeGetOperationStatusController(opts controller.Options) (controller.Controller, error) {
+func makeGetOperationStatusController(ctx context.Context, opts controller.Options) (controller.Controller, error) {
 	return defaultoperation.NewGetOperationStatus(opts)
 }
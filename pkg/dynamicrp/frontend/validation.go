@@ -0,0 +1,167 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/azure/clientv2"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/dynamicrp/resourcetype"
+	"github.com/radius-project/radius/pkg/schema"
+	ucpdatamodel "github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+// ignoredSchemaProperties are properties managed by Radius that are not part of the user-defined schema.
+var ignoredSchemaProperties = []string{"provisioningState", "status"}
+
+// validateResourceSchema returns an UpdateFilter that validates the properties of a dynamic resource
+// against the schema registered in UCP for the resource type and API version of the request.
+//
+// Before validation the filter enforces the schema's handling of read-only and default values:
+//
+//   - Read-only properties provided by the client are discarded. Resource types with the ManualResourceProvisioning
+//     capability accept read-only properties from the client.
+//   - Default values are set for any properties that were not provided by the client.
+//
+// The read-only values stored on the existing resource are restored by preserveReadOnlyProperties once the request
+// has been converted to the default API version.
+func validateResourceSchema(resourceTypes *resourcetype.Client) controller.UpdateFilter[datamodel.DynamicResource] {
+	return func(ctx context.Context, newResource *datamodel.DynamicResource, oldResource *datamodel.DynamicResource, options *controller.Options) (rest.Response, error) {
+		serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+		apiVersion, err := resourceTypes.GetAPIVersion(ctx, serviceCtx.ResourceID, serviceCtx.APIVersion)
+		if clientv2.Is404Error(err) {
+			return rest.NewBadRequestARMResponse(v1.ErrorResponse{
+				Error: &v1.ErrorDetails{
+					Code:    v1.CodeInvalidApiVersionParameter,
+					Message: fmt.Sprintf("API version '%s' for type '%s' is not supported.", serviceCtx.APIVersion, serviceCtx.ResourceID.Type()),
+				},
+			}), nil
+		} else if err != nil {
+			return nil, err
+		}
+
+		var raw map[string]any
+		if apiVersion.Properties != nil {
+			raw = apiVersion.Properties.Schema
+		}
+
+		if newResource.Properties == nil {
+			newResource.Properties = map[string]any{}
+		}
+
+		resourceType, err := resourceTypes.Get(ctx, serviceCtx.ResourceID)
+		if err != nil {
+			return nil, err
+		}
+
+		// Read-only properties are the outputs of the resource. They are written by recipes, or by the
+		// client when the resource is provisioned manually.
+		manual := resourcetype.HasCapability(resourceType, ucpdatamodel.CapabilityManualResourceProvisioning)
+		if !manual {
+			err = schema.RemoveReadOnly(raw, newResource.Properties)
+			if err != nil {
+				return nil, fmt.Errorf("failed to remove read-only properties for %q: %w", serviceCtx.ResourceID.Type(), err)
+			}
+		}
+
+		err = schema.ApplyDefaults(raw, newResource.Properties)
+		if err != nil {
+			return nil, fmt.Errorf("failed to apply default values for %q: %w", serviceCtx.ResourceID.Type(), err)
+		}
+
+		properties := map[string]any{}
+		for key, value := range newResource.Properties {
+			properties[key] = value
+		}
+		for _, key := range ignoredSchemaProperties {
+			delete(properties, key)
+		}
+
+		errs, err := schema.ValidateProperties(raw, properties)
+		if err != nil {
+			return nil, fmt.Errorf("failed to validate resource against schema for %q: %w", serviceCtx.ResourceID.Type(), err)
+		} else if len(errs) > 0 {
+			return schemaValidationFailedResponse(serviceCtx.ResourceID.Type(), errs), nil
+		}
+
+		return nil, nil
+	}
+}
+
+// preserveReadOnlyProperties returns an UpdateFilter that copies the read-only properties of the existing resource
+// to the new resource. The existing resource is stored using the default API version of its resource type, so the
+// filter runs after the request is converted and uses the schema of the default API version.
+func preserveReadOnlyProperties(resourceTypes *resourcetype.Client) controller.UpdateFilter[datamodel.DynamicResource] {
+	return func(ctx context.Context, newResource *datamodel.DynamicResource, oldResource *datamodel.DynamicResource, options *controller.Options) (rest.Response, error) {
+		if oldResource == nil {
+			return nil, nil
+		}
+
+		serviceCtx := v1.ARMRequestContextFromContext(ctx)
+		resourceType, err := resourceTypes.Get(ctx, serviceCtx.ResourceID)
+		if err != nil {
+			return nil, err
+		}
+
+		// Manually provisioned resources receive their read-only properties from the client.
+		if resourcetype.HasCapability(resourceType, ucpdatamodel.CapabilityManualResourceProvisioning) {
+			return nil, nil
+		}
+
+		raw, err := resourceTypes.GetDefaultAPIVersionSchema(ctx, serviceCtx.ResourceID)
+		if err != nil {
+			return nil, err
+		}
+
+		if newResource.Properties == nil {
+			newResource.Properties = map[string]any{}
+		}
+
+		err = schema.PreserveReadOnly(raw, oldResource.Properties, newResource.Properties)
+		if err != nil {
+			return nil, fmt.Errorf("failed to preserve read-only properties for %q: %w", serviceCtx.ResourceID.Type(), err)
+		}
+
+		return nil, nil
+	}
+}
+
+func schemaValidationFailedResponse(resourceType string, errs schema.ValidationErrors) rest.Response {
+	details := []*v1.ErrorDetails{}
+	for _, err := range errs {
+		details = append(details, &v1.ErrorDetails{
+			Code:    v1.CodeInvalidProperties,
+			Target:  err.Path,
+			Message: err.Message,
+		})
+	}
+
+	return rest.NewBadRequestARMResponse(v1.ErrorResponse{
+		Error: &v1.ErrorDetails{
+			Code:    v1.CodeHTTPRequestPayloadAPISpecValidationFailed,
+			Target:  resourceType,
+			Message: "HTTP request payload failed validation against the resource type schema with one or more errors. Please see details for more information.",
+			Details: details,
+		},
+	})
+}
@@ -0,0 +1,276 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	armpolicy "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/policy"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/dynamicrp/resourcetype"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview/fake"
+	ucpdatamodel "github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testResourceID    = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Test/testResources/my-resource"
+	defaultAPIVersion = "2025-01-01"
+	renamedAPIVersion = "2024-01-01"
+)
+
+// testResourceType describes the resource type returned by the fake UCP server of newTestResourceTypes.
+type testResourceType struct {
+	capabilities []string
+	actions      map[string]*v20231001preview.ResourceTypeAction
+	apiVersions  map[string]*v20231001preview.APIVersionProperties
+}
+
+// newTestResourceTypes creates a resourcetype.Client backed by a fake UCP server that returns the given resource type
+// for every resource. The default API version of the resource type is defaultAPIVersion.
+func newTestResourceTypes(t *testing.T, resourceType testResourceType) *resourcetype.Client {
+	resourceTypesServer := fake.ResourceTypesServer{
+		Get: func(ctx context.Context, planeName, resourceProviderName, resourceTypeName string, options *v20231001preview.ResourceTypesClientGetOptions) (resp azfake.Responder[v20231001preview.ResourceTypesClientGetResponse], errResp azfake.ErrorResponder) {
+			properties := &v20231001preview.ResourceTypeProperties{
+				DefaultAPIVersion: to.Ptr(defaultAPIVersion),
+				Actions:           resourceType.actions,
+			}
+			for _, capability := range resourceType.capabilities {
+				properties.Capabilities = append(properties.Capabilities, to.Ptr(capability))
+			}
+
+			response := v20231001preview.ResourceTypesClientGetResponse{
+				ResourceTypeResource: v20231001preview.ResourceTypeResource{
+					Name:       to.Ptr(resourceTypeName),
+					Properties: properties,
+				},
+			}
+			resp.SetResponse(http.StatusOK, response, nil)
+			return
+		},
+	}
+
+	apiVersionsServer := fake.APIVersionsServer{
+		Get: func(ctx context.Context, planeName, resourceProviderName, resourceTypeName, apiVersionName string, options *v20231001preview.APIVersionsClientGetOptions) (resp azfake.Responder[v20231001preview.APIVersionsClientGetResponse], errResp azfake.ErrorResponder) {
+			properties, ok := resourceType.apiVersions[apiVersionName]
+			if !ok {
+				errResp.SetResponseError(http.StatusNotFound, v1.CodeNotFound)
+				return
+			}
+
+			response := v20231001preview.APIVersionsClientGetResponse{
+				APIVersionResource: v20231001preview.APIVersionResource{
+					Name:       to.Ptr(apiVersionName),
+					Properties: properties,
+				},
+			}
+			resp.SetResponse(http.StatusOK, response, nil)
+			return
+		},
+	}
+
+	ucp, err := v20231001preview.NewClientFactory(&aztoken.AnonymousCredential{}, &armpolicy.ClientOptions{
+		ClientOptions: policy.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				APIVersionsServer:   apiVersionsServer,
+				ResourceTypesServer: resourceTypesServer,
+			}),
+		},
+	})
+	require.NoError(t, err)
+
+	return resourcetype.NewClient(ucp)
+}
+
+// testContext creates a context for a request for the test resource with the given API version.
+func testContext(t *testing.T, apiVersion string) context.Context {
+	return v1.WithARMRequestContext(testcontext.New(t), &v1.ARMRequestContext{
+		ResourceID: resources.MustParse(testResourceID),
+		APIVersion: apiVersion,
+	})
+}
+
+// testSchemas returns the API versions of a resource type that renamed the read-only "host" property of the default
+// API version to "hostname".
+func testSchemas() map[string]*v20231001preview.APIVersionProperties {
+	return map[string]*v20231001preview.APIVersionProperties{
+		defaultAPIVersion: {
+			Schema: map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"size": map[string]any{"type": "string", "enum": []any{"S", "M", "L"}, "default": "S"},
+					"host": map[string]any{"type": "string", "readOnly": true},
+				},
+			},
+		},
+		renamedAPIVersion: {
+			Schema: map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"size":     map[string]any{"type": "string", "enum": []any{"S", "M", "L"}, "default": "S"},
+					"hostname": map[string]any{"type": "string", "readOnly": true},
+				},
+			},
+			Conversion: &v20231001preview.APIVersionConversion{
+				FieldMappings: []*v20231001preview.FieldMapping{
+					{From: to.Ptr("$.hostname"), To: to.Ptr("$.host")},
+				},
+			},
+		},
+	}
+}
+
+func Test_ValidateResourceSchema(t *testing.T) {
+	tests := []struct {
+		name         string
+		apiVersion   string
+		capabilities []string
+		properties   map[string]any
+		expected     map[string]any
+		statusCode   int
+	}{
+		{
+			name:       "applies defaults",
+			apiVersion: defaultAPIVersion,
+			properties: map[string]any{},
+			expected:   map[string]any{"size": "S"},
+		},
+		{
+			name:       "removes read-only properties",
+			apiVersion: defaultAPIVersion,
+			properties: map[string]any{"size": "M", "host": "example.com"},
+			expected:   map[string]any{"size": "M"},
+		},
+		{
+			name:         "manual provisioning accepts read-only properties",
+			apiVersion:   defaultAPIVersion,
+			capabilities: []string{ucpdatamodel.CapabilityManualResourceProvisioning},
+			properties:   map[string]any{"size": "M", "host": "example.com"},
+			expected:     map[string]any{"size": "M", "host": "example.com"},
+		},
+		{
+			name:       "invalid properties",
+			apiVersion: defaultAPIVersion,
+			properties: map[string]any{"size": "XL"},
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name:       "unknown API version",
+			apiVersion: "2000-01-01",
+			properties: map[string]any{},
+			statusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resourceTypes := newTestResourceTypes(t, testResourceType{capabilities: tt.capabilities, apiVersions: testSchemas()})
+			newResource := &datamodel.DynamicResource{Properties: tt.properties}
+
+			response, err := validateResourceSchema(resourceTypes)(testContext(t, tt.apiVersion), newResource, nil, &controller.Options{})
+			require.NoError(t, err)
+
+			if tt.statusCode != 0 {
+				require.NotNil(t, response)
+				require.Equal(t, tt.statusCode, responseStatusCode(t, response))
+				return
+			}
+
+			require.Nil(t, response)
+			require.Equal(t, tt.expected, newResource.Properties)
+		})
+	}
+}
+
+func Test_PreserveReadOnlyProperties(t *testing.T) {
+	oldResource := &datamodel.DynamicResource{
+		Properties: map[string]any{"size": "M", "host": "example.com"},
+	}
+
+	tests := []struct {
+		name         string
+		apiVersion   string
+		capabilities []string
+		oldResource  *datamodel.DynamicResource
+		properties   map[string]any
+		expected     map[string]any
+	}{
+		{
+			name:       "create",
+			apiVersion: defaultAPIVersion,
+			properties: map[string]any{"size": "L"},
+			expected:   map[string]any{"size": "L"},
+		},
+		{
+			name:        "default API version",
+			apiVersion:  defaultAPIVersion,
+			oldResource: oldResource,
+			properties:  map[string]any{"size": "L"},
+			expected:    map[string]any{"size": "L", "host": "example.com"},
+		},
+		{
+			// The request has been converted, so the stored read-only property is preserved by the name used in the
+			// default API version.
+			name:        "converted API version",
+			apiVersion:  renamedAPIVersion,
+			oldResource: oldResource,
+			properties:  map[string]any{"size": "L"},
+			expected:    map[string]any{"size": "L", "host": "example.com"},
+		},
+		{
+			name:         "manual provisioning",
+			apiVersion:   defaultAPIVersion,
+			capabilities: []string{ucpdatamodel.CapabilityManualResourceProvisioning},
+			oldResource:  oldResource,
+			properties:   map[string]any{"size": "L", "host": "other.example.com"},
+			expected:     map[string]any{"size": "L", "host": "other.example.com"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resourceTypes := newTestResourceTypes(t, testResourceType{capabilities: tt.capabilities, apiVersions: testSchemas()})
+			newResource := &datamodel.DynamicResource{Properties: tt.properties}
+
+			response, err := preserveReadOnlyProperties(resourceTypes)(testContext(t, tt.apiVersion), newResource, tt.oldResource, &controller.Options{})
+			require.NoError(t, err)
+			require.Nil(t, response)
+			require.Equal(t, tt.expected, newResource.Properties)
+		})
+	}
+}
+
+// responseStatusCode returns the status code written by the given response.
+func responseStatusCode(t *testing.T, response rest.Response) int {
+	w := httptest.NewRecorder()
+	err := response.Apply(testcontext.New(t), w, &http.Request{})
+	require.NoError(t, err)
+	return w.Code
+}
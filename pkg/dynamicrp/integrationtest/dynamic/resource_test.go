@@ -19,6 +19,7 @@ package dynamic
 import (
 	"context"
 	"net/http"
+	"strings"
 	"testing"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
@@ -27,6 +28,7 @@ import (
 	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/recipes/configloader"
 	"github.com/radius-project/radius/pkg/recipes/driver"
+	recipes_util "github.com/radius-project/radius/pkg/recipes/util"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
@@ -119,6 +121,10 @@ func Test_Dynamic_Resource_Inert_Lifecycle(t *testing.T) {
 	response = ucp.MakeRequest(http.MethodGet, "/planes/radius/testing/providers/Applications.Test/exampleInertResources"+"?api-version="+apiVersion, nil)
 	response.EqualsValue(200, expectedList)
 
+	// This resource type does not expose secrets.
+	response = ucp.MakeRequest(http.MethodPost, testInertResourceID+"/listSecrets?api-version="+apiVersion, nil)
+	response.EqualsErrorCode(http.StatusBadRequest, v1.CodeInvalid)
+
 	// Now lets delete the resource
 	response = ucp.MakeRequest(http.MethodDelete, testInertResourceURL, nil)
 	response.WaitForOperationComplete(nil)
@@ -128,6 +134,266 @@ func Test_Dynamic_Resource_Inert_Lifecycle(t *testing.T) {
 	response.EqualsErrorCode(404, v1.CodeNotFound)
 }
 
+// This test covers the validation of a dynamic resource against the schema of its resource type.
+func Test_Dynamic_Resource_Schema_Validation(t *testing.T) {
+	_, ucp := testhost.Start(t)
+
+	// Setup a resource provider (Applications.Test/exampleInertResources) with a schema.
+	createRadiusPlane(ucp)
+	createResourceProvider(ucp)
+	createInertResourceType(ucp)
+	createAPIVersionWithSchema(ucp, inertResourceTypeName, map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"environment": map[string]any{
+				"type": "string",
+			},
+			"size": map[string]any{
+				"type": "string",
+				"enum": []any{"S", "M", "L"},
+			},
+		},
+		"required": []any{"size"},
+	})
+	createLocation(ucp, inertResourceTypeName)
+
+	// Setup a resource group where we can interact with the new resource type.
+	createResourceGroup(ucp)
+
+	// Create a resource that doesn't match the schema.
+	resource := map[string]any{
+		"properties": map[string]any{
+			"size": "XL",
+		},
+	}
+
+	response := ucp.MakeTypedRequest(http.MethodPut, testInertResourceURL, resource)
+	response.EqualsErrorCode(400, v1.CodeHTTPRequestPayloadAPISpecValidationFailed)
+	require.Len(t, response.Error.Error.Details, 1)
+	require.Equal(t, v1.CodeInvalidProperties, response.Error.Error.Details[0].Code)
+	require.Equal(t, "$.properties.size", response.Error.Error.Details[0].Target)
+
+	// The resource should not have been created.
+	response = ucp.MakeRequest(http.MethodGet, testInertResourceURL, nil)
+	response.EqualsErrorCode(404, v1.CodeNotFound)
+
+	// Create a resource that matches the schema.
+	resource = map[string]any{
+		"properties": map[string]any{
+			"size": "M",
+		},
+	}
+
+	response = ucp.MakeTypedRequest(http.MethodPut, testInertResourceURL, resource)
+	response.WaitForOperationComplete(nil)
+
+	response = ucp.MakeRequest(http.MethodGet, testInertResourceURL, nil)
+	response.EqualsStatusCode(200)
+}
+
+// This test covers the handling of default values and read-only properties declared by the schema of a resource type.
+func Test_Dynamic_Resource_Schema_DefaultsAndReadOnly(t *testing.T) {
+	_, ucp := testhost.Start(t)
+
+	// Setup a resource provider (Applications.Test/exampleInertResources) with a schema.
+	createRadiusPlane(ucp)
+	createResourceProvider(ucp)
+	createInertResourceType(ucp)
+	createAPIVersionWithSchema(ucp, inertResourceTypeName, map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"size": map[string]any{
+				"type":    "string",
+				"default": "S",
+			},
+			"host": map[string]any{
+				"type":     "string",
+				"readOnly": true,
+			},
+		},
+	})
+	createLocation(ucp, inertResourceTypeName)
+
+	// Setup a resource group where we can interact with the new resource type.
+	createResourceGroup(ucp)
+
+	// Read-only properties provided by the client are discarded and defaults are applied.
+	resource := map[string]any{
+		"properties": map[string]any{
+			"host": "example.com",
+		},
+	}
+
+	response := ucp.MakeTypedRequest(http.MethodPut, testInertResourceURL, resource)
+	response.WaitForOperationComplete(nil)
+
+	expectedResource := map[string]any{
+		"id":       "/planes/radius/testing/resourcegroups/test-group/providers/Applications.Test/exampleInertResources/my-inert-example",
+		"location": "global",
+		"name":     "my-inert-example",
+		"properties": map[string]any{
+			"size":              "S",
+			"provisioningState": "Succeeded",
+		},
+		"type": "Applications.Test/exampleInertResources",
+	}
+
+	response = ucp.MakeRequest(http.MethodGet, testInertResourceURL, nil)
+	response.EqualsValue(200, expectedResource)
+}
+
+func Test_Dynamic_Resource_APIVersionConversion(t *testing.T) {
+	_, ucp := testhost.Start(t)
+
+	const previousAPIVersion = "2023-01-01"
+	ctx := context.Background()
+
+	// Setup a resource provider (Applications.Test/exampleInertResources) where the previous API version
+	// renames a field of the default API version.
+	createRadiusPlane(ucp)
+	createResourceProvider(ucp)
+
+	resourceType := v20231001preview.ResourceTypeResource{
+		Properties: &v20231001preview.ResourceTypeProperties{
+			DefaultAPIVersion: to.Ptr(apiVersion),
+		},
+	}
+	resourceTypePoller, err := ucp.UCP().NewResourceTypesClient().BeginCreateOrUpdate(ctx, radiusPlaneName, resourceProviderNamespace, inertResourceTypeName, resourceType, nil)
+	require.NoError(t, err)
+	_, err = resourceTypePoller.PollUntilDone(ctx, nil)
+	require.NoError(t, err)
+
+	createAPIVersion(ucp, inertResourceTypeName)
+
+	previous := v20231001preview.APIVersionResource{
+		Properties: &v20231001preview.APIVersionProperties{
+			Conversion: &v20231001preview.APIVersionConversion{
+				FieldMappings: []*v20231001preview.FieldMapping{
+					{From: to.Ptr("$.hostname"), To: to.Ptr("$.host")},
+				},
+			},
+		},
+	}
+	apiVersionPoller, err := ucp.UCP().NewAPIVersionsClient().BeginCreateOrUpdate(ctx, radiusPlaneName, resourceProviderNamespace, inertResourceTypeName, previousAPIVersion, previous, nil)
+	require.NoError(t, err)
+	_, err = apiVersionPoller.PollUntilDone(ctx, nil)
+	require.NoError(t, err)
+
+	location := v20231001preview.LocationResource{
+		Properties: &v20231001preview.LocationProperties{
+			ResourceTypes: map[string]*v20231001preview.LocationResourceType{
+				inertResourceTypeName: {
+					APIVersions: map[string]map[string]any{
+						apiVersion:         {},
+						previousAPIVersion: {},
+					},
+				},
+			},
+		},
+	}
+	locationPoller, err := ucp.UCP().NewLocationsClient().BeginCreateOrUpdate(ctx, radiusPlaneName, resourceProviderNamespace, locationName, location, nil)
+	require.NoError(t, err)
+	_, err = locationPoller.PollUntilDone(ctx, nil)
+	require.NoError(t, err)
+
+	createResourceGroup(ucp)
+
+	// Create the resource using the previous API version.
+	resource := map[string]any{
+		"properties": map[string]any{
+			"hostname": "example.com",
+		},
+	}
+
+	previousURL := testInertResourceID + "?api-version=" + previousAPIVersion
+	response := ucp.MakeTypedRequest(http.MethodPut, previousURL, resource)
+	response.WaitForOperationComplete(nil)
+
+	expectedResource := map[string]any{
+		"id":       "/planes/radius/testing/resourcegroups/test-group/providers/Applications.Test/exampleInertResources/my-inert-example",
+		"location": "global",
+		"name":     "my-inert-example",
+		"properties": map[string]any{
+			"host":              "example.com",
+			"provisioningState": "Succeeded",
+		},
+		"type": "Applications.Test/exampleInertResources",
+	}
+
+	// The resource is returned in the shape of the default API version.
+	response = ucp.MakeRequest(http.MethodGet, testInertResourceURL, nil)
+	response.EqualsValue(200, expectedResource)
+
+	// The resource is returned in the shape of the previous API version.
+	expectedResource["properties"] = map[string]any{
+		"hostname":          "example.com",
+		"provisioningState": "Succeeded",
+	}
+	response = ucp.MakeRequest(http.MethodGet, previousURL, nil)
+	response.EqualsValue(200, expectedResource)
+}
+
+func Test_Dynamic_Resource_Capabilities(t *testing.T) {
+	_, ucp := testhost.Start(t)
+
+	// Setup a resource provider (Applications.Test/exampleInertResources) where resources are provisioned by
+	// the user and must belong to an application.
+	createRadiusPlane(ucp)
+	createResourceProvider(ucp)
+	createResourceTypeWithCapabilities(ucp, inertResourceTypeName, datamodel.CapabilityManualResourceProvisioning, datamodel.CapabilityApplicationScopedOnly)
+	createAPIVersionWithSchema(ucp, inertResourceTypeName, map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"application": map[string]any{
+				"type": "string",
+			},
+			"host": map[string]any{
+				"type":     "string",
+				"readOnly": true,
+			},
+		},
+	})
+	createLocation(ucp, inertResourceTypeName)
+
+	createResourceGroup(ucp)
+
+	// The resource must belong to an application.
+	resource := map[string]any{
+		"properties": map[string]any{
+			"host": "example.com",
+		},
+	}
+
+	response := ucp.MakeTypedRequest(http.MethodPut, testInertResourceURL, resource)
+	response.EqualsErrorCode(http.StatusBadRequest, v1.CodeInvalidProperties)
+
+	// The outputs of a manually provisioned resource are supplied by the user.
+	resource = map[string]any{
+		"properties": map[string]any{
+			"application": testResourceGroupID + "/providers/Applications.Core/applications/my-app",
+			"host":        "example.com",
+		},
+	}
+
+	response = ucp.MakeTypedRequest(http.MethodPut, testInertResourceURL, resource)
+	response.WaitForOperationComplete(nil)
+
+	expectedResource := map[string]any{
+		"id":       "/planes/radius/testing/resourcegroups/test-group/providers/Applications.Test/exampleInertResources/my-inert-example",
+		"location": "global",
+		"name":     "my-inert-example",
+		"properties": map[string]any{
+			"application":       testResourceGroupID + "/providers/Applications.Core/applications/my-app",
+			"host":              "example.com",
+			"provisioningState": "Succeeded",
+		},
+		"type": "Applications.Test/exampleInertResources",
+	}
+
+	response = ucp.MakeRequest(http.MethodGet, testInertResourceURL, nil)
+	response.EqualsValue(200, expectedResource)
+}
+
 func Test_Dynamic_Resource_Recipe_Lifecycle(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mockDriver := driver.NewMockDriver(ctrl)
@@ -230,7 +496,6 @@ func Test_Dynamic_Resource_Recipe_Lifecycle(t *testing.T) {
 				"binding": map[string]any{
 					"port":     float64(8080), // This is an artifact of the JSON unmarshal process. It's wierd but intended.
 					"hostname": "example.com",
-					"password": "v3ryS3cr3t", // TODO: See comments in dynamicresource.go
 				},
 				"outputResources": []any{
 					map[string]any{
@@ -268,6 +533,12 @@ func Test_Dynamic_Resource_Recipe_Lifecycle(t *testing.T) {
 	response = ucp.MakeRequest(http.MethodGet, "/planes/radius/testing/providers/Applications.Test/exampleRecipeResources"+"?api-version="+apiVersion, nil)
 	response.EqualsValue(200, expectedList)
 
+	// Secrets are returned separately from the resource body.
+	response = ucp.MakeRequest(http.MethodPost, testRecipeResourceID+"/listSecrets?api-version="+apiVersion, nil)
+	response.EqualsValue(200, map[string]any{
+		"password": "v3ryS3cr3t",
+	})
+
 	// Now lets delete the resource
 	response = ucp.MakeRequest(http.MethodDelete, testRecipeResourceURL, nil)
 	response.WaitForOperationComplete(nil)
@@ -277,6 +548,235 @@ func Test_Dynamic_Resource_Recipe_Lifecycle(t *testing.T) {
 	response.EqualsErrorCode(404, v1.CodeNotFound)
 }
 
+func Test_Dynamic_Resource_Recipe_Import(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockDriver := driver.NewMockDriver(ctrl)
+	mockConfigLoader := configloader.NewMockConfigurationLoader(ctrl)
+
+	_, ucp := testhost.Start(t, testhost.TestHostOptionFunc(func(options *dynamicrp.Options) {
+		options.Recipes.Drivers = map[string]func(options *dynamicrp.Options) (driver.Driver, error){
+			"test": func(options *dynamicrp.Options) (driver.Driver, error) {
+				return mockDriver, nil
+			},
+		}
+		options.Recipes.ConfigurationLoader = mockConfigLoader
+	}))
+
+	createRadiusPlane(ucp)
+	createResourceProvider(ucp)
+	createRecipeResourceType(ucp)
+	createAPIVersion(ucp, recipeResourceTypeName)
+	createLocation(ucp, recipeResourceTypeName)
+
+	createResourceGroup(ucp)
+
+	mockConfigLoader.EXPECT().
+		LoadRecipe(gomock.Any(), gomock.Any()).
+		Return(&recipes.EnvironmentDefinition{
+			Name:         "default",
+			Driver:       "test",
+			ResourceType: "Applications.Test/exampleRecipeResources",
+			TemplatePath: "test-path",
+		}, nil).
+		AnyTimes()
+	mockConfigLoader.EXPECT().
+		LoadConfiguration(gomock.Any(), gomock.Any()).
+		Return(&recipes.Configuration{}, nil).
+		AnyTimes()
+
+	importedResourceID := "/planes/example/testing/providers/Test.Namespace/testResource/example"
+
+	// The imported resources are passed to the driver, with the secrets provided by the client.
+	mockDriver.EXPECT().
+		Execute(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, opts driver.ExecuteOptions) (*recipes.RecipeOutput, error) {
+			require.Equal(t, &recipes.RecipeImport{
+				Resources: []recipes.ImportedResource{{ID: importedResourceID}},
+				Values:    map[string]any{"hostname": "example.com"},
+				Secrets:   map[string]any{"password": "v3ryS3cr3t"},
+			}, opts.Import)
+
+			return &recipes.RecipeOutput{
+				Resources: []string{importedResourceID},
+				Values:    opts.Import.Values,
+				Secrets:   opts.Import.Secrets,
+				Status:    &rpv1.RecipeStatus{TemplateKind: "test", TemplatePath: "test-path"},
+			}, nil
+		}).
+		Times(1)
+
+	resource := map[string]any{
+		"properties": map[string]any{
+			"recipe": map[string]any{
+				"name": "default",
+				"import": map[string]any{
+					"resources": []any{
+						map[string]any{"id": importedResourceID},
+					},
+					"values":  map[string]any{"hostname": "example.com"},
+					"secrets": map[string]any{"password": "v3ryS3cr3t"},
+				},
+			},
+		},
+	}
+
+	response := ucp.MakeTypedRequest(http.MethodPut, testRecipeResourceURL, resource)
+	require.NotContains(t, response.Body.String(), "v3ryS3cr3t")
+	response.WaitForOperationComplete(nil)
+
+	// The secrets of the imported resources are never returned, and are not kept in the recipe of the resource.
+	expectedResource := map[string]any{
+		"id":       "/planes/radius/testing/resourcegroups/test-group/providers/Applications.Test/exampleRecipeResources/my-recipe-example",
+		"location": "global",
+		"name":     "my-recipe-example",
+		"properties": map[string]any{
+			"provisioningState": "Succeeded",
+			"recipe": map[string]any{
+				"name": "default",
+				"import": map[string]any{
+					"resources": []any{
+						map[string]any{"id": importedResourceID},
+					},
+					"values": map[string]any{"hostname": "example.com"},
+				},
+				"recipeStatus": "success",
+			},
+			"status": map[string]any{
+				"binding": map[string]any{
+					"hostname": "example.com",
+				},
+				"outputResources": []any{
+					map[string]any{
+						"id":            importedResourceID,
+						"localID":       "",
+						"radiusManaged": true,
+					},
+				},
+				"recipe": map[string]any{
+					"templateKind": "test",
+					"templatePath": "test-path",
+				},
+			},
+		},
+		"type": "Applications.Test/exampleRecipeResources",
+	}
+
+	response = ucp.MakeRequest(http.MethodGet, testRecipeResourceURL, nil)
+	response.EqualsValue(200, expectedResource)
+
+	// The secrets of the imported resources are the secrets of the resource once it is deployed.
+	response = ucp.MakeRequest(http.MethodPost, testRecipeResourceID+"/listSecrets?api-version="+apiVersion, nil)
+	response.EqualsValue(200, map[string]any{
+		"password": "v3ryS3cr3t",
+	})
+}
+
+func Test_Dynamic_Resource_Recipe_Action(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockDriver := driver.NewMockDriver(ctrl)
+	mockConfigLoader := configloader.NewMockConfigurationLoader(ctrl)
+
+	_, ucp := testhost.Start(t, testhost.TestHostOptionFunc(func(options *dynamicrp.Options) {
+		options.Recipes.Drivers = map[string]func(options *dynamicrp.Options) (driver.Driver, error){
+			"test": func(options *dynamicrp.Options) (driver.Driver, error) {
+				return mockDriver, nil
+			},
+		}
+		options.Recipes.ConfigurationLoader = mockConfigLoader
+	}))
+
+	// Setup a resource provider (Applications.Test/exampleRecipeResources) with a "restart" action.
+	createRadiusPlane(ucp)
+	createResourceProvider(ucp)
+	createRecipeResourceType(ucp)
+	createAPIVersion(ucp, recipeResourceTypeName)
+	createLocation(ucp, recipeResourceTypeName)
+
+	createResourceGroup(ucp)
+
+	mockConfigLoader.EXPECT().
+		LoadRecipe(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, recipe *recipes.ResourceMetadata) (*recipes.EnvironmentDefinition, error) {
+			return &recipes.EnvironmentDefinition{
+				Name:         recipe.Name,
+				Driver:       "test",
+				ResourceType: "Applications.Test/exampleRecipeResources",
+				TemplatePath: "test-path",
+			}, nil
+		}).
+		AnyTimes()
+	mockConfigLoader.EXPECT().
+		LoadConfiguration(gomock.Any(), gomock.Any()).
+		Return(&recipes.Configuration{}, nil).
+		AnyTimes()
+
+	gomock.InOrder(
+		mockDriver.EXPECT().
+			Execute(gomock.Any(), gomock.Any()).
+			Return(&recipes.RecipeOutput{}, nil).
+			Times(1),
+
+		// The action is handled by the recipe of the action, with the context of the resource.
+		mockDriver.EXPECT().
+			Execute(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, opts driver.ExecuteOptions) (*recipes.RecipeOutput, error) {
+				require.Equal(t, "restart", opts.Recipe.Name)
+				require.Equal(t, "restart", opts.Recipe.Action)
+				require.True(t, strings.EqualFold(testRecipeResourceID, opts.Recipe.ResourceID))
+				return &recipes.RecipeOutput{}, nil
+			}).
+			Times(1),
+
+		mockDriver.EXPECT().
+			Execute(gomock.Any(), gomock.Any()).
+			Return(nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, "failed to restart", recipes_util.ExecutionError)).
+			Times(1),
+	)
+
+	resource := map[string]any{
+		"properties": map[string]any{
+			"foo": "bar",
+		},
+	}
+
+	response := ucp.MakeTypedRequest(http.MethodPut, testRecipeResourceURL, resource)
+	response.WaitForOperationComplete(nil)
+
+	// Actions that are not declared by the resource type are rejected.
+	response = ucp.MakeRequest(http.MethodPost, testRecipeResourceID+"/backup?api-version="+apiVersion, nil)
+	response.EqualsErrorCode(http.StatusBadRequest, v1.CodeInvalid)
+
+	// Action names are case-insensitive.
+	response = ucp.MakeRequest(http.MethodPost, testRecipeResourceID+"/Restart?api-version="+apiVersion, nil)
+	require.Equal(t, http.StatusAccepted, response.Raw.StatusCode)
+
+	response.WaitForOperationComplete(nil)
+
+	status := v1.AsyncOperationStatus{}
+	ucp.MakeRequest(http.MethodGet, response.Raw.Header.Get("Azure-AsyncOperation"), nil).ReadAs(&status)
+	require.Equal(t, v1.ProvisioningStateSucceeded, status.Status)
+
+	// A failed action fails the operation of the action.
+	response = ucp.MakeRequest(http.MethodPost, testRecipeResourceID+"/restart?api-version="+apiVersion, nil)
+	require.Equal(t, http.StatusAccepted, response.Raw.StatusCode)
+
+	response.WaitForOperationComplete(nil)
+
+	status = v1.AsyncOperationStatus{}
+	ucp.MakeRequest(http.MethodGet, response.Raw.Header.Get("Azure-AsyncOperation"), nil).ReadAs(&status)
+	require.Equal(t, v1.ProvisioningStateFailed, status.Status)
+	require.Equal(t, recipes.RecipeDeploymentFailed, status.Error.Code)
+
+	// The resource is not modified by the actions, including its provisioningState.
+	response = ucp.MakeRequest(http.MethodGet, testRecipeResourceURL, nil)
+	response.EqualsStatusCode(http.StatusOK)
+
+	body := map[string]any{}
+	response.ReadAs(&body)
+	require.Equal(t, "bar", body["properties"].(map[string]any)["foo"])
+	require.Equal(t, string(v1.ProvisioningStateSucceeded), body["properties"].(map[string]any)["provisioningState"])
+}
+
 func createRadiusPlane(server *ucptesthost.TestHost) v20231001preview.RadiusPlanesClientCreateOrUpdateResponse {
 	ctx := context.Background()
 
@@ -338,6 +838,10 @@ func createRecipeResourceType(server *ucptesthost.TestHost) {
 		Properties: &v20231001preview.ResourceTypeProperties{
 			Capabilities: []*string{
 				to.Ptr(datamodel.CapabilitySupportsRecipes),
+				to.Ptr(datamodel.CapabilityExposesSecrets),
+			},
+			Actions: map[string]*v20231001preview.ResourceTypeAction{
+				"restart": {Description: to.Ptr("Restarts the resource.")},
 			},
 		},
 	}
@@ -350,11 +854,34 @@ func createRecipeResourceType(server *ucptesthost.TestHost) {
 	require.NoError(server.T(), err)
 }
 
+func createResourceTypeWithCapabilities(server *ucptesthost.TestHost, resourceTypeName string, capabilities ...string) {
+	ctx := context.Background()
+
+	resourceType := v20231001preview.ResourceTypeResource{
+		Properties: &v20231001preview.ResourceTypeProperties{
+			Capabilities: to.SliceOfPtrs(capabilities...),
+		},
+	}
+
+	client := server.UCP().NewResourceTypesClient()
+	poller, err := client.BeginCreateOrUpdate(ctx, radiusPlaneName, resourceProviderNamespace, resourceTypeName, resourceType, nil)
+	require.NoError(server.T(), err)
+
+	_, err = poller.PollUntilDone(ctx, nil)
+	require.NoError(server.T(), err)
+}
+
 func createAPIVersion(server *ucptesthost.TestHost, resourceType string) {
+	createAPIVersionWithSchema(server, resourceType, nil)
+}
+
+func createAPIVersionWithSchema(server *ucptesthost.TestHost, resourceType string, schema map[string]any) {
 	ctx := context.Background()
 
 	apiVersionResource := v20231001preview.APIVersionResource{
-		Properties: &v20231001preview.APIVersionProperties{},
+		Properties: &v20231001preview.APIVersionProperties{
+			Schema: schema,
+		},
 	}
 
 	client := server.UCP().NewAPIVersionsClient()
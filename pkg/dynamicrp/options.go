@@ -137,6 +137,7 @@ func (o *Options) RecipeEngine() (engine.Engine, error) {
 		o.Recipes.Drivers = map[string]func(options *Options) (driver.Driver, error){
 			recipes.TemplateKindBicep:     bicepDriver,
 			recipes.TemplateKindTerraform: terraformDriver,
+			recipes.TemplateKindHelm:      helmDriver,
 		}
 	}
 
@@ -170,6 +171,15 @@ func bicepDriver(options *Options) (driver.Driver, error) {
 		return nil, err
 	}
 
+	deploymentOperationsClient, err := clients.NewResourceDeploymentOperationsClient(&clients.Options{
+		Cred:             &aztoken.AnonymousCredential{},
+		BaseURI:          options.UCP.Endpoint(),
+		ARMClientOptions: sdk.NewClientOptions(options.UCP),
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	provider, err := sdk_cred.NewAzureCredentialProvider(options.SecretProvider, options.UCP, &aztoken.AnonymousCredential{})
 	if err != nil {
 		return nil, err
@@ -195,6 +205,7 @@ func bicepDriver(options *Options) (driver.Driver, error) {
 	return driver.NewBicepDriver(
 		sdk.NewClientOptions(options.UCP),
 		deploymentEngineClient,
+		deploymentOperationsClient,
 		resourceClient,
 		driver.BicepOptions{
 			DeleteRetryCount:        bicepDeleteRetryCount,
@@ -207,6 +218,16 @@ func terraformDriver(options *Options) (driver.Driver, error) {
 		options.UCP,
 		options.SecretProvider,
 		driver.TerraformOptions{
-			Path: options.Config.Terraform.Path,
+			Path:               options.Config.Terraform.Path,
+			BinaryPath:         options.Config.Terraform.BinaryPath,
+			TofuBinaryPath:     options.Config.Terraform.TofuBinaryPath,
+			TofuSigningKeyPath: options.Config.Terraform.TofuSigningKeyPath,
+			MirrorDir:          options.Config.Terraform.MirrorDir,
+			CacheDisabled:      options.Config.Terraform.Cache.Disabled,
+			CacheMaxSizeBytes:  options.Config.Terraform.Cache.MaxSizeMB * 1024 * 1024,
 		}, *options.KubernetesProvider), nil
 }
+
+func helmDriver(options *Options) (driver.Driver, error) {
+	return driver.NewHelmDriver(options.KubernetesProvider), nil
+}
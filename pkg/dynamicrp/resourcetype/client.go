@@ -0,0 +1,141 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcetype
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/radius-project/radius/pkg/azure/clientv2"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// Client fetches the resource types of dynamic resources and their API versions from UCP.
+//
+// A Client caches the resource types and API versions it fetches, so it should be created for a single request or
+// operation. Create a new Client for each request so that changes to resource types are picked up.
+type Client struct {
+	ucp *v20231001preview.ClientFactory
+
+	mu            sync.Mutex
+	resourceTypes map[string]*v20231001preview.ResourceTypeResource
+	apiVersions   map[string]*v20231001preview.APIVersionResource
+}
+
+// NewClient creates a new Client.
+func NewClient(ucp *v20231001preview.ClientFactory) *Client {
+	return &Client{
+		ucp:           ucp,
+		resourceTypes: map[string]*v20231001preview.ResourceTypeResource{},
+		apiVersions:   map[string]*v20231001preview.APIVersionResource{},
+	}
+}
+
+// Get fetches the resource type of the resource with the given ID.
+func (c *Client) Get(ctx context.Context, id resources.ID) (*v20231001preview.ResourceTypeResource, error) {
+	planeName, providerNamespace, resourceTypeName := names(id)
+	key := strings.ToLower(planeName + "/" + id.Type())
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if resourceType, ok := c.resourceTypes[key]; ok {
+		return resourceType, nil
+	}
+
+	response, err := c.ucp.NewResourceTypesClient().Get(ctx, planeName, providerNamespace, resourceTypeName, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	c.resourceTypes[key] = &response.ResourceTypeResource
+	return &response.ResourceTypeResource, nil
+}
+
+// GetAPIVersion fetches the given API version of the resource type of the resource with the given ID.
+func (c *Client) GetAPIVersion(ctx context.Context, id resources.ID, apiVersion string) (*v20231001preview.APIVersionResource, error) {
+	planeName, providerNamespace, resourceTypeName := names(id)
+	key := strings.ToLower(planeName + "/" + id.Type() + "@" + apiVersion)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if version, ok := c.apiVersions[key]; ok {
+		return version, nil
+	}
+
+	response, err := c.ucp.NewAPIVersionsClient().Get(ctx, planeName, providerNamespace, resourceTypeName, apiVersion, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	c.apiVersions[key] = &response.APIVersionResource
+	return &response.APIVersionResource, nil
+}
+
+// GetDefaultAPIVersionSchema fetches the schema of the default API version of the resource type of the resource with
+// the given ID. Resources are stored using the default API version. It returns nil if the resource type does not
+// declare a default API version, or the API version does not exist or does not declare a schema.
+func (c *Client) GetDefaultAPIVersionSchema(ctx context.Context, id resources.ID) (map[string]any, error) {
+	resourceType, err := c.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if resourceType.Properties == nil || resourceType.Properties.DefaultAPIVersion == nil {
+		return nil, nil
+	}
+
+	version, err := c.GetAPIVersion(ctx, id, *resourceType.Properties.DefaultAPIVersion)
+	if clientv2.Is404Error(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if version.Properties == nil {
+		return nil, nil
+	}
+
+	return version.Properties.Schema, nil
+}
+
+// HasCapability determines if a resource type has a specific capability.
+func HasCapability(resourceType *v20231001preview.ResourceTypeResource, capability string) bool {
+	if resourceType == nil || resourceType.Properties == nil {
+		return false
+	}
+
+	for _, c := range resourceType.Properties.Capabilities {
+		if c != nil && *c == capability {
+			return true
+		}
+	}
+
+	return false
+}
+
+// names returns the plane name, provider namespace and resource type name of the resource with the given ID as
+// expected by the UCP resource type APIs.
+func names(id resources.ID) (string, string, string) {
+	providerNamespace := id.ProviderNamespace()
+	planeName := id.ScopeSegments()[0].Name
+	resourceTypeName := strings.TrimPrefix(id.Type(), providerNamespace+resources.SegmentSeparator)
+	return planeName, providerNamespace, resourceTypeName
+}
@@ -0,0 +1,256 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcetype
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	armpolicy "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/policy"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview/fake"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testResourceID    = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Test/testResources/my-resource"
+	noSchemaID        = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Test/noSchemaResources/my-resource"
+	noDefaultID       = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Test/noDefaultResources/my-resource"
+	unknownID         = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Test/unknownResources/my-resource"
+	defaultAPIVersion = "2025-01-01"
+)
+
+// requestCounts counts the requests made to the fake UCP servers.
+type requestCounts struct {
+	resourceTypes int
+	apiVersions   int
+}
+
+func Test_Client_Get(t *testing.T) {
+	ucp, counts := testUCPClientFactory(t)
+	client := NewClient(ucp)
+
+	resourceType, err := client.Get(context.Background(), resources.MustParse(testResourceID))
+	require.NoError(t, err)
+	require.Equal(t, "testResources", to.String(resourceType.Name))
+
+	// The resource type is cached, including for other resources of the same type.
+	other := "/planes/radius/local/resourceGroups/other-group/providers/Applications.Test/testResources/other-resource"
+	cached, err := client.Get(context.Background(), resources.MustParse(other))
+	require.NoError(t, err)
+	require.Same(t, resourceType, cached)
+	require.Equal(t, 1, counts.resourceTypes)
+}
+
+func Test_Client_Get_NotFound(t *testing.T) {
+	ucp, _ := testUCPClientFactory(t)
+	client := NewClient(ucp)
+
+	_, err := client.Get(context.Background(), resources.MustParse(unknownID))
+	require.Error(t, err)
+}
+
+func Test_Client_GetAPIVersion(t *testing.T) {
+	ucp, counts := testUCPClientFactory(t)
+	client := NewClient(ucp)
+	id := resources.MustParse(testResourceID)
+
+	version, err := client.GetAPIVersion(context.Background(), id, defaultAPIVersion)
+	require.NoError(t, err)
+	require.Equal(t, defaultAPIVersion, to.String(version.Name))
+
+	_, err = client.GetAPIVersion(context.Background(), id, defaultAPIVersion)
+	require.NoError(t, err)
+	require.Equal(t, 1, counts.apiVersions)
+}
+
+func Test_Client_GetDefaultAPIVersionSchema(t *testing.T) {
+	tests := []struct {
+		name       string
+		resourceID string
+		want       map[string]any
+		wantErr    bool
+	}{
+		{
+			name:       "schema",
+			resourceID: testResourceID,
+			want:       map[string]any{"type": "object"},
+		},
+		{
+			name:       "no schema",
+			resourceID: noSchemaID,
+			want:       nil,
+		},
+		{
+			name:       "no default API version",
+			resourceID: noDefaultID,
+			want:       nil,
+		},
+		{
+			name:       "unknown resource type",
+			resourceID: unknownID,
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ucp, _ := testUCPClientFactory(t)
+			client := NewClient(ucp)
+
+			schema, err := client.GetDefaultAPIVersionSchema(context.Background(), resources.MustParse(tt.resourceID))
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, schema)
+		})
+	}
+}
+
+func Test_HasCapability(t *testing.T) {
+	tests := []struct {
+		name         string
+		resourceType *v20231001preview.ResourceTypeResource
+		capability   string
+		want         bool
+	}{
+		{
+			name: "has capability",
+			resourceType: &v20231001preview.ResourceTypeResource{
+				Properties: &v20231001preview.ResourceTypeProperties{
+					Capabilities: []*string{to.Ptr("capability1"), to.Ptr("capability2")},
+				},
+			},
+			capability: "capability1",
+			want:       true,
+		},
+		{
+			name: "does not have capability",
+			resourceType: &v20231001preview.ResourceTypeResource{
+				Properties: &v20231001preview.ResourceTypeProperties{
+					Capabilities: []*string{to.Ptr("capability1"), to.Ptr("capability2")},
+				},
+			},
+			capability: "capability3",
+			want:       false,
+		},
+		{
+			name: "nil capabilities",
+			resourceType: &v20231001preview.ResourceTypeResource{
+				Properties: &v20231001preview.ResourceTypeProperties{
+					Capabilities: nil,
+				},
+			},
+			capability: "capability1",
+			want:       false,
+		},
+		{
+			name: "empty capabilities",
+			resourceType: &v20231001preview.ResourceTypeResource{
+				Properties: &v20231001preview.ResourceTypeProperties{
+					Capabilities: []*string{},
+				},
+			},
+			capability: "capability1",
+			want:       false,
+		},
+		{
+			name:         "nil properties",
+			resourceType: &v20231001preview.ResourceTypeResource{},
+			capability:   "capability1",
+			want:         false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := HasCapability(tt.resourceType, tt.capability)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func testUCPClientFactory(t *testing.T) (*v20231001preview.ClientFactory, *requestCounts) {
+	counts := &requestCounts{}
+
+	resourceTypesServer := fake.ResourceTypesServer{
+		Get: func(ctx context.Context, planeName, resourceProviderName, resourceTypeName string, options *v20231001preview.ResourceTypesClientGetOptions) (resp azfake.Responder[v20231001preview.ResourceTypesClientGetResponse], errResp azfake.ErrorResponder) {
+			counts.resourceTypes++
+
+			properties := &v20231001preview.ResourceTypeProperties{}
+			switch resourceTypeName {
+			case "testResources", "noSchemaResources":
+				properties.DefaultAPIVersion = to.Ptr(defaultAPIVersion)
+			case "noDefaultResources":
+			default:
+				errResp.SetResponseError(http.StatusNotFound, v1.CodeNotFound)
+				return
+			}
+
+			response := v20231001preview.ResourceTypesClientGetResponse{
+				ResourceTypeResource: v20231001preview.ResourceTypeResource{
+					Name:       to.Ptr(resourceTypeName),
+					Properties: properties,
+				},
+			}
+			resp.SetResponse(http.StatusOK, response, nil)
+			return
+		},
+	}
+
+	apiVersionsServer := fake.APIVersionsServer{
+		Get: func(ctx context.Context, planeName, resourceProviderName, resourceTypeName, apiVersionName string, options *v20231001preview.APIVersionsClientGetOptions) (resp azfake.Responder[v20231001preview.APIVersionsClientGetResponse], errResp azfake.ErrorResponder) {
+			counts.apiVersions++
+
+			properties := &v20231001preview.APIVersionProperties{}
+			if resourceTypeName == "testResources" {
+				properties.Schema = map[string]any{"type": "object"}
+			}
+
+			response := v20231001preview.APIVersionsClientGetResponse{
+				APIVersionResource: v20231001preview.APIVersionResource{
+					Name:       to.Ptr(apiVersionName),
+					Properties: properties,
+				},
+			}
+			resp.SetResponse(http.StatusOK, response, nil)
+			return
+		},
+	}
+
+	ucp, err := v20231001preview.NewClientFactory(&aztoken.AnonymousCredential{}, &armpolicy.ClientOptions{
+		ClientOptions: policy.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				APIVersionsServer:   apiVersionsServer,
+				ResourceTypesServer: resourceTypesServer,
+			}),
+		},
+	})
+	require.NoError(t, err)
+
+	return ucp, counts
+}
@@ -97,6 +97,7 @@ func (dst *RabbitMQQueueResource) ConvertFrom(src v1.DataModelInterface) error {
 		Status: &ResourceStatus{
 			OutputResources: toOutputResources(rabbitmq.Properties.Status.OutputResources),
 			Recipe:          fromRecipeStatus(rabbitmq.Properties.Status.Recipe),
+			Conditions:      fromResourceConditions(rabbitmq.Properties.Status.Conditions),
 		},
 		ProvisioningState:    fromProvisioningStateDataModel(rabbitmq.InternalMetadata.AsyncProvisioningState),
 		Environment:          to.Ptr(rabbitmq.Properties.Environment),
@@ -294,10 +294,38 @@ type Recipe struct {
 // REQUIRED; The name of the recipe within the environment to use
 	Name *string
 
+// Existing cloud resources to adopt instead of deploying new resources. The resources are imported the first time the
+// recipe is executed for the resource, and are then managed by the recipe when the resource is updated or deleted.
+	Import *RecipeImport
+
 // Key/value parameters to pass into the recipe at deployment
 	Parameters map[string]any
 }
 
+// RecipeImport - Existing cloud resources adopted by the recipe of a portable resource
+type RecipeImport struct {
+// REQUIRED; The existing cloud resources to adopt
+	Resources []*RecipeImportedResource
+
+// The secret values of the adopted resources, as the recipe would output them. They are stored as secrets of the resource
+// and are not returned with the recipe.
+	Secrets map[string]*string
+
+// The values of the adopted resources, as the recipe would output them
+	Values map[string]any
+}
+
+// RecipeImportedResource - An existing cloud resource adopted by the recipe of a portable resource
+type RecipeImportedResource struct {
+// REQUIRED; The ID of the cloud resource. For Terraform recipes, it is the ID used to import the resource, as documented
+// by the Terraform provider.
+	ID *string
+
+// The address of the resource in the Terraform module of the recipe, for example azurerm_redis_cache.cache. Required for
+// Terraform recipes.
+	Address *string
+}
+
 // RecipeStatus - Recipe status at deployment time for a resource.
 type RecipeStatus struct {
 // REQUIRED; TemplateKind is the kind of the recipe template used by the portable resource upon deployment.
@@ -325,6 +353,24 @@ type Resource struct {
 	Type *string
 }
 
+// ResourceCondition - An observation of the state of a resource.
+type ResourceCondition struct {
+// REQUIRED; The status of the condition, one of True, False or Unknown.
+	Status *string
+
+// REQUIRED; The type of the condition, for example Drifted.
+	Type *string
+
+// The last time the status of the condition changed.
+	LastTransitionTime *time.Time
+
+// A human-readable message with details about the condition.
+	Message *string
+
+// A machine-readable reason for the last transition of the condition.
+	Reason *string
+}
+
 // ResourceReference - Describes a reference to an existing resource
 type ResourceReference struct {
 // REQUIRED; Resource id of an existing resource
@@ -339,6 +385,9 @@ type ResourceStatus struct {
 // Properties of an output resource
 	OutputResources []*OutputResource
 
+// READ-ONLY; The latest observations of the state of the resource
+	Conditions []*ResourceCondition
+
 // READ-ONLY; The recipe data at the time of deployment
 	Recipe *RecipeStatus
 }
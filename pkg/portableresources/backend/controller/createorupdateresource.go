@@ -20,14 +20,19 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
+	"strings"
 
 	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
 	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/portableresources"
 	"github.com/radius-project/radius/pkg/portableresources/datamodel"
 	"github.com/radius-project/radius/pkg/portableresources/processors"
 	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/recipes/configloader"
 	"github.com/radius-project/radius/pkg/recipes/engine"
+	"github.com/radius-project/radius/pkg/recipes/recipelogs"
 	"github.com/radius-project/radius/pkg/recipes/util"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
@@ -74,6 +79,13 @@ func (c *CreateOrUpdateResource[P, T]) Run(ctx context.Context, req *ctrl.Reques
 		return ctrl.Result{}, err
 	}
 
+	// The secrets of the imported resources are removed from the stored resource before the recipe is executed, so
+	// they are not kept whatever the outcome of the operation.
+	importSecrets, err := c.removeImportSecrets(ctx, data, obj)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
 	// Clone existing output resources so we can diff them later.
 	previousOutputResources := c.copyOutputResources(data)
 
@@ -86,13 +98,22 @@ func (c *CreateOrUpdateResource[P, T]) Run(ctx context.Context, req *ctrl.Reques
 
 	// Now we're ready to process recipes (if needed).
 
-	recipeOutput, err := c.executeRecipeIfNeeded(ctx, data, previousOutputResources, config.Simulated)
+	recorder := recipelogs.NewRecorder(recipelogs.DefaultMaxSize)
+	recipeOutput, err := c.executeRecipeIfNeeded(recipelogs.WithRecorder(ctx, recorder), data, previousOutputResources, config.Simulated, importSecrets)
+	c.saveRecipeLogs(ctx, req.ResourceID, data, recorder, err)
 	if err != nil {
 		if recipeError, ok := err.(*recipes.RecipeError); ok {
 			logger.Error(err, fmt.Sprintf("failed to execute recipe. Encountered error while processing %s ", recipeError.ErrorDetails.Target))
 			// Set the deployment status to the recipe error code.
 			recipeDataModel := any(data).(datamodel.RecipeDataModel)
 			recipeDataModel.GetRecipe().DeploymentStatus = util.RecipeDeploymentStatus(recipeError.DeploymentStatus)
+			// The recipe output is returned with the error when the recipe was deployed but failed afterwards, e.g. when
+			// its outputs are invalid. The deployed resources are recorded so they are deleted with the resource.
+			if recipeOutput != nil {
+				if err := setOutputResources(data, recipeOutput); err != nil {
+					return ctrl.Result{}, err
+				}
+			}
 			update := &database.Object{
 				Metadata: database.Metadata{
 					ID: req.ResourceID,
@@ -153,7 +174,36 @@ func (c *CreateOrUpdateResource[P, T]) copyOutputResources(data P) []string {
 	return previousOutputResources
 }
 
-func (c *CreateOrUpdateResource[P, T]) executeRecipeIfNeeded(ctx context.Context, data P, prevState []string, simulated bool) (*recipes.RecipeOutput, error) {
+// removeImportSecrets removes the secrets of the imported resources from the recipe of the resource and saves the
+// resource without them. The removed secrets are returned, and are only held in memory to execute the recipe. The
+// names of the secrets are kept, so a retry of the operation fails instead of importing the resources without them.
+func (c *CreateOrUpdateResource[P, T]) removeImportSecrets(ctx context.Context, data P, obj *database.Object) (map[string]any, error) {
+	recipeDataModel, supportsRecipes := any(data).(datamodel.RecipeDataModel)
+	if !supportsRecipes {
+		return nil, nil
+	}
+
+	recipe := recipeDataModel.GetRecipe()
+	if recipe == nil || recipe.Import == nil || recipe.Import.Secrets == nil {
+		return nil, nil
+	}
+
+	secrets := recipe.Import.Secrets
+	recipe.Import.Secrets = nil
+	recipe.Import.SecretNames = slices.Sorted(maps.Keys(secrets))
+	recipeDataModel.SetRecipe(recipe)
+
+	// The ETag of obj is updated by the save, so the resource can be saved again after the recipe is executed.
+	obj.Data = data
+	err := c.DatabaseClient().Save(ctx, obj, database.WithETag(obj.ETag))
+	if err != nil {
+		return nil, err
+	}
+
+	return secrets, nil
+}
+
+func (c *CreateOrUpdateResource[P, T]) executeRecipeIfNeeded(ctx context.Context, data P, prevState []string, simulated bool, importSecrets map[string]any) (*recipes.RecipeOutput, error) {
 	// 'any' is required here to convert to an interface type, only then can we use a type assertion.
 	recipeDataModel, supportsRecipes := any(data).(datamodel.RecipeDataModel)
 	if !supportsRecipes {
@@ -172,21 +222,91 @@ func (c *CreateOrUpdateResource[P, T]) executeRecipeIfNeeded(ctx context.Context
 		ResourceID:    data.GetBaseResource().ID,
 	}
 
+	recipeImport, err := recipeImport(data, input, importSecrets)
+	if err != nil {
+		return nil, err
+	}
+
 	return c.engine.Execute(ctx, engine.ExecuteOptions{
 		BaseOptions: engine.BaseOptions{
 			Recipe: request,
 		},
 		PreviousState: prevState,
 		Simulated:     simulated,
+		Import:        recipeImport,
 	})
 }
 
+// recipeImport returns the existing cloud resources to import into the recipe, or nil if the recipe does not import
+// resources. Resources are only imported the first time the recipe of the resource is executed successfully, which is
+// when the resource has no recipe status. The recipe is executed normally after that, and manages the imported resources.
+// The secrets of the imported resources are not stored with the resource, so they are passed separately. It returns
+// an error if the resources import secrets that are no longer available, because they were removed from the resource
+// by a previous attempt of the operation.
+func recipeImport[P rpv1.RadiusResourceModel](data P, input *portableresources.ResourceRecipe, secrets map[string]any) (*recipes.RecipeImport, error) {
+	if input.Import == nil || data.ResourceMetadata().GetResourceStatus().Recipe != nil {
+		return nil, nil
+	}
+
+	if len(input.Import.SecretNames) > 0 && secrets == nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeImportFailed, fmt.Sprintf("the secrets %s of the imported resources are no longer available because a previous attempt to import the resources did not complete. Deploy the resource again with the secrets in 'recipe.import.secrets'", strings.Join(input.Import.SecretNames, ", ")), util.RecipeSetupError)
+	}
+
+	request := &recipes.RecipeImport{
+		Values:  input.Import.Values,
+		Secrets: secrets,
+	}
+	for _, resource := range input.Import.Resources {
+		request.Resources = append(request.Resources, recipes.ImportedResource{
+			ID:      resource.ID,
+			Address: resource.Address,
+		})
+	}
+
+	return request, nil
+}
+
+// saveRecipeLogs saves the logs recorded during the recipe execution, so they can be retrieved by developers. Failing
+// to save the logs does not fail the operation.
+func (c *CreateOrUpdateResource[P, T]) saveRecipeLogs(ctx context.Context, resourceID string, data P, recorder *recipelogs.Recorder, recipeErr error) {
+	recipeDataModel, supportsRecipes := any(data).(datamodel.RecipeDataModel)
+	if !supportsRecipes || recipeDataModel.GetRecipe() == nil {
+		return
+	}
+
+	err := recipelogs.NewStore(c.DatabaseClient()).SaveRecorded(ctx, resourceID, data.ResourceMetadata().EnvironmentID(), recipeDataModel.GetRecipe().Name, recorder, recipeErr)
+	if err != nil {
+		ucplog.FromContextOrDiscard(ctx).Error(err, "failed to save recipe logs")
+	}
+}
+
 // setRecipeStatus sets the recipe status for the given resource model.
 // It retrieves the resource metadata from the provided model, deep copies the current resource status,
 // updates the Recipe field with the supplied recipeStatus, and then applies the updated status back to the resource.
+// The Drifted condition is removed because the resources deployed by the recipe were just brought in line with it.
 func setRecipeStatus[P rpv1.RadiusResourceModel](data P, recipeStatus rpv1.RecipeStatus) {
 	rm := data.ResourceMetadata()
 	status := rm.GetResourceStatus().DeepCopyRecipeStatus()
 	status.Recipe = &recipeStatus
+	status.Conditions = rpv1.RemoveCondition(status.Conditions, rpv1.ConditionDrifted)
+	rm.SetResourceStatus(status)
+}
+
+// setOutputResources sets the output resources of the resource to the resources deployed by the recipe, and the
+// recipe status if the recipe output has one.
+func setOutputResources[P rpv1.RadiusResourceModel](data P, recipeOutput *recipes.RecipeOutput) error {
+	outputResources, err := processors.GetOutputResourcesFromRecipe(recipeOutput)
+	if err != nil {
+		return err
+	}
+
+	rm := data.ResourceMetadata()
+	status := rm.GetResourceStatus().DeepCopyRecipeStatus()
+	status.OutputResources = outputResources
+	if recipeOutput.Status != nil {
+		status.Recipe = recipeOutput.Status
+	}
 	rm.SetResourceStatus(status)
+
+	return nil
 }
@@ -30,6 +30,7 @@ import (
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
 	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
 	"github.com/radius-project/radius/pkg/portableresources"
 	"github.com/radius-project/radius/pkg/portableresources/datamodel"
 	"github.com/radius-project/radius/pkg/portableresources/processors"
@@ -37,7 +38,10 @@ import (
 	"github.com/radius-project/radius/pkg/recipes/configloader"
 	"github.com/radius-project/radius/pkg/recipes/controllerconfig"
 	"github.com/radius-project/radius/pkg/recipes/engine"
+	"github.com/radius-project/radius/pkg/recipes/recipelogs"
+	"github.com/radius-project/radius/pkg/recipes/util"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 )
 
@@ -441,3 +445,284 @@ func TestCreateOrUpdateResource_Run(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateOrUpdateResource_SaveRecipeLogs(t *testing.T) {
+	for _, recipeErr := range []error{nil, &recipes.RecipeError{ErrorDetails: v1.ErrorDetails{Code: recipes.RecipeDeploymentFailed, Message: "failed"}}} {
+		mctrl := gomock.NewController(t)
+		eng := engine.NewMockEngine(mctrl)
+		cfg := configloader.NewMockConfigurationLoader(mctrl)
+		databaseClient := inmemory.NewClient()
+
+		err := databaseClient.Save(context.Background(), &database.Object{
+			Metadata: database.Metadata{ID: TestResourceID},
+			Data: &TestResource{
+				BaseResource: v1.BaseResource{TrackedResource: v1.TrackedResource{ID: TestResourceID, Type: TestResourceType}},
+				Properties: TestResourceProperties{
+					BasicResourceProperties: rpv1.BasicResourceProperties{Environment: TestEnvironmentID},
+					Recipe:                  portableresources.ResourceRecipe{Name: "test-recipe"},
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		cfg.EXPECT().
+			LoadConfiguration(gomock.Any(), gomock.Any()).
+			Return(&recipes.Configuration{Runtime: recipes.RuntimeConfiguration{Kubernetes: &recipes.KubernetesRuntime{Namespace: "test-namespace"}}}, nil).
+			Times(1)
+		eng.EXPECT().
+			Execute(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, opts engine.ExecuteOptions) (*recipes.RecipeOutput, error) {
+				recipelogs.FromContext(ctx).Record(recipelogs.SourceStdout, "Apply complete! Resources: 1 added, 0 changed, 0 destroyed.")
+				return &recipes.RecipeOutput{}, recipeErr
+			}).
+			Times(1)
+
+		req := &ctrl.Request{
+			OperationID:   uuid.New(),
+			OperationType: "APPLICATIONS.TEST/TESTRESOURCES|PUT",
+			ResourceID:    TestResourceID,
+		}
+		armCtx, err := req.ARMRequestContext()
+		require.NoError(t, err)
+		ctx := v1.WithARMRequestContext(context.Background(), armCtx)
+
+		controller, err := NewCreateOrUpdateResource(ctrl.Options{DatabaseClient: databaseClient}, successProcessorReference, eng, cfg)
+		require.NoError(t, err)
+
+		_, err = controller.Run(ctx, req)
+		require.NoError(t, err)
+
+		logs, err := recipelogs.NewStore(databaseClient).Get(ctx, TestResourceID)
+		require.NoError(t, err)
+		require.Equal(t, TestEnvironmentID, logs.EnvironmentID)
+		require.Len(t, logs.Operations, 1)
+		require.Equal(t, req.OperationID.String(), logs.Operations[0].OperationID)
+		require.Equal(t, "test-recipe", logs.Operations[0].RecipeName)
+		require.Equal(t, "Apply complete! Resources: 1 added, 0 changed, 0 destroyed.", logs.Operations[0].Entries[0].Message)
+		if recipeErr != nil {
+			require.Equal(t, recipelogs.StatusFailed, logs.Operations[0].Status)
+		} else {
+			require.Equal(t, recipelogs.StatusSucceeded, logs.Operations[0].Status)
+		}
+	}
+}
+
+func TestCreateOrUpdateResource_Import(t *testing.T) {
+	recipeImport := &portableresources.RecipeImport{
+		Resources: []portableresources.ImportedResource{
+			{ID: newOutputResourceResourceID, Address: "test_resource.test"},
+		},
+		Values:  map[string]any{"host": "test-host"},
+		Secrets: map[string]any{"password": "test-password"},
+	}
+
+	// A retry of the operation finds the import without its secrets, which were removed by the first attempt.
+	retriedImport := &portableresources.RecipeImport{
+		Resources:   recipeImport.Resources,
+		Values:      recipeImport.Values,
+		SecretNames: []string{"password"},
+	}
+
+	tests := []struct {
+		name           string
+		recipeImport   *portableresources.RecipeImport
+		recipeStatus   *rpv1.RecipeStatus
+		recipeErr      error
+		expectedImport *recipes.RecipeImport
+		secretsMissing bool
+	}{
+		{
+			name: "first deployment imports the resources",
+			expectedImport: &recipes.RecipeImport{
+				Resources: []recipes.ImportedResource{
+					{ID: newOutputResourceResourceID, Address: "test_resource.test"},
+				},
+				Values:  map[string]any{"host": "test-host"},
+				Secrets: map[string]any{"password": "test-password"},
+			},
+		},
+		{
+			name:      "failed import",
+			recipeErr: &recipes.RecipeError{ErrorDetails: v1.ErrorDetails{Code: recipes.RecipeImportFailed, Message: "failed"}},
+			expectedImport: &recipes.RecipeImport{
+				Resources: []recipes.ImportedResource{
+					{ID: newOutputResourceResourceID, Address: "test_resource.test"},
+				},
+				Values:  map[string]any{"host": "test-host"},
+				Secrets: map[string]any{"password": "test-password"},
+			},
+		},
+		{
+			name:         "later deployments execute the recipe",
+			recipeStatus: &rpv1.RecipeStatus{TemplateKind: recipes.TemplateKindTerraform, TemplatePath: "test/path"},
+		},
+		{
+			name:           "retry without the secrets fails",
+			recipeImport:   retriedImport,
+			secretsMissing: true,
+		},
+		{
+			name:         "later deployments don't need the secrets",
+			recipeImport: retriedImport,
+			recipeStatus: &rpv1.RecipeStatus{TemplateKind: recipes.TemplateKindTerraform, TemplatePath: "test/path"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mctrl := gomock.NewController(t)
+			eng := engine.NewMockEngine(mctrl)
+			cfg := configloader.NewMockConfigurationLoader(mctrl)
+			databaseClient := inmemory.NewClient()
+
+			storedImport := recipeImport
+			if tt.recipeImport != nil {
+				storedImport = tt.recipeImport
+			}
+			err := databaseClient.Save(context.Background(), &database.Object{
+				Metadata: database.Metadata{ID: TestResourceID},
+				Data: &TestResource{
+					BaseResource: v1.BaseResource{TrackedResource: v1.TrackedResource{ID: TestResourceID, Type: TestResourceType}},
+					Properties: TestResourceProperties{
+						BasicResourceProperties: rpv1.BasicResourceProperties{
+							Environment: TestEnvironmentID,
+							Status:      rpv1.ResourceStatus{Recipe: tt.recipeStatus},
+						},
+						Recipe: portableresources.ResourceRecipe{Name: "test-recipe", Import: storedImport},
+					},
+				},
+			})
+			require.NoError(t, err)
+
+			cfg.EXPECT().
+				LoadConfiguration(gomock.Any(), gomock.Any()).
+				Return(&recipes.Configuration{Runtime: recipes.RuntimeConfiguration{Kubernetes: &recipes.KubernetesRuntime{Namespace: "test-namespace"}}}, nil).
+				Times(1)
+			if !tt.secretsMissing {
+				eng.EXPECT().
+					Execute(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, opts engine.ExecuteOptions) (*recipes.RecipeOutput, error) {
+						require.Equal(t, tt.expectedImport, opts.Import)
+						return &recipes.RecipeOutput{
+							Resources: []string{newOutputResourceResourceID},
+							Status:    &rpv1.RecipeStatus{TemplateKind: recipes.TemplateKindTerraform, TemplatePath: "test/path"},
+						}, tt.recipeErr
+					}).
+					Times(1)
+			}
+
+			req := &ctrl.Request{
+				OperationID:   uuid.New(),
+				OperationType: "APPLICATIONS.TEST/TESTRESOURCES|PUT",
+				ResourceID:    TestResourceID,
+			}
+			armCtx, err := req.ARMRequestContext()
+			require.NoError(t, err)
+			ctx := v1.WithARMRequestContext(context.Background(), armCtx)
+
+			controller, err := NewCreateOrUpdateResource(ctrl.Options{DatabaseClient: databaseClient}, successProcessorReference, eng, cfg)
+			require.NoError(t, err)
+
+			res, err := controller.Run(ctx, req)
+			require.NoError(t, err)
+			require.Equal(t, tt.recipeErr != nil || tt.secretsMissing, res.ProvisioningState() == v1.ProvisioningStateFailed)
+			if tt.secretsMissing {
+				require.Equal(t, recipes.RecipeImportFailed, res.Error.Code)
+				require.Contains(t, res.Error.Message, "the secrets password of the imported resources are no longer available")
+			}
+
+			// The imported secrets are not kept in the recipe, whatever the outcome of the operation. Their names
+			// are kept to detect a retry.
+			obj, err := databaseClient.Get(ctx, TestResourceID)
+			require.NoError(t, err)
+			saved := &TestResource{}
+			require.NoError(t, obj.As(saved))
+			require.Equal(t, recipeImport.Resources, saved.Properties.Recipe.Import.Resources)
+			require.Nil(t, saved.Properties.Recipe.Import.Secrets)
+			require.Equal(t, []string{"password"}, saved.Properties.Recipe.Import.SecretNames)
+		})
+	}
+}
+
+func TestCreateOrUpdateResource_InvalidOutputs(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	eng := engine.NewMockEngine(mctrl)
+	cfg := configloader.NewMockConfigurationLoader(mctrl)
+	databaseClient := inmemory.NewClient()
+
+	err := databaseClient.Save(context.Background(), &database.Object{
+		Metadata: database.Metadata{ID: TestResourceID},
+		Data: &TestResource{
+			BaseResource: v1.BaseResource{TrackedResource: v1.TrackedResource{ID: TestResourceID, Type: TestResourceType}},
+			Properties: TestResourceProperties{
+				BasicResourceProperties: rpv1.BasicResourceProperties{Environment: TestEnvironmentID},
+				Recipe:                  portableresources.ResourceRecipe{Name: "test-recipe"},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	recipeStatus := &rpv1.RecipeStatus{TemplateKind: recipes.TemplateKindBicep, TemplatePath: "test/path"}
+	cfg.EXPECT().
+		LoadConfiguration(gomock.Any(), gomock.Any()).
+		Return(&recipes.Configuration{Runtime: recipes.RuntimeConfiguration{Kubernetes: &recipes.KubernetesRuntime{Namespace: "test-namespace"}}}, nil).
+		Times(1)
+	// The recipe was deployed, but its outputs do not match the outputs declared by its registration.
+	eng.EXPECT().
+		Execute(gomock.Any(), gomock.Any()).
+		Return(&recipes.RecipeOutput{
+			Resources: []string{newOutputResourceResourceID},
+			Status:    recipeStatus,
+		}, recipes.NewRecipeError(recipes.InvalidRecipeOutputs, "invalid outputs", util.ExecutionError)).
+		Times(1)
+
+	req := &ctrl.Request{
+		OperationID:   uuid.New(),
+		OperationType: "APPLICATIONS.TEST/TESTRESOURCES|PUT",
+		ResourceID:    TestResourceID,
+	}
+	armCtx, err := req.ARMRequestContext()
+	require.NoError(t, err)
+	ctx := v1.WithARMRequestContext(context.Background(), armCtx)
+
+	controller, err := NewCreateOrUpdateResource(ctrl.Options{DatabaseClient: databaseClient}, successProcessorReference, eng, cfg)
+	require.NoError(t, err)
+
+	res, err := controller.Run(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, v1.ProvisioningStateFailed, res.ProvisioningState())
+
+	// The deployed resources are recorded, so they are deleted with the resource.
+	obj, err := databaseClient.Get(ctx, TestResourceID)
+	require.NoError(t, err)
+	saved := &TestResource{}
+	require.NoError(t, obj.As(saved))
+	require.Equal(t, util.ExecutionError, saved.Properties.Recipe.DeploymentStatus)
+	require.Equal(t, recipeStatus, saved.Properties.Status.Recipe)
+	require.Len(t, saved.Properties.Status.OutputResources, 1)
+	require.Equal(t, newOutputResourceResourceID, saved.Properties.Status.OutputResources[0].ID.String())
+	require.True(t, to.Bool(saved.Properties.Status.OutputResources[0].RadiusManaged))
+}
+
+func Test_setRecipeStatus(t *testing.T) {
+	data := &TestResource{
+		Properties: TestResourceProperties{
+			BasicResourceProperties: rpv1.BasicResourceProperties{
+				Status: rpv1.ResourceStatus{
+					OutputResources: []rpv1.OutputResource{newOutputResource},
+					Conditions: []rpv1.Condition{
+						{Type: rpv1.ConditionDrifted, Status: rpv1.ConditionTrue},
+						{Type: "Ready", Status: rpv1.ConditionTrue},
+					},
+				},
+			},
+		},
+	}
+
+	setRecipeStatus(data, rpv1.RecipeStatus{TemplateKind: recipes.TemplateKindBicep, TemplatePath: "test/path"})
+
+	status := data.Properties.Status
+	require.Equal(t, &rpv1.RecipeStatus{TemplateKind: recipes.TemplateKindBicep, TemplatePath: "test/path"}, status.Recipe)
+	require.Equal(t, []rpv1.OutputResource{newOutputResource}, status.OutputResources)
+	require.Equal(t, []rpv1.Condition{{Type: "Ready", Status: rpv1.ConditionTrue}}, status.Conditions)
+}
@@ -26,9 +26,11 @@ import (
 	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/recipes/configloader"
 	"github.com/radius-project/radius/pkg/recipes/engine"
+	"github.com/radius-project/radius/pkg/recipes/recipelogs"
 	"github.com/radius-project/radius/pkg/recipes/util"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
 )
 
 // DeleteResource is the async operation controller to delete a portable resource.
@@ -87,13 +89,19 @@ func (c *DeleteResource[P, T]) Run(ctx context.Context, request *ctrl.Request) (
 			ResourceID:    id.String(),
 		}
 
-		err = c.engine.Delete(ctx, engine.DeleteOptions{
+		recorder := recipelogs.NewRecorder(recipelogs.DefaultMaxSize)
+		err = c.engine.Delete(recipelogs.WithRecorder(ctx, recorder), engine.DeleteOptions{
 			BaseOptions: engine.BaseOptions{
 				Recipe: recipeData,
 			},
 			OutputResources: data.OutputResources(),
 		})
 		if err != nil {
+			// The logs of a failed deletion are kept with the resource, so the failure can be investigated.
+			if saveErr := recipelogs.NewStore(c.DatabaseClient()).SaveRecorded(ctx, request.ResourceID, recipeData.EnvironmentID, recipeData.Name, recorder, err); saveErr != nil {
+				ucplog.FromContextOrDiscard(ctx).Error(saveErr, "failed to save recipe logs")
+			}
+
 			if recipeError, ok := err.(*recipes.RecipeError); ok {
 				return ctrl.NewFailedResult(recipeError.ErrorDetails), nil
 			}
@@ -119,6 +127,12 @@ func (c *DeleteResource[P, T]) Run(ctx context.Context, request *ctrl.Request) (
 		return ctrl.Result{}, err
 	}
 
+	// The recipe logs are deleted with the resource.
+	err = recipelogs.NewStore(c.DatabaseClient()).Delete(ctx, request.ResourceID)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
 	return ctrl.Result{}, err
 }
 
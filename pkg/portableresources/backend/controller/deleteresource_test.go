@@ -29,6 +29,7 @@ import (
 	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/recipes/configloader"
 	"github.com/radius-project/radius/pkg/recipes/engine"
+	"github.com/radius-project/radius/pkg/recipes/recipelogs"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/pkg/ucp/resources"
@@ -147,9 +148,16 @@ func TestDeleteResourceRun_20231001Preview(t *testing.T) {
 						Times(1)
 
 					msc.EXPECT().
-						Delete(gomock.Any(), gomock.Any()).
+						Delete(gomock.Any(), req.ResourceID).
 						Return(tt.scDelErr).
 						Times(1)
+
+					if tt.scDelErr == nil {
+						msc.EXPECT().
+							Delete(gomock.Any(), recipelogs.ID(req.ResourceID)).
+							Return(&database.ErrNotFound{ID: recipelogs.ID(req.ResourceID)}).
+							Times(1)
+					}
 				}
 			}
 			opts := ctrl.Options{
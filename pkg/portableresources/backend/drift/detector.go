@@ -0,0 +1,309 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drift
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/metrics"
+	"github.com/radius-project/radius/pkg/portableresources"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	"github.com/radius-project/radius/pkg/recipes/util"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// DefaultInterval is the default interval between drift checks.
+	DefaultInterval = time.Hour
+
+	// DefaultRootScope is the default scope of the portable resources that are checked for drift.
+	DefaultRootScope = "/planes/radius/local"
+
+	// ReasonInSync is the reason of the Drifted condition when the cloud resources match the recipe.
+	ReasonInSync = "InSync"
+
+	// ReasonResourcesChanged is the reason of the Drifted condition when the cloud resources differ from the recipe.
+	ReasonResourcesChanged = "ResourcesChanged"
+
+	// ReasonCheckFailed is the reason of the Drifted condition when the drift check failed.
+	ReasonCheckFailed = "DriftCheckFailed"
+)
+
+// Options represents the options of the drift detector.
+type Options struct {
+	// DatabaseClient is the client for the database storing the portable resources.
+	DatabaseClient database.Client
+
+	// Engine is the recipe engine used to plan the recipes of the portable resources.
+	Engine engine.Engine
+
+	// ResourceTypes is the list of portable resource types that are checked for drift.
+	ResourceTypes []string
+
+	// RootScope is the scope of the portable resources that are checked for drift. DefaultRootScope is used if
+	// it is not set.
+	RootScope string
+
+	// Interval is the interval between drift checks. DefaultInterval is used if it is not set.
+	Interval time.Duration
+}
+
+// Detector periodically plans the recipe of each recipe-provisioned portable resource against its deployed cloud
+// resources and records the result as the Drifted condition of the resource.
+type Detector struct {
+	options Options
+	now     func() time.Time
+}
+
+// NewDetector creates a new drift detector.
+func NewDetector(options Options) *Detector {
+	if options.RootScope == "" {
+		options.RootScope = DefaultRootScope
+	}
+
+	if options.Interval <= 0 {
+		options.Interval = DefaultInterval
+	}
+
+	return &Detector{options: options, now: time.Now}
+}
+
+// portableResource is the subset of the datamodel shared by portable resources that is needed to check for drift.
+type portableResource struct {
+	v1.BaseResource
+
+	Properties portableResourceProperties `json:"properties"`
+}
+
+type portableResourceProperties struct {
+	rpv1.BasicResourceProperties
+
+	Recipe               portableresources.ResourceRecipe       `json:"recipe,omitempty"`
+	ResourceProvisioning portableresources.ResourceProvisioning `json:"resourceProvisioning,omitempty"`
+}
+
+// Run checks the portable resources for drift every interval until the context is cancelled.
+func (d *Detector) Run(ctx context.Context) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	logger.Info(fmt.Sprintf("Checking recipe-provisioned resources for drift every %s", d.options.Interval))
+
+	ticker := time.NewTicker(d.options.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			d.CheckAll(ctx)
+		}
+	}
+}
+
+// CheckAll checks every recipe-provisioned portable resource for drift. Failures are logged and do not stop the
+// check of the remaining resources.
+func (d *Detector) CheckAll(ctx context.Context) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	for _, resourceType := range d.options.ResourceTypes {
+		objects, err := d.query(ctx, resourceType)
+		if err != nil {
+			logger.Error(err, "failed to list resources for drift detection", "resourceType", resourceType)
+			continue
+		}
+
+		for _, obj := range objects {
+			if ctx.Err() != nil {
+				return
+			}
+
+			err := d.Check(ctx, &obj)
+			if err != nil {
+				logger.Error(err, "failed to check resource for drift", "resourceId", obj.ID)
+			}
+		}
+	}
+}
+
+func (d *Detector) query(ctx context.Context, resourceType string) ([]database.Object, error) {
+	objects := []database.Object{}
+	token := ""
+	for {
+		result, err := d.options.DatabaseClient.Query(ctx, database.Query{
+			RootScope:      d.options.RootScope,
+			ScopeRecursive: true,
+			ResourceType:   resourceType,
+		}, database.WithPaginationToken(token))
+		if err != nil {
+			return nil, err
+		}
+
+		objects = append(objects, result.Items...)
+		if result.PaginationToken == "" {
+			return objects, nil
+		}
+		token = result.PaginationToken
+	}
+}
+
+// Check plans the recipe of the portable resource and updates its Drifted condition. Resources that are not
+// provisioned by a recipe, or whose last deployment is not complete, are skipped.
+func (d *Detector) Check(ctx context.Context, obj *database.Object) error {
+	resource := portableResource{}
+	if err := obj.As(&resource); err != nil {
+		return err
+	}
+
+	if !shouldCheck(&resource) {
+		return nil
+	}
+
+	previousState := []string{}
+	for _, outputResource := range resource.Properties.Status.OutputResources {
+		previousState = append(previousState, outputResource.ID.String())
+	}
+
+	plan, err := d.options.Engine.Plan(ctx, engine.PlanOptions{
+		BaseOptions: engine.BaseOptions{
+			Recipe: recipes.ResourceMetadata{
+				Name:          resource.Properties.Recipe.Name,
+				Parameters:    resource.Properties.Recipe.Parameters,
+				EnvironmentID: resource.Properties.Environment,
+				ApplicationID: resource.Properties.Application,
+				ResourceID:    resource.ID,
+			},
+		},
+		PreviousState: previousState,
+	})
+
+	var condition rpv1.Condition
+	state := ""
+	if err != nil {
+		condition = rpv1.Condition{
+			Type:    rpv1.ConditionDrifted,
+			Status:  rpv1.ConditionUnknown,
+			Reason:  ReasonCheckFailed,
+			Message: err.Error(),
+		}
+		state = metrics.FailedOperationState
+	} else if plan == nil {
+		// The environment is simulated, so nothing was deployed.
+		return nil
+	} else {
+		condition = driftCondition(plan)
+		state = metrics.InSyncOperationState
+		if condition.Status == rpv1.ConditionTrue {
+			state = metrics.DriftedOperationState
+		}
+	}
+
+	metrics.DefaultRecipeEngineMetrics.RecordRecipeDriftCheck(ctx,
+		metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDriftCheck, resource.Properties.Recipe.Name, &recipes.EnvironmentDefinition{ResourceType: resource.Type}, state))
+
+	conditions, changed := rpv1.SetCondition(resource.Properties.Status.Conditions, condition, d.now())
+	if !changed {
+		return nil
+	}
+
+	return d.saveConditions(ctx, obj, conditions)
+}
+
+// shouldCheck returns true if the resource was deployed by a recipe and is not being updated or deleted.
+func shouldCheck(resource *portableResource) bool {
+	if resource.Properties.ResourceProvisioning == portableresources.ResourceProvisioningManual {
+		return false
+	}
+
+	if resource.Properties.Status.Recipe == nil || resource.Properties.Recipe.DeploymentStatus != util.Success {
+		return false
+	}
+
+	return resource.InternalMetadata.AsyncProvisioningState.IsTerminal()
+}
+
+// driftCondition creates the Drifted condition from the plan of the recipe.
+func driftCondition(plan *recipes.RecipePlan) rpv1.Condition {
+	changes := []string{}
+	for _, change := range plan.ResourceChanges {
+		if change.Action == recipes.ResourceChangeNone {
+			continue
+		}
+		changes = append(changes, fmt.Sprintf("%s (%s)", change.ID, change.Action))
+	}
+
+	if len(changes) == 0 {
+		return rpv1.Condition{
+			Type:    rpv1.ConditionDrifted,
+			Status:  rpv1.ConditionFalse,
+			Reason:  ReasonInSync,
+			Message: "The cloud resources deployed by the recipe match the recipe.",
+		}
+	}
+
+	return rpv1.Condition{
+		Type:    rpv1.ConditionDrifted,
+		Status:  rpv1.ConditionTrue,
+		Reason:  ReasonResourcesChanged,
+		Message: fmt.Sprintf("%d cloud resource(s) deployed by the recipe differ from the recipe: %s.", len(changes), strings.Join(changes, ", ")),
+	}
+}
+
+// saveConditions saves the conditions of the resource without modifying the rest of the resource. The save fails if
+// the resource was updated since it was read.
+func (d *Detector) saveConditions(ctx context.Context, obj *database.Object, conditions []rpv1.Condition) error {
+	b, err := json.Marshal(obj.Data)
+	if err != nil {
+		return err
+	}
+
+	data := map[string]any{}
+	if err := json.Unmarshal(b, &data); err != nil {
+		return err
+	}
+
+	properties, ok := data["properties"].(map[string]any)
+	if !ok {
+		return errors.New("resource has no properties")
+	}
+
+	status, ok := properties["status"].(map[string]any)
+	if !ok {
+		status = map[string]any{}
+		properties["status"] = status
+	}
+	status["conditions"] = conditions
+
+	err = d.options.DatabaseClient.Save(ctx, &database.Object{
+		Metadata: database.Metadata{ID: obj.ID},
+		Data:     data,
+	}, database.WithETag(obj.ETag))
+	if errors.Is(err, &database.ErrConcurrency{}) {
+		// The resource was updated while it was checked, so the result may be stale. It is checked again next time.
+		return nil
+	}
+
+	return err
+}
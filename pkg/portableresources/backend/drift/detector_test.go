@@ -0,0 +1,299 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drift
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+)
+
+const (
+	testResourceType  = "Applications.Datastores/redisCaches"
+	testResourceID    = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/redis"
+	testEnvironmentID = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/environments/env"
+	testApplicationID = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/applications/app"
+	testOutputID      = "/planes/azure/azurecloud/subscriptions/test-sub/resourceGroups/test-rg/providers/Microsoft.Cache/redis/redis"
+)
+
+var now = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func testObject(properties map[string]any) database.Object {
+	data := map[string]any{
+		"id":                testResourceID,
+		"name":              "redis",
+		"type":              testResourceType,
+		"provisioningState": "Succeeded",
+		"properties":        properties,
+	}
+
+	return database.Object{
+		Metadata: database.Metadata{ID: testResourceID, ETag: "etag"},
+		Data:     data,
+	}
+}
+
+func recipeProperties(conditions ...map[string]any) map[string]any {
+	status := map[string]any{
+		"outputResources": []any{
+			map[string]any{"id": testOutputID, "radiusManaged": true},
+		},
+		"recipe": map[string]any{
+			"templateKind": "bicep",
+			"templatePath": "ghcr.io/radius-project/recipes/redis:latest",
+		},
+	}
+	if len(conditions) > 0 {
+		list := []any{}
+		for _, c := range conditions {
+			list = append(list, c)
+		}
+		status["conditions"] = list
+	}
+
+	return map[string]any{
+		"environment": testEnvironmentID,
+		"application": testApplicationID,
+		"recipe": map[string]any{
+			"name":         "default",
+			"parameters":   map[string]any{"size": "C1"},
+			"recipeStatus": "success",
+		},
+		"status": status,
+	}
+}
+
+func savedConditions(t *testing.T, obj *database.Object) []rpv1.Condition {
+	saved := portableResource{}
+	require.NoError(t, obj.As(&saved))
+	return saved.Properties.Status.Conditions
+}
+
+func setup(t *testing.T) (*Detector, *database.MockClient, *engine.MockEngine) {
+	ctrl := gomock.NewController(t)
+	databaseClient := database.NewMockClient(ctrl)
+	eng := engine.NewMockEngine(ctrl)
+
+	detector := NewDetector(Options{
+		DatabaseClient: databaseClient,
+		Engine:         eng,
+		ResourceTypes:  []string{testResourceType},
+	})
+	detector.now = func() time.Time { return now }
+
+	return detector, databaseClient, eng
+}
+
+func Test_NewDetector_Defaults(t *testing.T) {
+	detector := NewDetector(Options{})
+	require.Equal(t, DefaultInterval, detector.options.Interval)
+	require.Equal(t, DefaultRootScope, detector.options.RootScope)
+}
+
+func Test_Check_Drifted(t *testing.T) {
+	detector, databaseClient, eng := setup(t)
+
+	eng.EXPECT().
+		Plan(gomock.Any(), engine.PlanOptions{
+			BaseOptions: engine.BaseOptions{
+				Recipe: recipes.ResourceMetadata{
+					Name:          "default",
+					Parameters:    map[string]any{"size": "C1"},
+					EnvironmentID: testEnvironmentID,
+					ApplicationID: testApplicationID,
+					ResourceID:    testResourceID,
+				},
+			},
+			PreviousState: []string{testOutputID},
+		}).
+		Return(&recipes.RecipePlan{
+			ResourceChanges: []recipes.ResourceChange{
+				{ID: testOutputID, Type: "Microsoft.Cache/redis", Action: recipes.ResourceChangeUpdate},
+				{ID: "other", Type: "Microsoft.Cache/redis", Action: recipes.ResourceChangeNone},
+			},
+		}, nil)
+
+	databaseClient.EXPECT().
+		Save(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, obj *database.Object, options ...database.SaveOptions) error {
+			require.Equal(t, testResourceID, obj.ID)
+			require.Equal(t, []rpv1.Condition{
+				{
+					Type:               rpv1.ConditionDrifted,
+					Status:             rpv1.ConditionTrue,
+					Reason:             ReasonResourcesChanged,
+					Message:            "1 cloud resource(s) deployed by the recipe differ from the recipe: " + testOutputID + " (update).",
+					LastTransitionTime: now,
+				},
+			}, savedConditions(t, obj))
+
+			// The rest of the resource is unchanged.
+			saved := portableResource{}
+			require.NoError(t, obj.As(&saved))
+			require.Equal(t, testEnvironmentID, saved.Properties.Environment)
+			require.Equal(t, "default", saved.Properties.Recipe.Name)
+			require.Len(t, saved.Properties.Status.OutputResources, 1)
+			return nil
+		})
+
+	obj := testObject(recipeProperties())
+	err := detector.Check(context.Background(), &obj)
+	require.NoError(t, err)
+}
+
+func Test_Check_InSync_Unchanged(t *testing.T) {
+	detector, _, eng := setup(t)
+
+	eng.EXPECT().
+		Plan(gomock.Any(), gomock.Any()).
+		Return(&recipes.RecipePlan{
+			ResourceChanges: []recipes.ResourceChange{
+				{ID: testOutputID, Action: recipes.ResourceChangeNone},
+			},
+		}, nil)
+
+	// The condition is already set, so the resource is not saved.
+	obj := testObject(recipeProperties(map[string]any{
+		"type":               rpv1.ConditionDrifted,
+		"status":             "False",
+		"reason":             ReasonInSync,
+		"message":            "The cloud resources deployed by the recipe match the recipe.",
+		"lastTransitionTime": "2023-06-01T00:00:00Z",
+	}))
+	err := detector.Check(context.Background(), &obj)
+	require.NoError(t, err)
+}
+
+func Test_Check_PlanFailed(t *testing.T) {
+	detector, databaseClient, eng := setup(t)
+
+	eng.EXPECT().
+		Plan(gomock.Any(), gomock.Any()).
+		Return(nil, errors.New("failed to plan"))
+
+	databaseClient.EXPECT().
+		Save(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, obj *database.Object, options ...database.SaveOptions) error {
+			require.Equal(t, []rpv1.Condition{
+				{
+					Type:               rpv1.ConditionDrifted,
+					Status:             rpv1.ConditionUnknown,
+					Reason:             ReasonCheckFailed,
+					Message:            "failed to plan",
+					LastTransitionTime: now,
+				},
+			}, savedConditions(t, obj))
+			return nil
+		})
+
+	obj := testObject(recipeProperties())
+	err := detector.Check(context.Background(), &obj)
+	require.NoError(t, err)
+}
+
+func Test_Check_ConcurrentUpdate(t *testing.T) {
+	detector, databaseClient, eng := setup(t)
+
+	eng.EXPECT().
+		Plan(gomock.Any(), gomock.Any()).
+		Return(&recipes.RecipePlan{}, nil)
+	databaseClient.EXPECT().
+		Save(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&database.ErrConcurrency{})
+
+	obj := testObject(recipeProperties())
+	err := detector.Check(context.Background(), &obj)
+	require.NoError(t, err)
+}
+
+func Test_Check_Skipped(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(data map[string]any)
+	}{
+		{
+			name: "manual provisioning",
+			modify: func(data map[string]any) {
+				data["properties"].(map[string]any)["resourceProvisioning"] = "manual"
+			},
+		},
+		{
+			name: "no recipe status",
+			modify: func(data map[string]any) {
+				delete(data["properties"].(map[string]any)["status"].(map[string]any), "recipe")
+			},
+		},
+		{
+			name: "recipe failed",
+			modify: func(data map[string]any) {
+				data["properties"].(map[string]any)["recipe"].(map[string]any)["recipeStatus"] = "failed"
+			},
+		},
+		{
+			name: "operation in progress",
+			modify: func(data map[string]any) {
+				data["provisioningState"] = "Updating"
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The engine and database are not called.
+			detector, _, _ := setup(t)
+
+			obj := testObject(recipeProperties())
+			tt.modify(obj.Data.(map[string]any))
+			err := detector.Check(context.Background(), &obj)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func Test_CheckAll(t *testing.T) {
+	detector, databaseClient, eng := setup(t)
+
+	first := testObject(recipeProperties())
+	second := testObject(recipeProperties())
+	second.Data.(map[string]any)["properties"].(map[string]any)["resourceProvisioning"] = "manual"
+
+	databaseClient.EXPECT().
+		Query(gomock.Any(), database.Query{RootScope: DefaultRootScope, ScopeRecursive: true, ResourceType: testResourceType}, gomock.Any()).
+		Return(&database.ObjectQueryResult{Items: []database.Object{first}, PaginationToken: "next"}, nil)
+	databaseClient.EXPECT().
+		Query(gomock.Any(), database.Query{RootScope: DefaultRootScope, ScopeRecursive: true, ResourceType: testResourceType}, gomock.Any()).
+		Return(&database.ObjectQueryResult{Items: []database.Object{second}}, nil)
+
+	// Only the first resource is provisioned by a recipe. The failure to save it does not stop the check.
+	eng.EXPECT().
+		Plan(gomock.Any(), gomock.Any()).
+		Return(&recipes.RecipePlan{}, nil)
+	databaseClient.EXPECT().
+		Save(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(errors.New("failed to save"))
+
+	detector.CheckAll(context.Background())
+}
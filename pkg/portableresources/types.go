@@ -58,6 +58,34 @@ type ResourceRecipe struct {
 	Parameters map[string]any `json:"parameters,omitempty"`
 	// DeploymentStatus is the deployment status of the recipe
 	DeploymentStatus util.RecipeDeploymentStatus `json:"recipeStatus,omitempty"`
+	// Import is the existing cloud resources adopted by the recipe the first time the resource is deployed,
+	// instead of deploying new resources.
+	Import *RecipeImport `json:"import,omitempty"`
+}
+
+// RecipeImport represents existing cloud resources adopted by the recipe of a resource. The resources are imported
+// instead of being deployed the first time the recipe is executed, and are then managed by the recipe when the
+// resource is updated or deleted.
+type RecipeImport struct {
+	// Resources are the existing cloud resources to adopt.
+	Resources []ImportedResource `json:"resources,omitempty"`
+	// Values are the values of the adopted resources, as the recipe would output them.
+	Values map[string]any `json:"values,omitempty"`
+	// Secrets are the secret values of the adopted resources, as the recipe would output them.
+	Secrets map[string]any `json:"secrets,omitempty"`
+	// SecretNames are the names of the secrets of the adopted resources. The secrets are removed from the stored
+	// resource before the recipe is executed, and their names are kept to detect that they are no longer available.
+	SecretNames []string `json:"secretNames,omitempty"`
+}
+
+// ImportedResource represents an existing cloud resource adopted by the recipe of a resource.
+type ImportedResource struct {
+	// ID is the ID of the cloud resource, e.g. the Azure resource ID. For Terraform recipes, it is the ID used to
+	// import the resource, as documented by the Terraform provider.
+	ID string `json:"id"`
+	// Address is the address of the resource in the Terraform module of the recipe, e.g. "azurerm_redis_cache.cache".
+	// It is required for Terraform recipes.
+	Address string `json:"address,omitempty"`
 }
 
 // ResourceReference represents a reference to a resource that was deployed by the user
@@ -20,14 +20,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/recipepack"
 	recipes_util "github.com/radius-project/radius/pkg/recipes/util"
 	"github.com/radius-project/radius/pkg/rp/kube"
 	"github.com/radius-project/radius/pkg/rp/util"
+	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 )
 
@@ -130,6 +133,22 @@ func (e *environmentLoader) LoadRecipe(ctx context.Context, recipe *recipes.Reso
 		return nil, err
 	}
 
+	if len(environment.Properties.RecipePacks) > 0 {
+		packs := []*v20231001preview.RecipePackResource{}
+		for _, recipePack := range environment.Properties.RecipePacks {
+			pack, err := util.FetchRecipePack(ctx, to.String(recipePack.ID), e.ArmClientOptions)
+			if err != nil {
+				err := fmt.Errorf("failed to fetch recipe pack %q of environment %q: %w", to.String(recipePack.ID), recipe.EnvironmentID, err)
+				return nil, recipes.NewRecipeError(recipes.RecipeNotFoundFailure, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+			}
+			packs = append(packs, pack)
+		}
+
+		if err := applyRecipePacks(environment, packs); err != nil {
+			return nil, err
+		}
+	}
+
 	envDefinition, err := getRecipeDefinition(environment, recipe)
 	if err != nil {
 		return nil, err
@@ -162,6 +181,7 @@ func getRecipeDefinition(environment *v20231001preview.EnvironmentResource, reci
 		ResourceType: resource.Type(),
 		Parameters:   found.GetRecipeProperties().Parameters,
 		TemplatePath: *found.GetRecipeProperties().TemplatePath,
+		Outputs:      toOutputContract(found.GetRecipeProperties().Outputs),
 	}
 	switch c := found.(type) {
 	case *v20231001preview.TerraformRecipeProperties:
@@ -170,7 +190,65 @@ func getRecipeDefinition(environment *v20231001preview.EnvironmentResource, reci
 		if c.PlainHTTP != nil {
 			definition.PlainHTTP = *c.PlainHTTP
 		}
+	case *v20231001preview.HelmRecipeProperties:
+		definition.TemplateVersion = to.String(c.TemplateVersion)
+		definition.PlainHTTP = to.Bool(c.PlainHTTP)
 	}
 
 	return definition, nil
 }
+
+// toOutputContract converts the output contract of a recipe registration to the output contract validated by the engine.
+func toOutputContract(outputs *v20231001preview.RecipeOutputContract) *recipes.OutputContract {
+	if outputs == nil {
+		return nil
+	}
+
+	contract := &recipes.OutputContract{
+		Values:    to.StringMap(outputs.Values),
+		Resources: to.Bool(outputs.Resources),
+	}
+	for _, secret := range outputs.Secrets {
+		contract.Secrets = append(contract.Secrets, to.String(secret))
+	}
+
+	return contract
+}
+
+// applyRecipePacks registers the recipes of the versions of the recipe packs referenced by the environment to the
+// environment, following the resolution rules of recipepack.Resolve.
+func applyRecipePacks(environment *v20231001preview.EnvironmentResource, packs []*v20231001preview.RecipePackResource) error {
+	recipePacks := []recipepack.Pack[v20231001preview.RecipePropertiesClassification]{}
+	for _, pack := range packs {
+		packVersion := recipePackVersion(environment, to.String(pack.ID))
+		published, ok := pack.Properties.Versions[packVersion]
+		if !ok || published == nil {
+			err := fmt.Errorf("version %q of recipe pack %q is not published", packVersion, to.String(pack.ID))
+			return recipes.NewRecipeError(recipes.RecipeConfigurationFailure, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+		}
+
+		recipePacks = append(recipePacks, recipepack.Pack[v20231001preview.RecipePropertiesClassification]{
+			ID:      to.String(pack.ID),
+			Recipes: published.Recipes,
+		})
+	}
+
+	resolved, err := recipepack.Resolve(environment.Properties.Recipes, recipePacks)
+	if err != nil {
+		return recipes.NewRecipeError(recipes.RecipeConfigurationFailure, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	environment.Properties.Recipes = resolved
+	return nil
+}
+
+// recipePackVersion returns the version of the recipe pack registered to the environment.
+func recipePackVersion(environment *v20231001preview.EnvironmentResource, recipePackID string) string {
+	for _, recipePack := range environment.Properties.RecipePacks {
+		if strings.EqualFold(to.String(recipePack.ID), recipePackID) {
+			return to.String(recipePack.Version)
+		}
+	}
+
+	return ""
+}
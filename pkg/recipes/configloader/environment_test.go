@@ -307,6 +307,17 @@ func TestGetRecipeDefinition(t *testing.T) {
 						TemplatePath:    to.Ptr("Azure/cosmosdb/azurerm"),
 						TemplateVersion: to.Ptr("1.1.0"),
 					},
+					"mongo-helm": &model.HelmRecipeProperties{
+						TemplateKind:    to.Ptr(recipes.TemplateKindHelm),
+						TemplatePath:    to.Ptr("oci://localhost:8000/charts/mongodb"),
+						TemplateVersion: to.Ptr("15.0.0"),
+						PlainHTTP:       to.Ptr(true),
+						Outputs: &model.RecipeOutputContract{
+							Values:    map[string]*string{"host": to.Ptr(recipes.OutputTypeString)},
+							Secrets:   []*string{to.Ptr("connectionString")},
+							Resources: to.Ptr(true),
+						},
+					},
 				},
 			},
 		},
@@ -365,6 +376,29 @@ func TestGetRecipeDefinition(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, recipeDef, &expected)
 	})
+	t.Run("success-helm", func(t *testing.T) {
+		metadata := recipes.ResourceMetadata{
+			Name:          "mongo-helm",
+			EnvironmentID: envResourceId,
+			ResourceID:    mongoResourceID,
+		}
+		expected := recipes.EnvironmentDefinition{
+			Name:            "mongo-helm",
+			Driver:          recipes.TemplateKindHelm,
+			ResourceType:    "Applications.Datastores/mongoDatabases",
+			TemplatePath:    "oci://localhost:8000/charts/mongodb",
+			TemplateVersion: "15.0.0",
+			PlainHTTP:       true,
+			Outputs: &recipes.OutputContract{
+				Values:    map[string]string{"host": recipes.OutputTypeString},
+				Secrets:   []string{"connectionString"},
+				Resources: true,
+			},
+		}
+		recipeDef, err := getRecipeDefinition(&envResource, &metadata)
+		require.NoError(t, err)
+		require.Equal(t, recipeDef, &expected)
+	})
 	t.Run("success-terraform", func(t *testing.T) {
 		recipeMetadata.Name = terraformRecipe
 		expected := recipes.EnvironmentDefinition{
@@ -386,3 +420,125 @@ func TestGetRecipeDefinition(t *testing.T) {
 		require.Contains(t, err.Error(), "could not find recipe")
 	})
 }
+
+func TestApplyRecipePacks(t *testing.T) {
+	corePackID := "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/recipePacks/core"
+	teamPackID := "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/recipePacks/team"
+	newEnvironment := func() *model.EnvironmentResource {
+		return &model.EnvironmentResource{
+			Properties: &model.EnvironmentProperties{
+				Recipes: map[string]map[string]model.RecipePropertiesClassification{
+					"Applications.Datastores/mongoDatabases": {
+						recipeName: &model.BicepRecipeProperties{
+							TemplateKind: to.Ptr(recipes.TemplateKindBicep),
+							TemplatePath: to.Ptr("ghcr.io/radius-project/dev/recipes/mongodatabases/azure:1.0"),
+						},
+					},
+				},
+				RecipePacks: []*model.RecipePackReference{
+					{ID: to.Ptr(corePackID), Version: to.Ptr("1.0.0")},
+					{ID: to.Ptr(teamPackID), Version: to.Ptr("0.1.0")},
+				},
+			},
+		}
+	}
+	coreRecipes := map[string]map[string]model.RecipePropertiesClassification{
+		"Applications.Datastores/mongoDatabases": {
+			recipeName: &model.BicepRecipeProperties{
+				TemplateKind: to.Ptr(recipes.TemplateKindBicep),
+				TemplatePath: to.Ptr("ghcr.io/radius-project/packs/core/mongodatabases:1.0"),
+			},
+		},
+		"Applications.Datastores/redisCaches": {
+			recipeName: &model.TerraformRecipeProperties{
+				TemplateKind:    to.Ptr(recipes.TemplateKindTerraform),
+				TemplatePath:    to.Ptr("Azure/redis/azurerm"),
+				TemplateVersion: to.Ptr("1.0.0"),
+			},
+		},
+	}
+	newCoreRecipes := map[string]map[string]model.RecipePropertiesClassification{
+		"Applications.Datastores/redisCaches": {
+			recipeName: &model.TerraformRecipeProperties{
+				TemplateKind:    to.Ptr(recipes.TemplateKindTerraform),
+				TemplatePath:    to.Ptr("Azure/redis/azurerm"),
+				TemplateVersion: to.Ptr("2.0.0"),
+			},
+		},
+	}
+	// The environment registers version 1.0.0 of the core pack, which is no longer its current version.
+	corePack := &model.RecipePackResource{
+		ID: to.Ptr(corePackID),
+		Properties: &model.RecipePackProperties{
+			Version: to.Ptr("2.0.0"),
+			Recipes: newCoreRecipes,
+			Versions: map[string]*model.RecipePackVersion{
+				"1.0.0": {Recipes: coreRecipes},
+				"2.0.0": {Recipes: newCoreRecipes},
+			},
+		},
+	}
+
+	t.Run("pack recipes are registered to the environment", func(t *testing.T) {
+		environment := newEnvironment()
+		err := applyRecipePacks(environment, []*model.RecipePackResource{corePack})
+		require.NoError(t, err)
+
+		// The recipe defined in the environment overrides the recipe of the pack.
+		mongo := environment.Properties.Recipes["Applications.Datastores/mongoDatabases"][recipeName]
+		require.Equal(t, "ghcr.io/radius-project/dev/recipes/mongodatabases/azure:1.0", *mongo.GetRecipeProperties().TemplatePath)
+
+		metadata := recipes.ResourceMetadata{
+			Name:          recipeName,
+			EnvironmentID: envResourceId,
+			ResourceID:    redisID,
+		}
+		recipeDef, err := getRecipeDefinition(environment, &metadata)
+		require.NoError(t, err)
+		require.Equal(t, &recipes.EnvironmentDefinition{
+			Name:            recipeName,
+			Driver:          recipes.TemplateKindTerraform,
+			ResourceType:    "Applications.Datastores/redisCaches",
+			TemplatePath:    "Azure/redis/azurerm",
+			TemplateVersion: "1.0.0",
+		}, recipeDef)
+	})
+
+	t.Run("conflicting packs", func(t *testing.T) {
+		teamRecipes := map[string]map[string]model.RecipePropertiesClassification{
+			"Applications.Datastores/redisCaches": {
+				recipeName: &model.BicepRecipeProperties{
+					TemplateKind: to.Ptr(recipes.TemplateKindBicep),
+					TemplatePath: to.Ptr("ghcr.io/radius-project/packs/team/rediscaches:1.0"),
+				},
+			},
+		}
+		teamPack := &model.RecipePackResource{
+			ID: to.Ptr(teamPackID),
+			Properties: &model.RecipePackProperties{
+				Version:  to.Ptr("0.1.0"),
+				Recipes:  teamRecipes,
+				Versions: map[string]*model.RecipePackVersion{"0.1.0": {Recipes: teamRecipes}},
+			},
+		}
+
+		err := applyRecipePacks(newEnvironment(), []*model.RecipePackResource{corePack, teamPack})
+		require.Error(t, err)
+		recipeErr, ok := err.(*recipes.RecipeError)
+		require.True(t, ok)
+		require.Equal(t, recipes.RecipeConfigurationFailure, recipeErr.ErrorDetails.Code)
+		require.Contains(t, err.Error(), "is defined by multiple recipe packs")
+	})
+
+	t.Run("version not published", func(t *testing.T) {
+		environment := newEnvironment()
+		environment.Properties.RecipePacks[0].Version = to.Ptr("3.0.0")
+
+		err := applyRecipePacks(environment, []*model.RecipePackResource{corePack})
+		require.Error(t, err)
+		recipeErr, ok := err.(*recipes.RecipeError)
+		require.True(t, ok)
+		require.Equal(t, recipes.RecipeConfigurationFailure, recipeErr.ErrorDetails.Code)
+		require.Contains(t, err.Error(), `version "3.0.0" of recipe pack`)
+	})
+}
@@ -70,6 +70,15 @@ func New(options hostoptions.HostOptions) (*RecipeControllerConfig, error) {
 		return nil, err
 	}
 
+	deploymentOperationsClient, err := clients.NewResourceDeploymentOperationsClient(&clients.Options{
+		Cred:             &aztoken.AnonymousCredential{},
+		BaseURI:          options.UCPConnection.Endpoint(),
+		ARMClientOptions: sdk.NewClientOptions(options.UCPConnection),
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	if options.Config.Bicep.DeleteRetryCount == "" {
 		options.Config.Bicep.DeleteRetryCount = "3"
 	}
@@ -96,6 +105,7 @@ func New(options hostoptions.HostOptions) (*RecipeControllerConfig, error) {
 			recipes.TemplateKindBicep: driver.NewBicepDriver(
 				clientOptions,
 				cfg.DeploymentEngineClient,
+				deploymentOperationsClient,
 				processors.NewResourceClient(options.Arm, options.UCPConnection, cfg.Kubernetes),
 				driver.BicepOptions{
 					DeleteRetryCount:        bicepDeleteRetryCount,
@@ -104,8 +114,15 @@ func New(options hostoptions.HostOptions) (*RecipeControllerConfig, error) {
 			),
 			recipes.TemplateKindTerraform: driver.NewTerraformDriver(options.UCPConnection, secretprovider.NewSecretProvider(options.Config.SecretProvider),
 				driver.TerraformOptions{
-					Path: options.Config.Terraform.Path,
+					Path:               options.Config.Terraform.Path,
+					BinaryPath:         options.Config.Terraform.BinaryPath,
+					TofuBinaryPath:     options.Config.Terraform.TofuBinaryPath,
+					TofuSigningKeyPath: options.Config.Terraform.TofuSigningKeyPath,
+					MirrorDir:          options.Config.Terraform.MirrorDir,
+					CacheDisabled:      options.Config.Terraform.Cache.Disabled,
+					CacheMaxSizeBytes:  options.Config.Terraform.Cache.MaxSizeMB * 1024 * 1024,
 				}, *cfg.Kubernetes),
+			recipes.TemplateKindHelm: driver.NewHelmDriver(cfg.Kubernetes),
 		},
 	})
 
@@ -20,7 +20,9 @@ import (
 	"context"
 	"fmt"
 	reflect "reflect"
+	"slices"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
@@ -35,6 +37,8 @@ import (
 	"github.com/radius-project/radius/pkg/portableresources/processors"
 	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/recipes/recipecontext"
+	"github.com/radius-project/radius/pkg/recipes/recipelogs"
+	"github.com/radius-project/radius/pkg/recipes/recipeparameters"
 	recipes_util "github.com/radius-project/radius/pkg/recipes/util"
 	"github.com/radius-project/radius/pkg/rp/util"
 	"github.com/radius-project/radius/pkg/rp/util/authclient"
@@ -54,16 +58,24 @@ const (
 
 var _ Driver = (*bicepDriver)(nil)
 
-// NewBicepDriver creates a new bicep driver instance with the given ARM client options, deployment client, resource client, and options.
-func NewBicepDriver(armOptions *arm.ClientOptions, deploymentClient clients.ResourceDeploymentsClient, client processors.ResourceClient, options BicepOptions) Driver {
+// NewBicepDriver creates a new bicep driver instance with the given ARM client options, deployment client, deployment operations client,
+// resource client, and options.
+func NewBicepDriver(armOptions *arm.ClientOptions, deploymentClient clients.ResourceDeploymentsClient, operationsClient DeploymentOperationsClient, client processors.ResourceClient, options BicepOptions) Driver {
 	return &bicepDriver{
 		ArmClientOptions: armOptions,
 		DeploymentClient: deploymentClient,
+		OperationsClient: operationsClient,
 		ResourceClient:   client,
 		options:          options,
 	}
 }
 
+// DeploymentOperationsClient lists the operations of a deployment.
+type DeploymentOperationsClient interface {
+	// List lists the operations of the deployment with the given resource ID.
+	List(ctx context.Context, resourceGroupName string, deploymentName string, resourceID string, apiVersion string, top *int32) (*armresources.DeploymentOperationsListResult, error)
+}
+
 type BicepOptions struct {
 	DeleteRetryCount        int
 	DeleteRetryDelaySeconds int
@@ -75,14 +87,22 @@ type bicepDriver struct {
 	ResourceClient   processors.ResourceClient
 	options          BicepOptions
 
+	// OperationsClient is the optional client used to list the operations of the deployments to record them to the recipe logs.
+	OperationsClient DeploymentOperationsClient
+
 	// RegistryClient is the optional client used to interact with the container registry.
 	RegistryClient remote.Client
 }
 
 // Execute fetches recipe contents from container registry, creates a deployment ID, a recipe context parameter, recipe parameters,
 // a provider config, and deploys a bicep template for the recipe using UCP deployment client, then polls until the deployment
-// is done and prepares the recipe response.
+// is done and prepares the recipe response. If existing resources are imported, the recipe is not deployed and the imported
+// resources are returned instead.
 func (d *bicepDriver) Execute(ctx context.Context, opts ExecuteOptions) (*recipes.RecipeOutput, error) {
+	if opts.Import != nil {
+		return d.importResources(ctx, opts)
+	}
+
 	logger := logr.FromContextOrDiscard(ctx)
 	logger.Info(fmt.Sprintf("Deploying recipe: %q, template: %q", opts.Definition.Name, opts.Definition.TemplatePath))
 
@@ -113,6 +133,12 @@ func (d *bicepDriver) Execute(ctx context.Context, opts ExecuteOptions) (*recipe
 	metrics.DefaultRecipeEngineMetrics.RecordRecipeDownloadDuration(ctx, downloadStartTime,
 		metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDownloadRecipe, opts.Recipe.Name, &opts.Definition, metrics.SuccessfulOperationState))
 
+	// Invalid parameters are reported before the deployment starts.
+	err = recipeparameters.Validate(recipeData, &opts.Definition, &opts.Recipe)
+	if err != nil {
+		return nil, err
+	}
+
 	// create the context object to be passed to the recipe deployment
 	recipeContext, err := recipecontext.New(&opts.Recipe, &opts.Configuration)
 	if err != nil {
@@ -134,6 +160,7 @@ func (d *bicepDriver) Execute(ctx context.Context, opts ExecuteOptions) (*recipe
 	providerConfig := newProviderConfig(deploymentID.FindScope(resources_radius.ScopeResourceGroups), opts.Configuration.Providers)
 
 	logger.Info("deploying bicep template for recipe", "deploymentID", deploymentID)
+	recipelogs.FromContext(ctx).Recordf(recipelogs.SourceDeployment, "Deploying Bicep template %q as deployment %q", opts.Definition.TemplatePath, deploymentID.String())
 	if providerConfig.AWS != nil {
 		logger.Info("using AWS provider", "deploymentID", deploymentID, "scope", providerConfig.AWS.Value.Scope)
 	}
@@ -160,6 +187,11 @@ func (d *bicepDriver) Execute(ctx context.Context, opts ExecuteOptions) (*recipe
 	}
 
 	resp, err := poller.PollUntilDone(ctx, &clients.PollUntilDoneOptions{Frequency: pollFrequency})
+	d.recordDeploymentOperations(ctx, deploymentID.String())
+	if err != nil && ctx.Err() != nil {
+		// Deployments cannot be canceled, so the deployment continues in the deployment engine after the recipe is canceled.
+		recipelogs.FromContext(ctx).Recordf(recipelogs.SourceDeployment, "Stopped waiting for deployment %q because the recipe was canceled, the deployment may still complete", deploymentID.String())
+	}
 	if err != nil {
 		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, fmt.Sprintf("failed to deploy recipe %s of type %s", opts.BaseOptions.Recipe.Name, opts.BaseOptions.Definition.ResourceType), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
 	}
@@ -193,6 +225,28 @@ func (d *bicepDriver) Execute(ctx context.Context, opts ExecuteOptions) (*recipe
 	return recipeResponse, nil
 }
 
+// importResources adopts the existing resources of the import instead of deploying the recipe. Bicep deployments do not
+// keep any state, so the resources are recorded as the output resources of the recipe. The next deployment of the recipe
+// updates the resources the template deploys with the same IDs, and deletes the adopted resources it no longer deploys
+// like any other obsolete output resource.
+func (d *bicepDriver) importResources(ctx context.Context, opts ExecuteOptions) (*recipes.RecipeOutput, error) {
+	importedResources := []string{}
+	for _, resource := range opts.Import.Resources {
+		id, err := resources.ParseResource(resource.ID)
+		if err != nil {
+			return nil, recipes.NewRecipeError(recipes.RecipeImportFailed, fmt.Sprintf("failed to import resource %q: the ID must be a valid resource ID", resource.ID), recipes_util.RecipeSetupError, nil)
+		}
+
+		recipelogs.FromContext(ctx).Recordf(recipelogs.SourceDeployment, "Importing resource %q", id.String())
+		importedResources = append(importedResources, id.String())
+	}
+
+	return importedRecipeOutput(opts.Import, importedResources, &rpv1.RecipeStatus{
+		TemplateKind: recipes.TemplateKindBicep,
+		TemplatePath: opts.Definition.TemplatePath,
+	}), nil
+}
+
 // Delete deletes all of the output resources that are marked as managed by Radius.
 // It will create a goroutine for each resource to be deleted and wait for them to finish,
 // retrying if necessary.
@@ -200,6 +254,7 @@ func (d *bicepDriver) Execute(ctx context.Context, opts ExecuteOptions) (*recipe
 // all in parallel. Since some resources may depend on others, we may need to retry.
 func (d *bicepDriver) Delete(ctx context.Context, opts DeleteOptions) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
+	recorder := recipelogs.FromContext(ctx)
 
 	// Create a waitgroup to track the deletion of each output resource
 	g, groupCtx := errgroup.WithContext(ctx)
@@ -215,6 +270,7 @@ func (d *bicepDriver) Delete(ctx context.Context, opts DeleteOptions) error {
 			// If the resource is not managed by Radius, skip the deletion
 			if outputResource.RadiusManaged == nil || !*outputResource.RadiusManaged {
 				logger.Info(fmt.Sprintf("Skipping deletion of output resource: %q, not managed by Radius", id))
+				recorder.Recordf(recipelogs.SourceDeployment, "Skipping deletion of resource %q, not managed by Radius", id)
 				return nil
 			}
 
@@ -237,10 +293,12 @@ func (d *bicepDriver) Delete(ctx context.Context, opts DeleteOptions) error {
 
 				// If the err is nil, then the resource is deleted successfully
 				logger.V(ucplog.LevelInfo).Info(fmt.Sprintf("Deleted output resource: %q", id))
+				recorder.Recordf(recipelogs.SourceDeployment, "Deleted resource %q", id)
 				return nil
 			}
 
 			deletionErr := fmt.Errorf("failed to delete resource after %d attempt(s), last error: %s", d.options.DeleteRetryCount+1, err.Error())
+			recorder.Recordf(recipelogs.SourceDeployment, "Failed to delete resource %q: %s", id, deletionErr.Error())
 			return recipes.NewRecipeError(recipes.RecipeDeletionFailed, deletionErr.Error(), "", recipes.GetErrorDetails(deletionErr))
 		})
 	}
@@ -252,6 +310,67 @@ func (d *bicepDriver) Delete(ctx context.Context, opts DeleteOptions) error {
 	return nil
 }
 
+// recordDeploymentOperations records the operations of the deployment to the recipe logs of the context, so the
+// cause of a failed deployment can be found without access to the deployment engine.
+func (d *bicepDriver) recordDeploymentOperations(ctx context.Context, deploymentID string) {
+	recorder := recipelogs.FromContext(ctx)
+	if recorder == nil || d.OperationsClient == nil {
+		return
+	}
+
+	result, err := d.OperationsClient.List(ctx, "", "", deploymentID, clients.DeploymentOperationsClientAPIVersion, nil)
+	if err != nil {
+		ucplog.FromContextOrDiscard(ctx).Error(err, "failed to list deployment operations", "deploymentID", deploymentID)
+		recorder.Recordf(recipelogs.SourceDeployment, "Failed to list the operations of deployment %q: %s", deploymentID, err.Error())
+		return
+	}
+
+	operations := slices.DeleteFunc(slices.Clone(result.Value), func(operation *armresources.DeploymentOperation) bool {
+		return operation == nil || operation.Properties == nil
+	})
+	slices.SortStableFunc(operations, func(a, b *armresources.DeploymentOperation) int {
+		var ta, tb time.Time
+		if a.Properties.Timestamp != nil {
+			ta = *a.Properties.Timestamp
+		}
+		if b.Properties.Timestamp != nil {
+			tb = *b.Properties.Timestamp
+		}
+		return ta.Compare(tb)
+	})
+
+	for _, operation := range operations {
+		recorder.Record(recipelogs.SourceDeployment, formatDeploymentOperation(operation.Properties))
+	}
+}
+
+// formatDeploymentOperation formats a deployment operation for the recipe logs, e.g.
+// "Failed: /planes/aws/aws/.../AWS.S3/Bucket/my-bucket: InvalidBucketName: The specified bucket is not valid.".
+func formatDeploymentOperation(properties *armresources.DeploymentOperationProperties) string {
+	target := "deployment"
+	if properties.TargetResource != nil && properties.TargetResource.ID != nil {
+		target = *properties.TargetResource.ID
+	}
+
+	message := fmt.Sprintf("%s: %s", to.String(properties.ProvisioningState), target)
+	if properties.StatusMessage == nil {
+		return message
+	}
+
+	if responseError := properties.StatusMessage.Error; responseError != nil {
+		message = fmt.Sprintf("%s: %s: %s", message, to.String(responseError.Code), to.String(responseError.Message))
+		for _, detail := range responseError.Details {
+			if detail != nil {
+				message = fmt.Sprintf("%s; %s: %s", message, to.String(detail.Code), to.String(detail.Message))
+			}
+		}
+	} else if status := to.String(properties.StatusMessage.Status); status != "" {
+		message = fmt.Sprintf("%s: %s", message, status)
+	}
+
+	return message
+}
+
 // GetRecipeMetadata gets the Bicep recipe parameters information from the container registry
 func (d *bicepDriver) GetRecipeMetadata(ctx context.Context, opts BaseOptions) (map[string]any, error) {
 	// Recipe parameters can be found in the recipe data pulled from the registry in the following format:
@@ -296,6 +415,123 @@ func (d *bicepDriver) GetRecipeMetadata(ctx context.Context, opts BaseOptions) (
 	return recipeData, nil
 }
 
+// Plan fetches recipe contents from container registry and previews the deployment of the bicep template for the recipe using
+// the what-if operation of the UCP deployment client. Resources of the previous deployment that are not deployed by the template
+// anymore are planned to be deleted, like they are deleted by the garbage collection of Execute.
+func (d *bicepDriver) Plan(ctx context.Context, opts ExecuteOptions) (*recipes.RecipePlan, error) {
+	logger := logr.FromContextOrDiscard(ctx)
+	logger.Info(fmt.Sprintf("Planning recipe: %q, template: %q", opts.Definition.Name, opts.Definition.TemplatePath))
+
+	recipeData, err := d.GetRecipeMetadata(ctx, opts.BaseOptions)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDownloadFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	err = recipeparameters.Validate(recipeData, &opts.Definition, &opts.Recipe)
+	if err != nil {
+		return nil, err
+	}
+
+	recipeContext, err := recipecontext.New(&opts.Recipe, &opts.Configuration)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	parameters := createRecipeParameters(opts.Recipe.Parameters, opts.Definition.Parameters, hasContextParameter(recipeData), recipeContext)
+
+	deploymentName := deploymentPrefix + strconv.FormatInt(time.Now().UnixNano(), 10)
+	deploymentID, err := createDeploymentID(recipeContext.Resource.ID, deploymentName)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	providerConfig := newProviderConfig(deploymentID.FindScope(resources_radius.ScopeResourceGroups), opts.Configuration.Providers)
+
+	poller, err := d.DeploymentClient.WhatIf(
+		ctx,
+		clients.Deployment{
+			Properties: &clients.DeploymentProperties{
+				Mode:           armresources.DeploymentModeIncremental,
+				ProviderConfig: &providerConfig,
+				Parameters:     parameters,
+				Template:       recipeData,
+			},
+		},
+		deploymentID.String(),
+		clients.DeploymentsClientAPIVersion,
+	)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, fmt.Sprintf("failed to plan recipe %s of type %s: %s", opts.Recipe.Name, opts.Definition.ResourceType, err.Error()), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	resp, err := poller.PollUntilDone(ctx, &clients.PollUntilDoneOptions{Frequency: pollFrequency})
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, fmt.Sprintf("failed to plan recipe %s of type %s: %s", opts.Recipe.Name, opts.Definition.ResourceType, err.Error()), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	return convertWhatIfResult(resp.WhatIfOperationResult, opts.PrevState), nil
+}
+
+// convertWhatIfResult converts the changes of a what-if operation to a recipe plan. The resources of the previous state
+// that are not part of the what-if result are planned to be deleted.
+func convertWhatIfResult(result armresources.WhatIfOperationResult, prevState []string) *recipes.RecipePlan {
+	plan := &recipes.RecipePlan{ResourceChanges: []recipes.ResourceChange{}}
+
+	found := map[string]bool{}
+	if result.Properties != nil {
+		for _, change := range result.Properties.Changes {
+			if change == nil || change.ResourceID == nil || change.ChangeType == nil {
+				continue
+			}
+
+			var action recipes.ResourceChangeAction
+			switch *change.ChangeType {
+			case armresources.ChangeTypeCreate:
+				action = recipes.ResourceChangeCreate
+			case armresources.ChangeTypeDelete:
+				action = recipes.ResourceChangeDelete
+			case armresources.ChangeTypeNoChange, armresources.ChangeTypeIgnore:
+				action = recipes.ResourceChangeNone
+			default:
+				// Modify, Deploy and Unsupported changes are all redeployments of an existing resource, whose
+				// properties may change.
+				action = recipes.ResourceChangeUpdate
+			}
+
+			found[strings.ToLower(*change.ResourceID)] = true
+			plan.ResourceChanges = append(plan.ResourceChanges, recipes.ResourceChange{
+				ID:     *change.ResourceID,
+				Type:   resourceType(*change.ResourceID),
+				Action: action,
+			})
+		}
+	}
+
+	for _, id := range prevState {
+		if found[strings.ToLower(id)] {
+			continue
+		}
+
+		plan.ResourceChanges = append(plan.ResourceChanges, recipes.ResourceChange{
+			ID:     id,
+			Type:   resourceType(id),
+			Action: recipes.ResourceChangeDelete,
+		})
+	}
+
+	return plan
+}
+
+// resourceType returns the type of the resource with the given ID, or an empty string if the ID is invalid.
+func resourceType(id string) string {
+	parsed, err := resources.Parse(id)
+	if err != nil {
+		return ""
+	}
+
+	return parsed.Type()
+}
+
 func hasContextParameter(recipeData map[string]any) bool {
 	parametersAny, ok := recipeData[recipeParameters]
 	if !ok {
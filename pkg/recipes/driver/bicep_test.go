@@ -17,9 +17,12 @@ limitations under the License.
 package driver
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
@@ -27,6 +30,7 @@ import (
 	"github.com/radius-project/radius/pkg/portableresources/processors"
 	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/recipes/recipecontext"
+	"github.com/radius-project/radius/pkg/recipes/recipelogs"
 	"github.com/radius-project/radius/pkg/rp/util/registrytest"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 	clients "github.com/radius-project/radius/pkg/sdk/clients"
@@ -378,6 +382,59 @@ func Test_Bicep_PrepareRecipeResponse_EmptyResult(t *testing.T) {
 	require.Equal(t, expectedResponse, actualResponse)
 }
 
+func Test_Bicep_Execute_Import_Success(t *testing.T) {
+	ctx := testcontext.New(t)
+	d := &bicepDriver{}
+
+	resourceID := "/subscriptions/test-sub/resourceGroups/test-rg/providers/Microsoft.Cache/redis/test-cache"
+	recipeImport := &recipes.RecipeImport{
+		Resources: []recipes.ImportedResource{
+			{ID: resourceID},
+		},
+		Values: map[string]any{
+			"host": "test-cache.redis.cache.windows.net",
+		},
+	}
+
+	// The template is not deployed, so no client is used.
+	recipeOutput, err := d.Execute(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Definition: recipes.EnvironmentDefinition{
+				TemplatePath: "radiusdev.azurecr.io/recipes/redis:1.0",
+			},
+		},
+		Import: recipeImport,
+	})
+	require.NoError(t, err)
+	require.Equal(t, &recipes.RecipeOutput{
+		Resources: []string{resourceID},
+		Values:    recipeImport.Values,
+		Secrets:   map[string]any{},
+		Status: &rpv1.RecipeStatus{
+			TemplateKind: recipes.TemplateKindBicep,
+			TemplatePath: "radiusdev.azurecr.io/recipes/redis:1.0",
+		},
+	}, recipeOutput)
+}
+
+func Test_Bicep_Execute_Import_InvalidID(t *testing.T) {
+	ctx := testcontext.New(t)
+	d := &bicepDriver{}
+
+	_, err := d.Execute(ctx, ExecuteOptions{
+		Import: &recipes.RecipeImport{
+			Resources: []recipes.ImportedResource{
+				{ID: "test-cache"},
+			},
+		},
+	})
+	require.Error(t, err)
+
+	recipeErr := &recipes.RecipeError{}
+	require.ErrorAs(t, err, &recipeErr)
+	require.Equal(t, recipes.RecipeImportFailed, recipeErr.ErrorDetails.Code)
+}
+
 func setupDeleteInputs(t *testing.T) (bicepDriver, *processors.MockResourceClient) {
 	ctrl := gomock.NewController(t)
 	client := processors.NewMockResourceClient(ctrl)
@@ -514,6 +571,37 @@ func Test_Bicep_GetRecipeMetadata_Error(t *testing.T) {
 	require.Equal(t, actualErr, &expErr)
 }
 
+func Test_Bicep_Execute_InvalidParameters(t *testing.T) {
+	ts := registrytest.NewFakeRegistryServer(t)
+	t.Cleanup(ts.CloseServer)
+
+	ctx := testcontext.New(t)
+	// The template is not deployed, so no deployment client is used.
+	driver := &bicepDriver{RegistryClient: ts.TestServer.Client()}
+
+	_, err := driver.Execute(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Recipe: recipes.ResourceMetadata{
+				Name:       "mongo-azure",
+				Parameters: map[string]any{"unknown": "value"},
+			},
+			Definition: recipes.EnvironmentDefinition{
+				Name:         "mongo-azure",
+				Driver:       recipes.TemplateKindBicep,
+				TemplatePath: ts.TestImageURL,
+				ResourceType: "Applications.Datastores/mongoDatabases",
+			},
+		},
+	})
+	require.Error(t, err)
+
+	recipeErr := &recipes.RecipeError{}
+	require.ErrorAs(t, err, &recipeErr)
+	require.Equal(t, recipes.InvalidRecipeParameters, recipeErr.ErrorDetails.Code)
+	require.Contains(t, recipeErr.ErrorDetails.Message, "unknown")
+	require.Contains(t, recipeErr.ErrorDetails.Message, "documentdbName")
+}
+
 func Test_GetGCOutputResources(t *testing.T) {
 	d := &bicepDriver{}
 	before := []string{
@@ -586,3 +674,104 @@ func Test_Bicep_Delete_Success_AfterRetry(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+func Test_ConvertWhatIfResult(t *testing.T) {
+	accountID := "/subscriptions/test-sub/resourceGroups/test-rg/providers/Microsoft.DocumentDB/databaseAccounts/account"
+	result := armresources.WhatIfOperationResult{
+		Properties: &armresources.WhatIfOperationProperties{
+			Changes: []*armresources.WhatIfChange{
+				{ResourceID: to.Ptr(accountID), ChangeType: to.Ptr(armresources.ChangeTypeModify)},
+				{ResourceID: to.Ptr(accountID + "/mongodbDatabases/db"), ChangeType: to.Ptr(armresources.ChangeTypeCreate)},
+				{ResourceID: to.Ptr("/planes/kubernetes/local/namespaces/default/providers/core/Secret/secret"), ChangeType: to.Ptr(armresources.ChangeTypeNoChange)},
+			},
+		},
+	}
+	prevState := []string{
+		// Resource IDs of the previous state are compared case-insensitively.
+		strings.ToLower(accountID),
+		"/subscriptions/test-sub/resourceGroups/test-rg/providers/Microsoft.Storage/storageAccounts/storage",
+	}
+
+	plan := convertWhatIfResult(result, prevState)
+	expected := &recipes.RecipePlan{
+		ResourceChanges: []recipes.ResourceChange{
+			{ID: accountID, Type: "Microsoft.DocumentDB/databaseAccounts", Action: recipes.ResourceChangeUpdate},
+			{ID: accountID + "/mongodbDatabases/db", Type: "Microsoft.DocumentDB/databaseAccounts/mongodbDatabases", Action: recipes.ResourceChangeCreate},
+			{ID: "/planes/kubernetes/local/namespaces/default/providers/core/Secret/secret", Type: "core/Secret", Action: recipes.ResourceChangeNone},
+			{ID: "/subscriptions/test-sub/resourceGroups/test-rg/providers/Microsoft.Storage/storageAccounts/storage", Type: "Microsoft.Storage/storageAccounts", Action: recipes.ResourceChangeDelete},
+		},
+	}
+	require.Equal(t, expected, plan)
+}
+
+// fakeOperationsClient is a DeploymentOperationsClient that returns a fixed list of operations.
+type fakeOperationsClient struct {
+	operations []*armresources.DeploymentOperation
+	err        error
+}
+
+func (c *fakeOperationsClient) List(ctx context.Context, resourceGroupName string, deploymentName string, resourceID string, apiVersion string, top *int32) (*armresources.DeploymentOperationsListResult, error) {
+	return &armresources.DeploymentOperationsListResult{Value: c.operations}, c.err
+}
+
+func Test_RecordDeploymentOperations(t *testing.T) {
+	deploymentID := "/planes/radius/local/resourceGroups/test-rg/providers/Microsoft.Resources/deployments/recipe123"
+	bucketID := "/planes/aws/aws/accounts/000/regions/us-west-2/providers/AWS.S3/Bucket/my-bucket"
+	secretID := "/planes/kubernetes/local/namespaces/default/providers/core/Secret/secret"
+	now := time.Now()
+
+	t.Run("operations", func(t *testing.T) {
+		d := &bicepDriver{OperationsClient: &fakeOperationsClient{
+			operations: []*armresources.DeploymentOperation{
+				{
+					Properties: &armresources.DeploymentOperationProperties{
+						ProvisioningState: to.Ptr("Failed"),
+						Timestamp:         to.Ptr(now.Add(time.Second)),
+						TargetResource:    &armresources.TargetResource{ID: to.Ptr(bucketID)},
+						StatusMessage: &armresources.StatusMessage{
+							Error: &armresources.ErrorResponse{
+								Code:    to.Ptr("InvalidRequest"),
+								Message: to.Ptr("The bucket name is not valid."),
+								Details: []*armresources.ErrorResponse{{Code: to.Ptr("InvalidBucketName"), Message: to.Ptr("Bucket names must be lowercase.")}},
+							},
+						},
+					},
+				},
+				nil,
+				{
+					Properties: &armresources.DeploymentOperationProperties{
+						ProvisioningState: to.Ptr("Succeeded"),
+						Timestamp:         to.Ptr(now),
+						TargetResource:    &armresources.TargetResource{ID: to.Ptr(secretID)},
+						StatusMessage:     &armresources.StatusMessage{Status: to.Ptr("OK")},
+					},
+				},
+			},
+		}}
+
+		recorder := recipelogs.NewRecorder(recipelogs.DefaultMaxSize)
+		d.recordDeploymentOperations(recipelogs.WithRecorder(testcontext.New(t), recorder), deploymentID)
+
+		entries, _ := recorder.Entries()
+		require.Len(t, entries, 2)
+		require.Equal(t, recipelogs.SourceDeployment, entries[0].Source)
+		require.Equal(t, "Succeeded: "+secretID+": OK", entries[0].Message)
+		require.Equal(t, "Failed: "+bucketID+": InvalidRequest: The bucket name is not valid.; InvalidBucketName: Bucket names must be lowercase.", entries[1].Message)
+	})
+
+	t.Run("list error", func(t *testing.T) {
+		d := &bicepDriver{OperationsClient: &fakeOperationsClient{err: errors.New("not found")}}
+
+		recorder := recipelogs.NewRecorder(recipelogs.DefaultMaxSize)
+		d.recordDeploymentOperations(recipelogs.WithRecorder(testcontext.New(t), recorder), deploymentID)
+
+		entries, _ := recorder.Entries()
+		require.Len(t, entries, 1)
+		require.Equal(t, fmt.Sprintf("Failed to list the operations of deployment %q: not found", deploymentID), entries[0].Message)
+	})
+
+	t.Run("no recorder", func(t *testing.T) {
+		d := &bicepDriver{OperationsClient: &fakeOperationsClient{err: errors.New("not called")}}
+		d.recordDeploymentOperations(testcontext.New(t), deploymentID)
+	})
+}
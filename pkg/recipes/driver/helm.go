@@ -0,0 +1,378 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"reflect"
+	"regexp"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/radius-project/radius/pkg/components/kubernetesclient/kubernetesclientprovider"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/helm"
+	"github.com/radius-project/radius/pkg/recipes/recipecontext"
+	recipes_util "github.com/radius-project/radius/pkg/recipes/util"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// maxReleaseNameLength is the maximum length of the name of a Helm release.
+	maxReleaseNameLength = 53
+
+	// releaseNameHashLength is the length of the hash of the resource ID that suffixes the name of a Helm release.
+	releaseNameHashLength = 8
+)
+
+var invalidReleaseNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+var _ Driver = (*helmDriver)(nil)
+
+// NewHelmDriver creates a new instance of driver to execute a Helm chart recipe.
+func NewHelmDriver(kubernetesClients *kubernetesclientprovider.KubernetesClientProvider) Driver {
+	return &helmDriver{
+		helmExecutor:      helm.NewExecutor(kubernetesClients.Config()),
+		kubernetesClients: kubernetesClients,
+	}
+}
+
+// helmDriver represents a driver to interact with Helm chart recipes - install the chart, uninstall the release, etc.
+type helmDriver struct {
+	// helmExecutor is used to install, upgrade and uninstall the Helm releases of the recipes.
+	helmExecutor helm.HelmExecutor
+
+	// kubernetesClients provides the client used to read the outputs of the recipes.
+	kubernetesClients *kubernetesclientprovider.KubernetesClientProvider
+}
+
+// Execute installs the Helm chart of the recipe as a release in the Kubernetes namespace of the resource, or upgrades
+// the release if it is already installed. The recipe context is passed to the chart as the "context" value, along with
+// the recipe parameters. The Kubernetes objects of the release are returned as the resources of the recipe, and the
+// values and secrets of the recipe are read from the ConfigMap and Secret of the release annotated with
+// "radapp.io/recipe-output: true".
+func (d *helmDriver) Execute(ctx context.Context, opts ExecuteOptions) (*recipes.RecipeOutput, error) {
+	if opts.Import != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeImportFailed, "importing existing resources is not supported by Helm recipes", recipes_util.RecipeSetupError, nil)
+	}
+
+	logger := ucplog.FromContextOrDiscard(ctx)
+	logger.Info(fmt.Sprintf("Deploying recipe: %q, template: %q", opts.Definition.Name, opts.Definition.TemplatePath))
+
+	options, err := helmOptions(opts.BaseOptions)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	result, err := d.helmExecutor.Deploy(ctx, options)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	recipeResponse, err := d.prepareRecipeResponse(ctx, opts.Definition, result)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.InvalidRecipeOutputs, fmt.Sprintf("failed to read the recipe outputs: %s", err.Error()), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	return recipeResponse, nil
+}
+
+// Delete uninstalls the Helm release of the recipe, which deletes all of its Kubernetes objects.
+func (d *helmDriver) Delete(ctx context.Context, opts DeleteOptions) error {
+	options, err := helmOptions(opts.BaseOptions)
+	if err != nil {
+		return recipes.NewRecipeError(recipes.RecipeDeletionFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	err = d.helmExecutor.Delete(ctx, options)
+	if err != nil {
+		return recipes.NewRecipeError(recipes.RecipeDeletionFailed, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	return nil
+}
+
+// GetRecipeMetadata returns the top-level default values of the Helm chart of the recipe as the recipe parameters.
+func (d *helmDriver) GetRecipeMetadata(ctx context.Context, opts BaseOptions) (map[string]any, error) {
+	values, err := d.helmExecutor.GetRecipeMetadata(ctx, helm.Options{
+		ChartPath:    opts.Definition.TemplatePath,
+		ChartVersion: opts.Definition.TemplateVersion,
+		PlainHTTP:    opts.Definition.PlainHTTP,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	parameters := map[string]any{}
+	for name, value := range values {
+		parameters[name] = map[string]any{
+			"type":         helmValueType(value),
+			"defaultValue": value,
+		}
+	}
+
+	return map[string]any{
+		"parameters": parameters,
+	}, nil
+}
+
+// Plan renders the Helm chart of the recipe and compares the Kubernetes objects to the objects of the installed release.
+// Since Helm only compares the chart to the last release, changes made to the objects in the cluster outside of Helm
+// are not detected.
+func (d *helmDriver) Plan(ctx context.Context, opts ExecuteOptions) (*recipes.RecipePlan, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	logger.Info(fmt.Sprintf("Planning recipe: %q, template: %q", opts.Definition.Name, opts.Definition.TemplatePath))
+
+	options, err := helmOptions(opts.BaseOptions)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	current, planned, err := d.helmExecutor.Plan(ctx, options)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	return convertHelmPlan(current, planned), nil
+}
+
+// convertHelmPlan converts the objects of the current and planned Helm releases to a recipe plan.
+func convertHelmPlan(current *helm.Result, planned *helm.Result) *recipes.RecipePlan {
+	plan := &recipes.RecipePlan{ResourceChanges: []recipes.ResourceChange{}}
+
+	currentObjects := map[string]*unstructured.Unstructured{}
+	if current != nil {
+		for _, obj := range current.Objects {
+			currentObjects[strings.ToLower(kubernetesObjectID(obj))] = obj
+		}
+	}
+
+	found := map[string]bool{}
+	for _, obj := range planned.Objects {
+		id := kubernetesObjectID(obj)
+		key := strings.ToLower(id)
+		found[key] = true
+
+		action := recipes.ResourceChangeCreate
+		if currentObj, ok := currentObjects[key]; ok {
+			action = recipes.ResourceChangeNone
+			if !reflect.DeepEqual(currentObj.Object, obj.Object) {
+				action = recipes.ResourceChangeUpdate
+			}
+		}
+
+		plan.ResourceChanges = append(plan.ResourceChanges, recipes.ResourceChange{
+			ID:     id,
+			Type:   resourceType(id),
+			Action: action,
+		})
+	}
+
+	if current != nil {
+		for _, obj := range current.Objects {
+			id := kubernetesObjectID(obj)
+			if found[strings.ToLower(id)] {
+				continue
+			}
+
+			plan.ResourceChanges = append(plan.ResourceChanges, recipes.ResourceChange{
+				ID:     id,
+				Type:   resourceType(id),
+				Action: recipes.ResourceChangeDelete,
+			})
+		}
+	}
+
+	return plan
+}
+
+// prepareRecipeResponse populates the recipe response from the Kubernetes objects of the Helm release and the
+// ConfigMap and Secret holding the outputs of the recipe.
+func (d *helmDriver) prepareRecipeResponse(ctx context.Context, definition recipes.EnvironmentDefinition, result *helm.Result) (*recipes.RecipeOutput, error) {
+	recipeResponse := &recipes.RecipeOutput{
+		Resources: []string{},
+		Values:    map[string]any{},
+		Secrets:   map[string]any{},
+		Status: &rpv1.RecipeStatus{
+			TemplateKind:    recipes.TemplateKindHelm,
+			TemplatePath:    definition.TemplatePath,
+			TemplateVersion: definition.TemplateVersion,
+		},
+	}
+
+	for _, obj := range result.Objects {
+		recipeResponse.Resources = append(recipeResponse.Resources, kubernetesObjectID(obj))
+
+		if obj.GetAnnotations()[helm.OutputAnnotation] != "true" {
+			continue
+		}
+
+		err := d.readOutputs(ctx, obj, recipeResponse)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return recipeResponse, nil
+}
+
+// readOutputs reads the values of the recipe from the data of a ConfigMap, or the secrets of the recipe from the data of a Secret.
+// Values that are valid JSON are decoded, so that numbers and booleans keep their type.
+func (d *helmDriver) readOutputs(ctx context.Context, obj *unstructured.Unstructured, recipeResponse *recipes.RecipeOutput) error {
+	client, err := d.kubernetesClients.RuntimeClient()
+	if err != nil {
+		return err
+	}
+
+	key := runtimeclient.ObjectKey{Namespace: obj.GetNamespace(), Name: obj.GetName()}
+	switch obj.GroupVersionKind().GroupKind() {
+	case corev1.SchemeGroupVersion.WithKind("ConfigMap").GroupKind():
+		configMap := &corev1.ConfigMap{}
+		if err := client.Get(ctx, key, configMap); err != nil {
+			return fmt.Errorf("failed to read the outputs from ConfigMap %q: %w", key, err)
+		}
+
+		for name, value := range configMap.Data {
+			recipeResponse.Values[name] = decodeOutputValue(value)
+		}
+	case corev1.SchemeGroupVersion.WithKind("Secret").GroupKind():
+		secret := &corev1.Secret{}
+		if err := client.Get(ctx, key, secret); err != nil {
+			return fmt.Errorf("failed to read the outputs from Secret %q: %w", key, err)
+		}
+
+		for name, value := range secret.Data {
+			recipeResponse.Secrets[name] = string(value)
+		}
+	default:
+		return fmt.Errorf("%s %q is annotated with %q but only a ConfigMap or a Secret can hold the outputs of a recipe", obj.GetKind(), key, helm.OutputAnnotation)
+	}
+
+	return nil
+}
+
+// decodeOutputValue returns the value decoded from JSON, or the value itself if it is not valid JSON.
+func decodeOutputValue(value string) any {
+	var decoded any
+	if err := json.Unmarshal([]byte(value), &decoded); err != nil || decoded == nil {
+		return value
+	}
+
+	return decoded
+}
+
+// helmOptions creates the options to install, upgrade or uninstall the Helm release of the recipe.
+func helmOptions(opts BaseOptions) (helm.Options, error) {
+	recipeContext, err := recipecontext.New(&opts.Recipe, &opts.Configuration)
+	if err != nil {
+		return helm.Options{}, err
+	}
+
+	values, err := helmValues(opts.Definition.Parameters, opts.Recipe.Parameters, recipeContext)
+	if err != nil {
+		return helm.Options{}, err
+	}
+
+	return helm.Options{
+		Namespace:    recipeContext.Runtime.Kubernetes.Namespace,
+		ReleaseName:  helmReleaseName(recipeContext.Resource.Name, recipeContext.Resource.ID),
+		ChartPath:    opts.Definition.TemplatePath,
+		ChartVersion: opts.Definition.TemplateVersion,
+		PlainHTTP:    opts.Definition.PlainHTTP,
+		Values:       values,
+	}, nil
+}
+
+// helmValues creates the values of the Helm chart from the operator and developer parameters, where the developer
+// parameters take precedence, and the recipe context.
+func helmValues(operatorParams, devParams map[string]any, recipeContext *recipecontext.Context) (map[string]any, error) {
+	values := map[string]any{}
+	maps.Copy(values, operatorParams)
+	maps.Copy(values, devParams)
+
+	// The recipe context is converted to a map so that the chart templates can access it using the JSON names
+	// of its fields, e.g. {{ .Values.context.resource.name }}.
+	b, err := json.Marshal(recipeContext)
+	if err != nil {
+		return nil, err
+	}
+
+	contextValues := map[string]any{}
+	if err := json.Unmarshal(b, &contextValues); err != nil {
+		return nil, err
+	}
+	values[recipecontext.RecipeContextParamKey] = contextValues
+
+	return values, nil
+}
+
+// helmReleaseName returns the name of the Helm release of the recipe of a resource. The name of the resource is
+// suffixed with a hash of the resource ID to keep the release names of resources with the same name in different
+// resource groups unique, and truncated to fit the maximum length of release names.
+func helmReleaseName(resourceName, resourceID string) string {
+	hash := sha256.Sum256([]byte(strings.ToLower(resourceID)))
+	suffix := hex.EncodeToString(hash[:])[:releaseNameHashLength]
+
+	name := invalidReleaseNameChars.ReplaceAllString(strings.ToLower(resourceName), "-")
+	if len(name) > maxReleaseNameLength-releaseNameHashLength-1 {
+		name = name[:maxReleaseNameLength-releaseNameHashLength-1]
+	}
+
+	name = strings.Trim(name, "-")
+	if name == "" {
+		return suffix
+	}
+
+	return name + "-" + suffix
+}
+
+// helmValueType returns the type of a value of a Helm chart, as shown in the recipe parameters.
+func helmValueType(value any) string {
+	switch value.(type) {
+	case string:
+		return "string"
+	case bool:
+		return "bool"
+	case int, int64, float64:
+		return "number"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	default:
+		return "any"
+	}
+}
+
+// kubernetesObjectID returns the UCP resource ID of a Kubernetes object.
+func kubernetesObjectID(obj *unstructured.Unstructured) string {
+	return resources_kubernetes.IDFromMeta(resources_kubernetes.PlaneNameTODO, obj.GroupVersionKind(), metav1.ObjectMeta{
+		Namespace: obj.GetNamespace(),
+		Name:      obj.GetName(),
+	}).String()
+}
@@ -0,0 +1,349 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/radius-project/radius/pkg/components/kubernetesclient/kubernetesclientprovider"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/helm"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/test/testcontext"
+)
+
+const (
+	testHelmNamespace  = "app1-ns"
+	testHelmChartPath  = "oci://ghcr.io/radius-project/charts/redis"
+	testHelmReleaseKey = "test-redis-recipe-"
+)
+
+func setupHelm(t *testing.T, objects ...runtimeclient.Object) (*helm.MockHelmExecutor, *helmDriver) {
+	ctrl := gomock.NewController(t)
+	executor := helm.NewMockHelmExecutor(ctrl)
+
+	clients := kubernetesclientprovider.FromConfig(nil)
+	clients.SetRuntimeClient(fake.NewClientBuilder().WithObjects(objects...).Build())
+
+	return executor, &helmDriver{helmExecutor: executor, kubernetesClients: clients}
+}
+
+func buildHelmTestInputs() BaseOptions {
+	return BaseOptions{
+		Configuration: recipes.Configuration{
+			Runtime: recipes.RuntimeConfiguration{
+				Kubernetes: &recipes.KubernetesRuntime{
+					Namespace:            testHelmNamespace,
+					EnvironmentNamespace: "env1-ns",
+				},
+			},
+		},
+		Recipe: recipes.ResourceMetadata{
+			Name:          "redis",
+			ApplicationID: "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/applications/app1",
+			EnvironmentID: "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/environments/env1",
+			ResourceID:    "/planes/radius/local/resourceGroups/test-rg/providers/applications.datastores/rediscaches/test-redis-recipe",
+			Parameters: map[string]any{
+				"replicas": 3,
+			},
+		},
+		Definition: recipes.EnvironmentDefinition{
+			Name:            "redis",
+			Driver:          recipes.TemplateKindHelm,
+			TemplatePath:    testHelmChartPath,
+			TemplateVersion: "18.0.0",
+			ResourceType:    "Applications.Datastores/redisCaches",
+			Parameters: map[string]any{
+				"replicas": 1,
+				"image":    "redis:7",
+			},
+		},
+	}
+}
+
+func testKubernetesObject(apiVersion, kind, name string, annotations map[string]string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion(apiVersion)
+	obj.SetKind(kind)
+	obj.SetNamespace(testHelmNamespace)
+	obj.SetName(name)
+	if annotations != nil {
+		obj.SetAnnotations(annotations)
+	}
+
+	return obj
+}
+
+func Test_Helm_Execute_Success(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	outputAnnotations := map[string]string{helm.OutputAnnotation: "true"}
+	objects := []*unstructured.Unstructured{
+		testKubernetesObject("apps/v1", "StatefulSet", "redis", nil),
+		testKubernetesObject("v1", "Service", "redis", nil),
+		testKubernetesObject("v1", "ConfigMap", "redis-outputs", outputAnnotations),
+		testKubernetesObject("v1", "Secret", "redis-secrets", outputAnnotations),
+	}
+
+	executor, driver := setupHelm(t,
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Namespace: testHelmNamespace, Name: "redis-outputs"},
+			Data: map[string]string{
+				"host": "redis.app1-ns.svc.cluster.local",
+				"port": "6379",
+				"tls":  "false",
+			},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: testHelmNamespace, Name: "redis-secrets"},
+			Data: map[string][]byte{
+				"password": []byte("p@ssw0rd"),
+			},
+		},
+	)
+
+	executor.EXPECT().
+		Deploy(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, options helm.Options) (*helm.Result, error) {
+			require.Equal(t, testHelmNamespace, options.Namespace)
+			require.True(t, strings.HasPrefix(options.ReleaseName, testHelmReleaseKey))
+			require.Equal(t, testHelmChartPath, options.ChartPath)
+			require.Equal(t, "18.0.0", options.ChartVersion)
+
+			// Developer parameters take precedence over operator parameters.
+			require.Equal(t, 3, options.Values["replicas"])
+			require.Equal(t, "redis:7", options.Values["image"])
+
+			recipeContext := options.Values["context"].(map[string]any)
+			require.Equal(t, "test-redis-recipe", recipeContext["resource"].(map[string]any)["name"])
+			require.Equal(t, testHelmNamespace, recipeContext["runtime"].(map[string]any)["kubernetes"].(map[string]any)["namespace"])
+
+			return &helm.Result{Objects: objects}, nil
+		})
+
+	recipeOutput, err := driver.Execute(ctx, ExecuteOptions{BaseOptions: buildHelmTestInputs()})
+	require.NoError(t, err)
+	require.Equal(t, &recipes.RecipeOutput{
+		Resources: []string{
+			"/planes/kubernetes/local/namespaces/app1-ns/providers/apps/StatefulSet/redis",
+			"/planes/kubernetes/local/namespaces/app1-ns/providers/core/Service/redis",
+			"/planes/kubernetes/local/namespaces/app1-ns/providers/core/ConfigMap/redis-outputs",
+			"/planes/kubernetes/local/namespaces/app1-ns/providers/core/Secret/redis-secrets",
+		},
+		Values: map[string]any{
+			"host": "redis.app1-ns.svc.cluster.local",
+			"port": float64(6379),
+			"tls":  false,
+		},
+		Secrets: map[string]any{
+			"password": "p@ssw0rd",
+		},
+		Status: &rpv1.RecipeStatus{
+			TemplateKind:    recipes.TemplateKindHelm,
+			TemplatePath:    testHelmChartPath,
+			TemplateVersion: "18.0.0",
+		},
+	}, recipeOutput)
+}
+
+func Test_Helm_Execute_DeployFailed(t *testing.T) {
+	ctx := testcontext.New(t)
+	executor, driver := setupHelm(t)
+
+	executor.EXPECT().
+		Deploy(ctx, gomock.Any()).
+		Return(nil, errors.New("failed to deploy Helm release"))
+
+	_, err := driver.Execute(ctx, ExecuteOptions{BaseOptions: buildHelmTestInputs()})
+	require.Error(t, err)
+
+	recipeErr := &recipes.RecipeError{}
+	require.ErrorAs(t, err, &recipeErr)
+	require.Equal(t, recipes.RecipeDeploymentFailed, recipeErr.ErrorDetails.Code)
+	require.Equal(t, "failed to deploy Helm release", recipeErr.ErrorDetails.Message)
+}
+
+func Test_Helm_Execute_Import_NotSupported(t *testing.T) {
+	ctx := testcontext.New(t)
+	_, driver := setupHelm(t)
+
+	_, err := driver.Execute(ctx, ExecuteOptions{
+		BaseOptions: buildHelmTestInputs(),
+		Import: &recipes.RecipeImport{
+			Resources: []recipes.ImportedResource{
+				{ID: "/planes/kubernetes/local/namespaces/default/providers/apps/Deployment/test"},
+			},
+		},
+	})
+	require.Error(t, err)
+
+	recipeErr := &recipes.RecipeError{}
+	require.ErrorAs(t, err, &recipeErr)
+	require.Equal(t, recipes.RecipeImportFailed, recipeErr.ErrorDetails.Code)
+}
+
+func Test_Helm_Execute_InvalidOutputObject(t *testing.T) {
+	ctx := testcontext.New(t)
+	executor, driver := setupHelm(t)
+
+	executor.EXPECT().
+		Deploy(ctx, gomock.Any()).
+		Return(&helm.Result{Objects: []*unstructured.Unstructured{
+			testKubernetesObject("apps/v1", "Deployment", "redis", map[string]string{helm.OutputAnnotation: "true"}),
+		}}, nil)
+
+	_, err := driver.Execute(ctx, ExecuteOptions{BaseOptions: buildHelmTestInputs()})
+	require.Error(t, err)
+
+	recipeErr := &recipes.RecipeError{}
+	require.ErrorAs(t, err, &recipeErr)
+	require.Equal(t, recipes.InvalidRecipeOutputs, recipeErr.ErrorDetails.Code)
+	require.Contains(t, recipeErr.ErrorDetails.Message, "only a ConfigMap or a Secret can hold the outputs of a recipe")
+}
+
+func Test_Helm_Delete(t *testing.T) {
+	ctx := testcontext.New(t)
+	executor, driver := setupHelm(t)
+
+	executor.EXPECT().
+		Delete(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, options helm.Options) error {
+			require.Equal(t, testHelmNamespace, options.Namespace)
+			require.True(t, strings.HasPrefix(options.ReleaseName, testHelmReleaseKey))
+			return nil
+		})
+
+	err := driver.Delete(ctx, DeleteOptions{BaseOptions: buildHelmTestInputs()})
+	require.NoError(t, err)
+}
+
+func Test_Helm_Delete_Failed(t *testing.T) {
+	ctx := testcontext.New(t)
+	executor, driver := setupHelm(t)
+
+	executor.EXPECT().
+		Delete(ctx, gomock.Any()).
+		Return(errors.New("failed to uninstall Helm release"))
+
+	err := driver.Delete(ctx, DeleteOptions{BaseOptions: buildHelmTestInputs()})
+	recipeErr := &recipes.RecipeError{}
+	require.ErrorAs(t, err, &recipeErr)
+	require.Equal(t, recipes.RecipeDeletionFailed, recipeErr.ErrorDetails.Code)
+}
+
+func Test_Helm_GetRecipeMetadata(t *testing.T) {
+	ctx := testcontext.New(t)
+	executor, driver := setupHelm(t)
+
+	executor.EXPECT().
+		GetRecipeMetadata(ctx, helm.Options{ChartPath: testHelmChartPath, ChartVersion: "18.0.0"}).
+		Return(map[string]any{
+			"image":       "redis:7",
+			"port":        float64(6379),
+			"persistence": map[string]any{"enabled": false},
+		}, nil)
+
+	metadata, err := driver.GetRecipeMetadata(ctx, buildHelmTestInputs())
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{
+		"parameters": map[string]any{
+			"image":       map[string]any{"type": "string", "defaultValue": "redis:7"},
+			"port":        map[string]any{"type": "number", "defaultValue": float64(6379)},
+			"persistence": map[string]any{"type": "object", "defaultValue": map[string]any{"enabled": false}},
+		},
+	}, metadata)
+}
+
+func Test_Helm_Plan(t *testing.T) {
+	ctx := testcontext.New(t)
+	executor, driver := setupHelm(t)
+
+	unchanged := testKubernetesObject("v1", "Service", "redis", nil)
+	updated := testKubernetesObject("apps/v1", "StatefulSet", "redis", nil)
+	updatedPlanned := updated.DeepCopy()
+	updatedPlanned.SetLabels(map[string]string{"version": "2"})
+	deleted := testKubernetesObject("v1", "ConfigMap", "redis-config", nil)
+	created := testKubernetesObject("v1", "Secret", "redis-auth", nil)
+
+	executor.EXPECT().
+		Plan(ctx, gomock.Any()).
+		Return(
+			&helm.Result{Objects: []*unstructured.Unstructured{unchanged, updated, deleted}},
+			&helm.Result{Objects: []*unstructured.Unstructured{unchanged, updatedPlanned, created}},
+			nil)
+
+	plan, err := driver.Plan(ctx, ExecuteOptions{BaseOptions: buildHelmTestInputs()})
+	require.NoError(t, err)
+	require.Equal(t, &recipes.RecipePlan{
+		ResourceChanges: []recipes.ResourceChange{
+			{ID: "/planes/kubernetes/local/namespaces/app1-ns/providers/core/Service/redis", Type: "core/Service", Action: recipes.ResourceChangeNone},
+			{ID: "/planes/kubernetes/local/namespaces/app1-ns/providers/apps/StatefulSet/redis", Type: "apps/StatefulSet", Action: recipes.ResourceChangeUpdate},
+			{ID: "/planes/kubernetes/local/namespaces/app1-ns/providers/core/Secret/redis-auth", Type: "core/Secret", Action: recipes.ResourceChangeCreate},
+			{ID: "/planes/kubernetes/local/namespaces/app1-ns/providers/core/ConfigMap/redis-config", Type: "core/ConfigMap", Action: recipes.ResourceChangeDelete},
+		},
+	}, plan)
+}
+
+func Test_Helm_Plan_NotInstalled(t *testing.T) {
+	ctx := testcontext.New(t)
+	executor, driver := setupHelm(t)
+
+	executor.EXPECT().
+		Plan(ctx, gomock.Any()).
+		Return(nil, &helm.Result{Objects: []*unstructured.Unstructured{testKubernetesObject("v1", "Service", "redis", nil)}}, nil)
+
+	plan, err := driver.Plan(ctx, ExecuteOptions{BaseOptions: buildHelmTestInputs()})
+	require.NoError(t, err)
+	require.Equal(t, []recipes.ResourceChange{
+		{ID: "/planes/kubernetes/local/namespaces/app1-ns/providers/core/Service/redis", Type: "core/Service", Action: recipes.ResourceChangeCreate},
+	}, plan.ResourceChanges)
+}
+
+func Test_helmReleaseName(t *testing.T) {
+	id := "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/redis"
+
+	name := helmReleaseName("redis", id)
+	require.Regexp(t, "^redis-[0-9a-f]{8}$", name)
+
+	// The name is case-insensitive like the resource ID, and differs for resources with the same name in other resource groups.
+	require.Equal(t, name, helmReleaseName("Redis", strings.ToUpper(id)))
+	require.NotEqual(t, name, helmReleaseName("redis", strings.Replace(id, "test-rg", "other-rg", 1)))
+
+	long := helmReleaseName(strings.Repeat("a", 80), id)
+	require.Len(t, long, maxReleaseNameLength)
+
+	require.Regexp(t, "^my-redis-[0-9a-f]{8}$", helmReleaseName("my_redis", id))
+}
+
+func Test_decodeOutputValue(t *testing.T) {
+	require.Equal(t, float64(6379), decodeOutputValue("6379"))
+	require.Equal(t, true, decodeOutputValue("true"))
+	require.Equal(t, "redis.svc", decodeOutputValue("redis.svc"))
+	require.Equal(t, "null", decodeOutputValue("null"))
+	require.Equal(t, []any{"a", "b"}, decodeOutputValue(`["a","b"]`))
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"maps"
+
+	"github.com/radius-project/radius/pkg/recipes"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+)
+
+// importedRecipeOutput returns the output of a recipe that adopted existing resources instead of deploying new
+// resources: the adopted resources, and the values and secrets of the import in place of the "result" output.
+func importedRecipeOutput(recipeImport *recipes.RecipeImport, resources []string, status *rpv1.RecipeStatus) *recipes.RecipeOutput {
+	output := &recipes.RecipeOutput{
+		Resources: resources,
+		Values:    maps.Clone(recipeImport.Values),
+		Secrets:   maps.Clone(recipeImport.Secrets),
+		Status:    status,
+	}
+
+	// Make sure the output is consistent with the output of a deployment.
+	if output.Resources == nil {
+		output.Resources = []string{}
+	}
+	if output.Values == nil {
+		output.Values = map[string]any{}
+	}
+	if output.Secrets == nil {
+		output.Secrets = map[string]any{}
+	}
+
+	return output
+}
@@ -194,3 +194,42 @@ func (c *MockDriverWithSecretsGetRecipeMetadataCall) DoAndReturn(f func(context.
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// Plan mocks base method.
+func (m *MockDriverWithSecrets) Plan(arg0 context.Context, arg1 ExecuteOptions) (*recipes.RecipePlan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Plan", arg0, arg1)
+	ret0, _ := ret[0].(*recipes.RecipePlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Plan indicates an expected call of Plan.
+func (mr *MockDriverWithSecretsMockRecorder) Plan(arg0, arg1 any) *MockDriverWithSecretsPlanCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Plan", reflect.TypeOf((*MockDriverWithSecrets)(nil).Plan), arg0, arg1)
+	return &MockDriverWithSecretsPlanCall{Call: call}
+}
+
+// MockDriverWithSecretsPlanCall wrap *gomock.Call
+type MockDriverWithSecretsPlanCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDriverWithSecretsPlanCall) Return(arg0 *recipes.RecipePlan, arg1 error) *MockDriverWithSecretsPlanCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDriverWithSecretsPlanCall) Do(f func(context.Context, ExecuteOptions) (*recipes.RecipePlan, error)) *MockDriverWithSecretsPlanCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDriverWithSecretsPlanCall) DoAndReturn(f func(context.Context, ExecuteOptions) (*recipes.RecipePlan, error)) *MockDriverWithSecretsPlanCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -45,20 +45,74 @@ import (
 	tfjson "github.com/hashicorp/terraform-json"
 )
 
+const (
+	// installCacheSubDir is the directory under the Terraform path where downloaded Terraform binaries are cached.
+	// It is prefixed with a dot so it cannot collide with the execution directories.
+	installCacheSubDir = ".install-cache"
+
+	// cacheSubDir is the directory under the Terraform path where provider plugins and modules are cached.
+	cacheSubDir = ".cache"
+
+	// defaultCacheMaxSizeBytes is the default size limit of the cache of provider plugins and modules.
+	defaultCacheMaxSizeBytes = 2048 * 1024 * 1024
+)
+
 var _ Driver = (*terraformDriver)(nil)
 
 // NewTerraformDriver creates a new instance of driver to execute a Terraform recipe.
 func NewTerraformDriver(ucpConn sdk.Connection, secretProvider *secretprovider.SecretProvider, options TerraformOptions, kubernetesClients kubernetesclientprovider.KubernetesClientProvider) Driver {
 	return &terraformDriver{
-		terraformExecutor: terraform.NewExecutor(ucpConn, secretProvider, kubernetesClients),
-		options:           options,
+		terraformExecutor: terraform.NewExecutor(ucpConn, secretProvider, kubernetesClients, terraform.InstallOptions{
+			BinaryPath:         options.BinaryPath,
+			TofuBinaryPath:     options.TofuBinaryPath,
+			TofuSigningKeyPath: options.TofuSigningKeyPath,
+			MirrorDir:          options.MirrorDir,
+			CacheDir:           filepath.Join(options.Path, installCacheSubDir),
+		}, newCache(options)),
+		options: options,
+	}
+}
+
+// newCache creates the cache of provider plugins and modules shared by the executions of the driver. It returns nil
+// if the cache is disabled.
+func newCache(options TerraformOptions) *terraform.Cache {
+	if options.CacheDisabled || options.Path == "" {
+		return nil
+	}
+
+	maxSizeBytes := options.CacheMaxSizeBytes
+	if maxSizeBytes <= 0 {
+		maxSizeBytes = defaultCacheMaxSizeBytes
 	}
+
+	return terraform.NewCache(filepath.Join(options.Path, cacheSubDir), maxSizeBytes)
 }
 
 // Options represents the options required for execution of Terraform driver.
 type TerraformOptions struct {
 	// Path is the path to the directory mounted to the container where terraform can be installed and executed.
 	Path string
+
+	// BinaryPath is the path to a pre-installed Terraform binary. Terraform is not downloaded when it is set.
+	BinaryPath string
+
+	// TofuBinaryPath is the path to a pre-installed OpenTofu binary. OpenTofu is not downloaded when it is set.
+	TofuBinaryPath string
+
+	// TofuSigningKeyPath is the path to the armored public key that signs the OpenTofu releases. OpenTofu is only
+	// downloaded when it is set.
+	TofuSigningKeyPath string
+
+	// MirrorDir is the path to a directory of pre-installed Terraform and OpenTofu binaries organized by version, e.g.
+	// <MirrorDir>/1.7.5/terraform or <MirrorDir>/1.8.0/tofu. Terraform is not downloaded when it is set.
+	MirrorDir string
+
+	// CacheDisabled disables the cache of provider plugins and modules shared by recipe executions.
+	CacheDisabled bool
+
+	// CacheMaxSizeBytes is the size limit of the cache of provider plugins and modules. The default limit is used
+	// if it is not set.
+	CacheMaxSizeBytes int64
 }
 
 // terraformDriver represents a driver to interact with Terraform Recipe - deploy recipe, delete resources, etc.
@@ -71,7 +125,8 @@ type terraformDriver struct {
 }
 
 // Execute creates a unique directory for each execution of terraform and deploys the recipe using the
-// the Terraform CLI through terraform-exec. It returns a RecipeOutput or an error if the deployment fails.
+// the Terraform CLI through terraform-exec, or imports the existing resources of the import into the state of the
+// recipe. It returns a RecipeOutput or an error if the deployment fails.
 func (d *terraformDriver) Execute(ctx context.Context, opts ExecuteOptions) (*recipes.RecipeOutput, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
@@ -85,16 +140,8 @@ func (d *terraformDriver) Execute(ctx context.Context, opts ExecuteOptions) (*re
 		}
 	}()
 
-	// Get the secret store ID associated with the git private terraform repository source.
-	secretStoreID, err := GetPrivateGitRepoSecretStoreID(opts.Configuration, opts.Definition.TemplatePath)
-	if err != nil {
-		return nil, err
-	}
-
-	// Add credential information to .gitconfig for module source of type git if applicable.
-	err = addSecretsToGitConfigIfApplicable(secretStoreID, opts.Secrets, requestDirPath, opts.Definition.TemplatePath)
-	if err != nil {
-		return nil, err
+	if opts.Import != nil {
+		return d.importResources(ctx, opts, requestDirPath)
 	}
 
 	tfState, err := d.terraformExecutor.Deploy(ctx, terraform.Options{
@@ -104,13 +151,9 @@ func (d *terraformDriver) Execute(ctx context.Context, opts ExecuteOptions) (*re
 		EnvRecipe:      &opts.Definition,
 		Secrets:        opts.Secrets,
 	})
-
-	unsetError := unsetGitConfigForDirIfApplicable(secretStoreID, opts.Secrets, requestDirPath, opts.Definition.TemplatePath)
-	if unsetError != nil {
-		return nil, unsetError
-	}
-
-	if err != nil {
+	if recipeError, ok := invalidParametersError(err); ok {
+		return nil, recipeError
+	} else if err != nil {
 		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
 	}
 
@@ -122,6 +165,37 @@ func (d *terraformDriver) Execute(ctx context.Context, opts ExecuteOptions) (*re
 	return recipeOutputs, nil
 }
 
+// importResources imports the existing resources of the import into the Terraform state of the recipe instead of
+// applying the module. The module has no outputs until it is applied, so the values and secrets of the import are
+// returned in place of the "result" output.
+func (d *terraformDriver) importResources(ctx context.Context, opts ExecuteOptions, requestDirPath string) (*recipes.RecipeOutput, error) {
+	for _, resource := range opts.Import.Resources {
+		if resource.Address == "" {
+			return nil, recipes.NewRecipeError(recipes.RecipeImportFailed, fmt.Sprintf("failed to import resource %q: the address of the resource in the Terraform module is required", resource.ID), recipes_util.RecipeSetupError, nil)
+		}
+	}
+
+	tfState, err := d.terraformExecutor.Import(ctx, terraform.Options{
+		RootDir:        requestDirPath,
+		EnvConfig:      &opts.Configuration,
+		ResourceRecipe: &opts.Recipe,
+		EnvRecipe:      &opts.Definition,
+		Secrets:        opts.Secrets,
+	}, opts.Import.Resources)
+	if recipeError, ok := invalidParametersError(err); ok {
+		return nil, recipeError
+	} else if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeImportFailed, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	recipeOutputs, err := d.prepareRecipeResponse(ctx, opts.Definition, tfState)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeImportFailed, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	return importedRecipeOutput(opts.Import, recipeOutputs.Resources, recipeOutputs.Status), nil
+}
+
 // Delete creates a unique directory for each execution of terraform and deletes the resources deployed by the Terraform module
 // using the Terraform CLI through terraform-exec. It returns an error if the deletion fails.
 func (d *terraformDriver) Delete(ctx context.Context, opts DeleteOptions) error {
@@ -137,36 +211,88 @@ func (d *terraformDriver) Delete(ctx context.Context, opts DeleteOptions) error
 		}
 	}()
 
-	// Get the secret store ID associated with the git private terraform repository source.
-	secretStoreID, err := GetPrivateGitRepoSecretStoreID(opts.Configuration, opts.Definition.TemplatePath)
+	err = d.terraformExecutor.Delete(ctx, terraform.Options{
+		RootDir:        requestDirPath,
+		EnvConfig:      &opts.Configuration,
+		ResourceRecipe: &opts.Recipe,
+		EnvRecipe:      &opts.Definition,
+		Secrets:        opts.Secrets,
+	})
 	if err != nil {
-		return err
+		return recipes.NewRecipeError(recipes.RecipeDeletionFailed, err.Error(), "", recipes.GetErrorDetails(err))
 	}
 
-	// Add credential information to .gitconfig for module source of type git if applicable.
-	err = addSecretsToGitConfigIfApplicable(secretStoreID, opts.Secrets, requestDirPath, opts.Definition.TemplatePath)
+	return nil
+}
+
+// Plan creates a unique directory for each execution of terraform and plans the deployment of the recipe using
+// the Terraform CLI through terraform-exec. It returns the changes to the resources managed by the module.
+func (d *terraformDriver) Plan(ctx context.Context, opts ExecuteOptions) (*recipes.RecipePlan, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	requestDirPath, err := d.createExecutionDirectory(ctx, opts.Recipe, opts.Definition)
 	if err != nil {
-		return err
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
 	}
+	defer func() {
+		if err := os.RemoveAll(requestDirPath); err != nil {
+			logger.Info(fmt.Sprintf("Failed to cleanup Terraform execution directory %q. Err: %s", requestDirPath, err.Error()))
+		}
+	}()
 
-	err = d.terraformExecutor.Delete(ctx, terraform.Options{
+	tfPlan, err := d.terraformExecutor.Plan(ctx, terraform.Options{
 		RootDir:        requestDirPath,
 		EnvConfig:      &opts.Configuration,
 		ResourceRecipe: &opts.Recipe,
 		EnvRecipe:      &opts.Definition,
 		Secrets:        opts.Secrets,
 	})
+	if recipeError, ok := invalidParametersError(err); ok {
+		return nil, recipeError
+	} else if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
 
-	unsetError := unsetGitConfigForDirIfApplicable(secretStoreID, opts.Secrets, requestDirPath, opts.Definition.TemplatePath)
-	if unsetError != nil {
-		return unsetError
+	return convertTerraformPlan(tfPlan), nil
+}
+
+// convertTerraformPlan converts the changes to the managed resources of a Terraform plan to a recipe plan.
+// Data sources are read while planning and are not included.
+func convertTerraformPlan(tfPlan *tfjson.Plan) *recipes.RecipePlan {
+	plan := &recipes.RecipePlan{ResourceChanges: []recipes.ResourceChange{}}
+	if tfPlan == nil {
+		return plan
 	}
 
-	if err != nil {
-		return recipes.NewRecipeError(recipes.RecipeDeletionFailed, err.Error(), "", recipes.GetErrorDetails(err))
+	for _, change := range tfPlan.ResourceChanges {
+		if change == nil || change.Change == nil || change.Mode == tfjson.DataResourceMode {
+			continue
+		}
+
+		var action recipes.ResourceChangeAction
+		switch actions := change.Change.Actions; {
+		case actions.Replace():
+			action = recipes.ResourceChangeReplace
+		case actions.Create():
+			action = recipes.ResourceChangeCreate
+		case actions.Update():
+			action = recipes.ResourceChangeUpdate
+		case actions.Delete():
+			action = recipes.ResourceChangeDelete
+		case actions.NoOp():
+			action = recipes.ResourceChangeNone
+		default:
+			continue
+		}
+
+		plan.ResourceChanges = append(plan.ResourceChanges, recipes.ResourceChange{
+			ID:     change.Address,
+			Type:   change.Type,
+			Action: action,
+		})
 	}
 
-	return nil
+	return plan
 }
 
 // prepareRecipeResponse populates the recipe response from the module output named "result" and the
@@ -264,29 +390,13 @@ func (d *terraformDriver) GetRecipeMetadata(ctx context.Context, opts BaseOption
 		}
 	}()
 
-	// Get the secret store ID associated with the git private terraform repository source.
-	secretStoreID, err := GetPrivateGitRepoSecretStoreID(opts.Configuration, opts.Definition.TemplatePath)
-	if err != nil {
-		return nil, err
-	}
-
-	// Add credential information to .gitconfig for module source of type git if applicable.
-	err = addSecretsToGitConfigIfApplicable(secretStoreID, opts.Secrets, requestDirPath, opts.Definition.TemplatePath)
-	if err != nil {
-		return nil, err
-	}
-
 	recipeData, err := d.terraformExecutor.GetRecipeMetadata(ctx, terraform.Options{
 		RootDir:        requestDirPath,
+		EnvConfig:      &opts.Configuration,
 		ResourceRecipe: &opts.Recipe,
 		EnvRecipe:      &opts.Definition,
+		Secrets:        opts.Secrets,
 	})
-
-	unsetError := unsetGitConfigForDirIfApplicable(secretStoreID, opts.Secrets, requestDirPath, opts.Definition.TemplatePath)
-	if unsetError != nil {
-		return nil, unsetError
-	}
-
 	if err != nil {
 		return nil, recipes.NewRecipeError(recipes.RecipeGetMetadataFailed, err.Error(), "", recipes.GetErrorDetails(err))
 	}
@@ -294,21 +404,27 @@ func (d *terraformDriver) GetRecipeMetadata(ctx context.Context, opts BaseOption
 	return recipeData, nil
 }
 
+// invalidParametersError returns the error reported by the executor when the parameters of the recipe are invalid. It
+// is returned as-is rather than as the failure of the Terraform operation, since Terraform did not run.
+func invalidParametersError(err error) (*recipes.RecipeError, bool) {
+	var recipeError *recipes.RecipeError
+	if errors.As(err, &recipeError) && recipeError.ErrorDetails.Code == recipes.InvalidRecipeParameters {
+		return recipeError, true
+	}
+
+	return nil, false
+}
+
 // FindSecretIDs is used to retrieve a map of secretStoreIDs and corresponding secret keys.
 // associated with the given environment configuration and environment definition.
 func (d *terraformDriver) FindSecretIDs(ctx context.Context, envConfig recipes.Configuration, definition recipes.EnvironmentDefinition) (secretStoreIDResourceKeys map[string][]string, err error) {
-	secretStoreIDResourceKeys = make(map[string][]string)
-
-	// Get the secret store ID associated with the git private terraform repository source.
-	secretStoreID, err := GetPrivateGitRepoSecretStoreID(envConfig, definition.TemplatePath)
+	// Get the secret IDs and associated keys of the credentials of the module sources: the private git repository
+	// source of the template and the private Terraform module registries.
+	secretStoreIDResourceKeys, err = terraform.GetModuleSourceSecretIDs(envConfig, definition.TemplatePath)
 	if err != nil {
 		return nil, err
 	}
 
-	if secretStoreID != "" {
-		secretStoreIDResourceKeys[secretStoreID] = []string{PrivateRegistrySecretKey_Pat, PrivateRegistrySecretKey_Username}
-	}
-
 	// Get the secret IDs and associated keys in provider configuration and environment variables
 	providerSecretIDs := terraform.GetProviderEnvSecretIDs(envConfig)
 
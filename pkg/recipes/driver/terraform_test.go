@@ -33,6 +33,7 @@ import (
 	gomock "go.uber.org/mock/gomock"
 
 	"github.com/radius-project/radius/pkg/recipes/terraform"
+	recipes_util "github.com/radius-project/radius/pkg/recipes/util"
 	"github.com/radius-project/radius/test/testcontext"
 	"github.com/stretchr/testify/require"
 )
@@ -169,6 +170,30 @@ func Test_Terraform_Execute_DeploymentFailure(t *testing.T) {
 	verifyDirectoryCleanup(t, driver.options.Path, armCtx.OperationID.String())
 }
 
+func Test_Terraform_Execute_InvalidParameters(t *testing.T) {
+	ctx := testcontext.New(t)
+	armCtx := &v1.ARMRequestContext{
+		OperationID: uuid.New(),
+	}
+	ctx = v1.WithARMRequestContext(ctx, armCtx)
+
+	tfExecutor, driver := setup(t)
+	envConfig, recipeMetadata, envRecipe := buildTestInputs()
+	recipeError := recipes.NewRecipeError(recipes.InvalidRecipeParameters, "invalid parameters for recipe", recipes_util.RecipeSetupError)
+	tfExecutor.EXPECT().Deploy(ctx, gomock.Any()).Times(1).Return(nil, recipeError)
+
+	_, err := driver.Execute(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.Error(t, err)
+	require.Equal(t, recipeError, err)
+	verifyDirectoryCleanup(t, driver.options.Path, armCtx.OperationID.String())
+}
+
 func Test_Terraform_Execute_OutputsFailure(t *testing.T) {
 	ctx := testcontext.New(t)
 	armCtx := &v1.ARMRequestContext{
@@ -307,6 +332,133 @@ func Test_Terraform_Execute_MissingARMRequestContext_Panics(t *testing.T) {
 	})
 }
 
+func Test_Terraform_Execute_Import_Success(t *testing.T) {
+	ctx := testcontext.New(t)
+	armCtx := &v1.ARMRequestContext{
+		OperationID: uuid.New(),
+	}
+	ctx = v1.WithARMRequestContext(ctx, armCtx)
+
+	tfExecutor, driver := setup(t)
+	envConfig, recipeMetadata, envRecipe := buildTestInputs()
+
+	resourceID := "/subscriptions/test-sub/resourceGroups/test-rg/providers/Microsoft.Cache/redis/test-cache"
+	recipeImport := &recipes.RecipeImport{
+		Resources: []recipes.ImportedResource{
+			{
+				ID:      resourceID,
+				Address: "azurerm_redis_cache.cache",
+			},
+		},
+		Values: map[string]any{
+			"host": "test-cache.redis.cache.windows.net",
+		},
+		Secrets: map[string]any{
+			"password": "test-password",
+		},
+	}
+
+	// The module is not applied, so the state has no outputs.
+	importedTFState := &tfjson.State{
+		Values: &tfjson.StateValues{
+			RootModule: &tfjson.StateModule{
+				ChildModules: []*tfjson.StateModule{
+					{
+						Resources: []*tfjson.StateResource{
+							{
+								Address:      "module.redis-azure.azurerm_redis_cache.cache",
+								ProviderName: "registry.terraform.io/hashicorp/azurerm",
+								AttributeValues: map[string]any{
+									"id": resourceID,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	tfExecutor.EXPECT().Import(ctx, gomock.Any(), recipeImport.Resources).Times(1).Return(importedTFState, nil)
+
+	recipeOutput, err := driver.Execute(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+		Import: recipeImport,
+	})
+	require.NoError(t, err)
+	require.Equal(t, &recipes.RecipeOutput{
+		Resources: []string{resourceID},
+		Values:    recipeImport.Values,
+		Secrets:   recipeImport.Secrets,
+		Status: &rpv1.RecipeStatus{
+			TemplateKind:    recipes.TemplateKindTerraform,
+			TemplatePath:    "Azure/redis/azurerm",
+			TemplateVersion: "1.0",
+		},
+	}, recipeOutput)
+	verifyDirectoryCleanup(t, driver.options.Path, armCtx.OperationID.String())
+}
+
+func Test_Terraform_Execute_Import_MissingAddress(t *testing.T) {
+	ctx := testcontext.New(t)
+	armCtx := &v1.ARMRequestContext{
+		OperationID: uuid.New(),
+	}
+	ctx = v1.WithARMRequestContext(ctx, armCtx)
+
+	_, driver := setup(t)
+	envConfig, recipeMetadata, envRecipe := buildTestInputs()
+
+	_, err := driver.Execute(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+		Import: &recipes.RecipeImport{
+			Resources: []recipes.ImportedResource{
+				{ID: "test-id"},
+			},
+		},
+	})
+	require.Error(t, err)
+	require.Equal(t, recipes.RecipeImportFailed, recipes.GetErrorDetails(err).Code)
+	require.Contains(t, err.Error(), "the address of the resource in the Terraform module is required")
+}
+
+func Test_Terraform_Execute_Import_Failure(t *testing.T) {
+	ctx := testcontext.New(t)
+	armCtx := &v1.ARMRequestContext{
+		OperationID: uuid.New(),
+	}
+	ctx = v1.WithARMRequestContext(ctx, armCtx)
+
+	tfExecutor, driver := setup(t)
+	envConfig, recipeMetadata, envRecipe := buildTestInputs()
+
+	tfExecutor.EXPECT().Import(ctx, gomock.Any(), gomock.Any()).Times(1).Return(nil, errors.New("terraform import failure"))
+
+	_, err := driver.Execute(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+		Import: &recipes.RecipeImport{
+			Resources: []recipes.ImportedResource{
+				{ID: "test-id", Address: "azurerm_redis_cache.cache"},
+			},
+		},
+	})
+	require.Error(t, err)
+	require.Equal(t, recipes.RecipeImportFailed, recipes.GetErrorDetails(err).Code)
+	verifyDirectoryCleanup(t, driver.options.Path, armCtx.OperationID.String())
+}
+
 func TestTerraformDriver_GetRecipeMetadata_Success(t *testing.T) {
 	ctx := testcontext.New(t)
 	armCtx := &v1.ARMRequestContext{
@@ -461,6 +613,109 @@ func Test_Terraform_Delete_Failure(t *testing.T) {
 	verifyDirectoryCleanup(t, driver.options.Path, armCtx.OperationID.String())
 }
 
+func Test_Terraform_Plan_Success(t *testing.T) {
+	ctx := testcontext.New(t)
+	armCtx := &v1.ARMRequestContext{
+		OperationID: uuid.New(),
+	}
+	ctx = v1.WithARMRequestContext(ctx, armCtx)
+
+	tfExecutor, driver := setup(t)
+	envConfig, recipeMetadata, envRecipe := buildTestInputs()
+
+	tfPlan := &tfjson.Plan{
+		ResourceChanges: []*tfjson.ResourceChange{
+			{
+				Address: "module.redis-azure.azurerm_redis_cache.redis",
+				Mode:    tfjson.ManagedResourceMode,
+				Type:    "azurerm_redis_cache",
+				Change:  &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionCreate}},
+			},
+			{
+				Address: "module.redis-azure.azurerm_resource_group.rg",
+				Mode:    tfjson.ManagedResourceMode,
+				Type:    "azurerm_resource_group",
+				Change:  &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionNoop}},
+			},
+			{
+				Address: "module.redis-azure.azurerm_redis_firewall_rule.rule",
+				Mode:    tfjson.ManagedResourceMode,
+				Type:    "azurerm_redis_firewall_rule",
+				Change:  &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionUpdate}},
+			},
+			{
+				Address: "module.redis-azure.azurerm_private_endpoint.endpoint",
+				Mode:    tfjson.ManagedResourceMode,
+				Type:    "azurerm_private_endpoint",
+				Change:  &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionDelete, tfjson.ActionCreate}},
+			},
+			{
+				Address: "module.redis-azure.azurerm_redis_linked_server.server",
+				Mode:    tfjson.ManagedResourceMode,
+				Type:    "azurerm_redis_linked_server",
+				Change:  &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionDelete}},
+			},
+			{
+				Address: "module.redis-azure.data.azurerm_client_config.current",
+				Mode:    tfjson.DataResourceMode,
+				Type:    "azurerm_client_config",
+				Change:  &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionRead}},
+			},
+		},
+	}
+	expected := &recipes.RecipePlan{
+		ResourceChanges: []recipes.ResourceChange{
+			{ID: "module.redis-azure.azurerm_redis_cache.redis", Type: "azurerm_redis_cache", Action: recipes.ResourceChangeCreate},
+			{ID: "module.redis-azure.azurerm_resource_group.rg", Type: "azurerm_resource_group", Action: recipes.ResourceChangeNone},
+			{ID: "module.redis-azure.azurerm_redis_firewall_rule.rule", Type: "azurerm_redis_firewall_rule", Action: recipes.ResourceChangeUpdate},
+			{ID: "module.redis-azure.azurerm_private_endpoint.endpoint", Type: "azurerm_private_endpoint", Action: recipes.ResourceChangeReplace},
+			{ID: "module.redis-azure.azurerm_redis_linked_server.server", Type: "azurerm_redis_linked_server", Action: recipes.ResourceChangeDelete},
+		},
+	}
+
+	tfExecutor.EXPECT().Plan(ctx, gomock.Any()).Times(1).Return(tfPlan, nil)
+
+	plan, err := driver.Plan(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, expected, plan)
+	verifyDirectoryCleanup(t, driver.options.Path, armCtx.OperationID.String())
+}
+
+func Test_Terraform_Plan_Failure(t *testing.T) {
+	ctx := testcontext.New(t)
+	armCtx := &v1.ARMRequestContext{
+		OperationID: uuid.New(),
+	}
+	ctx = v1.WithARMRequestContext(ctx, armCtx)
+
+	tfExecutor, driver := setup(t)
+	envConfig, recipeMetadata, envRecipe := buildTestInputs()
+	recipeError := recipes.RecipeError{
+		ErrorDetails: v1.ErrorDetails{
+			Code:    recipes.RecipePlanFailed,
+			Message: "terraform plan failure",
+		},
+		DeploymentStatus: "executionError",
+	}
+	tfExecutor.EXPECT().Plan(ctx, gomock.Any()).Times(1).Return(nil, errors.New("terraform plan failure"))
+
+	_, err := driver.Plan(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.Equal(t, &recipeError, err)
+	verifyDirectoryCleanup(t, driver.options.Path, armCtx.OperationID.String())
+}
+
 func Test_Terraform_PrepareRecipeResponse(t *testing.T) {
 	d := &terraformDriver{}
 	tests := []struct {
@@ -838,7 +1093,32 @@ func Test_FindSecretIDs(t *testing.T) {
 			},
 		},
 		{
-			name:          "GetPrivateGitRepoSecretStoreID returns error",
+			name: "Secrets in SSH and registry auth config",
+			envConfig: recipes.Configuration{
+				RecipeConfig: datamodel.RecipeConfigProperties{
+					Terraform: datamodel.TerraformConfigProperties{
+						Authentication: datamodel.AuthConfig{
+							Git: datamodel.GitAuthConfig{
+								SSH: map[string]datamodel.SSHConfig{
+									"github.com": {Secret: "secret-store-ssh"},
+								},
+							},
+							Registry: map[string]datamodel.TerraformRegistryAuthConfig{
+								"app.terraform.io": {Secret: "secret-store-registry"},
+							},
+						},
+					},
+				},
+			},
+			definition:    recipes.EnvironmentDefinition{TemplatePath: "git::git@github.com:project/module.git"},
+			expectedError: false,
+			expectedSecretIDs: map[string][]string{
+				"secret-store-ssh":      {"privateKey", "knownHosts"},
+				"secret-store-registry": {"token"},
+			},
+		},
+		{
+			name:          "Invalid git template path returns error",
 			definition:    recipes.EnvironmentDefinition{TemplatePath: "git::https://dev.azu  re.com/project/module"},
 			envConfig:     createTerraformConfigWithAuthProviderEnvSecrets(),
 			expectedError: true,
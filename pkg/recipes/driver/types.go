@@ -18,18 +18,15 @@ package driver
 
 import (
 	"context"
-	"strings"
 
 	"github.com/radius-project/radius/pkg/recipes"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 )
 
 const (
-	TerraformAzureProvider            = "registry.terraform.io/hashicorp/azurerm"
-	TerraformAWSProvider              = "registry.terraform.io/hashicorp/aws"
-	TerraformKubernetesProvider       = "registry.terraform.io/hashicorp/kubernetes"
-	PrivateRegistrySecretKey_Pat      = "pat"
-	PrivateRegistrySecretKey_Username = "username"
+	TerraformAzureProvider      = "registry.terraform.io/hashicorp/azurerm"
+	TerraformAWSProvider        = "registry.terraform.io/hashicorp/aws"
+	TerraformKubernetesProvider = "registry.terraform.io/hashicorp/kubernetes"
 )
 
 // Driver is an interface to implement recipe deployment and recipe resources deletion.
@@ -44,6 +41,10 @@ type Driver interface {
 
 	// Gets the Recipe metadata and parameters from Recipe's template path
 	GetRecipeMetadata(ctx context.Context, opts BaseOptions) (map[string]any, error)
+
+	// Plan fetches the recipe contents and returns the changes that deploying the recipe would make to the
+	// resources it manages, without making them.
+	Plan(ctx context.Context, opts ExecuteOptions) (*recipes.RecipePlan, error)
 }
 
 // DriverWithSecrets is an optional interface and used when the driver needs to load secrets for recipe deployment.
@@ -90,6 +91,10 @@ type ExecuteOptions struct {
 	BaseOptions
 	// Previously deployed state of output resource IDs.
 	PrevState []string
+
+	// Import represents the existing cloud resources to adopt instead of deploying the recipe. The adopted resources
+	// are returned as the output resources of the recipe, with the values and secrets of the import.
+	Import *recipes.RecipeImport
 }
 
 // DeleteOptions is the options for the Delete method.
@@ -99,17 +104,3 @@ type DeleteOptions struct {
 	// OutputResources is the list of output resources for the recipe.
 	OutputResources []rpv1.OutputResource
 }
-
-// GetPrivateGitRepoSecretStoreID returns secretstore resource ID associated with git private terraform repository source.
-func GetPrivateGitRepoSecretStoreID(envConfig recipes.Configuration, templatePath string) (string, error) {
-	if strings.HasPrefix(templatePath, "git::") {
-		url, err := GetGitURL(templatePath)
-		if err != nil {
-			return "", err
-		}
-
-		// get the secret store id associated with the git domain of the template path.
-		return envConfig.RecipeConfig.Terraform.Authentication.Git.PAT[strings.TrimPrefix(url.Hostname(), "www.")].Secret, nil
-	}
-	return "", nil
-}
@@ -18,6 +18,7 @@ package engine
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -25,6 +26,7 @@ import (
 	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/recipes/configloader"
 	recipedriver "github.com/radius-project/radius/pkg/recipes/driver"
+	"github.com/radius-project/radius/pkg/recipes/recipelogs"
 	"github.com/radius-project/radius/pkg/recipes/util"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
@@ -48,6 +50,9 @@ type engine struct {
 	options Options
 }
 
+// errRecipeTimedOut is the cause of the cancellation of a recipe execution that exceeded the recipe timeout of the environment.
+var errRecipeTimedOut = errors.New("recipe timed out")
+
 // Execute loads the recipe definition from the environment, finds the driver associated with the recipe, loads the
 // configuration associated with the recipe, and then executes the recipe using the driver. It returns a RecipeOutput and
 // an error if one occurs.
@@ -55,13 +60,14 @@ func (e *engine) Execute(ctx context.Context, opts ExecuteOptions) (*recipes.Rec
 	executionStart := time.Now()
 	result := metrics.SuccessfulOperationState
 
-	recipeOutput, definition, err := e.executeCore(ctx, opts.Recipe, opts.PreviousState)
+	recipeOutput, definition, err := e.executeCore(ctx, opts.Recipe, opts.PreviousState, opts.Import)
 	if err != nil {
 		result = metrics.FailedOperationState
 		if recipes.GetErrorDetails(err) != nil {
 			result = recipes.GetErrorDetails(err).Code
 		}
 	}
+	recordResult(ctx, "execution", definition, err)
 
 	metrics.DefaultRecipeEngineMetrics.RecordRecipeOperationDuration(ctx, executionStart,
 		metrics.NewRecipeAttributes(metrics.RecipeEngineOperationExecute, opts.Recipe.Name,
@@ -72,7 +78,7 @@ func (e *engine) Execute(ctx context.Context, opts ExecuteOptions) (*recipes.Rec
 
 // executeCore function is the core logic of the Execute function.
 // Any changes to the core logic of the Execute function should be made here.
-func (e *engine) executeCore(ctx context.Context, recipe recipes.ResourceMetadata, prevState []string) (*recipes.RecipeOutput, *recipes.EnvironmentDefinition, error) {
+func (e *engine) executeCore(ctx context.Context, recipe recipes.ResourceMetadata, prevState []string, recipeImport *recipes.RecipeImport) (*recipes.RecipeOutput, *recipes.EnvironmentDefinition, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
 	configuration, err := e.options.ConfigurationLoader.LoadConfiguration(ctx, recipe)
@@ -91,22 +97,42 @@ func (e *engine) executeCore(ctx context.Context, recipe recipes.ResourceMetadat
 		return nil, nil, err
 	}
 
+	if recipeImport != nil {
+		recipelogs.FromContext(ctx).Recordf(recipelogs.SourceEngine, "Importing %d existing resource(s) into recipe %q of type %q using %s template %q", len(recipeImport.Resources), recipe.Name, definition.ResourceType, definition.Driver, definition.TemplatePath)
+	} else {
+		recipelogs.FromContext(ctx).Recordf(recipelogs.SourceEngine, "Executing recipe %q of type %q using %s template %q", recipe.Name, definition.ResourceType, definition.Driver, definition.TemplatePath)
+	}
+
 	secrets, err := e.getRecipeConfigSecrets(ctx, driver, configuration, definition)
 	if err != nil {
 		return nil, nil, err
 	}
 
+	baseOptions := recipedriver.BaseOptions{
+		Configuration: *configuration,
+		Recipe:        recipe,
+		Definition:    *definition,
+		Secrets:       secrets,
+	}
+
+	ctx, cancel := withRecipeTimeout(ctx, configuration)
+	defer cancel()
+
 	res, err := driver.Execute(ctx, recipedriver.ExecuteOptions{
-		BaseOptions: recipedriver.BaseOptions{
-			Configuration: *configuration,
-			Recipe:        recipe,
-			Definition:    *definition,
-			Secrets:       secrets,
-		},
-		PrevState: prevState,
+		BaseOptions: baseOptions,
+		PrevState:   prevState,
+		Import:      recipeImport,
 	})
 	if err != nil {
-		return nil, definition, err
+		return nil, definition, recipeTimeoutError(ctx, configuration, err)
+	}
+
+	// Fail the execution when the recipe does not produce the outputs declared by its registration, rather than
+	// letting the resource be deployed with missing connection values. The recipe was already deployed, so the output
+	// is returned with the error for the deployed resources to be recorded and deleted with the resource.
+	if err := definition.Outputs.Validate(res); err != nil {
+		err = fmt.Errorf("recipe %q of type %q produced invalid outputs: %w", recipe.Name, definition.ResourceType, err)
+		return res, definition, recipes.NewRecipeError(recipes.InvalidRecipeOutputs, err.Error(), util.ExecutionError, recipes.GetErrorDetails(err))
 	}
 
 	return res, definition, nil
@@ -124,6 +150,7 @@ func (e *engine) Delete(ctx context.Context, opts DeleteOptions) error {
 			result = recipes.GetErrorDetails(err).Code
 		}
 	}
+	recordResult(ctx, "deletion", definition, err)
 
 	metrics.DefaultRecipeEngineMetrics.RecordRecipeOperationDuration(ctx, deletionStart,
 		metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDelete, opts.Recipe.Name,
@@ -151,10 +178,16 @@ func (e *engine) deleteCore(ctx context.Context, recipe recipes.ResourceMetadata
 		return nil, err
 	}
 
+	recipelogs.FromContext(ctx).Recordf(recipelogs.SourceEngine, "Deleting the resources of recipe %q of type %q using %s template %q", recipe.Name, definition.ResourceType, definition.Driver, definition.TemplatePath)
+
 	secrets, err := e.getRecipeConfigSecrets(ctx, driver, configuration, definition)
 	if err != nil {
 		return nil, err
 	}
+
+	ctx, cancel := withRecipeTimeout(ctx, configuration)
+	defer cancel()
+
 	err = driver.Delete(ctx, recipedriver.DeleteOptions{
 		BaseOptions: recipedriver.BaseOptions{
 			Configuration: *configuration,
@@ -165,12 +198,104 @@ func (e *engine) deleteCore(ctx context.Context, recipe recipes.ResourceMetadata
 		OutputResources: outputResources,
 	})
 	if err != nil {
-		return definition, err
+		return definition, recipeTimeoutError(ctx, configuration, err)
 	}
 
 	return definition, nil
 }
 
+// withRecipeTimeout returns a context that is canceled once the recipe timeout of the environment expires. The context is
+// returned unchanged if the environment does not configure a recipe timeout.
+func withRecipeTimeout(ctx context.Context, configuration *recipes.Configuration) (context.Context, context.CancelFunc) {
+	if configuration.RecipeConfig.TimeoutSeconds <= 0 {
+		return ctx, func() {}
+	}
+
+	timeout := time.Duration(configuration.RecipeConfig.TimeoutSeconds) * time.Second
+	return context.WithTimeoutCause(ctx, timeout, errRecipeTimedOut)
+}
+
+// recipeTimeoutError returns a RecipeTimedOut error if the driver failed because the recipe timeout of the environment
+// expired, otherwise it returns the error of the driver unchanged.
+func recipeTimeoutError(ctx context.Context, configuration *recipes.Configuration, err error) error {
+	if !errors.Is(context.Cause(ctx), errRecipeTimedOut) {
+		return err
+	}
+
+	recipelogs.FromContext(ctx).Recordf(recipelogs.SourceEngine, "Recipe timed out after %d seconds", configuration.RecipeConfig.TimeoutSeconds)
+	msg := fmt.Sprintf("recipe did not complete within the timeout of %d seconds configured in the environment: %s", configuration.RecipeConfig.TimeoutSeconds, err.Error())
+	return recipes.NewRecipeError(recipes.RecipeTimedOut, msg, util.ExecutionError, nil)
+}
+
+// Plan loads the recipe definition from the environment, finds the driver associated with the recipe, loads the
+// configuration associated with the recipe, and then plans the recipe deployment using the driver. It returns the
+// changes the recipe would make, or nil if the environment is simulated.
+func (e *engine) Plan(ctx context.Context, opts PlanOptions) (*recipes.RecipePlan, error) {
+	planStart := time.Now()
+	result := metrics.SuccessfulOperationState
+
+	plan, definition, err := e.planCore(ctx, opts.Recipe, opts.PreviousState)
+	if err != nil {
+		result = metrics.FailedOperationState
+		if recipes.GetErrorDetails(err) != nil {
+			result = recipes.GetErrorDetails(err).Code
+		}
+	}
+
+	metrics.DefaultRecipeEngineMetrics.RecordRecipeOperationDuration(ctx, planStart,
+		metrics.NewRecipeAttributes(metrics.RecipeEngineOperationPlan, opts.Recipe.Name,
+			definition, result))
+
+	return plan, err
+}
+
+// planCore function is the core logic of the Plan function.
+// Any changes to the core logic of the Plan function should be made here.
+func (e *engine) planCore(ctx context.Context, recipe recipes.ResourceMetadata, prevState []string) (*recipes.RecipePlan, *recipes.EnvironmentDefinition, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	configuration, err := e.options.ConfigurationLoader.LoadConfiguration(ctx, recipe)
+	if err != nil {
+		return nil, nil, recipes.NewRecipeError(recipes.RecipeConfigurationFailure, err.Error(), util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	// Nothing would be deployed in a simulated environment.
+	if configuration.Simulated {
+		logger.Info("simulated environment enabled, skipping plan")
+		return nil, nil, nil
+	}
+
+	definition, driver, err := e.getDriver(ctx, recipe)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	secrets, err := e.getRecipeConfigSecrets(ctx, driver, configuration, definition)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	baseOptions := recipedriver.BaseOptions{
+		Configuration: *configuration,
+		Recipe:        recipe,
+		Definition:    *definition,
+		Secrets:       secrets,
+	}
+
+	plan, err := driver.Plan(ctx, recipedriver.ExecuteOptions{
+		BaseOptions: baseOptions,
+		PrevState:   prevState,
+	})
+	if err != nil {
+		return nil, definition, err
+	}
+
+	plan.TemplateKind = definition.Driver
+	plan.TemplatePath = definition.TemplatePath
+
+	return plan, definition, nil
+}
+
 // Gets the Recipe metadata and parameters from Recipe's template path.
 func (e *engine) GetRecipeMetadata(ctx context.Context, opts GetRecipeMetadataOptions) (map[string]any, error) {
 	recipeData, err := e.getRecipeMetadataCore(ctx, opts)
@@ -210,6 +335,17 @@ func (e *engine) getRecipeMetadataCore(ctx context.Context, opts GetRecipeMetada
 	})
 }
 
+// recordResult records the result of a recipe operation to the recipe logs of the context. Success is not recorded
+// for operations that did not run a recipe, e.g. in simulated environments.
+func recordResult(ctx context.Context, operation string, definition *recipes.EnvironmentDefinition, err error) {
+	recorder := recipelogs.FromContext(ctx)
+	if err != nil {
+		recorder.Recordf(recipelogs.SourceEngine, "Recipe %s failed: %s", operation, err.Error())
+	} else if definition != nil {
+		recorder.Recordf(recipelogs.SourceEngine, "Recipe %s succeeded", operation)
+	}
+}
+
 func (e *engine) getDriver(ctx context.Context, recipeMetadata recipes.ResourceMetadata) (*recipes.EnvironmentDefinition, recipedriver.Driver, error) {
 	// Load Recipe Definition from the environment.
 	definition, err := e.options.ConfigurationLoader.LoadRecipe(ctx, &recipeMetadata)
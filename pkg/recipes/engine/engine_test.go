@@ -17,6 +17,7 @@ limitations under the License.
 package engine
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"testing"
@@ -25,6 +26,8 @@ import (
 	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/recipes/configloader"
 	recipedriver "github.com/radius-project/radius/pkg/recipes/driver"
+	"github.com/radius-project/radius/pkg/recipes/recipelogs"
+	recipes_util "github.com/radius-project/radius/pkg/recipes/util"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/test/testcontext"
@@ -125,6 +128,73 @@ func Test_Engine_Execute_Success(t *testing.T) {
 	require.Equal(t, result, recipeResult)
 }
 
+func Test_Engine_Execute_Import_Success(t *testing.T) {
+	recipeMetadata := recipes.ResourceMetadata{
+		Name:          "redis-azure",
+		ApplicationID: "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/applications/app1",
+		EnvironmentID: "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/environments/env1",
+		ResourceID:    "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/redis",
+	}
+	recipeImport := &recipes.RecipeImport{
+		Resources: []recipes.ImportedResource{
+			{
+				ID: "/subscriptions/test-sub/resourceGroups/test-rg/providers/Microsoft.Cache/redis/test-cache",
+			},
+		},
+		Values: map[string]any{
+			"host": "test-cache.redis.cache.windows.net",
+		},
+	}
+	envConfig := &recipes.Configuration{
+		Runtime: recipes.RuntimeConfiguration{
+			Kubernetes: &recipes.KubernetesRuntime{
+				Namespace: "default",
+			},
+		},
+	}
+	recipeResult := &recipes.RecipeOutput{
+		Resources: []string{"/subscriptions/test-sub/resourceGroups/test-rg/providers/Microsoft.Cache/redis/test-cache"},
+		Secrets:   map[string]any{},
+		Values:    recipeImport.Values,
+	}
+	recipeDefinition := &recipes.EnvironmentDefinition{
+		Driver:       recipes.TemplateKindBicep,
+		TemplatePath: "ghcr.io/radius-project/dev/recipes/redis:1.0",
+		ResourceType: "Applications.Datastores/redisCaches",
+	}
+	ctx := testcontext.New(t)
+	engine, configLoader, driver, _, _ := setup(t)
+
+	configLoader.EXPECT().
+		LoadConfiguration(ctx, recipeMetadata).
+		Times(1).
+		Return(envConfig, nil)
+	configLoader.EXPECT().
+		LoadRecipe(ctx, &recipeMetadata).
+		Times(1).
+		Return(recipeDefinition, nil)
+	driver.EXPECT().
+		Execute(ctx, recipedriver.ExecuteOptions{
+			BaseOptions: recipedriver.BaseOptions{
+				Configuration: *envConfig,
+				Recipe:        recipeMetadata,
+				Definition:    *recipeDefinition,
+			},
+			Import: recipeImport,
+		}).
+		Times(1).
+		Return(recipeResult, nil)
+
+	result, err := engine.Execute(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Recipe: recipeMetadata,
+		},
+		Import: recipeImport,
+	})
+	require.NoError(t, err)
+	require.Equal(t, recipeResult, result)
+}
+
 func Test_Engine_Execute_SimulatedEnv_Success(t *testing.T) {
 	recipeMetadata := recipes.ResourceMetadata{
 		Name:          "mongo-azure",
@@ -238,6 +308,121 @@ func Test_Engine_Execute_Failure(t *testing.T) {
 	require.Equal(t, err.Error(), "failed to execute recipe")
 }
 
+func Test_Engine_Execute_TimedOut(t *testing.T) {
+	recipeMetadata := recipes.ResourceMetadata{
+		Name:          "mongo-azure",
+		ApplicationID: "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/applications/app1",
+		EnvironmentID: "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/environments/env1",
+		ResourceID:    "/planes/radius/local/resourceGroups/test-rg/providers/Microsoft.Resources/deployments/recipe",
+	}
+	envConfig := &recipes.Configuration{
+		Runtime: recipes.RuntimeConfiguration{
+			Kubernetes: &recipes.KubernetesRuntime{
+				Namespace: "default",
+			},
+		},
+		RecipeConfig: datamodel.RecipeConfigProperties{
+			TimeoutSeconds: 1,
+		},
+	}
+	recipeDefinition := &recipes.EnvironmentDefinition{
+		Driver:       recipes.TemplateKindBicep,
+		TemplatePath: "ghcr.io/radius-project/dev/recipes/functionaltest/basic/mongodatabases/azure:1.0",
+		ResourceType: "Applications.Datastores/mongoDatabases",
+	}
+	ctx := testcontext.New(t)
+	engine, configLoader, driver, _, _ := setup(t)
+
+	configLoader.EXPECT().
+		LoadConfiguration(ctx, recipeMetadata).
+		Times(1).
+		Return(envConfig, nil)
+	configLoader.EXPECT().
+		LoadRecipe(ctx, &recipeMetadata).
+		Times(1).
+		Return(recipeDefinition, nil)
+	driver.EXPECT().
+		Execute(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(ctx context.Context, opts recipedriver.ExecuteOptions) (*recipes.RecipeOutput, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		})
+
+	result, err := engine.Execute(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Recipe: recipeMetadata,
+		},
+	})
+	require.Nil(t, result)
+	require.Error(t, err)
+	require.Equal(t, recipes.RecipeTimedOut, recipes.GetErrorDetails(err).Code)
+}
+
+func Test_Engine_Execute_InvalidOutputs(t *testing.T) {
+	recipeMetadata := recipes.ResourceMetadata{
+		Name:          "mongo-azure",
+		ApplicationID: "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/applications/app1",
+		EnvironmentID: "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/environments/env1",
+		ResourceID:    "/planes/radius/local/resourceGroups/test-rg/providers/Microsoft.Resources/deployments/recipe",
+	}
+	envConfig := &recipes.Configuration{
+		Runtime: recipes.RuntimeConfiguration{
+			Kubernetes: &recipes.KubernetesRuntime{
+				Namespace: "default",
+			},
+		},
+	}
+	recipeResult := &recipes.RecipeOutput{
+		Resources: []string{"/subscriptions/test-sub/resourceGroups/test-rg/providers/Microsoft.DocumentDB/databaseAccounts/testAccount1"},
+		Values: map[string]any{
+			"host": "testAccount1.mongo.cosmos.azure.com",
+			"port": "10255",
+		},
+	}
+	recipeDefinition := &recipes.EnvironmentDefinition{
+		Driver:       recipes.TemplateKindBicep,
+		TemplatePath: "ghcr.io/radius-project/dev/recipes/functionaltest/basic/mongodatabases/azure:1.0",
+		ResourceType: "Applications.Datastores/mongoDatabases",
+		Outputs: &recipes.OutputContract{
+			Values:  map[string]string{"host": recipes.OutputTypeString, "port": recipes.OutputTypeInteger},
+			Secrets: []string{"connectionString"},
+		},
+	}
+	recorder := recipelogs.NewRecorder(recipelogs.DefaultMaxSize)
+	ctx := recipelogs.WithRecorder(testcontext.New(t), recorder)
+	engine, configLoader, driver, _, _ := setup(t)
+
+	configLoader.EXPECT().
+		LoadConfiguration(ctx, recipeMetadata).
+		Times(1).
+		Return(envConfig, nil)
+	configLoader.EXPECT().
+		LoadRecipe(ctx, &recipeMetadata).
+		Times(1).
+		Return(recipeDefinition, nil)
+	driver.EXPECT().
+		Execute(ctx, gomock.Any()).
+		Times(1).
+		Return(recipeResult, nil)
+
+	result, err := engine.Execute(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Recipe: recipeMetadata,
+		},
+	})
+	// The recipe was deployed, so its output is returned for the deployed resources to be recorded.
+	require.Equal(t, recipeResult, result)
+	require.Equal(t, recipes.NewRecipeError(recipes.InvalidRecipeOutputs,
+		"recipe \"mongo-azure\" of type \"Applications.Datastores/mongoDatabases\" produced invalid outputs: the recipe outputs do not satisfy the output contract of the recipe: value \"port\" must be of type integer, got string; missing secret \"connectionString\"",
+		recipes_util.ExecutionError, nil), err)
+
+	// The failure is recorded to the recipe logs, so it can be retrieved by developers.
+	entries, _ := recorder.Entries()
+	require.Len(t, entries, 2)
+	require.Equal(t, "Recipe execution failed: "+err.Error(), entries[1].Message)
+}
+
 func Test_Engine_Terraform_Success(t *testing.T) {
 	recipeMetadata := recipes.ResourceMetadata{
 		Name:          "mongo-azure",
@@ -825,6 +1010,118 @@ func Test_Delete_Lookup_Error(t *testing.T) {
 	require.Error(t, err)
 }
 
+func Test_Engine_Plan_Success(t *testing.T) {
+	recipeMetadata, recipeDefinition, _ := getRecipeInputs()
+	prevState := []string{
+		"/subscriptions/test-sub/resourceGroups/test-rg/providers/System.Test/testResources/test1",
+	}
+	envConfig := &recipes.Configuration{
+		Runtime: recipes.RuntimeConfiguration{
+			Kubernetes: &recipes.KubernetesRuntime{
+				Namespace: "default",
+			},
+		},
+	}
+	plan := &recipes.RecipePlan{
+		ResourceChanges: []recipes.ResourceChange{
+			{
+				ID:     "/subscriptions/test-sub/resourceGroups/test-rg/providers/System.Test/testResources/test1",
+				Type:   "System.Test/testResources",
+				Action: recipes.ResourceChangeDelete,
+			},
+		},
+	}
+	ctx := testcontext.New(t)
+	engine, configLoader, driver, _, _ := setup(t)
+
+	configLoader.EXPECT().
+		LoadConfiguration(ctx, recipeMetadata).
+		Times(1).
+		Return(envConfig, nil)
+	configLoader.EXPECT().
+		LoadRecipe(ctx, &recipeMetadata).
+		Times(1).
+		Return(&recipeDefinition, nil)
+	driver.EXPECT().
+		Plan(ctx, recipedriver.ExecuteOptions{
+			BaseOptions: recipedriver.BaseOptions{
+				Configuration: *envConfig,
+				Recipe:        recipeMetadata,
+				Definition:    recipeDefinition,
+			},
+			PrevState: prevState,
+		}).
+		Times(1).
+		Return(plan, nil)
+
+	result, err := engine.Plan(ctx, PlanOptions{
+		BaseOptions: BaseOptions{
+			Recipe: recipeMetadata,
+		},
+		PreviousState: prevState,
+	})
+	require.NoError(t, err)
+	require.Equal(t, recipeDefinition.Driver, result.TemplateKind)
+	require.Equal(t, recipeDefinition.TemplatePath, result.TemplatePath)
+	require.Equal(t, plan.ResourceChanges, result.ResourceChanges)
+}
+
+func Test_Engine_Plan_SimulatedEnv_Success(t *testing.T) {
+	recipeMetadata, _, _ := getRecipeInputs()
+	envConfig := &recipes.Configuration{
+		Simulated: true,
+	}
+	ctx := testcontext.New(t)
+	engine, configLoader, _, _, _ := setup(t)
+
+	configLoader.EXPECT().
+		LoadConfiguration(ctx, recipeMetadata).
+		Times(1).
+		Return(envConfig, nil)
+
+	result, err := engine.Plan(ctx, PlanOptions{
+		BaseOptions: BaseOptions{
+			Recipe: recipeMetadata,
+		},
+	})
+	require.NoError(t, err)
+	require.Nil(t, result)
+}
+
+func Test_Engine_Plan_Error(t *testing.T) {
+	recipeMetadata, recipeDefinition, _ := getRecipeInputs()
+	envConfig := &recipes.Configuration{
+		Runtime: recipes.RuntimeConfiguration{
+			Kubernetes: &recipes.KubernetesRuntime{
+				Namespace: "default",
+			},
+		},
+	}
+	recipeErr := recipes.NewRecipeError(recipes.RecipePlanFailed, "failed to plan recipe", "", nil)
+	ctx := testcontext.New(t)
+	engine, configLoader, driver, _, _ := setup(t)
+
+	configLoader.EXPECT().
+		LoadConfiguration(ctx, recipeMetadata).
+		Times(1).
+		Return(envConfig, nil)
+	configLoader.EXPECT().
+		LoadRecipe(ctx, &recipeMetadata).
+		Times(1).
+		Return(&recipeDefinition, nil)
+	driver.EXPECT().
+		Plan(ctx, gomock.Any()).
+		Times(1).
+		Return(nil, recipeErr)
+
+	_, err := engine.Plan(ctx, PlanOptions{
+		BaseOptions: BaseOptions{
+			Recipe: recipeMetadata,
+		},
+	})
+	require.Equal(t, recipeErr, err)
+}
+
 func Test_Engine_GetRecipeMetadata_Success(t *testing.T) {
 	recipeMetadata, recipeDefinition, _ := getRecipeInputs()
 	envConfig := &recipes.Configuration{
@@ -28,6 +28,8 @@ import (
 type Engine interface {
 	// Execute gathers environment configuration, recipe definition and calls the driver to deploy the recipe.
 	// prevState is added to the driver execute options, which is used to get the obsolete resources for cleanup. It consists list of recipe output resource IDs that were created in the previous deployment.
+	// If the recipe was deployed but its outputs do not match the outputs declared by its registration, the recipe output is returned
+	// along with the error, so the deployed resources can be recorded and deleted later.
 	Execute(ctx context.Context, opts ExecuteOptions) (*recipes.RecipeOutput, error)
 
 	// Delete handles deletion of output resources for the recipe deployment.
@@ -35,6 +37,10 @@ type Engine interface {
 
 	// Gets the Recipe metadata and parameters from Recipe's template path
 	GetRecipeMetadata(ctx context.Context, opts GetRecipeMetadataOptions) (map[string]any, error)
+
+	// Plan gathers environment configuration, recipe definition and calls the driver to plan the recipe deployment.
+	// It returns the changes that deploying the recipe would make, without making them.
+	Plan(ctx context.Context, opts PlanOptions) (*recipes.RecipePlan, error)
 }
 
 // BaseOptions is the base options for the engine operations.
@@ -50,6 +56,9 @@ type ExecuteOptions struct {
 	PreviousState []string
 	// Simulated is the flag to indicate if the execution is a simulation.
 	Simulated bool
+	// Import represents the existing cloud resources to adopt instead of deploying the recipe. It is only set the
+	// first time the recipe of a resource is executed.
+	Import *recipes.RecipeImport
 }
 
 // DeleteOptions is the options for the Delete method.
@@ -60,6 +69,14 @@ type DeleteOptions struct {
 	OutputResources []rpv1.OutputResource
 }
 
+// PlanOptions is the options for the Plan method.
+type PlanOptions struct {
+	BaseOptions
+	// PreviousState represents previously deployed state of output resource IDs. Resources that are no longer
+	// deployed by the recipe are planned to be deleted.
+	PreviousState []string
+}
+
 type GetRecipeMetadataOptions struct {
 	BaseOptions
 	RecipeDefinition recipes.EnvironmentDefinition
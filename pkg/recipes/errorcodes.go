@@ -26,9 +26,18 @@ const (
 	// Used for recipe deletion failures.
 	RecipeDeletionFailed = "RecipeDeletionFailed"
 
+	// Used for recipe executions that exceeded the recipe timeout configured in the environment.
+	RecipeTimedOut = "RecipeTimedOut"
+
+	// Used for failures to import existing cloud resources into a recipe.
+	RecipeImportFailed = "RecipeImportFailed"
+
 	// Used for errors encountered during processing recipe outputs.
 	InvalidRecipeOutputs = "InvalidRecipeOutputs"
 
+	// Used for recipe parameters that do not match the parameters defined by the recipe template.
+	InvalidRecipeParameters = "InvalidRecipeParameters"
+
 	// Used for errors encountered while reading a recipe from registry.
 	RecipeLanguageFailure = "RecipeLanguageFailure"
 
@@ -38,6 +47,9 @@ const (
 	// Used for errors encountered when getting recipe parameters.
 	RecipeGetMetadataFailed = "RecipeGetMetadataFailed"
 
+	// Used for errors encountered when planning recipe deployment.
+	RecipePlanFailed = "RecipePlanFailed"
+
 	// Used for errors when checking the existence of a recipe.
 	RecipeNotFoundFailure = "RecipeNotFoundFailure"
 
@@ -0,0 +1,326 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/cli"
+	"helm.sh/helm/v3/pkg/kube"
+	"helm.sh/helm/v3/pkg/registry"
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/storage/driver"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+
+	"github.com/radius-project/radius/pkg/recipes/recipelogs"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// storageDriver stores the releases in Kubernetes secrets, like the Helm CLI does by default.
+	storageDriver = "secret"
+)
+
+var _ HelmExecutor = (*executor)(nil)
+
+// NewExecutor creates a new Executor to install, upgrade and uninstall the Helm charts of recipes in the
+// Kubernetes cluster of the given config.
+func NewExecutor(config *rest.Config) *executor {
+	return &executor{config: config}
+}
+
+type executor struct {
+	// config is the configuration of the Kubernetes cluster where the charts are installed.
+	config *rest.Config
+}
+
+// Deploy loads the Helm chart referenced by the recipe and installs it as a release, or upgrades the release
+// if it is already installed. A failed installation is uninstalled and a failed upgrade is rolled back, so
+// the next deployment starts from the last successful release.
+func (e *executor) Deploy(ctx context.Context, options Options) (*Result, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	cfg, err := e.actionConfig(ctx, options.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	helmChart, err := loadChart(cfg, options)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := lastRelease(cfg, options.ReleaseName)
+	if err != nil {
+		return nil, err
+	}
+
+	var rel *release.Release
+	if current == nil {
+		logger.Info(fmt.Sprintf("Installing Helm release %q in namespace %q", options.ReleaseName, options.Namespace))
+		install := action.NewInstall(cfg)
+		install.ReleaseName = options.ReleaseName
+		install.Namespace = options.Namespace
+		install.Atomic = true
+		install.Timeout = timeout(options)
+		rel, err = install.RunWithContext(ctx, helmChart, options.Values)
+	} else {
+		logger.Info(fmt.Sprintf("Upgrading Helm release %q in namespace %q", options.ReleaseName, options.Namespace))
+		upgrade := action.NewUpgrade(cfg)
+		upgrade.Namespace = options.Namespace
+		upgrade.Atomic = true
+		upgrade.Timeout = timeout(options)
+		rel, err = upgrade.RunWithContext(ctx, options.ReleaseName, helmChart, options.Values)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to deploy Helm release %q: %w", options.ReleaseName, err)
+	}
+
+	return newResult(cfg, rel)
+}
+
+// Delete uninstalls the release. It does not fail if the release is not installed.
+func (e *executor) Delete(ctx context.Context, options Options) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	cfg, err := e.actionConfig(ctx, options.Namespace)
+	if err != nil {
+		return err
+	}
+
+	logger.Info(fmt.Sprintf("Uninstalling Helm release %q in namespace %q", options.ReleaseName, options.Namespace))
+	uninstall := action.NewUninstall(cfg)
+	uninstall.IgnoreNotFound = true
+	uninstall.Wait = true
+	uninstall.Timeout = timeout(options)
+	_, err = uninstall.Run(options.ReleaseName)
+	if err != nil {
+		return fmt.Errorf("failed to uninstall Helm release %q: %w", options.ReleaseName, err)
+	}
+
+	return nil
+}
+
+// Plan loads the Helm chart referenced by the recipe and renders it with a dry run of the installation or upgrade.
+// It returns the current release, or nil if the release is not installed, and the release that Deploy would install.
+func (e *executor) Plan(ctx context.Context, options Options) (*Result, *Result, error) {
+	cfg, err := e.actionConfig(ctx, options.Namespace)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	helmChart, err := loadChart(cfg, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	current, err := lastRelease(cfg, options.ReleaseName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var planned *release.Release
+	if current == nil {
+		install := action.NewInstall(cfg)
+		install.ReleaseName = options.ReleaseName
+		install.Namespace = options.Namespace
+		install.DryRun = true
+		install.DryRunOption = "server"
+		planned, err = install.RunWithContext(ctx, helmChart, options.Values)
+	} else {
+		upgrade := action.NewUpgrade(cfg)
+		upgrade.Namespace = options.Namespace
+		upgrade.DryRun = true
+		upgrade.DryRunOption = "server"
+		planned, err = upgrade.RunWithContext(ctx, options.ReleaseName, helmChart, options.Values)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to render Helm release %q: %w", options.ReleaseName, err)
+	}
+
+	plannedResult, err := newResult(cfg, planned)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if current == nil {
+		return nil, plannedResult, nil
+	}
+
+	currentResult, err := newResult(cfg, current)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return currentResult, plannedResult, nil
+}
+
+// GetRecipeMetadata loads the Helm chart referenced by the recipe and returns its default values.
+func (e *executor) GetRecipeMetadata(ctx context.Context, options Options) (map[string]any, error) {
+	cfg, err := e.actionConfig(ctx, options.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	helmChart, err := loadChart(cfg, options)
+	if err != nil {
+		return nil, err
+	}
+
+	if helmChart.Values == nil {
+		return map[string]any{}, nil
+	}
+
+	return helmChart.Values, nil
+}
+
+// actionConfig creates the configuration of the Helm actions for releases in the given namespace.
+func (e *executor) actionConfig(ctx context.Context, namespace string) (*action.Configuration, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	recorder := recipelogs.FromContext(ctx)
+
+	cfg := &action.Configuration{}
+	err := cfg.Init(newRESTClientGetter(e.config, namespace), namespace, storageDriver, func(format string, v ...any) {
+		logger.V(ucplog.LevelDebug).Info(fmt.Sprintf(format, v...))
+		recorder.Recordf(recipelogs.SourceHelm, format, v...)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize Helm: %w", err)
+	}
+
+	// Objects without a namespace in the chart are created in the namespace of the release.
+	if kubeClient, ok := cfg.KubeClient.(*kube.Client); ok {
+		kubeClient.Namespace = namespace
+	}
+
+	return cfg, nil
+}
+
+// loadChart loads the chart from the OCI registry or the local path referenced by the options.
+func loadChart(cfg *action.Configuration, options Options) (*chart.Chart, error) {
+	if !registry.IsOCI(options.ChartPath) {
+		helmChart, err := loader.Load(options.ChartPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load Helm chart %q: %w", options.ChartPath, err)
+		}
+
+		return helmChart, nil
+	}
+
+	clientOptions := []registry.ClientOption{}
+	if options.PlainHTTP {
+		clientOptions = append(clientOptions, registry.ClientOptPlainHTTP())
+	}
+
+	registryClient, err := registry.NewClient(clientOptions...)
+	if err != nil {
+		return nil, err
+	}
+
+	dir, err := os.MkdirTemp("", "recipe-chart")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	pull := action.NewPullWithOpts(action.WithConfig(cfg))
+	pull.Settings = cli.New()
+	pull.SetRegistryClient(registryClient)
+	pull.Version = options.ChartVersion
+	pull.PlainHTTP = options.PlainHTTP
+	pull.DestDir = dir
+
+	_, err = pull.Run(options.ChartPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download Helm chart %q: %w", options.ChartPath, err)
+	}
+
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(files) != 1 {
+		return nil, fmt.Errorf("expected to download 1 file for Helm chart %q, found %d", options.ChartPath, len(files))
+	}
+
+	return loader.Load(filepath.Join(dir, files[0].Name()))
+}
+
+// lastRelease returns the last release with the given name, or nil if the release is not installed.
+func lastRelease(cfg *action.Configuration, releaseName string) (*release.Release, error) {
+	history := action.NewHistory(cfg)
+	history.Max = 1
+
+	releases, err := history.Run(releaseName)
+	if errors.Is(err, driver.ErrReleaseNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get Helm release %q: %w", releaseName, err)
+	}
+
+	if len(releases) == 0 {
+		return nil, nil
+	}
+
+	return releases[len(releases)-1], nil
+}
+
+// newResult creates the result for the release, resolving the namespace of the objects of its manifest.
+func newResult(cfg *action.Configuration, rel *release.Release) (*Result, error) {
+	result := &Result{Release: rel, Objects: []*unstructured.Unstructured{}}
+	if rel.Manifest == "" {
+		return result, nil
+	}
+
+	resources, err := cfg.KubeClient.Build(bytes.NewBufferString(rel.Manifest), false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the manifest of Helm release %q: %w", rel.Name, err)
+	}
+
+	for _, info := range resources {
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(info.Object)
+		if err != nil {
+			return nil, err
+		}
+
+		obj := &unstructured.Unstructured{Object: content}
+		obj.SetNamespace(info.Namespace)
+		result.Objects = append(result.Objects, obj)
+	}
+
+	return result, nil
+}
+
+func timeout(options Options) time.Duration {
+	if options.Timeout <= 0 {
+		return DefaultTimeout
+	}
+
+	return options.Timeout
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/rest"
+
+	"github.com/radius-project/radius/test/testcontext"
+)
+
+func Test_GetRecipeMetadata_LocalChart(t *testing.T) {
+	ctx := testcontext.New(t)
+	e := NewExecutor(&rest.Config{Host: "https://localhost:6443"})
+
+	values, err := e.GetRecipeMetadata(ctx, Options{ChartPath: "testdata/redis"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{
+		"port":     float64(6379),
+		"replicas": float64(1),
+		"image":    "redis:7",
+		"persistence": map[string]any{
+			"enabled": false,
+		},
+	}, values)
+}
+
+func Test_GetRecipeMetadata_ChartNotFound(t *testing.T) {
+	ctx := testcontext.New(t)
+	e := NewExecutor(&rest.Config{Host: "https://localhost:6443"})
+
+	_, err := e.GetRecipeMetadata(ctx, Options{ChartPath: "testdata/not-found"})
+	require.ErrorContains(t, err, "failed to load Helm chart \"testdata/not-found\"")
+}
+
+func Test_ActionConfig_Namespace(t *testing.T) {
+	ctx := testcontext.New(t)
+	e := NewExecutor(&rest.Config{Host: "https://localhost:6443"})
+
+	cfg, err := e.actionConfig(ctx, "test-namespace")
+	require.NoError(t, err)
+	require.NotNil(t, cfg.KubeClient)
+
+	getter := newRESTClientGetter(e.config, "test-namespace")
+	namespace, _, err := getter.ToRawKubeConfigLoader().Namespace()
+	require.NoError(t, err)
+	require.Equal(t, "test-namespace", namespace)
+
+	config, err := getter.ToRESTConfig()
+	require.NoError(t, err)
+	require.Equal(t, "https://localhost:6443", config.Host)
+}
+
+func Test_Timeout(t *testing.T) {
+	require.Equal(t, DefaultTimeout, timeout(Options{}))
+	require.Equal(t, time.Minute, timeout(Options{Timeout: time.Minute}))
+}
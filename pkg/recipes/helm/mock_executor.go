@@ -0,0 +1,196 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/radius-project/radius/pkg/recipes/helm (interfaces: HelmExecutor)
+//
+// Generated by this command:
+//
+//	mockgen -typed -destination=./mock_executor.go -package=helm -self_package github.com/radius-project/radius/pkg/recipes/helm github.com/radius-project/radius/pkg/recipes/helm HelmExecutor
+//
+
+// Package helm is a generated GoMock package.
+package helm
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockHelmExecutor is a mock of HelmExecutor interface.
+type MockHelmExecutor struct {
+	ctrl     *gomock.Controller
+	recorder *MockHelmExecutorMockRecorder
+}
+
+// MockHelmExecutorMockRecorder is the mock recorder for MockHelmExecutor.
+type MockHelmExecutorMockRecorder struct {
+	mock *MockHelmExecutor
+}
+
+// NewMockHelmExecutor creates a new mock instance.
+func NewMockHelmExecutor(ctrl *gomock.Controller) *MockHelmExecutor {
+	mock := &MockHelmExecutor{ctrl: ctrl}
+	mock.recorder = &MockHelmExecutorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHelmExecutor) EXPECT() *MockHelmExecutorMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockHelmExecutor) Delete(arg0 context.Context, arg1 Options) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockHelmExecutorMockRecorder) Delete(arg0, arg1 any) *MockHelmExecutorDeleteCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockHelmExecutor)(nil).Delete), arg0, arg1)
+	return &MockHelmExecutorDeleteCall{Call: call}
+}
+
+// MockHelmExecutorDeleteCall wrap *gomock.Call
+type MockHelmExecutorDeleteCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockHelmExecutorDeleteCall) Return(arg0 error) *MockHelmExecutorDeleteCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockHelmExecutorDeleteCall) Do(f func(context.Context, Options) error) *MockHelmExecutorDeleteCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockHelmExecutorDeleteCall) DoAndReturn(f func(context.Context, Options) error) *MockHelmExecutorDeleteCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Deploy mocks base method.
+func (m *MockHelmExecutor) Deploy(arg0 context.Context, arg1 Options) (*Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deploy", arg0, arg1)
+	ret0, _ := ret[0].(*Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Deploy indicates an expected call of Deploy.
+func (mr *MockHelmExecutorMockRecorder) Deploy(arg0, arg1 any) *MockHelmExecutorDeployCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deploy", reflect.TypeOf((*MockHelmExecutor)(nil).Deploy), arg0, arg1)
+	return &MockHelmExecutorDeployCall{Call: call}
+}
+
+// MockHelmExecutorDeployCall wrap *gomock.Call
+type MockHelmExecutorDeployCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockHelmExecutorDeployCall) Return(arg0 *Result, arg1 error) *MockHelmExecutorDeployCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockHelmExecutorDeployCall) Do(f func(context.Context, Options) (*Result, error)) *MockHelmExecutorDeployCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockHelmExecutorDeployCall) DoAndReturn(f func(context.Context, Options) (*Result, error)) *MockHelmExecutorDeployCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetRecipeMetadata mocks base method.
+func (m *MockHelmExecutor) GetRecipeMetadata(arg0 context.Context, arg1 Options) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecipeMetadata", arg0, arg1)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecipeMetadata indicates an expected call of GetRecipeMetadata.
+func (mr *MockHelmExecutorMockRecorder) GetRecipeMetadata(arg0, arg1 any) *MockHelmExecutorGetRecipeMetadataCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecipeMetadata", reflect.TypeOf((*MockHelmExecutor)(nil).GetRecipeMetadata), arg0, arg1)
+	return &MockHelmExecutorGetRecipeMetadataCall{Call: call}
+}
+
+// MockHelmExecutorGetRecipeMetadataCall wrap *gomock.Call
+type MockHelmExecutorGetRecipeMetadataCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockHelmExecutorGetRecipeMetadataCall) Return(arg0 map[string]any, arg1 error) *MockHelmExecutorGetRecipeMetadataCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockHelmExecutorGetRecipeMetadataCall) Do(f func(context.Context, Options) (map[string]any, error)) *MockHelmExecutorGetRecipeMetadataCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockHelmExecutorGetRecipeMetadataCall) DoAndReturn(f func(context.Context, Options) (map[string]any, error)) *MockHelmExecutorGetRecipeMetadataCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Plan mocks base method.
+func (m *MockHelmExecutor) Plan(arg0 context.Context, arg1 Options) (*Result, *Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Plan", arg0, arg1)
+	ret0, _ := ret[0].(*Result)
+	ret1, _ := ret[1].(*Result)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Plan indicates an expected call of Plan.
+func (mr *MockHelmExecutorMockRecorder) Plan(arg0, arg1 any) *MockHelmExecutorPlanCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Plan", reflect.TypeOf((*MockHelmExecutor)(nil).Plan), arg0, arg1)
+	return &MockHelmExecutorPlanCall{Call: call}
+}
+
+// MockHelmExecutorPlanCall wrap *gomock.Call
+type MockHelmExecutorPlanCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockHelmExecutorPlanCall) Return(arg0, arg1 *Result, arg2 error) *MockHelmExecutorPlanCall {
+	c.Call = c.Call.Return(arg0, arg1, arg2)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockHelmExecutorPlanCall) Do(f func(context.Context, Options) (*Result, *Result, error)) *MockHelmExecutorPlanCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockHelmExecutorPlanCall) DoAndReturn(f func(context.Context, Options) (*Result, *Result, error)) *MockHelmExecutorPlanCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
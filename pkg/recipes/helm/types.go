@@ -0,0 +1,85 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"context"
+	"time"
+
+	"helm.sh/helm/v3/pkg/release"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	// OutputAnnotation is the annotation that designates the ConfigMap or Secret rendered by a Helm chart
+	// which holds the outputs of the recipe. The value of the annotation must be "true".
+	OutputAnnotation = "radapp.io/recipe-output"
+
+	// DefaultTimeout is the default time to wait for the Kubernetes objects of a release to be ready.
+	DefaultTimeout = 10 * time.Minute
+)
+
+//go:generate mockgen -typed -destination=./mock_executor.go -package=helm -self_package github.com/radius-project/radius/pkg/recipes/helm github.com/radius-project/radius/pkg/recipes/helm HelmExecutor
+type HelmExecutor interface {
+	// Deploy loads the Helm chart referenced by the recipe and installs it as a release, or upgrades the release
+	// if it is already installed. A failed installation or upgrade is rolled back.
+	Deploy(ctx context.Context, options Options) (*Result, error)
+
+	// Delete uninstalls the release. It does not fail if the release is not installed.
+	Delete(ctx context.Context, options Options) error
+
+	// Plan loads the Helm chart referenced by the recipe and renders it without installing it. It returns the
+	// current release, or nil if the release is not installed, and the release that Deploy would install.
+	Plan(ctx context.Context, options Options) (current *Result, planned *Result, err error)
+
+	// GetRecipeMetadata loads the Helm chart referenced by the recipe and returns its default values.
+	GetRecipeMetadata(ctx context.Context, options Options) (map[string]any, error)
+}
+
+// Options represents the options required to install, upgrade and uninstall the Helm chart of a recipe.
+type Options struct {
+	// Namespace is the Kubernetes namespace of the release.
+	Namespace string
+
+	// ReleaseName is the name of the release.
+	ReleaseName string
+
+	// ChartPath is the path to the chart, either an OCI reference prefixed with "oci://" or a local path.
+	ChartPath string
+
+	// ChartVersion is the version of the chart stored in an OCI registry. The latest version is used if it is not set.
+	ChartVersion string
+
+	// PlainHTTP connects to the OCI registry using HTTP instead of HTTPS.
+	PlainHTTP bool
+
+	// Values are the values passed to the chart.
+	Values map[string]any
+
+	// Timeout is the time to wait for the Kubernetes objects of the release to be ready. DefaultTimeout is used if
+	// it is not set.
+	Timeout time.Duration
+}
+
+// Result represents a release of the Helm chart of a recipe.
+type Result struct {
+	// Release is the Helm release.
+	Release *release.Release
+
+	// Objects are the Kubernetes objects rendered by the chart, with their namespace set for namespaced kinds.
+	Objects []*unstructured.Unstructured
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipes
+
+import (
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// OutputTypeString is the type of the string values output by a recipe.
+	OutputTypeString = "string"
+
+	// OutputTypeInteger is the type of the integer values output by a recipe.
+	OutputTypeInteger = "integer"
+
+	// OutputTypeNumber is the type of the numeric values output by a recipe.
+	OutputTypeNumber = "number"
+
+	// OutputTypeBoolean is the type of the boolean values output by a recipe.
+	OutputTypeBoolean = "boolean"
+
+	// OutputTypeObject is the type of the object values output by a recipe.
+	OutputTypeObject = "object"
+
+	// OutputTypeArray is the type of the array values output by a recipe.
+	OutputTypeArray = "array"
+)
+
+// SupportedOutputTypes is the list of the types of values that an output contract can declare.
+var SupportedOutputTypes = []string{OutputTypeString, OutputTypeInteger, OutputTypeNumber, OutputTypeBoolean, OutputTypeObject, OutputTypeArray}
+
+// OutputContract represents the outputs a recipe must produce, as declared by the registration of the recipe.
+type OutputContract struct {
+	// Values represents the values the recipe must output, keyed by name. The value is the expected type of the output.
+	Values map[string]string
+	// Secrets represents the names of the secrets the recipe must output.
+	Secrets []string
+	// Resources represents whether the recipe must output the IDs of the resources it deploys.
+	Resources bool
+}
+
+// Validate returns an error describing the outputs of the recipe that are missing or do not have the type declared
+// by the contract, or nil if the outputs satisfy the contract.
+func (c *OutputContract) Validate(output *RecipeOutput) error {
+	if c == nil {
+		return nil
+	}
+	if output == nil {
+		output = &RecipeOutput{}
+	}
+
+	problems := []string{}
+	for _, name := range slices.Sorted(maps.Keys(c.Values)) {
+		value, ok := output.Values[name]
+		if !ok || value == nil {
+			problems = append(problems, fmt.Sprintf("missing value %q", name))
+			continue
+		}
+
+		if !isOutputType(value, c.Values[name]) {
+			problems = append(problems, fmt.Sprintf("value %q must be of type %s, got %s", name, c.Values[name], outputType(value)))
+		}
+	}
+
+	for _, name := range c.Secrets {
+		value, ok := output.Secrets[name]
+		if !ok || value == nil || value == "" {
+			problems = append(problems, fmt.Sprintf("missing secret %q", name))
+			continue
+		}
+
+		if _, ok := value.(string); !ok {
+			problems = append(problems, fmt.Sprintf("secret %q must be of type string, got %s", name, outputType(value)))
+		}
+	}
+
+	if c.Resources {
+		if len(output.Resources) == 0 {
+			problems = append(problems, "missing resource IDs")
+		}
+
+		for _, id := range output.Resources {
+			if _, err := resources.ParseResource(id); err != nil {
+				problems = append(problems, fmt.Sprintf("invalid resource ID %q", id))
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("the recipe outputs do not satisfy the output contract of the recipe: %s", strings.Join(problems, "; "))
+	}
+
+	return nil
+}
+
+// isOutputType returns true if the value output by a recipe has the type declared by an output contract. Recipe
+// outputs are decoded from JSON, so numbers are usually float64.
+func isOutputType(value any, outputType string) bool {
+	switch outputType {
+	case OutputTypeString:
+		_, ok := value.(string)
+		return ok
+	case OutputTypeInteger:
+		switch v := value.(type) {
+		case int, int32, int64:
+			return true
+		case float64:
+			return v == math.Trunc(v)
+		}
+		return false
+	case OutputTypeNumber:
+		switch value.(type) {
+		case int, int32, int64, float32, float64:
+			return true
+		}
+		return false
+	case OutputTypeBoolean:
+		_, ok := value.(bool)
+		return ok
+	case OutputTypeObject:
+		_, ok := value.(map[string]any)
+		return ok
+	case OutputTypeArray:
+		_, ok := value.([]any)
+		return ok
+	}
+
+	return false
+}
+
+// outputType returns the type of a value output by a recipe, for error messages.
+func outputType(value any) string {
+	for _, t := range SupportedOutputTypes {
+		// Integers are also numbers, report the most specific type.
+		if isOutputType(value, t) {
+			return t
+		}
+	}
+
+	return fmt.Sprintf("%T", value)
+}
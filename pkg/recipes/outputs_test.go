@@ -0,0 +1,133 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutputContract_Validate(t *testing.T) {
+	contract := &OutputContract{
+		Values: map[string]string{
+			"host":    OutputTypeString,
+			"port":    OutputTypeInteger,
+			"ratio":   OutputTypeNumber,
+			"tls":     OutputTypeBoolean,
+			"labels":  OutputTypeObject,
+			"servers": OutputTypeArray,
+		},
+		Secrets:   []string{"connectionString"},
+		Resources: true,
+	}
+	validOutput := func() *RecipeOutput {
+		return &RecipeOutput{
+			Values: map[string]any{
+				"host":    "testhost",
+				"port":    float64(6379),
+				"ratio":   0.5,
+				"tls":     true,
+				"labels":  map[string]any{"team": "radius"},
+				"servers": []any{"server1"},
+			},
+			Secrets: map[string]any{
+				"connectionString": "testConnectionString",
+			},
+			Resources: []string{"/planes/kubernetes/local/namespaces/default/providers/core/Service/redis"},
+		}
+	}
+
+	tests := []struct {
+		desc        string
+		contract    *OutputContract
+		output      func(output *RecipeOutput)
+		expectedErr string
+	}{
+		{
+			desc:     "no contract",
+			contract: nil,
+			output:   func(output *RecipeOutput) { output.Values = nil },
+		},
+		{
+			desc:     "valid outputs",
+			contract: contract,
+			output:   func(output *RecipeOutput) {},
+		},
+		{
+			desc:     "integer of a go type",
+			contract: contract,
+			output:   func(output *RecipeOutput) { output.Values["port"] = 6379 },
+		},
+		{
+			desc:        "missing value",
+			contract:    contract,
+			output:      func(output *RecipeOutput) { delete(output.Values, "host") },
+			expectedErr: "the recipe outputs do not satisfy the output contract of the recipe: missing value \"host\"",
+		},
+		{
+			desc:        "null value",
+			contract:    contract,
+			output:      func(output *RecipeOutput) { output.Values["labels"] = nil },
+			expectedErr: "the recipe outputs do not satisfy the output contract of the recipe: missing value \"labels\"",
+		},
+		{
+			desc:     "malformed values",
+			contract: contract,
+			output: func(output *RecipeOutput) {
+				output.Values["port"] = 6379.5
+				output.Values["tls"] = "true"
+			},
+			expectedErr: "the recipe outputs do not satisfy the output contract of the recipe: value \"port\" must be of type integer, got number; value \"tls\" must be of type boolean, got string",
+		},
+		{
+			desc:     "missing and malformed secrets",
+			contract: &OutputContract{Secrets: []string{"connectionString", "password", "token"}},
+			output: func(output *RecipeOutput) {
+				output.Secrets["password"] = ""
+				output.Secrets["token"] = float64(1)
+			},
+			expectedErr: "the recipe outputs do not satisfy the output contract of the recipe: missing secret \"password\"; secret \"token\" must be of type string, got integer",
+		},
+		{
+			desc:        "missing resources",
+			contract:    contract,
+			output:      func(output *RecipeOutput) { output.Resources = []string{} },
+			expectedErr: "the recipe outputs do not satisfy the output contract of the recipe: missing resource IDs",
+		},
+		{
+			desc:        "invalid resource ID",
+			contract:    contract,
+			output:      func(output *RecipeOutput) { output.Resources = append(output.Resources, "redis") },
+			expectedErr: "the recipe outputs do not satisfy the output contract of the recipe: invalid resource ID \"redis\"",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			output := validOutput()
+			tt.output(output)
+
+			err := tt.contract.Validate(output)
+			if tt.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
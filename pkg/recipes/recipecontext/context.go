@@ -60,6 +60,7 @@ func New(metadata *recipes.ResourceMetadata, config *recipes.Configuration) (*Co
 				EnvironmentNamespace: config.Runtime.Kubernetes.EnvironmentNamespace,
 			},
 		},
+		Action: metadata.Action,
 	}
 
 	if metadata.ApplicationID != "" {
@@ -181,6 +181,48 @@ func TestNewContext(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "action",
+			metadata: &recipes.ResourceMetadata{
+				ResourceID:    testMetadata.ResourceID,
+				EnvironmentID: testMetadata.EnvironmentID,
+				ApplicationID: testMetadata.ApplicationID,
+				Action:        "rotateCredentials",
+			},
+			providers: &recipes.Configuration{
+				Runtime: recipes.RuntimeConfiguration{
+					Kubernetes: &recipes.KubernetesRuntime{
+						Namespace:            "radius-test-app",
+						EnvironmentNamespace: "radius-test-env",
+					},
+				},
+				Providers: coredm.Providers{},
+			},
+			out: &Context{
+				Resource: Resource{
+					ResourceInfo: ResourceInfo{
+						ID:   "/planes/radius/local/resourceGroups/testGroup/providers/applications.datastores/mongodatabases/mongo0",
+						Name: "mongo0",
+					},
+					Type: "applications.datastores/mongodatabases",
+				},
+				Application: ResourceInfo{
+					Name: "testApplication",
+					ID:   "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/testApplication",
+				},
+				Environment: ResourceInfo{
+					Name: "env0",
+					ID:   "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/env0",
+				},
+				Runtime: recipes.RuntimeConfiguration{
+					Kubernetes: &recipes.KubernetesRuntime{
+						Namespace:            "radius-test-app",
+						EnvironmentNamespace: "radius-test-env",
+					},
+				},
+				Action: "rotateCredentials",
+			},
+		},
 	}
 
 	for _, tc := range ctxTests {
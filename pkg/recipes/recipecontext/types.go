@@ -41,6 +41,8 @@ type Context struct {
 	Azure *ProviderAzure `json:"azure,omitempty"`
 	// AWS represents AWS provider scope.
 	AWS *ProviderAWS `json:"aws,omitempty"`
+	// Action represents the name of the custom action the recipe is handling. It is empty when the recipe deploys the resource.
+	Action string `json:"action,omitempty"`
 }
 
 // Resource contains the information needed to deploy a recipe.
@@ -0,0 +1,230 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipelogs
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultMaxSize is the default limit of the total size in bytes of the messages kept by a recorder. The logs of
+	// several operations are persisted in a single document, so the limit keeps the document well below the size
+	// limits of the databases.
+	DefaultMaxSize = 256 * 1024
+
+	// maxMessageLength is the maximum length of a single message. Longer messages are truncated.
+	maxMessageLength = 4096
+
+	// truncatedSuffix is appended to the messages that were truncated.
+	truncatedSuffix = "...(truncated)"
+)
+
+type contextKey struct{}
+
+// WithRecorder returns a new context that carries the recorder. Drivers record the output of the recipe execution
+// to the recorder of the context.
+func WithRecorder(ctx context.Context, recorder *Recorder) context.Context {
+	return context.WithValue(ctx, contextKey{}, recorder)
+}
+
+// FromContext returns the recorder carried by the context, or nil if there is none. All the methods of a nil
+// recorder are no-ops, so the result can be used without checking for nil.
+func FromContext(ctx context.Context) *Recorder {
+	recorder, _ := ctx.Value(contextKey{}).(*Recorder)
+	return recorder
+}
+
+// Recorder captures the logs of a recipe execution. It keeps the most recent entries up to a total size, since the
+// cause of a failure is usually at the end of the logs. It is safe for concurrent use.
+type Recorder struct {
+	mu        sync.Mutex
+	maxSize   int
+	size      int
+	truncated bool
+	startTime time.Time
+	entries   []Entry
+	writers   []*lineWriter
+}
+
+// NewRecorder creates a new Recorder that keeps entries up to the given total size in bytes.
+func NewRecorder(maxSize int) *Recorder {
+	return &Recorder{
+		maxSize:   maxSize,
+		startTime: time.Now().UTC(),
+	}
+}
+
+// StartTime returns the time the recorder was created.
+func (r *Recorder) StartTime() time.Time {
+	if r == nil {
+		return time.Time{}
+	}
+
+	return r.startTime
+}
+
+// Record records a message from the given source. Multi-line messages are recorded as one entry per line, and empty
+// lines are dropped.
+func (r *Recorder) Record(source string, message string) {
+	if r == nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, line := range strings.Split(message, "\n") {
+		r.appendLocked(source, line)
+	}
+}
+
+// Recordf formats a message and records it from the given source.
+func (r *Recorder) Recordf(source string, format string, args ...any) {
+	if r == nil {
+		return
+	}
+
+	r.Record(source, fmt.Sprintf(format, args...))
+}
+
+// Writer returns a writer that records each line written to it from the given source.
+func (r *Recorder) Writer(source string) io.Writer {
+	return r.FilteredWriter(source, nil)
+}
+
+// FilteredWriter returns a writer that records each line written to it from the given source, if keep returns true
+// for the line. Lines are recorded when they are complete, or when the entries of the recorder are read.
+func (r *Recorder) FilteredWriter(source string, keep func(line string) bool) io.Writer {
+	if r == nil {
+		return io.Discard
+	}
+
+	w := &lineWriter{recorder: r, source: source, keep: keep}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.writers = append(r.writers, w)
+
+	return w
+}
+
+// Entries returns a copy of the recorded entries, and whether older entries were dropped to stay within the size
+// limit of the recorder.
+func (r *Recorder) Entries() ([]Entry, bool) {
+	if r == nil {
+		return nil, false
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, w := range r.writers {
+		w.flushLocked()
+	}
+
+	entries := make([]Entry, len(r.entries))
+	copy(entries, r.entries)
+	return entries, r.truncated
+}
+
+// appendLocked appends an entry and drops the oldest entries if the size limit is exceeded. The caller must hold
+// the lock of the recorder.
+func (r *Recorder) appendLocked(source string, message string) {
+	message = strings.TrimRight(message, "\r")
+	if strings.TrimSpace(message) == "" {
+		return
+	}
+
+	if len(message) > maxMessageLength {
+		message = message[:maxMessageLength] + truncatedSuffix
+	}
+
+	r.entries = append(r.entries, Entry{
+		Timestamp: time.Now().UTC(),
+		Source:    source,
+		Message:   message,
+	})
+	r.size += len(message)
+
+	dropped := 0
+	for r.size > r.maxSize && dropped < len(r.entries) {
+		r.size -= len(r.entries[dropped].Message)
+		dropped++
+	}
+
+	if dropped > 0 {
+		r.entries = r.entries[dropped:]
+		r.truncated = true
+	}
+}
+
+// lineWriter is an io.Writer that splits the written bytes into lines and records them.
+type lineWriter struct {
+	recorder *Recorder
+	source   string
+	keep     func(line string) bool
+	partial  []byte
+}
+
+// Write implements the io.Writer interface to record the complete lines written. The last line is buffered until
+// it is complete.
+func (w *lineWriter) Write(p []byte) (int, error) {
+	w.recorder.mu.Lock()
+	defer w.recorder.mu.Unlock()
+
+	w.partial = append(w.partial, p...)
+	for {
+		i := bytes.IndexByte(w.partial, '\n')
+		if i < 0 {
+			break
+		}
+
+		w.recordLocked(string(w.partial[:i]))
+		w.partial = w.partial[i+1:]
+	}
+
+	// Lines longer than the maximum length of a message are truncated anyway, so there is no need to buffer more.
+	if len(w.partial) > maxMessageLength {
+		w.flushLocked()
+	}
+
+	return len(p), nil
+}
+
+// flushLocked records the buffered partial line. The caller must hold the lock of the recorder.
+func (w *lineWriter) flushLocked() {
+	if len(w.partial) == 0 {
+		return
+	}
+
+	w.recordLocked(string(w.partial))
+	w.partial = nil
+}
+
+func (w *lineWriter) recordLocked(line string) {
+	if w.keep != nil && !w.keep(line) {
+		return
+	}
+
+	w.recorder.appendLocked(w.source, line)
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipelogs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// messages returns the source and message of the entries, ignoring the timestamps.
+func messages(entries []Entry) []string {
+	result := []string{}
+	for _, entry := range entries {
+		result = append(result, entry.Source+": "+entry.Message)
+	}
+	return result
+}
+
+func Test_Recorder_Record(t *testing.T) {
+	recorder := NewRecorder(DefaultMaxSize)
+	recorder.Record(SourceEngine, "first line\n\nsecond line\r\n")
+	recorder.Recordf(SourceDeployment, "resource %q %s", "redis", "Succeeded")
+
+	entries, truncated := recorder.Entries()
+	require.False(t, truncated)
+	require.Equal(t, []string{
+		"engine: first line",
+		"engine: second line",
+		`deployment: resource "redis" Succeeded`,
+	}, messages(entries))
+}
+
+func Test_Recorder_Writer(t *testing.T) {
+	recorder := NewRecorder(DefaultMaxSize)
+	stdout := recorder.Writer(SourceStdout)
+	stderr := recorder.FilteredWriter(SourceStderr, func(line string) bool {
+		return !strings.HasPrefix(line, "[TRACE]")
+	})
+
+	_, _ = fmt.Fprint(stdout, "Initializing the backend...\nInitializing provider")
+	_, _ = fmt.Fprint(stderr, "[TRACE] provider started\nError: invalid value\n")
+	_, _ = fmt.Fprint(stdout, " plugins...\nApply complete!")
+
+	entries, _ := recorder.Entries()
+	require.Equal(t, []string{
+		"stdout: Initializing the backend...",
+		"stderr: Error: invalid value",
+		"stdout: Initializing provider plugins...",
+		"stdout: Apply complete!",
+	}, messages(entries))
+}
+
+func Test_Recorder_Bounded(t *testing.T) {
+	recorder := NewRecorder(10)
+	recorder.Record(SourceEngine, "12345")
+	recorder.Record(SourceEngine, "67890")
+
+	entries, truncated := recorder.Entries()
+	require.False(t, truncated)
+	require.Len(t, entries, 2)
+
+	recorder.Record(SourceEngine, "abc")
+	entries, truncated = recorder.Entries()
+	require.True(t, truncated)
+	require.Equal(t, []string{"engine: 67890", "engine: abc"}, messages(entries))
+
+	long := NewRecorder(DefaultMaxSize)
+	long.Record(SourceEngine, strings.Repeat("a", maxMessageLength+10))
+	entries, _ = long.Entries()
+	require.Equal(t, strings.Repeat("a", maxMessageLength)+truncatedSuffix, entries[0].Message)
+}
+
+func Test_Recorder_Nil(t *testing.T) {
+	recorder := FromContext(context.Background())
+	require.Nil(t, recorder)
+
+	recorder.Record(SourceEngine, "ignored")
+	_, err := recorder.Writer(SourceStdout).Write([]byte("ignored\n"))
+	require.NoError(t, err)
+
+	entries, truncated := recorder.Entries()
+	require.Empty(t, entries)
+	require.False(t, truncated)
+}
+
+func Test_WithRecorder(t *testing.T) {
+	recorder := NewRecorder(DefaultMaxSize)
+	ctx := WithRecorder(context.Background(), recorder)
+	require.Same(t, recorder, FromContext(ctx))
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipelogs
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/components/database"
+)
+
+const (
+	// DefaultMaxOperations is the default number of the most recent operations of a resource whose logs are kept.
+	DefaultMaxOperations = 3
+
+	// logsTypeSegment is the child resource type under which the logs of a resource are stored.
+	logsTypeSegment = "recipeLogs"
+
+	// logsName is the name of the child resource holding the logs of a resource.
+	logsName = "default"
+)
+
+// ID returns the ID of the database entry holding the recipe logs of the resource.
+func ID(resourceID string) string {
+	return strings.TrimSuffix(resourceID, "/") + "/" + logsTypeSegment + "/" + logsName
+}
+
+// Store persists the recipe logs of resources in the database. The logs of each resource are stored in a single
+// entry, keyed by operation ID, and only the logs of the most recent operations are kept.
+type Store struct {
+	client        database.Client
+	maxOperations int
+}
+
+// NewStore creates a new Store that persists the recipe logs using the database client.
+func NewStore(client database.Client) *Store {
+	return &Store{client: client, maxOperations: DefaultMaxOperations}
+}
+
+// Get returns the recipe logs of the resource. It returns empty logs if no recipe was executed for the resource.
+func (s *Store) Get(ctx context.Context, resourceID string) (*ResourceLogs, error) {
+	logs, _, err := s.get(ctx, resourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return logs, nil
+}
+
+// Save saves the log of an operation on the resource in the environment. The log replaces the log of the same
+// operation if it was already saved, e.g. when the operation is retried, and the logs of the oldest operations are
+// dropped. The logs of operations in another environment are dropped as well.
+func (s *Store) Save(ctx context.Context, resourceID string, environmentID string, log OperationLog) error {
+	logs, etag, err := s.get(ctx, resourceID)
+	if err != nil {
+		return err
+	}
+
+	operations := []OperationLog{}
+	for _, operation := range logs.Operations {
+		if strings.EqualFold(logs.EnvironmentID, environmentID) && !strings.EqualFold(operation.OperationID, log.OperationID) {
+			operations = append(operations, operation)
+		}
+	}
+	operations = append(operations, log)
+
+	if len(operations) > s.maxOperations {
+		operations = operations[len(operations)-s.maxOperations:]
+	}
+	logs.EnvironmentID = environmentID
+	logs.Operations = operations
+
+	obj := &database.Object{
+		Metadata: database.Metadata{ID: ID(resourceID)},
+		Data:     logs,
+	}
+
+	if etag == "" {
+		return s.client.Save(ctx, obj)
+	}
+
+	return s.client.Save(ctx, obj, database.WithETag(etag))
+}
+
+// SaveRecorded saves the entries captured by the recorder as the log of the asynchronous operation of the request
+// context, executed in the environment. The status of the operation is derived from the error returned by the recipe engine. Nothing is saved if
+// nothing was recorded, e.g. when the resource does not use a recipe.
+func (s *Store) SaveRecorded(ctx context.Context, resourceID string, environmentID string, recipeName string, recorder *Recorder, recipeErr error) error {
+	entries, truncated := recorder.Entries()
+	if len(entries) == 0 {
+		return nil
+	}
+
+	log := OperationLog{
+		RecipeName: recipeName,
+		Status:     StatusSucceeded,
+		StartTime:  recorder.StartTime(),
+		EndTime:    time.Now().UTC(),
+		Truncated:  truncated,
+		Entries:    entries,
+	}
+	if recipeErr != nil {
+		log.Status = StatusFailed
+	}
+
+	if serviceCtx := v1.ARMRequestContextFromContext(ctx); serviceCtx != nil {
+		log.OperationID = serviceCtx.OperationID.String()
+		log.OperationType = serviceCtx.OperationType.String()
+	}
+
+	return s.Save(ctx, resourceID, environmentID, log)
+}
+
+// Delete deletes the recipe logs of the resource. It does not return an error if there are no logs.
+func (s *Store) Delete(ctx context.Context, resourceID string) error {
+	err := s.client.Delete(ctx, ID(resourceID))
+	if errors.Is(err, &database.ErrNotFound{}) {
+		return nil
+	}
+
+	return err
+}
+
+func (s *Store) get(ctx context.Context, resourceID string) (*ResourceLogs, string, error) {
+	obj, err := s.client.Get(ctx, ID(resourceID))
+	if errors.Is(err, &database.ErrNotFound{}) {
+		return &ResourceLogs{ResourceID: resourceID, Operations: []OperationLog{}}, "", nil
+	} else if err != nil {
+		return nil, "", err
+	}
+
+	logs := &ResourceLogs{}
+	if err := obj.As(logs); err != nil {
+		return nil, "", err
+	}
+
+	return logs, obj.ETag, nil
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipelogs
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testResourceID    = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/redis"
+	testEnvironmentID = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/environments/env"
+)
+
+func Test_ID(t *testing.T) {
+	require.Equal(t, testResourceID+"/recipeLogs/default", ID(testResourceID))
+}
+
+func Test_Store_SaveAndGet(t *testing.T) {
+	ctx := testcontext.New(t)
+	store := NewStore(inmemory.NewClient())
+
+	logs, err := store.Get(ctx, testResourceID)
+	require.NoError(t, err)
+	require.Equal(t, &ResourceLogs{ResourceID: testResourceID, Operations: []OperationLog{}}, logs)
+
+	for _, operationID := range []string{"op-1", "op-2", "op-3", "op-2", "op-4"} {
+		err = store.Save(ctx, testResourceID, testEnvironmentID, OperationLog{
+			OperationID: operationID,
+			Status:      StatusSucceeded,
+			Entries:     []Entry{{Source: SourceEngine, Message: operationID}},
+		})
+		require.NoError(t, err)
+	}
+
+	logs, err = store.Get(ctx, testResourceID)
+	require.NoError(t, err)
+	require.Equal(t, testResourceID, logs.ResourceID)
+	require.Equal(t, testEnvironmentID, logs.EnvironmentID)
+
+	operationIDs := []string{}
+	for _, operation := range logs.Operations {
+		operationIDs = append(operationIDs, operation.OperationID)
+	}
+	require.Equal(t, []string{"op-3", "op-2", "op-4"}, operationIDs)
+
+	// The logs of the operations in the previous environment are dropped when the resource moves to another one.
+	otherEnvironmentID := "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/environments/other"
+	err = store.Save(ctx, testResourceID, otherEnvironmentID, OperationLog{OperationID: "op-5", Status: StatusSucceeded})
+	require.NoError(t, err)
+
+	logs, err = store.Get(ctx, testResourceID)
+	require.NoError(t, err)
+	require.Equal(t, otherEnvironmentID, logs.EnvironmentID)
+	require.Len(t, logs.Operations, 1)
+	require.Equal(t, "op-5", logs.Operations[0].OperationID)
+}
+
+func Test_Store_SaveRecorded(t *testing.T) {
+	ctx := testcontext.New(t)
+	store := NewStore(inmemory.NewClient())
+
+	t.Run("nothing recorded", func(t *testing.T) {
+		err := store.SaveRecorded(ctx, testResourceID, testEnvironmentID, "default", NewRecorder(DefaultMaxSize), nil)
+		require.NoError(t, err)
+
+		logs, err := store.Get(ctx, testResourceID)
+		require.NoError(t, err)
+		require.Empty(t, logs.Operations)
+	})
+
+	t.Run("failed operation", func(t *testing.T) {
+		operationID := uuid.New()
+		operationType := v1.OperationType{Type: "Applications.Datastores/redisCaches", Method: v1.OperationPut}
+		ctx := v1.WithARMRequestContext(ctx, &v1.ARMRequestContext{OperationID: operationID, OperationType: operationType})
+
+		recorder := NewRecorder(DefaultMaxSize)
+		recorder.Record(SourceStderr, "Error: invalid value")
+
+		err := store.SaveRecorded(ctx, testResourceID, testEnvironmentID, "default", recorder, errors.New("failed"))
+		require.NoError(t, err)
+
+		logs, err := store.Get(ctx, testResourceID)
+		require.NoError(t, err)
+		require.Equal(t, testEnvironmentID, logs.EnvironmentID)
+		require.Len(t, logs.Operations, 1)
+
+		log := logs.Operations[0]
+		require.Equal(t, operationID.String(), log.OperationID)
+		require.Equal(t, operationType.String(), log.OperationType)
+		require.Equal(t, "default", log.RecipeName)
+		require.Equal(t, StatusFailed, log.Status)
+		require.False(t, log.EndTime.Before(log.StartTime))
+		require.Equal(t, []string{"stderr: Error: invalid value"}, messages(log.Entries))
+	})
+}
+
+func Test_Store_Delete(t *testing.T) {
+	ctx := testcontext.New(t)
+	client := inmemory.NewClient()
+	store := NewStore(client)
+
+	require.NoError(t, store.Delete(ctx, testResourceID))
+
+	require.NoError(t, store.Save(ctx, testResourceID, testEnvironmentID, OperationLog{OperationID: "op-1"}))
+	require.NoError(t, store.Delete(ctx, testResourceID))
+
+	_, err := client.Get(ctx, ID(testResourceID))
+	require.True(t, errors.Is(err, &database.ErrNotFound{}))
+}
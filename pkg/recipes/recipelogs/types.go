@@ -0,0 +1,96 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipelogs
+
+import "time"
+
+const (
+	// SourceEngine is the source of the log entries written by the recipe engine.
+	SourceEngine = "engine"
+
+	// SourceStdout is the source of the log entries read from the standard output of the recipe tooling.
+	SourceStdout = "stdout"
+
+	// SourceStderr is the source of the log entries read from the standard error of the recipe tooling.
+	SourceStderr = "stderr"
+
+	// SourceDeployment is the source of the log entries describing the operations of a Bicep deployment.
+	SourceDeployment = "deployment"
+
+	// SourceHelm is the source of the log entries written by the Helm actions.
+	SourceHelm = "helm"
+)
+
+const (
+	// StatusSucceeded is the status of a recipe operation that completed successfully.
+	StatusSucceeded = "Succeeded"
+
+	// StatusFailed is the status of a recipe operation that failed.
+	StatusFailed = "Failed"
+)
+
+// Entry is a single line of the logs of a recipe operation.
+type Entry struct {
+	// Timestamp is the time the entry was recorded.
+	Timestamp time.Time `json:"timestamp"`
+
+	// Source is the source of the entry, e.g. "stdout" for the standard output of Terraform.
+	Source string `json:"source"`
+
+	// Message is the content of the entry.
+	Message string `json:"message"`
+}
+
+// OperationLog is the log of the recipe execution for a single operation on a resource.
+type OperationLog struct {
+	// OperationID is the ID of the asynchronous operation that executed the recipe.
+	OperationID string `json:"operationId"`
+
+	// OperationType is the type of the operation that executed the recipe, e.g. "APPLICATIONS.DATASTORES/REDISCACHES|PUT".
+	OperationType string `json:"operationType,omitempty"`
+
+	// RecipeName is the name of the recipe that was executed.
+	RecipeName string `json:"recipeName,omitempty"`
+
+	// Status is the status of the recipe execution, either "Succeeded" or "Failed".
+	Status string `json:"status"`
+
+	// StartTime is the time the recipe execution started.
+	StartTime time.Time `json:"startTime"`
+
+	// EndTime is the time the recipe execution completed.
+	EndTime time.Time `json:"endTime"`
+
+	// Truncated is true if the oldest entries were dropped because the log exceeded its size limit.
+	Truncated bool `json:"truncated,omitempty"`
+
+	// Entries is the list of log entries, oldest first.
+	Entries []Entry `json:"entries"`
+}
+
+// ResourceLogs is the persisted document holding the logs of the most recent recipe operations of a resource.
+type ResourceLogs struct {
+	// ResourceID is the ID of the resource the recipe was executed for.
+	ResourceID string `json:"resourceId"`
+
+	// EnvironmentID is the ID of the environment the recipe was executed in. The logs are only returned for this
+	// environment, even after the resource was deleted.
+	EnvironmentID string `json:"environmentId"`
+
+	// Operations is the list of the logs of the most recent operations, oldest first.
+	Operations []OperationLog `json:"operations"`
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipepack
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// Pack is a recipe pack registered to an environment.
+type Pack[T any] struct {
+	// ID is the resource ID of the recipe pack.
+	ID string
+
+	// Recipes are the recipes of the pack, keyed by resource type and recipe name.
+	Recipes map[string]map[string]T
+}
+
+// ConflictError is returned when a recipe is defined by several recipe packs registered to an environment, and the
+// environment does not define the recipe itself.
+type ConflictError struct {
+	// ResourceType is the resource type of the recipe.
+	ResourceType string
+
+	// RecipeName is the name of the recipe.
+	RecipeName string
+
+	// RecipePacks are the IDs of the recipe packs defining the recipe.
+	RecipePacks []string
+}
+
+// Error returns the error message of the conflict.
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("recipe %q for resource type %q is defined by multiple recipe packs: %s. Remove the recipe from all but one of the recipe packs, or define the recipe in the environment to override the recipe packs", e.RecipeName, e.ResourceType, strings.Join(e.RecipePacks, ", "))
+}
+
+// Resolve returns the recipes registered to an environment, keyed by resource type and recipe name: the recipes
+// defined in the environment and the recipes of its recipe packs. The following rules apply to recipes defined more
+// than once:
+//   - A recipe defined in the environment takes precedence over the recipes of the packs with the same resource type
+//     and name, which allows an environment to override a recipe of a pack.
+//   - A recipe defined by several packs and not by the environment is a conflict, and a *ConflictError is returned.
+//
+// The recipes of the environment and of the packs are not modified.
+func Resolve[T any](recipes map[string]map[string]T, packs []Pack[T]) (map[string]map[string]T, error) {
+	resolved := map[string]map[string]T{}
+	for resourceType, resourceTypeRecipes := range recipes {
+		resolved[resourceType] = maps.Clone(resourceTypeRecipes)
+	}
+
+	// sources tracks the pack each recipe is resolved from, to report conflicts.
+	sources := map[string]map[string]string{}
+	for _, pack := range packs {
+		for _, resourceType := range slices.Sorted(maps.Keys(pack.Recipes)) {
+			for _, recipeName := range slices.Sorted(maps.Keys(pack.Recipes[resourceType])) {
+				if _, ok := recipes[resourceType][recipeName]; ok {
+					continue
+				}
+
+				if source, ok := sources[resourceType][recipeName]; ok {
+					// A pack registered more than once does not conflict with itself.
+					if strings.EqualFold(source, pack.ID) {
+						continue
+					}
+
+					return nil, &ConflictError{
+						ResourceType: resourceType,
+						RecipeName:   recipeName,
+						RecipePacks:  []string{source, pack.ID},
+					}
+				}
+
+				if resolved[resourceType] == nil {
+					resolved[resourceType] = map[string]T{}
+				}
+				if sources[resourceType] == nil {
+					sources[resourceType] = map[string]string{}
+				}
+				resolved[resourceType][recipeName] = pack.Recipes[resourceType][recipeName]
+				sources[resourceType][recipeName] = pack.ID
+			}
+		}
+	}
+
+	return resolved, nil
+}
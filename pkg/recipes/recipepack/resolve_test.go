@@ -0,0 +1,114 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipepack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	corePackID = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/recipePacks/core"
+	teamPackID = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/recipePacks/team"
+	redisType  = "Applications.Datastores/redisCaches"
+	sqlType    = "Applications.Datastores/sqlDatabases"
+)
+
+func Test_Resolve(t *testing.T) {
+	tests := []struct {
+		desc     string
+		recipes  map[string]map[string]string
+		packs    []Pack[string]
+		expected map[string]map[string]string
+		err      error
+	}{
+		{
+			desc:     "no packs",
+			recipes:  map[string]map[string]string{redisType: {"default": "env"}},
+			expected: map[string]map[string]string{redisType: {"default": "env"}},
+		},
+		{
+			desc: "packs are merged with the environment recipes",
+			recipes: map[string]map[string]string{
+				redisType: {"default": "env"},
+			},
+			packs: []Pack[string]{
+				{ID: corePackID, Recipes: map[string]map[string]string{redisType: {"small": "core"}, sqlType: {"default": "core"}}},
+				{ID: teamPackID, Recipes: map[string]map[string]string{redisType: {"large": "team"}}},
+			},
+			expected: map[string]map[string]string{
+				redisType: {"default": "env", "small": "core", "large": "team"},
+				sqlType:   {"default": "core"},
+			},
+		},
+		{
+			desc:    "environment recipes override pack recipes",
+			recipes: map[string]map[string]string{redisType: {"default": "env"}},
+			packs: []Pack[string]{
+				{ID: corePackID, Recipes: map[string]map[string]string{redisType: {"default": "core"}}},
+				{ID: teamPackID, Recipes: map[string]map[string]string{redisType: {"default": "team"}}},
+			},
+			expected: map[string]map[string]string{redisType: {"default": "env"}},
+		},
+		{
+			desc: "conflicting packs",
+			packs: []Pack[string]{
+				{ID: corePackID, Recipes: map[string]map[string]string{redisType: {"default": "core"}}},
+				{ID: teamPackID, Recipes: map[string]map[string]string{redisType: {"default": "team"}}},
+			},
+			err: &ConflictError{ResourceType: redisType, RecipeName: "default", RecipePacks: []string{corePackID, teamPackID}},
+		},
+		{
+			desc: "pack registered twice",
+			packs: []Pack[string]{
+				{ID: corePackID, Recipes: map[string]map[string]string{redisType: {"default": "core"}}},
+				{ID: corePackID, Recipes: map[string]map[string]string{redisType: {"default": "core"}}},
+			},
+			expected: map[string]map[string]string{redisType: {"default": "core"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			resolved, err := Resolve(tt.recipes, tt.packs)
+			if tt.err != nil {
+				require.Equal(t, tt.err, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, resolved)
+		})
+	}
+}
+
+func Test_Resolve_DoesNotModifyRecipes(t *testing.T) {
+	recipes := map[string]map[string]string{redisType: {"default": "env"}}
+	packs := []Pack[string]{
+		{ID: corePackID, Recipes: map[string]map[string]string{redisType: {"small": "core"}}},
+	}
+
+	_, err := Resolve(recipes, packs)
+	require.NoError(t, err)
+	require.Equal(t, map[string]map[string]string{redisType: {"default": "env"}}, recipes)
+}
+
+func Test_ConflictError(t *testing.T) {
+	err := &ConflictError{ResourceType: redisType, RecipeName: "default", RecipePacks: []string{corePackID, teamPackID}}
+	require.Contains(t, err.Error(), `recipe "default" for resource type "Applications.Datastores/redisCaches" is defined by multiple recipe packs`)
+}
@@ -0,0 +1,290 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipeparameters
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/recipecontext"
+	"github.com/radius-project/radius/pkg/recipes/util"
+)
+
+const (
+	// parametersKey is the key of the parameter definitions in the recipe metadata returned by the drivers.
+	parametersKey = "parameters"
+)
+
+// Validate validates the operator and developer parameters of a recipe against the parameters defined by the recipe
+// template, read from the recipe metadata of the driver. It checks for unknown parameters, missing required parameters,
+// type mismatches and values that are not allowed, and returns an InvalidRecipeParameters error describing each problem
+// found. The parameters of drivers whose metadata does not describe the parameters, e.g. Helm, are not validated.
+func Validate(metadata map[string]any, definition *recipes.EnvironmentDefinition, recipe *recipes.ResourceMetadata) error {
+	definitions, ok := parameterDefinitions(definition.Driver, metadata)
+	if !ok {
+		return nil
+	}
+
+	problems := validateParameters(definition.Driver, definitions, definition.Parameters, recipe.Parameters)
+	if len(problems) > 0 {
+		return recipes.NewRecipeError(recipes.InvalidRecipeParameters, fmt.Sprintf("invalid parameters for recipe %q of type %q: %s", recipe.Name, definition.ResourceType, strings.Join(problems, "; ")), util.RecipeSetupError)
+	}
+
+	return nil
+}
+
+// parameterDefinition is the definition of a parameter of a recipe template, read from the recipe metadata.
+type parameterDefinition struct {
+	// Type is the type of the parameter as declared in the template, e.g. "int" for Bicep or "list(string)" for Terraform.
+	Type string
+
+	// Required is true if the parameter must be set because the template has no default value for it.
+	Required bool
+
+	// AllowedValues is the list of the values allowed for the parameter. Any value is allowed if it is empty.
+	AllowedValues []any
+}
+
+// parameterDefinitions reads the parameter definitions from the recipe metadata returned by the driver. It returns
+// false if the parameters of the recipe cannot be validated: Helm charts accept values that are not declared in
+// their default values, so the parameters of Helm recipes are not validated.
+func parameterDefinitions(driver string, metadata map[string]any) (map[string]parameterDefinition, bool) {
+	parameters, ok := metadata[parametersKey].(map[string]any)
+	if !ok {
+		return nil, false
+	}
+
+	definitions := map[string]parameterDefinition{}
+	for name, value := range parameters {
+		parameter, ok := value.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		switch driver {
+		case recipes.TemplateKindBicep:
+			// Parameters without a default value are required unless they are nullable.
+			_, hasDefault := parameter["defaultValue"]
+			nullable, _ := parameter["nullable"].(bool)
+			allowedValues, _ := parameter["allowedValues"].([]any)
+			typ, _ := parameter["type"].(string)
+			definitions[name] = parameterDefinition{
+				Type:          strings.ToLower(typ),
+				Required:      !hasDefault && !nullable,
+				AllowedValues: allowedValues,
+			}
+		case recipes.TemplateKindTerraform:
+			required, _ := parameter["required"].(bool)
+			typ, _ := parameter["type"].(string)
+			definitions[name] = parameterDefinition{
+				Type:     strings.ReplaceAll(typ, " ", ""),
+				Required: required,
+			}
+		default:
+			return nil, false
+		}
+	}
+
+	return definitions, true
+}
+
+// validateParameters validates the operator and developer parameters of a recipe against the parameters defined by
+// the recipe template. It checks for unknown parameters, missing required parameters, type mismatches and values
+// that are not allowed, and returns a description of each problem found.
+func validateParameters(driver string, definitions map[string]parameterDefinition, operatorParameters map[string]any, developerParameters map[string]any) []string {
+	problems := []string{}
+
+	for _, name := range slices.Sorted(maps.Keys(operatorParameters)) {
+		if _, ok := definitions[name]; !ok && name != recipecontext.RecipeContextParamKey {
+			problems = append(problems, fmt.Sprintf("parameter %q set by the environment is not defined by the recipe", name))
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(developerParameters)) {
+		if _, ok := definitions[name]; !ok && name != recipecontext.RecipeContextParamKey {
+			problems = append(problems, fmt.Sprintf("parameter %q is not defined by the recipe", name))
+		}
+	}
+
+	// Developer parameters override the operator parameters of the same name.
+	parameters := map[string]any{}
+	maps.Copy(parameters, operatorParameters)
+	maps.Copy(parameters, developerParameters)
+
+	for _, name := range slices.Sorted(maps.Keys(definitions)) {
+		// The recipe context is always set by Radius.
+		if name == recipecontext.RecipeContextParamKey {
+			continue
+		}
+
+		definition := definitions[name]
+		value, ok := parameters[name]
+		if !ok {
+			if definition.Required {
+				problems = append(problems, fmt.Sprintf("required parameter %q is not set", name))
+			}
+			continue
+		}
+
+		if value == nil {
+			continue
+		}
+
+		if !matchesType(driver, definition.Type, value) {
+			problems = append(problems, fmt.Sprintf("parameter %q must be of type %s, got %s", name, definition.Type, valueKind(value)))
+			continue
+		}
+
+		if len(definition.AllowedValues) > 0 && !slices.ContainsFunc(definition.AllowedValues, func(allowed any) bool { return equalValues(allowed, value) }) {
+			allowed, _ := json.Marshal(definition.AllowedValues)
+			problems = append(problems, fmt.Sprintf("parameter %q must be one of %s, got %v", name, allowed, value))
+		}
+	}
+
+	return problems
+}
+
+// matchesType returns true if the value can be assigned to a parameter of the given type. Types that are not known
+// match any value.
+func matchesType(driver string, typ string, value any) bool {
+	if driver == recipes.TemplateKindTerraform {
+		return matchesTerraformType(typ, value)
+	}
+
+	switch typ {
+	case "string", "securestring":
+		return reflect.ValueOf(value).Kind() == reflect.String
+	case "int":
+		return isInteger(value)
+	case "bool":
+		return reflect.ValueOf(value).Kind() == reflect.Bool
+	case "object", "secureobject":
+		return reflect.ValueOf(value).Kind() == reflect.Map
+	case "array":
+		return isList(value)
+	default:
+		return true
+	}
+}
+
+// matchesTerraformType returns true if the value can be converted to a Terraform variable of the given type.
+// Terraform converts primitive values, e.g. the string "true" to a bool, so these conversions are allowed. The
+// element types of collections are not validated.
+func matchesTerraformType(typ string, value any) bool {
+	kind := reflect.ValueOf(value).Kind()
+	base, _, _ := strings.Cut(typ, "(")
+
+	switch base {
+	case "string":
+		return kind == reflect.String || kind == reflect.Bool || isNumber(value)
+	case "number":
+		if s, ok := value.(string); ok {
+			_, err := strconv.ParseFloat(s, 64)
+			return err == nil
+		}
+		return isNumber(value)
+	case "bool":
+		if s, ok := value.(string); ok {
+			return s == "true" || s == "false"
+		}
+		return kind == reflect.Bool
+	case "list", "set", "tuple":
+		return isList(value)
+	case "map", "object":
+		return kind == reflect.Map
+	default:
+		return true
+	}
+}
+
+func isNumber(value any) bool {
+	switch reflect.ValueOf(value).Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
+
+func isInteger(value any) bool {
+	if !isNumber(value) {
+		return false
+	}
+
+	f, _ := toFloat(value)
+	return f == math.Trunc(f)
+}
+
+func isList(value any) bool {
+	kind := reflect.ValueOf(value).Kind()
+	return kind == reflect.Slice || kind == reflect.Array
+}
+
+// toFloat converts a number to float64. It returns false if the value is not a number.
+func toFloat(value any) (float64, bool) {
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), true
+	default:
+		return 0, false
+	}
+}
+
+// equalValues compares two parameter values, ignoring the difference between the Go types of numbers.
+func equalValues(a any, b any) bool {
+	if fa, ok := toFloat(a); ok {
+		fb, ok := toFloat(b)
+		return ok && fa == fb
+	}
+
+	return reflect.DeepEqual(a, b)
+}
+
+// valueKind returns the kind of the value in the terms of the recipe parameters.
+func valueKind(value any) string {
+	switch {
+	case isNumber(value):
+		return "number"
+	case isList(value):
+		return "array"
+	}
+
+	switch reflect.ValueOf(value).Kind() {
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "bool"
+	case reflect.Map:
+		return "object"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
@@ -0,0 +1,239 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipeparameters
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/util"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParameterDefinitions(t *testing.T) {
+	t.Run("bicep", func(t *testing.T) {
+		definitions, ok := parameterDefinitions(recipes.TemplateKindBicep, map[string]any{
+			"parameters": map[string]any{
+				"context":  map[string]any{"type": "object"},
+				"location": map[string]any{"type": "string", "defaultValue": "[resourceGroup().location]"},
+				"sku":      map[string]any{"type": "String", "allowedValues": []any{"Basic", "Standard"}},
+				"tags":     map[string]any{"type": "object", "nullable": true},
+			},
+		})
+		require.True(t, ok)
+		require.Equal(t, map[string]parameterDefinition{
+			"context":  {Type: "object", Required: true},
+			"location": {Type: "string"},
+			"sku":      {Type: "string", Required: true, AllowedValues: []any{"Basic", "Standard"}},
+			"tags":     {Type: "object"},
+		}, definitions)
+	})
+
+	t.Run("terraform", func(t *testing.T) {
+		definitions, ok := parameterDefinitions(recipes.TemplateKindTerraform, map[string]any{
+			"parameters": map[string]any{
+				"redis_cache_name": map[string]any{"type": "string", "required": true},
+				"zones":            map[string]any{"type": "list(string)", "required": false, "defaultValue": []any{}},
+				"settings":         map[string]any{"type": "object({ name = string })", "required": false},
+			},
+		})
+		require.True(t, ok)
+		require.Equal(t, map[string]parameterDefinition{
+			"redis_cache_name": {Type: "string", Required: true},
+			"zones":            {Type: "list(string)"},
+			"settings":         {Type: "object({name=string})"},
+		}, definitions)
+	})
+
+	t.Run("helm is not validated", func(t *testing.T) {
+		_, ok := parameterDefinitions(recipes.TemplateKindHelm, map[string]any{
+			"parameters": map[string]any{
+				"replicas": map[string]any{"type": "number", "defaultValue": float64(1)},
+			},
+		})
+		require.False(t, ok)
+	})
+
+	t.Run("no parameters", func(t *testing.T) {
+		_, ok := parameterDefinitions(recipes.TemplateKindBicep, nil)
+		require.False(t, ok)
+	})
+}
+
+func Test_ValidateParameters(t *testing.T) {
+	bicepDefinitions := map[string]parameterDefinition{
+		"context":  {Type: "object", Required: true},
+		"name":     {Type: "string", Required: true},
+		"replicas": {Type: "int"},
+		"enabled":  {Type: "bool"},
+		"tags":     {Type: "object"},
+		"zones":    {Type: "array"},
+		"sku":      {Type: "string", AllowedValues: []any{"Basic", "Standard"}},
+		"size":     {Type: "int", AllowedValues: []any{float64(1), float64(2)}},
+	}
+
+	terraformDefinitions := map[string]parameterDefinition{
+		"context": {Type: "any", Required: true},
+		"name":    {Type: "string", Required: true},
+		"port":    {Type: "number"},
+		"enabled": {Type: "bool"},
+		"zones":   {Type: "list(string)"},
+		"tags":    {Type: "map(string)"},
+		"any":     {Type: ""},
+	}
+
+	tests := []struct {
+		name        string
+		driver      string
+		definitions map[string]parameterDefinition
+		operator    map[string]any
+		developer   map[string]any
+		expected    []string
+	}{
+		{
+			name:        "bicep valid parameters",
+			driver:      recipes.TemplateKindBicep,
+			definitions: bicepDefinitions,
+			operator:    map[string]any{"name": "operator", "sku": "Basic"},
+			developer: map[string]any{
+				"name":     "developer",
+				"replicas": 3,
+				"enabled":  true,
+				"tags":     map[string]any{"team": "radius"},
+				"zones":    []any{"1", "2"},
+				"size":     2,
+			},
+			expected: []string{},
+		},
+		{
+			name:        "bicep invalid parameters",
+			driver:      recipes.TemplateKindBicep,
+			definitions: bicepDefinitions,
+			operator:    map[string]any{"location": "westus"},
+			developer: map[string]any{
+				"nmae":     "typo",
+				"replicas": 1.5,
+				"enabled":  "true",
+				"tags":     []any{"team"},
+				"zones":    "1",
+				"sku":      "Premium",
+				"size":     float64(3),
+			},
+			expected: []string{
+				`parameter "location" set by the environment is not defined by the recipe`,
+				`parameter "nmae" is not defined by the recipe`,
+				`parameter "enabled" must be of type bool, got string`,
+				`required parameter "name" is not set`,
+				`parameter "replicas" must be of type int, got number`,
+				`parameter "size" must be one of [1,2], got 3`,
+				`parameter "sku" must be one of ["Basic","Standard"], got Premium`,
+				`parameter "tags" must be of type object, got array`,
+				`parameter "zones" must be of type array, got string`,
+			},
+		},
+		{
+			name:        "developer parameters override operator parameters",
+			driver:      recipes.TemplateKindBicep,
+			definitions: bicepDefinitions,
+			operator:    map[string]any{"name": 1},
+			developer:   map[string]any{"name": "developer"},
+			expected:    []string{},
+		},
+		{
+			name:        "recipe context is set by Radius",
+			driver:      recipes.TemplateKindBicep,
+			definitions: map[string]parameterDefinition{"name": {Type: "string"}},
+			developer:   map[string]any{"context": map[string]any{}},
+			expected:    []string{},
+		},
+		{
+			name:        "terraform converts primitive values",
+			driver:      recipes.TemplateKindTerraform,
+			definitions: terraformDefinitions,
+			developer: map[string]any{
+				"name":    8080,
+				"port":    "8080",
+				"enabled": "false",
+				"zones":   []string{"1"},
+				"tags":    map[string]string{"team": "radius"},
+				"any":     struct{}{},
+			},
+			expected: []string{},
+		},
+		{
+			name:        "terraform invalid parameters",
+			driver:      recipes.TemplateKindTerraform,
+			definitions: terraformDefinitions,
+			developer: map[string]any{
+				"port":    "http",
+				"enabled": "yes",
+				"zones":   map[string]any{},
+				"tags":    "team=radius",
+			},
+			expected: []string{
+				`parameter "enabled" must be of type bool, got string`,
+				`required parameter "name" is not set`,
+				`parameter "port" must be of type number, got string`,
+				`parameter "tags" must be of type map(string), got string`,
+				`parameter "zones" must be of type list(string), got object`,
+			},
+		},
+		{
+			name:        "null values are allowed",
+			driver:      recipes.TemplateKindTerraform,
+			definitions: terraformDefinitions,
+			developer:   map[string]any{"name": "redis", "port": nil},
+			expected:    []string{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			problems := validateParameters(tc.driver, tc.definitions, tc.operator, tc.developer)
+			require.Equal(t, tc.expected, problems)
+		})
+	}
+}
+
+func Test_Validate(t *testing.T) {
+	metadata := map[string]any{
+		"parameters": map[string]any{
+			"resourceName": map[string]any{"type": "string"},
+		},
+	}
+	definition := &recipes.EnvironmentDefinition{
+		Driver:       recipes.TemplateKindBicep,
+		ResourceType: "Applications.Datastores/mongoDatabases",
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		err := Validate(metadata, definition, &recipes.ResourceMetadata{Name: "mongo-azure", Parameters: map[string]any{"resourceName": "resource1"}})
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		err := Validate(metadata, definition, &recipes.ResourceMetadata{Name: "mongo-azure", Parameters: map[string]any{"resourceNmae": "resource1"}})
+		require.Equal(t, recipes.NewRecipeError(recipes.InvalidRecipeParameters,
+			"invalid parameters for recipe \"mongo-azure\" of type \"Applications.Datastores/mongoDatabases\": parameter \"resourceNmae\" is not defined by the recipe; required parameter \"resourceName\" is not set",
+			util.RecipeSetupError), err)
+	})
+
+	t.Run("parameters are not described", func(t *testing.T) {
+		err := Validate(map[string]any{}, &recipes.EnvironmentDefinition{Driver: recipes.TemplateKindHelm}, &recipes.ResourceMetadata{Parameters: map[string]any{"replicas": 3}})
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,366 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/radius-project/radius/pkg/components/metrics"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/terraform/config"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	// cacheKindPlugins is the kind of cache entries that hold Terraform provider plugins.
+	cacheKindPlugins = "plugins"
+
+	// cacheKindModules is the kind of cache entries that hold downloaded Terraform modules.
+	cacheKindModules = "modules"
+
+	// cacheContentDir is the directory of a cache entry that holds its content.
+	cacheContentDir = "content"
+
+	// cacheCompleteMarker is the file written to a cache entry once its content is complete. Entries without
+	// the marker are discarded when the cache is loaded from disk.
+	cacheCompleteMarker = ".complete"
+
+	// pluginCacheDirEnvVar is the environment variable that configures the Terraform provider plugin cache.
+	pluginCacheDirEnvVar = "TF_PLUGIN_CACHE_DIR"
+
+	// pluginCacheMayBreakLockFileEnvVar allows Terraform to use the plugin cache without a dependency lock file.
+	// Recipe executions never have a dependency lock file, so Terraform would otherwise download every provider
+	// to record its checksums.
+	pluginCacheMayBreakLockFileEnvVar = "TF_PLUGIN_CACHE_MAY_BREAK_DEPENDENCY_LOCK_FILE"
+)
+
+// Cache is a content-addressed cache of Terraform provider plugins and modules that is shared by recipe executions.
+//
+// Plugin entries are addressed by the providers required by a module, and module entries by the source and version
+// of a module. Terraform does not support concurrent use of a plugin cache directory, so executions that populate the
+// same entry are serialized, while executions that use different entries run concurrently. Entries that are not in
+// use are evicted, least recently used first, when the size of the cache exceeds its limit.
+type Cache struct {
+	rootDir      string
+	maxSizeBytes int64
+
+	loadOnce sync.Once
+
+	// mu guards entries and size, and the bookkeeping fields of each entry.
+	mu      sync.Mutex
+	entries map[string]*cacheEntry
+	size    int64
+}
+
+// cacheEntry is an entry of the cache.
+type cacheEntry struct {
+	kind string
+	dir  string
+
+	// lock is held by the execution that populates the entry.
+	lock sync.Mutex
+
+	size      int64
+	lastUsed  time.Time
+	refs      int
+	populated bool
+}
+
+// cacheLease is an entry of the cache in use by a recipe execution. The entry cannot be evicted until the lease
+// is released. A nil lease is valid and does nothing, so callers do not need to check if the cache is enabled.
+type cacheLease struct {
+	cache  *Cache
+	entry  *cacheEntry
+	hit    bool
+	locked bool
+}
+
+// NewCache creates a cache of Terraform provider plugins and modules in the given directory, limited to the given
+// size in bytes. Entries left in the directory by a previous process are reused.
+func NewCache(rootDir string, maxSizeBytes int64) *Cache {
+	return &Cache{
+		rootDir:      rootDir,
+		maxSizeBytes: maxSizeBytes,
+		entries:      map[string]*cacheEntry{},
+	}
+}
+
+// acquire returns a lease on the entry of the given kind addressed by the key. The lease holds the lock of the
+// entry until it is unlocked or released.
+func (c *Cache) acquire(ctx context.Context, kind string, key string) *cacheLease {
+	if c == nil {
+		return nil
+	}
+
+	c.loadOnce.Do(func() { c.load(ctx) })
+
+	hash := sha256.Sum256([]byte(key))
+	id := kind + "/" + hex.EncodeToString(hash[:])
+
+	c.mu.Lock()
+	entry, ok := c.entries[id]
+	if !ok {
+		entry = &cacheEntry{kind: kind, dir: filepath.Join(c.rootDir, id)}
+		c.entries[id] = entry
+	}
+	entry.refs++
+	c.mu.Unlock()
+
+	if err := os.MkdirAll(filepath.Join(entry.dir, cacheContentDir), 0755); err != nil {
+		ucplog.FromContextOrDiscard(ctx).Info(fmt.Sprintf("Failed to create Terraform cache entry %q: %s", entry.dir, err.Error()))
+		c.mu.Lock()
+		entry.refs--
+		c.mu.Unlock()
+		return nil
+	}
+
+	entry.lock.Lock()
+
+	c.mu.Lock()
+	hit := entry.populated
+	c.mu.Unlock()
+
+	result := metrics.CacheMiss
+	if hit {
+		result = metrics.CacheHit
+	}
+	metrics.DefaultRecipeEngineMetrics.RecordTerraformCacheLookup(ctx, []attribute.KeyValue{
+		metrics.TerraformCacheKindAttrKey.String(kind),
+		metrics.TerraformCacheResultAttrKey.String(result),
+	})
+
+	return &cacheLease{cache: c, entry: entry, hit: hit, locked: true}
+}
+
+// Dir returns the directory that holds the content of the leased entry.
+func (l *cacheLease) Dir() string {
+	if l == nil {
+		return ""
+	}
+
+	return filepath.Join(l.entry.dir, cacheContentDir)
+}
+
+// Hit returns true if the leased entry was populated when the lease was acquired.
+func (l *cacheLease) Hit() bool {
+	return l != nil && l.hit
+}
+
+// unlock releases the lock of the leased entry so other executions can use it, while keeping the entry from
+// being evicted. If populated is true, the entry is marked as complete.
+func (l *cacheLease) unlock(ctx context.Context, populated bool) {
+	if l == nil || !l.locked {
+		return
+	}
+
+	logger := ucplog.FromContextOrDiscard(ctx)
+	if populated {
+		if err := os.WriteFile(filepath.Join(l.entry.dir, cacheCompleteMarker), nil, 0644); err != nil {
+			logger.Info(fmt.Sprintf("Failed to mark Terraform cache entry %q as complete: %s", l.entry.dir, err.Error()))
+			populated = false
+		}
+	}
+
+	// The content can change even when it is not populated, e.g. by a failed Terraform init.
+	size, err := dirSize(l.entry.dir)
+	if err != nil {
+		logger.Info(fmt.Sprintf("Failed to compute the size of Terraform cache entry %q: %s", l.entry.dir, err.Error()))
+	}
+
+	l.cache.mu.Lock()
+	delta := size - l.entry.size
+	l.entry.size = size
+	l.entry.populated = l.entry.populated || populated
+	l.cache.size += delta
+	l.cache.mu.Unlock()
+
+	metrics.DefaultRecipeEngineMetrics.RecordTerraformCacheSizeChange(ctx, delta, []attribute.KeyValue{
+		metrics.TerraformCacheKindAttrKey.String(l.entry.kind),
+	})
+
+	l.locked = false
+	l.entry.lock.Unlock()
+}
+
+// release releases the lease, unlocking the entry if needed, and evicts entries if the cache exceeds its limit.
+func (l *cacheLease) release(ctx context.Context, populated bool) {
+	if l == nil {
+		return
+	}
+
+	l.unlock(ctx, populated)
+
+	l.cache.mu.Lock()
+	l.entry.refs--
+	l.entry.lastUsed = time.Now()
+	l.cache.mu.Unlock()
+
+	l.cache.evict(ctx)
+}
+
+// evict removes the least recently used entries that are not in use until the cache is within its size limit.
+func (c *Cache) evict(ctx context.Context) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for c.size > c.maxSizeBytes {
+		var oldestID string
+		var oldest *cacheEntry
+		for id, entry := range c.entries {
+			if entry.refs > 0 {
+				continue
+			}
+			if oldest == nil || entry.lastUsed.Before(oldest.lastUsed) {
+				oldestID, oldest = id, entry
+			}
+		}
+
+		// All remaining entries are in use.
+		if oldest == nil {
+			return
+		}
+
+		logger.Info(fmt.Sprintf("Evicting Terraform cache entry %q of %d bytes", oldest.dir, oldest.size))
+		if err := os.RemoveAll(oldest.dir); err != nil {
+			logger.Info(fmt.Sprintf("Failed to evict Terraform cache entry %q: %s", oldest.dir, err.Error()))
+			return
+		}
+
+		delete(c.entries, oldestID)
+		c.size -= oldest.size
+
+		attrs := []attribute.KeyValue{metrics.TerraformCacheKindAttrKey.String(oldest.kind)}
+		metrics.DefaultRecipeEngineMetrics.RecordTerraformCacheEviction(ctx, attrs)
+		metrics.DefaultRecipeEngineMetrics.RecordTerraformCacheSizeChange(ctx, -oldest.size, attrs)
+	}
+}
+
+// load loads the complete entries left on disk by a previous process and removes incomplete ones.
+func (c *Cache) load(ctx context.Context) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, kind := range []string{cacheKindPlugins, cacheKindModules} {
+		dirEntries, err := os.ReadDir(filepath.Join(c.rootDir, kind))
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		} else if err != nil {
+			logger.Info(fmt.Sprintf("Failed to load Terraform %s cache: %s", kind, err.Error()))
+			continue
+		}
+
+		for _, dirEntry := range dirEntries {
+			dir := filepath.Join(c.rootDir, kind, dirEntry.Name())
+			info, err := os.Stat(filepath.Join(dir, cacheCompleteMarker))
+			if err != nil {
+				_ = os.RemoveAll(dir)
+				continue
+			}
+
+			size, err := dirSize(dir)
+			if err != nil {
+				_ = os.RemoveAll(dir)
+				continue
+			}
+
+			c.entries[kind+"/"+dirEntry.Name()] = &cacheEntry{kind: kind, dir: dir, size: size, lastUsed: info.ModTime(), populated: true}
+			c.size += size
+			metrics.DefaultRecipeEngineMetrics.RecordTerraformCacheSizeChange(ctx, size, []attribute.KeyValue{
+				metrics.TerraformCacheKindAttrKey.String(kind),
+			})
+		}
+	}
+}
+
+// pluginCacheKey returns the key of the plugin cache entry for the providers required by a module.
+func pluginCacheKey(requiredProviders map[string]*config.RequiredProviderInfo) string {
+	providers := []string{}
+	for name, provider := range requiredProviders {
+		if provider == nil {
+			providers = append(providers, name)
+			continue
+		}
+		providers = append(providers, fmt.Sprintf("%s=%s@%s", name, provider.Source, provider.Version))
+	}
+	sort.Strings(providers)
+
+	return strings.Join(providers, ";")
+}
+
+// moduleCacheKey returns the key of the module cache entry for a recipe, or an empty string if the module of the
+// recipe cannot be cached. Only modules with a version, i.e. modules from a registry, are immutable and cached.
+func moduleCacheKey(recipe *recipes.EnvironmentDefinition) string {
+	if recipe == nil || recipe.TemplateVersion == "" {
+		return ""
+	}
+
+	// The name of the recipe is the name of the module in the generated configuration, which determines the
+	// directory the module is downloaded to.
+	return strings.Join([]string{recipe.Name, recipe.TemplatePath, recipe.TemplateVersion}, ";")
+}
+
+// pluginCacheEnv returns the environment variables that configure Terraform to use the plugin cache of a lease.
+func pluginCacheEnv(lease *cacheLease) map[string]string {
+	if lease == nil {
+		return nil
+	}
+
+	return map[string]string{
+		pluginCacheDirEnvVar:              lease.Dir(),
+		pluginCacheMayBreakLockFileEnvVar: "true",
+	}
+}
+
+// dirSize returns the total size of the regular files in a directory.
+func dirSize(dir string) (int64, error) {
+	var size int64
+	err := filepath.WalkDir(dir, func(_ string, d fs.DirEntry, err error) error {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		if d.Type().IsRegular() {
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+			size += info.Size()
+		}
+
+		return nil
+	})
+
+	return size, err
+}
@@ -0,0 +1,239 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/terraform/config"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+func writeCacheContent(t *testing.T, lease *cacheLease, size int) {
+	require.NoError(t, os.WriteFile(filepath.Join(lease.Dir(), "content"), make([]byte, size), 0644))
+}
+
+func Test_Cache_Acquire(t *testing.T) {
+	ctx := testcontext.New(t)
+	cache := NewCache(t.TempDir(), 1024)
+
+	lease := cache.acquire(ctx, cacheKindPlugins, "aws")
+	require.False(t, lease.Hit())
+	require.DirExists(t, lease.Dir())
+	writeCacheContent(t, lease, 100)
+	lease.release(ctx, true)
+
+	lease = cache.acquire(ctx, cacheKindPlugins, "aws")
+	require.True(t, lease.Hit())
+	lease.release(ctx, false)
+
+	// Entries are addressed by kind and key.
+	lease = cache.acquire(ctx, cacheKindModules, "aws")
+	require.False(t, lease.Hit())
+	lease.release(ctx, false)
+
+	lease = cache.acquire(ctx, cacheKindPlugins, "azurerm")
+	require.False(t, lease.Hit())
+	lease.release(ctx, false)
+}
+
+func Test_Cache_FailedPopulation(t *testing.T) {
+	ctx := testcontext.New(t)
+	cache := NewCache(t.TempDir(), 1024)
+
+	lease := cache.acquire(ctx, cacheKindPlugins, "aws")
+	writeCacheContent(t, lease, 100)
+	lease.release(ctx, false)
+
+	lease = cache.acquire(ctx, cacheKindPlugins, "aws")
+	require.False(t, lease.Hit())
+	lease.release(ctx, false)
+	require.Equal(t, int64(100), cache.size)
+}
+
+func Test_Cache_ConcurrentAcquire(t *testing.T) {
+	ctx := testcontext.New(t)
+	cache := NewCache(t.TempDir(), 1024)
+
+	lease := cache.acquire(ctx, cacheKindPlugins, "aws")
+
+	acquired := make(chan *cacheLease)
+	go func() {
+		acquired <- cache.acquire(ctx, cacheKindPlugins, "aws")
+	}()
+
+	// The second execution waits until the entry is unlocked by the first one.
+	select {
+	case <-acquired:
+		require.Fail(t, "entry was acquired while locked")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	lease.unlock(ctx, true)
+	second := <-acquired
+	require.True(t, second.Hit())
+
+	second.release(ctx, false)
+	lease.release(ctx, false)
+}
+
+func Test_Cache_Evict(t *testing.T) {
+	ctx := testcontext.New(t)
+	cache := NewCache(t.TempDir(), 250)
+
+	var leases []*cacheLease
+	for _, key := range []string{"first", "second", "third"} {
+		lease := cache.acquire(ctx, cacheKindPlugins, key)
+		writeCacheContent(t, lease, 100)
+		lease.unlock(ctx, true)
+		leases = append(leases, lease)
+	}
+
+	// Entries in use are not evicted, even though the cache exceeds its limit.
+	require.Equal(t, int64(300), cache.size)
+	leases[1].release(ctx, false)
+	require.Equal(t, int64(200), cache.size)
+	require.NoDirExists(t, filepath.Dir(leases[1].Dir()))
+
+	leases[0].release(ctx, false)
+	leases[2].release(ctx, false)
+	require.Equal(t, int64(200), cache.size)
+	require.DirExists(t, leases[0].Dir())
+	require.DirExists(t, leases[2].Dir())
+
+	// Using an entry makes it the most recently used.
+	lease := cache.acquire(ctx, cacheKindPlugins, "first")
+	lease.release(ctx, false)
+
+	lease = cache.acquire(ctx, cacheKindPlugins, "fourth")
+	writeCacheContent(t, lease, 100)
+	lease.release(ctx, true)
+	require.Equal(t, int64(200), cache.size)
+	require.DirExists(t, leases[0].Dir())
+	require.NoDirExists(t, leases[2].Dir())
+}
+
+func Test_Cache_Load(t *testing.T) {
+	ctx := testcontext.New(t)
+	rootDir := t.TempDir()
+
+	cache := NewCache(rootDir, 1024)
+	complete := cache.acquire(ctx, cacheKindModules, "complete")
+	writeCacheContent(t, complete, 100)
+	complete.release(ctx, true)
+
+	incomplete := cache.acquire(ctx, cacheKindModules, "incomplete")
+	writeCacheContent(t, incomplete, 100)
+	incomplete.release(ctx, false)
+
+	// A new process reuses complete entries and discards incomplete ones.
+	cache = NewCache(rootDir, 1024)
+	lease := cache.acquire(ctx, cacheKindModules, "complete")
+	require.True(t, lease.Hit())
+	lease.release(ctx, false)
+	require.Equal(t, int64(100), cache.size)
+	require.NoDirExists(t, filepath.Dir(incomplete.Dir()))
+}
+
+func Test_Cache_Nil(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	var cache *Cache
+	lease := cache.acquire(ctx, cacheKindPlugins, "aws")
+	require.Nil(t, lease)
+	require.False(t, lease.Hit())
+	require.Empty(t, lease.Dir())
+	require.Nil(t, pluginCacheEnv(lease))
+
+	// Releasing a nil lease is a no-op.
+	lease.unlock(ctx, true)
+	lease.release(ctx, true)
+}
+
+func Test_Cache_Parallel(t *testing.T) {
+	ctx := testcontext.New(t)
+	cache := NewCache(t.TempDir(), 150)
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(key string) {
+			defer wg.Done()
+			lease := cache.acquire(ctx, cacheKindPlugins, key)
+			if !lease.Hit() {
+				writeCacheContent(t, lease, 50)
+			}
+			lease.release(ctx, true)
+		}([]string{"aws", "azurerm", "kubernetes", "random"}[i%4])
+	}
+	wg.Wait()
+
+	require.LessOrEqual(t, cache.size, int64(150))
+}
+
+func Test_PluginCacheKey(t *testing.T) {
+	first := pluginCacheKey(map[string]*config.RequiredProviderInfo{
+		"aws":        {Source: "hashicorp/aws", Version: ">= 5.0"},
+		"kubernetes": {Source: "hashicorp/kubernetes"},
+	})
+	second := pluginCacheKey(map[string]*config.RequiredProviderInfo{
+		"kubernetes": {Source: "hashicorp/kubernetes"},
+		"aws":        {Source: "hashicorp/aws", Version: ">= 5.0"},
+	})
+	require.Equal(t, first, second)
+	require.Equal(t, "aws=hashicorp/aws@>= 5.0;kubernetes=hashicorp/kubernetes@", first)
+
+	require.NotEqual(t, first, pluginCacheKey(map[string]*config.RequiredProviderInfo{
+		"aws":        {Source: "hashicorp/aws", Version: ">= 5.1"},
+		"kubernetes": {Source: "hashicorp/kubernetes"},
+	}))
+}
+
+func Test_ModuleCacheKey(t *testing.T) {
+	require.Empty(t, moduleCacheKey(nil))
+	require.Empty(t, moduleCacheKey(&recipes.EnvironmentDefinition{Name: "redis", TemplatePath: "git::https://github.com/org/recipes//redis"}))
+	require.Equal(t, "redis;Azure/redis/azurerm;1.0.0", moduleCacheKey(&recipes.EnvironmentDefinition{
+		Name:            "redis",
+		TemplatePath:    "Azure/redis/azurerm",
+		TemplateVersion: "1.0.0",
+	}))
+}
+
+func Test_StoreModules(t *testing.T) {
+	ctx := testcontext.New(t)
+	cache := NewCache(t.TempDir(), 1024)
+
+	workingDir := t.TempDir()
+	moduleDir := filepath.Join(workingDir, moduleRootDir, "redis")
+	require.NoError(t, os.MkdirAll(moduleDir, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(moduleDir, "main.tf"), []byte("variable \"context\" {}"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(workingDir, moduleRootDir, "modules.json"), []byte("{}"), 0644))
+
+	lease := cache.acquire(ctx, cacheKindModules, "redis")
+	require.True(t, storeModules(ctx, workingDir, lease))
+	lease.release(ctx, true)
+
+	require.FileExists(t, filepath.Join(lease.Dir(), "redis", "main.tf"))
+	require.FileExists(t, filepath.Join(lease.Dir(), "modules.json"))
+	require.False(t, storeModules(ctx, workingDir, nil))
+}
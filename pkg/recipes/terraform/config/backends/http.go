@@ -0,0 +1,76 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"context"
+	"maps"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/recipes"
+)
+
+const (
+	BackendHTTP = "http"
+)
+
+// httpAddressArguments are the arguments of the http backend that hold the address of the state, or of its lock.
+var httpAddressArguments = []string{"address", "lock_address", "unlock_address"}
+
+var _ Backend = (*httpBackend)(nil)
+
+type httpBackend struct {
+	arguments map[string]any
+}
+
+// NewHTTPBackend creates a backend that stores the Terraform state using a REST client. The arguments are the
+// configuration arguments of the backend.
+func NewHTTPBackend(arguments map[string]any) Backend {
+	return &httpBackend{arguments: arguments}
+}
+
+// BuildBackend generates the Terraform backend configuration for the http backend. The "address" argument is the base
+// address of the states, and the state of each recipe is stored at its own address under it. The same applies to the
+// "lock_address" and "unlock_address" arguments if they are set.
+// https://developer.hashicorp.com/terraform/language/settings/backends/http
+func (b *httpBackend) BuildBackend(resourceRecipe *recipes.ResourceMetadata) (map[string]any, error) {
+	if err := requireArgument(BackendHTTP, b.arguments, "address"); err != nil {
+		return nil, err
+	}
+
+	suffix, err := generateSecretSuffix(resourceRecipe)
+	if err != nil {
+		return nil, err
+	}
+
+	backend := maps.Clone(b.arguments)
+	for _, name := range httpAddressArguments {
+		if address, ok := backend[name].(string); ok && address != "" {
+			backend[name] = strings.TrimSuffix(address, "/") + "/" + suffix
+		}
+	}
+
+	return map[string]any{
+		BackendHTTP: backend,
+	}, nil
+}
+
+// ValidateBackendExists returns true, because Terraform fails to apply the configuration if the state cannot be
+// written to the address.
+func (b *httpBackend) ValidateBackendExists(ctx context.Context, name string) (bool, error) {
+	return true, nil
+}
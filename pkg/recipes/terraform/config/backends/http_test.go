@@ -0,0 +1,56 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package backends
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_HTTPBackend_BuildBackend(t *testing.T) {
+	_, resourceRecipe := getTestInputs()
+	suffix, err := generateSecretSuffix(&resourceRecipe)
+	require.NoError(t, err)
+
+	t.Run("success", func(t *testing.T) {
+		arguments := map[string]any{
+			"address":      "http://state.default.svc/states/",
+			"lock_address": "http://state.default.svc/locks",
+			"lock_method":  "PUT",
+			"username":     "radius",
+			"retry_max":    float64(3),
+		}
+
+		config, err := NewHTTPBackend(arguments).BuildBackend(&resourceRecipe)
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{
+			BackendHTTP: map[string]any{
+				"address":      "http://state.default.svc/states/" + suffix,
+				"lock_address": "http://state.default.svc/locks/" + suffix,
+				"lock_method":  "PUT",
+				"username":     "radius",
+				"retry_max":    float64(3),
+			},
+		}, config)
+		require.Equal(t, "http://state.default.svc/states/", arguments["address"])
+	})
+
+	t.Run("missing address", func(t *testing.T) {
+		_, err := NewHTTPBackend(map[string]any{"lock_address": "http://state.default.svc/locks"}).BuildBackend(&resourceRecipe)
+		require.EqualError(t, err, "the \"address\" argument of the http backend is required")
+	})
+}
@@ -89,8 +89,9 @@ func (p *kubernetesBackend) ValidateBackendExists(ctx context.Context, name stri
 	return true, nil
 }
 
-// generateSecretSuffix returns a unique string from the resourceID, environmentID, and applicationID
-// which is used as key for kubernetes secret in defining terraform backend.
+// generateSecretSuffix returns a unique string from the resourceID, environmentID and applicationID
+// which is used as key for kubernetes secret in defining terraform backend. Other backends use it to address
+// the state of the recipe.
 func generateSecretSuffix(resourceRecipe *recipes.ResourceMetadata) (string, error) {
 	parsedResourceID, err := resources.Parse(resourceRecipe.ResourceID)
 	if err != nil {
@@ -107,8 +108,10 @@ func generateSecretSuffix(resourceRecipe *recipes.ResourceMetadata) (string, err
 		return "", err
 	}
 
+	key := fmt.Sprintf("%s-%s-%s", parsedEnvID.Name(), parsedAppID.Name(), parsedResourceID.String())
+
 	hasher := sha1.New()
-	_, err = hasher.Write([]byte(strings.ToLower(fmt.Sprintf("%s-%s-%s", parsedEnvID.Name(), parsedAppID.Name(), parsedResourceID.String()))))
+	_, err = hasher.Write([]byte(strings.ToLower(key)))
 	if err != nil {
 		return "", err
 	}
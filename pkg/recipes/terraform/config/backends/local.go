@@ -0,0 +1,55 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"context"
+
+	"github.com/radius-project/radius/pkg/recipes"
+)
+
+const (
+	BackendLocal = "local"
+
+	// localStatePath is the path of the state file of the local backend, relative to the working directory.
+	localStatePath = "terraform.tfstate"
+)
+
+var _ Backend = (*localBackend)(nil)
+
+type localBackend struct{}
+
+// NewLocalBackend creates a backend that stores the Terraform state in the working directory. The state is removed
+// with the working directory after the recipe is executed, so it is not shared between executions.
+func NewLocalBackend() Backend {
+	return &localBackend{}
+}
+
+// BuildBackend generates the Terraform backend configuration for the local backend.
+// https://developer.hashicorp.com/terraform/language/settings/backends/local
+func (b *localBackend) BuildBackend(resourceRecipe *recipes.ResourceMetadata) (map[string]any, error) {
+	return map[string]any{
+		BackendLocal: map[string]any{
+			"path": localStatePath,
+		},
+	}, nil
+}
+
+// ValidateBackendExists returns true, because Terraform creates the state file when the configuration is applied.
+func (b *localBackend) ValidateBackendExists(ctx context.Context, name string) (bool, error) {
+	return true, nil
+}
@@ -0,0 +1,40 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_LocalBackend(t *testing.T) {
+	_, resourceRecipe := getTestInputs()
+	backend := NewLocalBackend()
+
+	config, err := backend.BuildBackend(&resourceRecipe)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"local": map[string]any{"path": "terraform.tfstate"}}, config)
+
+	exists, err := backend.ValidateBackendExists(testcontext.New(t), "")
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	_, ok := backend.(WorkspaceBackend)
+	require.False(t, ok)
+}
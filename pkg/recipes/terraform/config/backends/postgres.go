@@ -0,0 +1,68 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"context"
+	"maps"
+
+	"github.com/radius-project/radius/pkg/recipes"
+)
+
+const (
+	BackendPostgres = "pg"
+)
+
+var (
+	_ Backend          = (*postgresBackend)(nil)
+	_ WorkspaceBackend = (*postgresBackend)(nil)
+)
+
+type postgresBackend struct {
+	arguments map[string]any
+}
+
+// NewPostgresBackend creates a backend that stores the Terraform state in a Postgres database. The arguments are the
+// configuration arguments of the backend.
+func NewPostgresBackend(arguments map[string]any) Backend {
+	return &postgresBackend{arguments: arguments}
+}
+
+// BuildBackend generates the Terraform backend configuration for the pg backend. The states of all recipes are stored
+// in the same table, one row per Terraform workspace.
+// https://developer.hashicorp.com/terraform/language/settings/backends/pg
+func (b *postgresBackend) BuildBackend(resourceRecipe *recipes.ResourceMetadata) (map[string]any, error) {
+	if err := requireArgument(BackendPostgres, b.arguments, "conn_str"); err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		BackendPostgres: maps.Clone(b.arguments),
+	}, nil
+}
+
+// ValidateBackendExists returns true, because Terraform fails to apply the configuration if the state cannot be
+// written to the database.
+func (b *postgresBackend) ValidateBackendExists(ctx context.Context, name string) (bool, error) {
+	return true, nil
+}
+
+// Workspace returns the name of the Terraform workspace that stores the state of the recipe. The pg backend has no
+// argument to address the state, so each recipe uses its own workspace.
+func (b *postgresBackend) Workspace(resourceRecipe *recipes.ResourceMetadata) (string, error) {
+	return generateSecretSuffix(resourceRecipe)
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"context"
+	"maps"
+	"path"
+
+	"github.com/radius-project/radius/pkg/recipes"
+)
+
+const (
+	BackendS3 = "s3"
+
+	// s3StateFileExtension is the extension of the state files stored in the bucket.
+	s3StateFileExtension = ".tfstate"
+)
+
+var _ Backend = (*s3Backend)(nil)
+
+type s3Backend struct {
+	arguments map[string]any
+}
+
+// NewS3Backend creates a backend that stores the Terraform state in an S3 bucket, or a bucket of an S3-compatible
+// storage service such as MinIO. The arguments are the configuration arguments of the backend.
+func NewS3Backend(arguments map[string]any) Backend {
+	return &s3Backend{arguments: arguments}
+}
+
+// BuildBackend generates the Terraform backend configuration for the S3 backend. The state of each recipe is stored
+// in its own object in the bucket. If the "key" argument is set, it is used as the prefix of the object keys.
+// https://developer.hashicorp.com/terraform/language/settings/backends/s3
+func (b *s3Backend) BuildBackend(resourceRecipe *recipes.ResourceMetadata) (map[string]any, error) {
+	if err := requireArgument(BackendS3, b.arguments, "bucket"); err != nil {
+		return nil, err
+	}
+
+	suffix, err := generateSecretSuffix(resourceRecipe)
+	if err != nil {
+		return nil, err
+	}
+
+	backend := maps.Clone(b.arguments)
+	key := suffix + s3StateFileExtension
+	if prefix, ok := backend["key"].(string); ok && prefix != "" {
+		key = path.Join(prefix, key)
+	}
+	backend["key"] = key
+
+	return map[string]any{
+		BackendS3: backend,
+	}, nil
+}
+
+// ValidateBackendExists returns true, because Terraform fails to apply the configuration if the state cannot be
+// written to the bucket.
+func (b *s3Backend) ValidateBackendExists(ctx context.Context, name string) (bool, error) {
+	return true, nil
+}
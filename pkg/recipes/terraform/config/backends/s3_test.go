@@ -0,0 +1,70 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package backends
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_S3Backend_BuildBackend(t *testing.T) {
+	_, resourceRecipe := getTestInputs()
+	suffix, err := generateSecretSuffix(&resourceRecipe)
+	require.NoError(t, err)
+
+	t.Run("minio", func(t *testing.T) {
+		arguments := map[string]any{
+			"bucket":                      "tfstate",
+			"endpoints":                   map[string]any{"s3": "http://minio.default.svc:9000"},
+			"use_path_style":              true,
+			"skip_credentials_validation": true,
+		}
+
+		config, err := NewS3Backend(arguments).BuildBackend(&resourceRecipe)
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{
+			BackendS3: map[string]any{
+				"bucket":                      "tfstate",
+				"endpoints":                   map[string]any{"s3": "http://minio.default.svc:9000"},
+				"use_path_style":              true,
+				"skip_credentials_validation": true,
+				"key":                         suffix + ".tfstate",
+			},
+		}, config)
+
+		// The arguments are shared by all recipes and must not be modified.
+		require.NotContains(t, arguments, "key")
+	})
+
+	t.Run("key prefix", func(t *testing.T) {
+		config, err := NewS3Backend(map[string]any{"bucket": "tfstate", "key": "radius/"}).BuildBackend(&resourceRecipe)
+		require.NoError(t, err)
+		require.Equal(t, "radius/"+suffix+".tfstate", config[BackendS3].(map[string]any)["key"])
+	})
+
+	t.Run("missing bucket", func(t *testing.T) {
+		_, err := NewS3Backend(map[string]any{}).BuildBackend(&resourceRecipe)
+		require.EqualError(t, err, "the \"bucket\" argument of the s3 backend is required")
+	})
+
+	t.Run("validate", func(t *testing.T) {
+		exists, err := NewS3Backend(map[string]any{}).ValidateBackendExists(context.Background(), suffix)
+		require.NoError(t, err)
+		require.True(t, exists)
+	})
+}
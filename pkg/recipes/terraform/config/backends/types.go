@@ -18,8 +18,11 @@ package backends
 
 import (
 	"context"
+	"fmt"
 
+	dm "github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/recipes"
+	"k8s.io/client-go/kubernetes"
 )
 
 //go:generate mockgen -typed -destination=./mock_backend.go -package=backends -self_package github.com/radius-project/radius/pkg/recipes/terraform/config/backends github.com/radius-project/radius/pkg/recipes/terraform/config/backends Backend
@@ -36,3 +39,78 @@ type Backend interface {
 	// returns true if backend is found, false otherwise.
 	ValidateBackendExists(ctx context.Context, name string) (bool, error)
 }
+
+// WorkspaceBackend is implemented by backends that store the state of each recipe in its own Terraform workspace,
+// because the backend cannot be configured with a key or address for the state of each recipe.
+type WorkspaceBackend interface {
+	// Workspace returns the name of the Terraform workspace that stores the state of the recipe.
+	Workspace(resourceRecipe *recipes.ResourceMetadata) (string, error)
+}
+
+// Kind returns the kind of the backend configured for an environment. The Kubernetes backend is used if no backend is configured.
+func Kind(config dm.TerraformBackendConfig) string {
+	if config.Kind == "" {
+		return dm.TerraformBackendKubernetes
+	}
+
+	return config.Kind
+}
+
+// NewBackend creates the backend configured for an environment. Sensitive configuration arguments of the backend are read
+// from the given secrets. The Kubernetes client is only used by the Kubernetes backend, and can be nil for other backends.
+func NewBackend(config dm.TerraformBackendConfig, secrets map[string]recipes.SecretData, k8sClientSet kubernetes.Interface) (Backend, error) {
+	kind := Kind(config)
+	if kind == dm.TerraformBackendKubernetes {
+		return NewKubernetesBackend(k8sClientSet), nil
+	}
+
+	arguments, err := backendArguments(config, secrets)
+	if err != nil {
+		return nil, err
+	}
+
+	switch kind {
+	case dm.TerraformBackendPostgres:
+		return NewPostgresBackend(arguments), nil
+	case dm.TerraformBackendS3:
+		return NewS3Backend(arguments), nil
+	case dm.TerraformBackendHTTP:
+		return NewHTTPBackend(arguments), nil
+	default:
+		return nil, fmt.Errorf("unsupported Terraform backend kind %q", kind)
+	}
+}
+
+// backendArguments returns the configuration arguments of a backend, with the values of its sensitive arguments
+// read from the given secrets.
+func backendArguments(config dm.TerraformBackendConfig, secrets map[string]recipes.SecretData) (map[string]any, error) {
+	arguments := map[string]any{}
+	for name, value := range config.Config {
+		arguments[name] = value
+	}
+
+	for name, secretReference := range config.Secrets {
+		secretData, ok := secrets[secretReference.Source]
+		if !ok {
+			return nil, fmt.Errorf("missing secret source: %s", secretReference.Source)
+		}
+
+		secretValue, ok := secretData.Data[secretReference.Key]
+		if !ok {
+			return nil, fmt.Errorf("missing secret key in secret store id: %s", secretReference.Source)
+		}
+
+		arguments[name] = secretValue
+	}
+
+	return arguments, nil
+}
+
+// requireArgument returns an error if the backend argument with the given name is not set.
+func requireArgument(backend string, arguments map[string]any, name string) error {
+	if value, ok := arguments[name].(string); !ok || value == "" {
+		return fmt.Errorf("the %q argument of the %s backend is required", name, backend)
+	}
+
+	return nil
+}
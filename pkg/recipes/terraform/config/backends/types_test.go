@@ -0,0 +1,117 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package backends
+
+import (
+	"testing"
+
+	dm "github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+const testSecretStoreID = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/secretStores/backend"
+
+func Test_NewBackend(t *testing.T) {
+	tests := []struct {
+		desc     string
+		config   dm.TerraformBackendConfig
+		expected Backend
+	}{
+		{
+			desc:     "default",
+			config:   dm.TerraformBackendConfig{},
+			expected: &kubernetesBackend{},
+		},
+		{
+			desc:     "kubernetes",
+			config:   dm.TerraformBackendConfig{Kind: dm.TerraformBackendKubernetes},
+			expected: &kubernetesBackend{},
+		},
+		{
+			desc:     "pg",
+			config:   dm.TerraformBackendConfig{Kind: dm.TerraformBackendPostgres},
+			expected: &postgresBackend{},
+		},
+		{
+			desc:     "s3",
+			config:   dm.TerraformBackendConfig{Kind: dm.TerraformBackendS3},
+			expected: &s3Backend{},
+		},
+		{
+			desc:     "http",
+			config:   dm.TerraformBackendConfig{Kind: dm.TerraformBackendHTTP},
+			expected: &httpBackend{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			backend, err := NewBackend(tc.config, nil, fake.NewSimpleClientset())
+			require.NoError(t, err)
+			require.IsType(t, tc.expected, backend)
+		})
+	}
+
+	t.Run("unsupported", func(t *testing.T) {
+		_, err := NewBackend(dm.TerraformBackendConfig{Kind: "gcs"}, nil, nil)
+		require.EqualError(t, err, "unsupported Terraform backend kind \"gcs\"")
+	})
+}
+
+func Test_BackendArguments(t *testing.T) {
+	config := dm.TerraformBackendConfig{
+		Kind: dm.TerraformBackendS3,
+		Config: map[string]any{
+			"bucket":         "tfstate",
+			"use_path_style": true,
+		},
+		Secrets: map[string]dm.SecretReference{
+			"access_key": {Source: testSecretStoreID, Key: "accessKey"},
+			"secret_key": {Source: testSecretStoreID, Key: "secretKey"},
+		},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		secrets := map[string]recipes.SecretData{
+			testSecretStoreID: {Data: map[string]string{"accessKey": "minio", "secretKey": "minio123"}},
+		}
+
+		arguments, err := backendArguments(config, secrets)
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{
+			"bucket":         "tfstate",
+			"use_path_style": true,
+			"access_key":     "minio",
+			"secret_key":     "minio123",
+		}, arguments)
+	})
+
+	t.Run("missing secret source", func(t *testing.T) {
+		_, err := backendArguments(config, nil)
+		require.EqualError(t, err, "missing secret source: "+testSecretStoreID)
+	})
+
+	t.Run("missing secret key", func(t *testing.T) {
+		secrets := map[string]recipes.SecretData{
+			testSecretStoreID: {Data: map[string]string{"accessKey": "minio"}},
+		}
+
+		_, err := backendArguments(config, secrets)
+		require.EqualError(t, err, "missing secret key in secret store id: "+testSecretStoreID)
+	})
+}
@@ -263,7 +263,7 @@ func getProviderConfigs(ctx context.Context, requiredProviders map[string]*Requi
 
 // AddTerraformBackend adds backend configurations to store Terraform state file for the deployment.
 // Save() must be called to save the generated backend config.
-// Currently, the supported backend for Terraform Recipes is Kubernetes secret. https://developer.hashicorp.com/terraform/language/settings/backends/kubernetes
+// The backend is configured per environment, and defaults to Kubernetes secret. https://developer.hashicorp.com/terraform/language/settings/backends/configuration
 func (cfg *TerraformConfig) AddTerraformBackend(resourceRecipe *recipes.ResourceMetadata, backend backends.Backend) (map[string]any, error) {
 	backendConfig, err := backend.BuildBackend(resourceRecipe)
 	if err != nil {
@@ -0,0 +1,268 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/recipes"
+)
+
+const (
+	// GitSecretKeyPAT is the key of the personal access token in the secret store of a Git platform.
+	GitSecretKeyPAT = "pat"
+
+	// GitSecretKeyUsername is the key of the optional username associated with the personal access token.
+	GitSecretKeyUsername = "username"
+
+	// SSHSecretKeyPrivateKey is the key of the SSH private key in the secret store of a Git platform.
+	SSHSecretKeyPrivateKey = "privateKey"
+
+	// SSHSecretKeyKnownHosts is the key of the known_hosts entries used to verify the host key of a Git platform.
+	SSHSecretKeyKnownHosts = "knownHosts"
+
+	// RegistrySecretKeyToken is the key of the API token in the secret store of a private Terraform module registry.
+	RegistrySecretKeyToken = "token"
+
+	// credentialsDir is the directory of the execution where the SSH configuration of the module sources is written.
+	credentialsDir = "credentials"
+
+	// credentialsFileMode is the file mode of the files containing credentials. SSH refuses private keys that are
+	// accessible by other users.
+	credentialsFileMode os.FileMode = 0600
+)
+
+// registryHostnamePattern matches the hostnames that can be represented in the name of a TF_TOKEN_* environment variable.
+var registryHostnamePattern = regexp.MustCompile(`^[A-Za-z0-9.-]+$`)
+
+// scpLikeGitURLPattern matches the scp-like syntax of Git SSH URLs, e.g. git@github.com:org/repo.git.
+var scpLikeGitURLPattern = regexp.MustCompile(`^([^@/]+@)?([^/:]+):([^/].*)$`)
+
+// GetGitURL returns git url from generic git module source.
+// git::https://exmaple.com/project/module -> https://exmaple.com/project/module
+// git::git@example.com:project/module -> ssh://git@example.com/project/module
+func GetGitURL(templatePath string) (*url.URL, error) {
+	paths := strings.Split(templatePath, "git::")
+	gitUrl := paths[len(paths)-1]
+
+	if !(len(strings.Split(gitUrl, "://")) > 1) {
+		if match := scpLikeGitURLPattern.FindStringSubmatch(gitUrl); match != nil && match[1] != "" {
+			gitUrl = fmt.Sprintf("ssh://%s%s/%s", match[1], match[2], match[3])
+		} else {
+			gitUrl = fmt.Sprintf("https://%s", gitUrl)
+		}
+	}
+
+	url, err := url.Parse(gitUrl)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse git url %s : %w", gitUrl, err)
+	}
+
+	return url, nil
+}
+
+// GetModuleSourceSecretIDs returns the secret store IDs and keys of the credentials used to download the module of the
+// recipe: the personal access token or SSH key of the Git platform hosting the module, and the tokens of the private
+// Terraform module registries, which can host the module or the modules it calls.
+func GetModuleSourceSecretIDs(envConfig recipes.Configuration, templatePath string) (map[string][]string, error) {
+	secretIDs := map[string][]string{}
+	authentication := envConfig.RecipeConfig.Terraform.Authentication
+
+	if strings.HasPrefix(templatePath, "git::") {
+		url, err := GetGitURL(templatePath)
+		if err != nil {
+			return nil, err
+		}
+
+		hostname := strings.TrimPrefix(url.Hostname(), "www.")
+		if url.Scheme == "ssh" {
+			if secretStoreID := authentication.Git.SSH[hostname].Secret; secretStoreID != "" {
+				secretIDs[secretStoreID] = append(secretIDs[secretStoreID], SSHSecretKeyPrivateKey, SSHSecretKeyKnownHosts)
+			}
+		} else if secretStoreID := authentication.Git.PAT[hostname].Secret; secretStoreID != "" {
+			secretIDs[secretStoreID] = append(secretIDs[secretStoreID], GitSecretKeyPAT, GitSecretKeyUsername)
+		}
+	}
+
+	for _, registry := range authentication.Registry {
+		if registry.Secret != "" {
+			secretIDs[registry.Secret] = append(secretIDs[registry.Secret], RegistrySecretKeyToken)
+		}
+	}
+
+	return secretIDs, nil
+}
+
+// moduleSourceCredentialsEnv returns the environment variables that configure the credentials of the module sources
+// for the Terraform processes of the execution. The credentials are never written to the global Git configuration, so
+// they are only used by the execution they are configured for:
+//   - The personal access token of a Git platform is set through the GIT_CONFIG_* environment variables.
+//   - The SSH key of a Git platform is written to an SSH configuration in the execution directory, with strict host
+//     key checking against the configured known_hosts entries, which is used through GIT_SSH_COMMAND.
+//   - The tokens of the private registries are set through the TF_TOKEN_* environment variables.
+func moduleSourceCredentialsEnv(options Options) (map[string]string, error) {
+	env := map[string]string{}
+	if options.EnvConfig == nil || options.EnvRecipe == nil {
+		return env, nil
+	}
+
+	authentication := options.EnvConfig.RecipeConfig.Terraform.Authentication
+	if strings.HasPrefix(options.EnvRecipe.TemplatePath, "git::") {
+		url, err := GetGitURL(options.EnvRecipe.TemplatePath)
+		if err != nil {
+			return nil, err
+		}
+
+		hostname := strings.TrimPrefix(url.Hostname(), "www.")
+		if url.Scheme == "ssh" {
+			if secretStoreID := authentication.Git.SSH[hostname].Secret; secretStoreID != "" {
+				secrets, err := moduleSourceSecrets(options.Secrets, secretStoreID)
+				if err != nil {
+					return nil, err
+				}
+
+				sshCommand, err := writeSSHConfig(options.RootDir, url.Hostname(), secrets)
+				if err != nil {
+					return nil, err
+				}
+				env["GIT_SSH_COMMAND"] = sshCommand
+			}
+		} else if secretStoreID := authentication.Git.PAT[hostname].Secret; secretStoreID != "" {
+			secrets, err := moduleSourceSecrets(options.Secrets, secretStoreID)
+			if err != nil {
+				return nil, err
+			}
+
+			// Equivalent to git config url.<git url with credentials>.insteadOf <git url>.
+			env["GIT_CONFIG_COUNT"] = "1"
+			env["GIT_CONFIG_KEY_0"] = fmt.Sprintf("url.%s.insteadOf", getGitURLWithSecrets(secrets, url))
+			env["GIT_CONFIG_VALUE_0"] = fmt.Sprintf("%s://%s", url.Scheme, url.Hostname())
+		}
+	}
+
+	for hostname, registry := range authentication.Registry {
+		if registry.Secret == "" {
+			continue
+		}
+
+		secrets, err := moduleSourceSecrets(options.Secrets, registry.Secret)
+		if err != nil {
+			return nil, err
+		}
+
+		token, ok := secrets[RegistrySecretKeyToken]
+		if !ok {
+			return nil, fmt.Errorf("missing secret key %q in secret store id: %s", RegistrySecretKeyToken, registry.Secret)
+		}
+
+		name, err := registryTokenEnvVar(hostname)
+		if err != nil {
+			return nil, err
+		}
+		env[name] = token
+	}
+
+	return env, nil
+}
+
+// moduleSourceSecrets returns the secrets of the secret store used to authenticate to a module source.
+func moduleSourceSecrets(secrets map[string]recipes.SecretData, secretStoreID string) (map[string]string, error) {
+	secretData, ok := secrets[secretStoreID]
+	if !ok {
+		return nil, fmt.Errorf("secrets not found for secret store ID %q", secretStoreID)
+	}
+
+	return secretData.Data, nil
+}
+
+// getGitURLWithSecrets returns the git URL with secrets information added, in the format
+// https://<username>:<pat>@<hostname>.
+func getGitURLWithSecrets(secrets map[string]string, url *url.URL) string {
+	path := fmt.Sprintf("%s://", url.Scheme)
+	if user, ok := secrets[GitSecretKeyUsername]; ok {
+		path += fmt.Sprintf("%s:", user)
+	}
+
+	if token, ok := secrets[GitSecretKeyPAT]; ok {
+		path += token
+	}
+	path += fmt.Sprintf("@%s", url.Hostname())
+
+	return path
+}
+
+// writeSSHConfig writes the private key and known_hosts entries of a Git platform, and an SSH configuration using them
+// for the hostname, to the credentials directory of the execution. It returns the SSH command using the configuration.
+func writeSSHConfig(rootDir string, hostname string, secrets map[string]string) (string, error) {
+	privateKey, ok := secrets[SSHSecretKeyPrivateKey]
+	if !ok {
+		return "", fmt.Errorf("missing secret key %q in the SSH secret store of %q", SSHSecretKeyPrivateKey, hostname)
+	}
+
+	knownHosts, ok := secrets[SSHSecretKeyKnownHosts]
+	if !ok {
+		return "", fmt.Errorf("missing secret key %q in the SSH secret store of %q, the host key of the Git platform must be pinned", SSHSecretKeyKnownHosts, hostname)
+	}
+
+	dir := filepath.Join(rootDir, credentialsDir)
+	if err := os.MkdirAll(dir, workingDirFileMode); err != nil {
+		return "", fmt.Errorf("failed to create the credentials directory: %w", err)
+	}
+
+	// SSH requires private keys to end with a newline.
+	if !strings.HasSuffix(privateKey, "\n") {
+		privateKey += "\n"
+	}
+
+	keyFile := filepath.Join(dir, "id_"+hostname)
+	knownHostsFile := filepath.Join(dir, "known_hosts")
+	configFile := filepath.Join(dir, "ssh_config")
+	config := strings.Join([]string{
+		fmt.Sprintf("Host %s", hostname),
+		fmt.Sprintf("  IdentityFile \"%s\"", keyFile),
+		"  IdentitiesOnly yes",
+		fmt.Sprintf("  UserKnownHostsFile \"%s\"", knownHostsFile),
+		"  StrictHostKeyChecking yes",
+		"",
+	}, "\n")
+
+	for file, content := range map[string]string{keyFile: privateKey, knownHostsFile: knownHosts, configFile: config} {
+		if err := os.WriteFile(file, []byte(content), credentialsFileMode); err != nil {
+			return "", fmt.Errorf("failed to write the SSH configuration: %w", err)
+		}
+	}
+
+	return fmt.Sprintf("ssh -F '%s'", configFile), nil
+}
+
+// registryTokenEnvVar returns the name of the environment variable of the API token of a Terraform module registry.
+// Periods in the hostname are encoded as underscores and dashes as double underscores.
+// See https://developer.hashicorp.com/terraform/cli/config/config-file#environment-variable-credentials.
+func registryTokenEnvVar(hostname string) (string, error) {
+	if !registryHostnamePattern.MatchString(hostname) {
+		return "", fmt.Errorf("invalid Terraform registry hostname %q: only ASCII letters, digits, periods and dashes are supported", hostname)
+	}
+
+	name := strings.ReplaceAll(hostname, "-", "__")
+	name = strings.ReplaceAll(name, ".", "_")
+	return "TF_TOKEN_" + name, nil
+}
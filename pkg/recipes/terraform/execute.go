@@ -20,7 +20,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"os"
+	"path/filepath"
+	"slices"
 	"strings"
 	"time"
 
@@ -30,7 +33,11 @@ import (
 	"github.com/radius-project/radius/pkg/components/kubernetesclient/kubernetesclientprovider"
 	"github.com/radius-project/radius/pkg/components/metrics"
 	"github.com/radius-project/radius/pkg/components/secret/secretprovider"
+	dm "github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/recipes/recipecontext"
+	"github.com/radius-project/radius/pkg/recipes/recipelogs"
+	"github.com/radius-project/radius/pkg/recipes/recipeparameters"
 	"github.com/radius-project/radius/pkg/recipes/terraform/config"
 	"github.com/radius-project/radius/pkg/recipes/terraform/config/backends"
 	"github.com/radius-project/radius/pkg/recipes/terraform/config/providers"
@@ -38,6 +45,7 @@ import (
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 	"go.opentelemetry.io/otel/attribute"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
 )
 
 var (
@@ -45,11 +53,21 @@ var (
 	ErrRecipeNameEmpty = errors.New("recipe name cannot be empty")
 )
 
+const (
+	// defaultWorkspace is the name of the Terraform workspace that is used if no workspace is selected.
+	defaultWorkspace = "default"
+
+	// planFileName is the name of the file in the working directory that Terraform plan writes the plan to.
+	planFileName = "recipe.tfplan"
+)
+
 var _ TerraformExecutor = (*executor)(nil)
 
 // NewExecutor creates a new Executor with the given UCP connection and secret provider, to execute a Terraform recipe.
-func NewExecutor(ucpConn sdk.Connection, secretProvider *secretprovider.SecretProvider, kubernetesClients kubernetesclientprovider.KubernetesClientProvider) *executor {
-	return &executor{ucpConn: ucpConn, secretProvider: secretProvider, kubernetesClients: kubernetesClients}
+// The install options configure where Terraform binaries are found or cached. The version of Terraform is configured
+// per environment. The cache of provider plugins and modules is shared by executions, and is disabled if nil.
+func NewExecutor(ucpConn sdk.Connection, secretProvider *secretprovider.SecretProvider, kubernetesClients kubernetesclientprovider.KubernetesClientProvider, installOptions InstallOptions, cache *Cache) *executor {
+	return &executor{ucpConn: ucpConn, secretProvider: secretProvider, kubernetesClients: kubernetesClients, installOptions: installOptions, cache: cache}
 }
 
 type executor struct {
@@ -61,6 +79,12 @@ type executor struct {
 
 	// kubernetesClients provides access to the Kubernetes clients.
 	kubernetesClients kubernetesclientprovider.KubernetesClientProvider
+
+	// installOptions configures where Terraform binaries are found or cached.
+	installOptions InstallOptions
+
+	// cache is the cache of provider plugins and modules shared by executions. The cache is disabled if nil.
+	cache *Cache
 }
 
 // Deploy installs Terraform, creates a working directory, generates a config, and runs Terraform init and
@@ -70,7 +94,7 @@ func (e *executor) Deploy(ctx context.Context, options Options) (*tfjson.State,
 
 	// Install Terraform
 	i := install.NewInstaller()
-	tf, err := Install(ctx, i, options.RootDir)
+	tf, err := Install(ctx, i, options.RootDir, e.installOptionsFor(options))
 	// The terraform zip for installation is downloaded in a location outside of the install directory and is only accessible through the installer.Remove function -
 	// stored in latestVersion.pathsToRemove. So this needs to be called for complete cleanup even if the root terraform directory is deleted.
 	defer func() {
@@ -82,38 +106,138 @@ func (e *executor) Deploy(ctx context.Context, options Options) (*tfjson.State,
 		return nil, err
 	}
 
+	credentials, err := e.setModuleSourceCredentials(tf, options)
+	if err != nil {
+		return nil, err
+	}
+
+	backend, err := e.newBackend(options)
+	if err != nil {
+		return nil, err
+	}
+
 	// Create Terraform config in the working directory
-	kubernetesBackendSuffix, err := e.generateConfig(ctx, tf, options)
+	kubernetesBackendSuffix, loadedModule, err := e.generateConfig(ctx, tf, options, backend)
 	if err != nil {
 		return nil, err
 	}
 
-	if options.EnvConfig != nil {
-		// Set environment variables for the Terraform process.
-		err = e.setEnvironmentVariables(tf, options)
-		if err != nil {
-			return nil, err
-		}
+	err = validateParameters(options, loadedModule)
+	if err != nil {
+		return nil, err
+	}
+
+	workspace, err := backendWorkspace(backend, options)
+	if err != nil {
+		return nil, err
+	}
+
+	// Use the plugin cache for the providers required by the module. The lease is held until Terraform no longer
+	// runs the providers, so they are not evicted from the cache while they are in use.
+	plugins := e.cache.acquire(ctx, cacheKindPlugins, pluginCacheKey(loadedModule.RequiredProviders))
+	defer plugins.release(ctx, false)
+
+	// Set environment variables for the Terraform process.
+	env, err := additionalEnv(options, plugins, credentials)
+	if err != nil {
+		return nil, err
+	}
+
+	err = e.setEnvironmentVariables(tf, options, env)
+	if err != nil {
+		return nil, err
 	}
 
 	// Run TF Init and Apply in the working directory
-	state, err := initAndApply(ctx, tf)
+	state, err := initAndApply(ctx, tf, plugins, workspace)
 	if err != nil {
 		return nil, err
 	}
 
 	// Validate that the terraform state file backend source exists.
-	// Currently only Kubernetes secret backend is supported, which is created by Terraform as a part of Terraform apply.
-	kubernetesClient, err := e.kubernetesClients.ClientGoClient()
+	// For the Kubernetes backend, the secret is created by Terraform as a part of Terraform apply.
+	backendExists, err := backend.ValidateBackendExists(ctx, backends.KubernetesBackendNamePrefix+kubernetesBackendSuffix)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving terraform state: %w", err)
+	} else if !backendExists {
+		return nil, errors.New("expected terraform state is not found")
+	}
+
+	return state, nil
+}
+
+// Import installs Terraform, creates a working directory, generates a config, and runs Terraform init and import in the
+// working directory for each of the resources, returning the state or an error if any of these steps fail. The resources
+// are imported into the state of the recipe, so they are managed by the recipe when it is deployed or deleted.
+func (e *executor) Import(ctx context.Context, options Options, resources []recipes.ImportedResource) (*tfjson.State, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	// Install Terraform
+	i := install.NewInstaller()
+	tf, err := Install(ctx, i, options.RootDir, e.installOptionsFor(options))
+	// The terraform zip for installation is downloaded in a location outside of the install directory and is only accessible through the installer.Remove function -
+	// stored in latestVersion.pathsToRemove. So this needs to be called for complete cleanup even if the root terraform directory is deleted.
+	defer func() {
+		if err := i.Remove(ctx); err != nil {
+			logger.Info(fmt.Sprintf("Failed to cleanup Terraform installation: %s", err.Error()))
+		}
+	}()
+	if err != nil {
+		return nil, err
+	}
+
+	credentials, err := e.setModuleSourceCredentials(tf, options)
 	if err != nil {
-		return nil, fmt.Errorf("error getting kubernetes client: %w", err)
+		return nil, err
 	}
 
-	backendExists, err := backends.NewKubernetesBackend(kubernetesClient).ValidateBackendExists(ctx, backends.KubernetesBackendNamePrefix+kubernetesBackendSuffix)
+	backend, err := e.newBackend(options)
 	if err != nil {
-		return nil, fmt.Errorf("error retrieving kubernetes secret for terraform state: %w", err)
+		return nil, err
+	}
+
+	// Create Terraform config in the working directory
+	kubernetesBackendSuffix, loadedModule, err := e.generateConfig(ctx, tf, options, backend)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateParameters(options, loadedModule)
+	if err != nil {
+		return nil, err
+	}
+
+	workspace, err := backendWorkspace(backend, options)
+	if err != nil {
+		return nil, err
+	}
+
+	plugins := e.cache.acquire(ctx, cacheKindPlugins, pluginCacheKey(loadedModule.RequiredProviders))
+	defer plugins.release(ctx, false)
+
+	// The providers read the imported resources, so the environment variables of the recipe configuration are used
+	// like for a deployment.
+	env, err := additionalEnv(options, plugins, credentials)
+	if err != nil {
+		return nil, err
+	}
+
+	err = e.setEnvironmentVariables(tf, options, env)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := initAndImport(ctx, tf, plugins, workspace, options.EnvRecipe.Name, resources)
+	if err != nil {
+		return nil, err
+	}
+
+	// For the Kubernetes backend, the secret is created by Terraform when the first resource is imported.
+	backendExists, err := backend.ValidateBackendExists(ctx, backends.KubernetesBackendNamePrefix+kubernetesBackendSuffix)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving terraform state: %w", err)
 	} else if !backendExists {
-		return nil, errors.New("expected kubernetes secret for terraform state is not found")
+		return nil, errors.New("expected terraform state is not found")
 	}
 
 	return state, nil
@@ -126,7 +250,7 @@ func (e *executor) Delete(ctx context.Context, options Options) error {
 
 	// Install Terraform
 	i := install.NewInstaller()
-	tf, err := Install(ctx, i, options.RootDir)
+	tf, err := Install(ctx, i, options.RootDir, e.installOptionsFor(options))
 	// The terraform zip for installation is downloaded in a location outside of the install directory and is only accessible through the installer.Remove function -
 	// stored in latestVersion.pathsToRemove. So this needs to be called for complete cleanup even if the root terraform directory is deleted.
 	defer func() {
@@ -138,21 +262,47 @@ func (e *executor) Delete(ctx context.Context, options Options) error {
 		return err
 	}
 
+	credentials, err := e.setModuleSourceCredentials(tf, options)
+	if err != nil {
+		return err
+	}
+
+	backend, err := e.newBackend(options)
+	if err != nil {
+		return err
+	}
+
 	// Create Terraform config in the working directory
-	kubernetesBackendSuffix, err := e.generateConfig(ctx, tf, options)
+	kubernetesBackendSuffix, loadedModule, err := e.generateConfig(ctx, tf, options, backend)
 	if err != nil {
 		return err
 	}
 
-	// Before running terraform init and destroy, ensure that the Terraform state file storage source exists.
-	// If the state file source has been deleted or wasn't created due to a failure during apply then
-	// terraform initialization will fail due to missing backend source.
-	kubernetesClient, err := e.kubernetesClients.ClientGoClient()
+	workspace, err := backendWorkspace(backend, options)
 	if err != nil {
-		return fmt.Errorf("error getting kubernetes client: %w", err)
+		return err
+	}
+
+	// Use the plugin cache for the providers required by the module. Environment variables of the recipe
+	// configuration are not used to destroy the resources, so only the plugin cache, the state encryption and the
+	// credentials of the module sources are configured.
+	plugins := e.cache.acquire(ctx, cacheKindPlugins, pluginCacheKey(loadedModule.RequiredProviders))
+	defer plugins.release(ctx, false)
+
+	env, err := additionalEnv(options, plugins, credentials)
+	if err != nil {
+		return err
 	}
 
-	backendExists, err := backends.NewKubernetesBackend(kubernetesClient).ValidateBackendExists(ctx, backends.KubernetesBackendNamePrefix+kubernetesBackendSuffix)
+	err = e.setEnvironmentVariables(tf, Options{}, env)
+	if err != nil {
+		return err
+	}
+
+	// Before running terraform init and destroy, ensure that the Terraform state file storage source exists.
+	// If the state file source has been deleted or wasn't created due to a failure during apply then
+	// terraform initialization will fail due to missing backend source.
+	backendExists, err := backend.ValidateBackendExists(ctx, backends.KubernetesBackendNamePrefix+kubernetesBackendSuffix)
 	if err != nil {
 		// Continue with the delete flow for all errors other than backend not found.
 		// If it is an intermittent error then the delete flow will fail and should be retried from the client.
@@ -164,11 +314,22 @@ func (e *executor) Delete(ctx context.Context, options Options) error {
 	}
 
 	// Run TF Destroy in the working directory to delete the resources deployed by the recipe
-	err = initAndDestroy(ctx, tf)
+	err = initAndDestroy(ctx, tf, plugins, workspace)
 	if err != nil {
 		return err
 	}
 
+	// The state of other backends is left empty by Terraform destroy, and the workspace of the state, if any,
+	// is deleted by initAndDestroy.
+	if backends.Kind(backendConfig(options)) != dm.TerraformBackendKubernetes {
+		return nil
+	}
+
+	kubernetesClient, err := e.kubernetesClients.ClientGoClient()
+	if err != nil {
+		return fmt.Errorf("error getting kubernetes client: %w", err)
+	}
+
 	// Delete the kubernetes secret created for terraform state file.
 	err = kubernetesClient.CoreV1().
 		Secrets(backends.RadiusNamespace).
@@ -180,12 +341,77 @@ func (e *executor) Delete(ctx context.Context, options Options) error {
 	return nil
 }
 
+// Plan installs Terraform, creates a working directory, generates a config, and runs Terraform init and plan
+// in the working directory, returning the plan or an error if any of these steps fail. Nothing is changed by
+// the plan. The plan does not take the Terraform state lock, so it does not block or fail deployments of the recipe
+// that run at the same time.
+func (e *executor) Plan(ctx context.Context, options Options) (*tfjson.Plan, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	// Install Terraform
+	i := install.NewInstaller()
+	tf, err := Install(ctx, i, options.RootDir, e.installOptionsFor(options))
+	// The terraform zip for installation is downloaded in a location outside of the install directory and is only accessible through the installer.Remove function -
+	// stored in latestVersion.pathsToRemove. So this needs to be called for complete cleanup even if the root terraform directory is deleted.
+	defer func() {
+		if err := i.Remove(ctx); err != nil {
+			logger.Info(fmt.Sprintf("Failed to cleanup Terraform installation: %s", err.Error()))
+		}
+	}()
+	if err != nil {
+		return nil, err
+	}
+
+	credentials, err := e.setModuleSourceCredentials(tf, options)
+	if err != nil {
+		return nil, err
+	}
+
+	backend, err := e.newBackend(options)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create Terraform config in the working directory
+	_, loadedModule, err := e.generateConfig(ctx, tf, options, backend)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateParameters(options, loadedModule)
+	if err != nil {
+		return nil, err
+	}
+
+	workspace, err := backendWorkspace(backend, options)
+	if err != nil {
+		return nil, err
+	}
+
+	plugins := e.cache.acquire(ctx, cacheKindPlugins, pluginCacheKey(loadedModule.RequiredProviders))
+	defer plugins.release(ctx, false)
+
+	// The providers read the current state of the resources while planning, so the environment variables of the
+	// recipe configuration are used like for a deployment.
+	env, err := additionalEnv(options, plugins, credentials)
+	if err != nil {
+		return nil, err
+	}
+
+	err = e.setEnvironmentVariables(tf, options, env)
+	if err != nil {
+		return nil, err
+	}
+
+	return initAndPlan(ctx, tf, plugins, workspace)
+}
+
 func (e *executor) GetRecipeMetadata(ctx context.Context, options Options) (map[string]any, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
 	// Install Terraform
 	i := install.NewInstaller()
-	tf, err := Install(ctx, i, options.RootDir)
+	tf, err := Install(ctx, i, options.RootDir, e.installOptionsFor(options))
 	// The terraform zip for installation is downloaded in a location outside of the install directory and is only accessible through the installer.Remove function -
 	// stored in latestVersion.pathsToRemove. So this needs to be called for complete cleanup even if the root terraform directory is deleted.
 	defer func() {
@@ -197,12 +423,16 @@ func (e *executor) GetRecipeMetadata(ctx context.Context, options Options) (map[
 		return nil, err
 	}
 
+	if _, err := e.setModuleSourceCredentials(tf, options); err != nil {
+		return nil, err
+	}
+
 	_, err = getTerraformConfig(ctx, tf.WorkingDir(), options)
 	if err != nil {
 		return nil, err
 	}
 
-	result, err := downloadAndInspect(ctx, tf, options)
+	result, err := downloadAndInspect(ctx, tf, options, e.cache)
 	if err != nil {
 		return nil, err
 	}
@@ -212,18 +442,144 @@ func (e *executor) GetRecipeMetadata(ctx context.Context, options Options) (map[
 	}, nil
 }
 
-// setEnvironmentVariables sets environment variables for the Terraform process by reading values from the recipe configuration.
-// Terraform process will use environment variables as input for the recipe deployment.
-func (e executor) setEnvironmentVariables(tf *tfexec.Terraform, options Options) error {
+// validateParameters validates the parameters of the recipe against the variables of the loaded module, so invalid
+// parameters are reported before Terraform runs.
+func validateParameters(options Options, loadedModule *moduleInspectResult) error {
+	return recipeparameters.Validate(map[string]any{"parameters": loadedModule.Parameters}, options.EnvRecipe, options.ResourceRecipe)
+}
+
+// installOptionsFor returns the options to install Terraform for an execution, using the version and distribution of
+// Terraform configured for the environment.
+func (e *executor) installOptionsFor(options Options) InstallOptions {
+	installOptions := e.installOptions
+	if options.EnvConfig != nil {
+		installOptions.Version = options.EnvConfig.RecipeConfig.Terraform.Version
+		installOptions.Distribution = options.EnvConfig.RecipeConfig.Terraform.Distribution
+	}
+
+	return installOptions
+}
+
+// backendConfig returns the configuration of the backend that stores the Terraform state, as configured for the environment.
+func backendConfig(options Options) dm.TerraformBackendConfig {
 	if options.EnvConfig == nil {
-		return nil
+		return dm.TerraformBackendConfig{}
+	}
+
+	return options.EnvConfig.RecipeConfig.Terraform.Backend
+}
+
+// newBackend creates the backend that stores the Terraform state of the recipe. The Kubernetes client is only
+// retrieved for the Kubernetes backend.
+func (e *executor) newBackend(options Options) (backends.Backend, error) {
+	// Recipes that handle custom actions operate on existing infrastructure and do not own the infrastructure they
+	// deploy, so they run with a throwaway state that is removed with the working directory.
+	if options.ResourceRecipe != nil && options.ResourceRecipe.Action != "" {
+		return backends.NewLocalBackend(), nil
+	}
+
+	config := backendConfig(options)
+
+	var kubernetesClient kubernetes.Interface
+	if backends.Kind(config) == dm.TerraformBackendKubernetes {
+		client, err := e.kubernetesClients.ClientGoClient()
+		if err != nil {
+			return nil, fmt.Errorf("error getting kubernetes client: %w", err)
+		}
+		kubernetesClient = client
+	}
+
+	return backends.NewBackend(config, options.Secrets, kubernetesClient)
+}
+
+// backendWorkspace returns the Terraform workspace that stores the state of the recipe, or an empty string if the
+// backend stores the state in the default workspace.
+func backendWorkspace(backend backends.Backend, options Options) (string, error) {
+	workspaceBackend, ok := backend.(backends.WorkspaceBackend)
+	if !ok {
+		return "", nil
+	}
+
+	return workspaceBackend.Workspace(options.ResourceRecipe)
+}
+
+// selectWorkspace selects the Terraform workspace after Terraform is initialized. If create is true, the workspace is
+// created if it does not exist. It returns false if the workspace does not exist and was not created.
+func selectWorkspace(ctx context.Context, tf *tfexec.Terraform, workspace string, create bool) (bool, error) {
+	if workspace == "" {
+		return true, nil
 	}
 
+	workspaces, _, err := tf.WorkspaceList(ctx)
+	if err != nil {
+		return false, fmt.Errorf("terraform workspace list failure: %w", err)
+	}
+
+	if slices.Contains(workspaces, workspace) {
+		if err := tf.WorkspaceSelect(ctx, workspace); err != nil {
+			return false, fmt.Errorf("terraform workspace select failure: %w", err)
+		}
+		return true, nil
+	}
+
+	if !create {
+		return false, nil
+	}
+
+	if err := tf.WorkspaceNew(ctx, workspace); err != nil {
+		return false, fmt.Errorf("terraform workspace new failure: %w", err)
+	}
+
+	return true, nil
+}
+
+// additionalEnv returns the environment variables set for every Terraform process of the recipe, in addition to the
+// environment variables of the recipe configuration: the plugin cache, the state encryption of OpenTofu and the
+// credentials of the module sources.
+func additionalEnv(options Options, plugins *cacheLease, credentials map[string]string) (map[string]string, error) {
+	env, err := stateEncryptionEnv(options)
+	if err != nil {
+		return nil, err
+	}
+
+	maps.Copy(env, pluginCacheEnv(plugins))
+	maps.Copy(env, credentials)
+	return env, nil
+}
+
+// setModuleSourceCredentials sets the credentials of the module sources for the Terraform process, so the module can
+// be downloaded. It returns the environment variables of the credentials, which are kept when the environment
+// variables of the Terraform process are set again.
+func (e executor) setModuleSourceCredentials(tf *tfexec.Terraform, options Options) (map[string]string, error) {
+	credentials, err := moduleSourceCredentialsEnv(options)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := e.setEnvironmentVariables(tf, Options{}, credentials); err != nil {
+		return nil, err
+	}
+
+	return credentials, nil
+}
+
+// setEnvironmentVariables sets environment variables for the Terraform process by reading values from the recipe configuration,
+// in addition to the given environment variables. Terraform process will use environment variables as input for the recipe deployment.
+func (e executor) setEnvironmentVariables(tf *tfexec.Terraform, options Options, additionalEnvVars map[string]string) error {
 	// Populate envVars with the environment variables from current process
 	envVars := splitEnvVar(os.Environ())
-	recipeConfig := &options.EnvConfig.RecipeConfig
 	var envVarUpdate bool
 
+	for key, value := range additionalEnvVars {
+		envVarUpdate = true
+		envVars[key] = value
+	}
+
+	if options.EnvConfig == nil {
+		return setEnv(tf, envVars, envVarUpdate)
+	}
+
+	recipeConfig := &options.EnvConfig.RecipeConfig
 	if len(recipeConfig.Env.AdditionalProperties) > 0 {
 		envVarUpdate = true
 		for key, value := range recipeConfig.Env.AdditionalProperties {
@@ -247,7 +603,11 @@ func (e executor) setEnvironmentVariables(tf *tfexec.Terraform, options Options)
 		}
 	}
 
-	// Set the environment variables for the Terraform process
+	return setEnv(tf, envVars, envVarUpdate)
+}
+
+// setEnv sets the environment variables for the Terraform process if they were updated.
+func setEnv(tf *tfexec.Terraform, envVars map[string]string, envVarUpdate bool) error {
 	if envVarUpdate {
 		if err := tf.SetEnv(envVars); err != nil {
 			return fmt.Errorf("failed to set environment variables: %w", err)
@@ -271,39 +631,35 @@ func splitEnvVar(envVars []string) map[string]string {
 }
 
 // generateConfig generates Terraform configuration with required inputs for the module, providers and backend to be initialized and applied.
-func (e *executor) generateConfig(ctx context.Context, tf *tfexec.Terraform, options Options) (string, error) {
+// It returns the secret suffix of the Kubernetes backend and the result of inspecting the module.
+func (e *executor) generateConfig(ctx context.Context, tf *tfexec.Terraform, options Options, backend backends.Backend) (string, *moduleInspectResult, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 	workingDir := tf.WorkingDir()
 
 	tfConfig, err := getTerraformConfig(ctx, workingDir, options)
 	if err != nil {
-		return "", err
+		return "", nil, err
 	}
 
-	loadedModule, err := downloadAndInspect(ctx, tf, options)
+	loadedModule, err := downloadAndInspect(ctx, tf, options, e.cache)
 	if err != nil {
-		return "", err
+		return "", nil, err
 	}
 
 	// Generate Terraform providers configuration for required providers and add it to the Terraform configuration.
 	logger.Info(fmt.Sprintf("Adding provider config for required providers %+v", loadedModule.RequiredProviders))
 	if err := tfConfig.AddProviders(ctx, loadedModule.RequiredProviders, providers.GetUCPConfiguredTerraformProviders(e.ucpConn, e.secretProvider),
 		options.EnvConfig, options.Secrets); err != nil {
-		return "", err
-	}
-
-	kubernetesClient, err := e.kubernetesClients.ClientGoClient()
-	if err != nil {
-		return "", fmt.Errorf("error getting kubernetes client: %w", err)
+		return "", nil, err
 	}
 
-	backendConfig, err := tfConfig.AddTerraformBackend(options.ResourceRecipe, backends.NewKubernetesBackend(kubernetesClient))
+	backendConfig, err := tfConfig.AddTerraformBackend(options.ResourceRecipe, backend)
 	if err != nil {
-		return "", err
+		return "", nil, err
 	}
 
 	// Retrieving the secret_suffix property from backend config to use it to verify secret creation during terraform init.
-	// This is only used for the backend of type kubernetes and should be moved inside an if block when we add more backends.
+	// This is only set for the backend of type kubernetes.
 	var secretSuffix string
 	if backendDetails, ok := backendConfig[backends.BackendKubernetes]; ok {
 		backendMap := backendDetails.(map[string]any)
@@ -320,16 +676,16 @@ func (e *executor) generateConfig(ctx context.Context, tf *tfexec.Terraform, opt
 		// Create the recipe context object to be passed to the recipe deployment
 		recipectx, err := recipecontext.New(options.ResourceRecipe, options.EnvConfig)
 		if err != nil {
-			return "", err
+			return "", nil, err
 		}
 
 		if err = tfConfig.AddRecipeContext(ctx, options.EnvRecipe.Name, recipectx); err != nil {
-			return "", err
+			return "", nil, err
 		}
 	}
 	if loadedModule.ResultOutputExists {
 		if err = tfConfig.AddOutputs(options.EnvRecipe.Name); err != nil {
-			return "", err
+			return "", nil, err
 		}
 	}
 
@@ -337,10 +693,10 @@ func (e *executor) generateConfig(ctx context.Context, tf *tfexec.Terraform, opt
 
 	// Ensure that we need to save the configuration after adding providers and recipecontext.
 	if err := tfConfig.Save(ctx, workingDir); err != nil {
-		return "", err
+		return "", nil, err
 	}
 
-	return secretSuffix, nil
+	return secretSuffix, loadedModule, nil
 }
 
 // getTerraformConfig initializes the Terraform json config with provided module source and saves it
@@ -370,8 +726,11 @@ func getTerraformConfig(ctx context.Context, workingDir string, options Options)
 	return tfConfig, nil
 }
 
-// initAndApply runs Terraform init and apply in the provided working directory.
-func initAndApply(ctx context.Context, tf *tfexec.Terraform) (*tfjson.State, error) {
+// initAndApply runs Terraform init and apply in the provided working directory. The plugin cache entry is unlocked
+// once Terraform is initialized, so other executions can use it while Terraform applies the configuration.
+// If a workspace is given, the configuration is applied in the workspace, which is created if it does not exist.
+// Terraform is interrupted rather than killed if ctx is canceled while applying, so it saves the state.
+func initAndApply(ctx context.Context, tf *tfexec.Terraform, plugins *cacheLease, workspace string) (*tfjson.State, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
 	// Initialize Terraform
@@ -385,10 +744,15 @@ func initAndApply(ctx context.Context, tf *tfexec.Terraform) (*tfjson.State, err
 	}
 	metrics.DefaultRecipeEngineMetrics.RecordTerraformInitializationDuration(ctx, terraformInitStartTime,
 		[]attribute.KeyValue{metrics.OperationStateAttrKey.String(metrics.SuccessfulOperationState)})
+	plugins.unlock(ctx, true)
+
+	if _, err := selectWorkspace(ctx, tf, workspace, true); err != nil {
+		return nil, err
+	}
 
 	// Apply Terraform configuration
 	logger.Info("Running Terraform apply")
-	if err := tf.Apply(ctx); err != nil {
+	if err := runInterruptible(ctx, tf.WorkingDir(), func(ctx context.Context) error { return tf.Apply(ctx) }); err != nil {
 		return nil, fmt.Errorf("terraform apply failure: %w", err)
 	}
 
@@ -397,8 +761,123 @@ func initAndApply(ctx context.Context, tf *tfexec.Terraform) (*tfjson.State, err
 	return tf.Show(ctx)
 }
 
-// initAndDestroy runs Terraform init and destroy in the provided working directory.
-func initAndDestroy(ctx context.Context, tf *tfexec.Terraform) error {
+// initAndImport runs Terraform init and imports the resources into the module of the recipe in the provided working
+// directory. If a workspace is given, the resources are imported in the workspace, which is created if it does not exist.
+// Resources that are already in the state, e.g. imported by a previous attempt that failed, are not imported again.
+func initAndImport(ctx context.Context, tf *tfexec.Terraform, plugins *cacheLease, workspace string, moduleName string, resources []recipes.ImportedResource) (*tfjson.State, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	// Initialize Terraform
+	logger.Info("Initializing Terraform")
+	terraformInitStartTime := time.Now()
+	if err := tf.Init(ctx); err != nil {
+		metrics.DefaultRecipeEngineMetrics.RecordTerraformInitializationDuration(ctx, terraformInitStartTime,
+			[]attribute.KeyValue{metrics.OperationStateAttrKey.String(metrics.FailedOperationState)})
+
+		return nil, fmt.Errorf("terraform init failure: %w", err)
+	}
+	metrics.DefaultRecipeEngineMetrics.RecordTerraformInitializationDuration(ctx, terraformInitStartTime,
+		[]attribute.KeyValue{metrics.OperationStateAttrKey.String(metrics.SuccessfulOperationState)})
+	plugins.unlock(ctx, true)
+
+	if _, err := selectWorkspace(ctx, tf, workspace, true); err != nil {
+		return nil, err
+	}
+
+	state, err := tf.Show(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("terraform show failure: %w", err)
+	}
+	imported := stateResourceAddresses(state)
+
+	for _, resource := range resources {
+		address := fmt.Sprintf("module.%s.%s", moduleName, resource.Address)
+		if imported[address] {
+			logger.Info(fmt.Sprintf("Skipping import of %q: the resource is already in the Terraform state", address))
+			continue
+		}
+
+		logger.Info(fmt.Sprintf("Running Terraform import of %q", address))
+		recipelogs.FromContext(ctx).Recordf(recipelogs.SourceEngine, "Importing resource %q as %q", resource.ID, address)
+		if err := runInterruptible(ctx, tf.WorkingDir(), func(ctx context.Context) error { return tf.Import(ctx, address, resource.ID) }); err != nil {
+			return nil, fmt.Errorf("terraform import failure for %q: %w", address, err)
+		}
+	}
+
+	// Load Terraform state to retrieve the imported resources
+	logger.Info("Fetching Terraform state")
+	return tf.Show(ctx)
+}
+
+// stateResourceAddresses returns the addresses of the resources in the Terraform state, including the resources of
+// child modules.
+func stateResourceAddresses(state *tfjson.State) map[string]bool {
+	addresses := map[string]bool{}
+	if state == nil || state.Values == nil {
+		return addresses
+	}
+
+	modules := []*tfjson.StateModule{state.Values.RootModule}
+	for len(modules) > 0 {
+		module := modules[0]
+		modules = modules[1:]
+		if module == nil {
+			continue
+		}
+
+		for _, resource := range module.Resources {
+			addresses[resource.Address] = true
+		}
+		modules = append(modules, module.ChildModules...)
+	}
+
+	return addresses
+}
+
+// initAndPlan runs Terraform init and plan in the provided working directory, and returns the plan read from the
+// plan file. The plan is created in the workspace if it exists. Otherwise the recipe was never deployed, and the
+// plan is created in the default workspace, which holds no state, so that the workspace is not created by a plan.
+// The plan is only read, so it is created without locking the state.
+func initAndPlan(ctx context.Context, tf *tfexec.Terraform, plugins *cacheLease, workspace string) (*tfjson.Plan, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	// Initialize Terraform
+	logger.Info("Initializing Terraform")
+	terraformInitStartTime := time.Now()
+	if err := tf.Init(ctx); err != nil {
+		metrics.DefaultRecipeEngineMetrics.RecordTerraformInitializationDuration(ctx, terraformInitStartTime,
+			[]attribute.KeyValue{metrics.OperationStateAttrKey.String(metrics.FailedOperationState)})
+
+		return nil, fmt.Errorf("terraform init failure: %w", err)
+	}
+	metrics.DefaultRecipeEngineMetrics.RecordTerraformInitializationDuration(ctx, terraformInitStartTime,
+		[]attribute.KeyValue{metrics.OperationStateAttrKey.String(metrics.SuccessfulOperationState)})
+	plugins.unlock(ctx, true)
+
+	if _, err := selectWorkspace(ctx, tf, workspace, false); err != nil {
+		return nil, err
+	}
+
+	logger.Info("Running Terraform plan")
+	planFile := filepath.Join(tf.WorkingDir(), planFileName)
+	if _, err := tf.Plan(ctx, tfexec.Out(planFile), tfexec.Lock(false)); err != nil {
+		return nil, fmt.Errorf("terraform plan failure: %w", err)
+	}
+
+	plan, err := tf.ShowPlanFile(ctx, planFile)
+	if err != nil {
+		return nil, fmt.Errorf("terraform show failure: %w", err)
+	}
+
+	return plan, nil
+}
+
+// initAndDestroy runs Terraform init and destroy in the provided working directory. The plugin cache entry is unlocked
+// once Terraform is initialized, so other executions can use it while Terraform destroys the resources.
+// If a workspace is given, the resources of the workspace are destroyed and the workspace is deleted. Nothing is
+// destroyed if the workspace does not exist. Terraform is interrupted rather than killed if ctx is canceled while
+// destroying, so it saves the state.
+func initAndDestroy(ctx context.Context, tf *tfexec.Terraform, plugins *cacheLease, workspace string) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
 	// Initialize Terraform
@@ -411,12 +890,31 @@ func initAndDestroy(ctx context.Context, tf *tfexec.Terraform) error {
 		return fmt.Errorf("terraform init failure: %w", err)
 	}
 	metrics.DefaultRecipeEngineMetrics.RecordTerraformInitializationDuration(ctx, terraformInitStartTime, nil)
+	plugins.unlock(ctx, true)
+
+	found, err := selectWorkspace(ctx, tf, workspace, false)
+	if err != nil {
+		return err
+	} else if !found {
+		logger.Info(fmt.Sprintf("Skipping deletion of recipe resources: Terraform workspace %q does not exist.", workspace))
+		return nil
+	}
 
 	// Destroy Terraform configuration
 	logger.Info("Running Terraform destroy")
-	if err := tf.Destroy(ctx); err != nil {
+	if err := runInterruptible(ctx, tf.WorkingDir(), func(ctx context.Context) error { return tf.Destroy(ctx) }); err != nil {
 		return fmt.Errorf("terraform destroy failure: %w", err)
 	}
 
+	if workspace != "" {
+		// The current workspace cannot be deleted, so the default workspace is selected first.
+		if err := tf.WorkspaceSelect(ctx, defaultWorkspace); err != nil {
+			return fmt.Errorf("terraform workspace select failure: %w", err)
+		}
+		if err := tf.WorkspaceDelete(ctx, workspace); err != nil {
+			return fmt.Errorf("terraform workspace delete failure: %w", err)
+		}
+	}
+
 	return nil
 }
@@ -22,6 +22,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-exec/tfexec"
+	tfjson "github.com/hashicorp/terraform-json"
 	dm "github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/recipes/terraform/config"
@@ -57,7 +58,7 @@ func TestGenerateConfig(t *testing.T) {
 			require.NoError(t, err)
 
 			e := executor{}
-			_, err = e.generateConfig(ctx, tf, tc.opts)
+			_, _, err = e.generateConfig(ctx, tf, tc.opts, nil)
 			require.Error(t, err)
 			require.ErrorContains(t, err, tc.err)
 		})
@@ -244,7 +245,7 @@ func TestSetEnvironmentVariables(t *testing.T) {
 			require.NoError(t, err)
 
 			e := executor{}
-			err = e.setEnvironmentVariables(tf, tc.opts)
+			err = e.setEnvironmentVariables(tf, tc.opts, nil)
 
 			if tc.wantErr {
 				require.Error(t, err)
@@ -301,3 +302,117 @@ func TestSplitEnvVar(t *testing.T) {
 		})
 	}
 }
+
+func Test_NewBackend(t *testing.T) {
+	secretStoreID := "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/secretStores/postgres"
+	options := Options{
+		EnvConfig: &recipes.Configuration{
+			RecipeConfig: dm.RecipeConfigProperties{
+				Terraform: dm.TerraformConfigProperties{
+					Backend: dm.TerraformBackendConfig{
+						Kind: dm.TerraformBackendPostgres,
+						Secrets: map[string]dm.SecretReference{
+							"conn_str": {Source: secretStoreID, Key: "connectionString"},
+						},
+					},
+				},
+			},
+		},
+		ResourceRecipe: &recipes.ResourceMetadata{
+			EnvironmentID: "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/env",
+			ApplicationID: "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/app",
+			ResourceID:    "/planes/radius/local/resourceGroups/test-group/providers/Applications.Datastores/redisCaches/redis",
+		},
+		Secrets: map[string]recipes.SecretData{
+			secretStoreID: {Data: map[string]string{"connectionString": "postgres://postgres.default.svc/tfstate"}},
+		},
+	}
+
+	// The Kubernetes client is not needed for backends other than Kubernetes.
+	e := executor{}
+	backend, err := e.newBackend(options)
+	require.NoError(t, err)
+
+	backendConfig, err := backend.BuildBackend(options.ResourceRecipe)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"pg": map[string]any{"conn_str": "postgres://postgres.default.svc/tfstate"}}, backendConfig)
+
+	workspace, err := backendWorkspace(backend, options)
+	require.NoError(t, err)
+	require.Len(t, workspace, 40)
+
+	options.Secrets = nil
+	_, err = e.newBackend(options)
+	require.EqualError(t, err, "missing secret source: "+secretStoreID)
+}
+
+func Test_NewBackend_Action(t *testing.T) {
+	options := Options{
+		EnvConfig: &recipes.Configuration{
+			RecipeConfig: dm.RecipeConfigProperties{
+				Terraform: dm.TerraformConfigProperties{
+					Backend: dm.TerraformBackendConfig{Kind: dm.TerraformBackendKubernetes},
+				},
+			},
+		},
+		ResourceRecipe: &recipes.ResourceMetadata{
+			EnvironmentID: "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/env",
+			ApplicationID: "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/app",
+			ResourceID:    "/planes/radius/local/resourceGroups/test-group/providers/Applications.Datastores/redisCaches/redis",
+			Action:        "rotateCredentials",
+		},
+	}
+
+	// Each run of the action stores its state in its own working directory, so running the action twice does not
+	// leave state behind in the backend of the environment. The Kubernetes client is not needed.
+	e := executor{}
+	for range 2 {
+		backend, err := e.newBackend(options)
+		require.NoError(t, err)
+
+		backendConfig, err := backend.BuildBackend(options.ResourceRecipe)
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{"local": map[string]any{"path": "terraform.tfstate"}}, backendConfig)
+
+		workspace, err := backendWorkspace(backend, options)
+		require.NoError(t, err)
+		require.Empty(t, workspace)
+	}
+}
+
+func Test_StateResourceAddresses(t *testing.T) {
+	require.Empty(t, stateResourceAddresses(nil))
+	require.Empty(t, stateResourceAddresses(&tfjson.State{}))
+
+	state := &tfjson.State{
+		Values: &tfjson.StateValues{
+			RootModule: &tfjson.StateModule{
+				Resources: []*tfjson.StateResource{
+					{Address: "random_id.suffix"},
+				},
+				ChildModules: []*tfjson.StateModule{
+					{
+						Address: "module.redis",
+						Resources: []*tfjson.StateResource{
+							{Address: "module.redis.azurerm_redis_cache.cache"},
+						},
+						ChildModules: []*tfjson.StateModule{
+							{
+								Address: "module.redis.module.network",
+								Resources: []*tfjson.StateResource{
+									{Address: "module.redis.module.network.azurerm_subnet.subnet"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	require.Equal(t, map[string]bool{
+		"random_id.suffix":                                  true,
+		"module.redis.azurerm_redis_cache.cache":            true,
+		"module.redis.module.network.azurerm_subnet.subnet": true,
+	}, stateResourceAddresses(state))
+}
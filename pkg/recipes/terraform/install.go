@@ -18,12 +18,16 @@ package terraform
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"sync"
 	"time"
 
+	"github.com/hashicorp/go-version"
 	install "github.com/hashicorp/hc-install"
+	"github.com/hashicorp/hc-install/fs"
 	"github.com/hashicorp/hc-install/product"
 	"github.com/hashicorp/hc-install/releases"
 	"github.com/hashicorp/hc-install/src"
@@ -37,35 +41,98 @@ const (
 	installSubDir                     = "install"
 	installVerificationRetryCount     = 5
 	installVerificationRetryDelaySecs = 3
+	latestVersion                     = "latest"
 )
 
-// Install installs Terraform under /install in the provided Terraform root directory for the resource. It installs
-// the latest version of Terraform and returns the path to the installed Terraform executable. It returns an error
-// if the directory creation or Terraform installation fails.
-func Install(ctx context.Context, installer *install.Installer, tfDir string) (*tfexec.Terraform, error) {
+var (
+	// installedBinaries caches the path of each verified Terraform binary by the options used to install it, so that
+	// Terraform is installed and verified once per process rather than once per recipe execution.
+	installedBinaries = map[InstallOptions]string{}
+
+	// installLocks serializes the installations of Terraform with the same options, so concurrent recipe executions
+	// do not download or verify the same binary more than once. Installations with different options run concurrently.
+	installLocks = map[InstallOptions]*sync.Mutex{}
+
+	// installMutex guards installedBinaries and installLocks. It is never held while Terraform is installed.
+	installMutex sync.Mutex
+)
+
+// InstallOptions represents the options to install Terraform for a recipe execution.
+type InstallOptions struct {
+	// Version is the exact version of Terraform to use, e.g. "1.7.5". The latest version is used if empty.
+	Version string
+
+	// BinaryPath is the path to a pre-installed Terraform binary. Terraform is never downloaded when it is set.
+	BinaryPath string
+
+	// MirrorDir is the path to a directory of pre-installed Terraform binaries, with the binary of each version at
+	// <MirrorDir>/<Version>/terraform and the binary used when no version is configured at <MirrorDir>/terraform.
+	// OpenTofu binaries are named tofu in the same layout. Terraform is never downloaded when it is set.
+	MirrorDir string
+
+	// CacheDir is the directory where downloaded Terraform binaries are cached across recipe executions. Terraform
+	// is downloaded for every execution if it is empty.
+	CacheDir string
+
+	// Distribution is the distribution of Terraform to install, e.g. "opentofu". Terraform is installed if empty.
+	Distribution string
+
+	// TofuBinaryPath is the path to a pre-installed OpenTofu binary. OpenTofu is never downloaded when it is set.
+	TofuBinaryPath string
+
+	// TofuSigningKeyPath is the path to the armored OpenTofu release signing public key. The checksums of downloaded
+	// OpenTofu releases are verified against it, and OpenTofu is never downloaded when it is empty.
+	TofuSigningKeyPath string
+}
+
+// Install returns a Terraform executor for the provided Terraform root directory of the resource. Terraform is
+// resolved from the pre-installed binary or the mirror directory when configured, and downloaded otherwise. The
+// binary is verified and cached the first time it is used, so subsequent executions with the same options reuse it.
+// It returns an error if the binary cannot be found, downloaded or verified, or if its version does not match the
+// requested version.
+func Install(ctx context.Context, installer *install.Installer, tfDir string, options InstallOptions) (*tfexec.Terraform, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
-	// Create Terraform installation directory
-	installDir := filepath.Join(tfDir, installSubDir)
-	if err := os.MkdirAll(installDir, 0755); err != nil {
-		return nil, fmt.Errorf("failed to create directory for terraform installation for resource: %w", err)
+	var requiredVersion *version.Version
+	if options.Version != "" {
+		v, err := version.NewVersion(options.Version)
+		if err != nil {
+			return nil, fmt.Errorf("invalid Terraform version %q: %w", options.Version, err)
+		}
+		requiredVersion = v
 	}
 
-	logger.Info(fmt.Sprintf("Installing Terraform in the directory: %q", installDir))
+	// Downloaded binaries are only shared when they are stored outside of the directory of the execution. Otherwise
+	// each execution installs Terraform in its own directory, so there is nothing to serialize.
+	shared := isSharedInstall(options)
+	if shared {
+		lock := installLock(options)
+		lock.Lock()
+		defer lock.Unlock()
+
+		if execPath, ok := installedBinary(options); ok {
+			logger.Info(fmt.Sprintf("Using cached Terraform installation: %q", execPath))
+			tf, err := NewTerraform(ctx, tfDir, execPath)
+			if err != nil {
+				return nil, err
+			}
+
+			configureTerraformLogs(ctx, tf)
+			return tf, nil
+		}
+	}
+
+	versionAttr := options.Version
+	if versionAttr == "" {
+		versionAttr = latestVersion
+	}
 
 	installStartTime := time.Now()
-	// Re-visit this: consider checking if an existing installation of same version of Terraform is available.
-	// For initial iteration we will always install Terraform for every execution of the recipe driver.
-	execPath, err := installer.Ensure(ctx, []src.Source{
-		&releases.LatestVersion{
-			Product:    product.Terraform,
-			InstallDir: installDir,
-		},
-	})
+	execPath, err := ensureBinary(ctx, installer, tfDir, options, requiredVersion)
 	if err != nil {
 		metrics.DefaultRecipeEngineMetrics.RecordTerraformInstallationDuration(ctx, installStartTime,
 			[]attribute.KeyValue{
-				metrics.TerraformVersionAttrKey.String("latest"),
+				metrics.TerraformVersionAttrKey.String(versionAttr),
 				metrics.OperationStateAttrKey.String(metrics.FailedOperationState),
 			},
 		)
@@ -74,12 +141,12 @@ func Install(ctx context.Context, installer *install.Installer, tfDir string) (*
 
 	metrics.DefaultRecipeEngineMetrics.RecordTerraformInstallationDuration(ctx, installStartTime,
 		[]attribute.KeyValue{
-			metrics.TerraformVersionAttrKey.String("latest"),
+			metrics.TerraformVersionAttrKey.String(versionAttr),
 			metrics.OperationStateAttrKey.String(metrics.SuccessfulOperationState),
 		},
 	)
 
-	logger.Info(fmt.Sprintf("Terraform latest version installed to: %q", execPath))
+	logger.Info(fmt.Sprintf("Terraform %s version installed to: %q", versionAttr, execPath))
 
 	// Create a new instance of tfexec.Terraform with current Terraform installation path
 	tf, err := NewTerraform(ctx, tfDir, execPath)
@@ -88,12 +155,13 @@ func Install(ctx context.Context, installer *install.Installer, tfDir string) (*
 	}
 
 	// Verify Terraform installation is complete before proceeding
+	var installedVersion *version.Version
 	for attempt := 0; attempt <= installVerificationRetryCount; attempt++ {
-		_, _, err = tf.Version(ctx, false)
+		installedVersion, _, err = tf.Version(ctx, false)
 		if err == nil {
 			metrics.DefaultRecipeEngineMetrics.RecordTerraformInstallVerificationDuration(ctx, installStartTime,
 				[]attribute.KeyValue{
-					metrics.TerraformVersionAttrKey.String("latest"),
+					metrics.TerraformVersionAttrKey.String(versionAttr),
 					metrics.OperationStateAttrKey.String(metrics.SuccessfulOperationState),
 				},
 			)
@@ -103,7 +171,7 @@ func Install(ctx context.Context, installer *install.Installer, tfDir string) (*
 			logger.Info(fmt.Sprintf("Failed to verify Terraform installation completion: %s. Retrying after %d seconds", err.Error(), installVerificationRetryDelaySecs))
 			metrics.DefaultRecipeEngineMetrics.RecordTerraformInstallVerificationDuration(ctx, installStartTime,
 				[]attribute.KeyValue{
-					metrics.TerraformVersionAttrKey.String("latest"),
+					metrics.TerraformVersionAttrKey.String(versionAttr),
 					metrics.OperationStateAttrKey.String(metrics.FailedOperationState),
 				},
 			)
@@ -113,8 +181,118 @@ func Install(ctx context.Context, installer *install.Installer, tfDir string) (*
 		return nil, fmt.Errorf("failed to verify Terraform installation completion after %d attempts. Error: %s", installVerificationRetryCount, err.Error())
 	}
 
+	if requiredVersion != nil && !installedVersion.Equal(requiredVersion) {
+		return nil, fmt.Errorf("terraform binary %q has version %s, but version %s is required", execPath, installedVersion, requiredVersion)
+	}
+
+	if shared {
+		installMutex.Lock()
+		installedBinaries[options] = execPath
+		installMutex.Unlock()
+	}
+
 	// Configure Terraform logs once Terraform installation is complete
 	configureTerraformLogs(ctx, tf)
 
 	return tf, nil
 }
+
+// isSharedInstall determines if the Terraform binary for the provided options is stored outside of the directory of
+// the execution, so that it can be reused by other executions.
+func isSharedInstall(options InstallOptions) bool {
+	return options.BinaryPath != "" || options.TofuBinaryPath != "" || options.MirrorDir != "" || options.CacheDir != ""
+}
+
+// installLock returns the lock that serializes the installations of Terraform with the provided options.
+func installLock(options InstallOptions) *sync.Mutex {
+	installMutex.Lock()
+	defer installMutex.Unlock()
+
+	lock, ok := installLocks[options]
+	if !ok {
+		lock = &sync.Mutex{}
+		installLocks[options] = lock
+	}
+
+	return lock
+}
+
+// installedBinary returns the path of the verified Terraform binary for the provided options, if any.
+func installedBinary(options InstallOptions) (string, bool) {
+	installMutex.Lock()
+	defer installMutex.Unlock()
+
+	execPath, ok := installedBinaries[options]
+	return execPath, ok
+}
+
+// ensureBinary returns the path to the Terraform or OpenTofu binary for the provided options, downloading it if there
+// is no pre-installed binary.
+func ensureBinary(ctx context.Context, installer *install.Installer, tfDir string, options InstallOptions, requiredVersion *version.Version) (string, error) {
+	if isOpenTofu(options) {
+		return ensureOpenTofuBinary(ctx, tfDir, options, requiredVersion)
+	}
+
+	if options.BinaryPath != "" {
+		return installer.Ensure(ctx, []src.Source{&fs.AnyVersion{ExactBinPath: options.BinaryPath}})
+	}
+
+	if options.MirrorDir != "" {
+		binaryPath := filepath.Join(options.MirrorDir, options.Version, product.Terraform.BinaryName())
+		return installer.Ensure(ctx, []src.Source{&fs.AnyVersion{ExactBinPath: binaryPath}})
+	}
+
+	// Pinned versions are cached on disk, so a restart of the process does not download them again. The latest
+	// version is downloaded once per process, so that new releases of Terraform are picked up on restart.
+	var cachedPath string
+	if options.CacheDir != "" && requiredVersion != nil {
+		cachedPath = filepath.Join(options.CacheDir, requiredVersion.String(), product.Terraform.BinaryName())
+		if _, err := os.Stat(cachedPath); err == nil {
+			return cachedPath, nil
+		} else if !errors.Is(err, os.ErrNotExist) {
+			return "", fmt.Errorf("failed to read cached terraform installation %q: %w", cachedPath, err)
+		}
+	} else if options.CacheDir != "" {
+		cachedPath = filepath.Join(options.CacheDir, latestVersion, product.Terraform.BinaryName())
+	}
+
+	// Create Terraform installation directory
+	installDir := filepath.Join(tfDir, installSubDir)
+	if err := os.MkdirAll(installDir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create directory for terraform installation for resource: %w", err)
+	}
+
+	ucplog.FromContextOrDiscard(ctx).Info(fmt.Sprintf("Installing Terraform in the directory: %q", installDir))
+
+	var source src.Installable = &releases.LatestVersion{
+		Product:    product.Terraform,
+		InstallDir: installDir,
+	}
+	if requiredVersion != nil {
+		source = &releases.ExactVersion{
+			Product:    product.Terraform,
+			Version:    requiredVersion,
+			InstallDir: installDir,
+		}
+	}
+
+	execPath, err := installer.Install(ctx, []src.Installable{source})
+	if err != nil {
+		return "", err
+	}
+
+	if cachedPath == "" {
+		return execPath, nil
+	}
+
+	// Move the binary out of the execution directory into the cache. The downloaded archive is still removed by the
+	// installer when the execution completes.
+	if err := os.MkdirAll(filepath.Dir(cachedPath), 0755); err != nil {
+		return "", fmt.Errorf("failed to create directory for terraform installation cache: %w", err)
+	}
+	if err := os.Rename(execPath, cachedPath); err != nil {
+		return "", fmt.Errorf("failed to cache terraform installation: %w", err)
+	}
+
+	return cachedPath, nil
+}
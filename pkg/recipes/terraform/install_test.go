@@ -0,0 +1,139 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	install "github.com/hashicorp/hc-install"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+// writeFakeTerraform writes a script to dir that reports the given version like the Terraform CLI.
+func writeFakeTerraform(t *testing.T, dir string, version string) string {
+	require.NoError(t, os.MkdirAll(dir, 0755))
+	execPath := filepath.Join(dir, "terraform")
+	script := fmt.Sprintf("#!/bin/sh\necho '{\"terraform_version\":\"%s\",\"platform\":\"linux_amd64\",\"provider_selections\":{},\"terraform_outdated\":false}'\n", version)
+	require.NoError(t, os.WriteFile(execPath, []byte(script), 0755))
+	return execPath
+}
+
+func Test_Install_PreinstalledBinary(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("fake Terraform binary is a shell script")
+	}
+
+	t.Run("binary path", func(t *testing.T) {
+		execPath := writeFakeTerraform(t, t.TempDir(), "1.7.5")
+		options := InstallOptions{Version: "1.7.5", BinaryPath: execPath}
+
+		tf, err := Install(testcontext.New(t), install.NewInstaller(), t.TempDir(), options)
+		require.NoError(t, err)
+		require.Equal(t, execPath, tf.ExecPath())
+		cached, ok := installedBinary(options)
+		require.True(t, ok)
+		require.Equal(t, execPath, cached)
+	})
+
+	t.Run("mirror directory", func(t *testing.T) {
+		mirrorDir := t.TempDir()
+		writeFakeTerraform(t, mirrorDir, "1.8.0")
+		execPath := writeFakeTerraform(t, filepath.Join(mirrorDir, "1.6.2"), "1.6.2")
+
+		tf, err := Install(testcontext.New(t), install.NewInstaller(), t.TempDir(), InstallOptions{Version: "1.6.2", MirrorDir: mirrorDir})
+		require.NoError(t, err)
+		require.Equal(t, execPath, tf.ExecPath())
+
+		tf, err = Install(testcontext.New(t), install.NewInstaller(), t.TempDir(), InstallOptions{MirrorDir: mirrorDir})
+		require.NoError(t, err)
+		require.Equal(t, filepath.Join(mirrorDir, "terraform"), tf.ExecPath())
+	})
+
+	t.Run("cached binary is not verified again", func(t *testing.T) {
+		execPath := writeFakeTerraform(t, t.TempDir(), "1.7.5")
+		options := InstallOptions{Version: "1.7.5", BinaryPath: execPath}
+
+		_, err := Install(testcontext.New(t), install.NewInstaller(), t.TempDir(), options)
+		require.NoError(t, err)
+
+		// Replace the binary with one that reports a different version. The cached binary is used as-is.
+		writeFakeTerraform(t, filepath.Dir(execPath), "1.0.0")
+		tf, err := Install(testcontext.New(t), install.NewInstaller(), t.TempDir(), options)
+		require.NoError(t, err)
+		require.Equal(t, execPath, tf.ExecPath())
+	})
+
+	t.Run("installations with different options are not serialized", func(t *testing.T) {
+		execPath := writeFakeTerraform(t, t.TempDir(), "1.7.5")
+		options := InstallOptions{Version: "1.7.5", BinaryPath: execPath}
+
+		// Hold the lock of another installation. Installing with different options does not wait for it.
+		other := installLock(InstallOptions{Version: "1.7.5", CacheDir: t.TempDir()})
+		other.Lock()
+		defer other.Unlock()
+
+		tf, err := Install(testcontext.New(t), install.NewInstaller(), t.TempDir(), options)
+		require.NoError(t, err)
+		require.Equal(t, execPath, tf.ExecPath())
+	})
+
+	t.Run("version mismatch", func(t *testing.T) {
+		execPath := writeFakeTerraform(t, t.TempDir(), "1.5.0")
+		options := InstallOptions{Version: "1.7.5", BinaryPath: execPath}
+
+		_, err := Install(testcontext.New(t), install.NewInstaller(), t.TempDir(), options)
+		require.EqualError(t, err, fmt.Sprintf("terraform binary %q has version 1.5.0, but version 1.7.5 is required", execPath))
+		_, ok := installedBinary(options)
+		require.False(t, ok)
+	})
+
+	t.Run("missing binary", func(t *testing.T) {
+		_, err := Install(testcontext.New(t), install.NewInstaller(), t.TempDir(), InstallOptions{MirrorDir: t.TempDir(), Version: "1.7.5"})
+		require.Error(t, err)
+	})
+
+	t.Run("invalid version", func(t *testing.T) {
+		_, err := Install(testcontext.New(t), install.NewInstaller(), t.TempDir(), InstallOptions{Version: "latest"})
+		require.ErrorContains(t, err, "invalid Terraform version \"latest\"")
+	})
+}
+
+func Test_installLock(t *testing.T) {
+	options := InstallOptions{Version: "1.7.5", CacheDir: t.TempDir()}
+
+	require.Same(t, installLock(options), installLock(options))
+	require.NotSame(t, installLock(options), installLock(InstallOptions{Version: "1.8.0", CacheDir: options.CacheDir}))
+}
+
+func Test_Install_CachedDownload(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("fake Terraform binary is a shell script")
+	}
+
+	// A pinned version that was downloaded before is reused from the cache directory without downloading it again.
+	cacheDir := t.TempDir()
+	execPath := writeFakeTerraform(t, filepath.Join(cacheDir, "1.7.5"), "1.7.5")
+
+	tf, err := Install(testcontext.New(t), install.NewInstaller(), t.TempDir(), InstallOptions{Version: "1.7.5", CacheDir: cacheDir})
+	require.NoError(t, err)
+	require.Equal(t, execPath, tf.ExecPath())
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/radius-project/radius/pkg/recipes/recipelogs"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// interruptGracePeriod is the duration to wait for an interrupted Terraform command to stop before it is killed.
+	// It is shorter than the grace period given by the async worker to canceled operations, so Terraform is
+	// killed before the operation is completed.
+	interruptGracePeriod = 4 * time.Minute
+
+	// interruptPollInterval is the interval to look for Terraform processes that were started after the command was
+	// interrupted, e.g. when the interruption happened while terraform-exec was starting the process.
+	interruptPollInterval = time.Second
+)
+
+// runInterruptible runs a Terraform command that changes infrastructure, e.g. apply or destroy, in the working
+// directory. terraform-exec kills Terraform when its context is canceled, which can leave resources that were
+// created out of the state. Instead, when ctx is canceled, Terraform is interrupted the same way as with Ctrl+C, so
+// it stops after the in-flight changes complete and saves the state. Terraform is killed if it does not stop within
+// the grace period.
+func runInterruptible(ctx context.Context, workingDir string, run func(ctx context.Context) error) error {
+	runCtx, kill := context.WithCancel(context.WithoutCancel(ctx))
+	defer kill()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- run(runCtx)
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+	}
+
+	logger := ucplog.FromContextOrDiscard(ctx)
+	if dir, err := filepath.EvalSymlinks(workingDir); err == nil {
+		workingDir = dir
+	}
+
+	// Terraform stops immediately without saving the state when it is interrupted twice, so each process is
+	// interrupted only once.
+	interrupted := map[int]bool{}
+	if err := interruptProcesses(workingDir, interrupted); err != nil {
+		logger.Error(err, "Failed to interrupt Terraform, killing it")
+		kill()
+		return canceledError(ctx, <-done)
+	}
+
+	logger.Info("Interrupting Terraform", "gracePeriod", interruptGracePeriod.String())
+	recipelogs.FromContext(ctx).Recordf(recipelogs.SourceEngine, "Interrupting Terraform, waiting up to %s for it to save its state", interruptGracePeriod.String())
+
+	gracePeriodAfter := time.After(interruptGracePeriod)
+	poll := time.NewTicker(interruptPollInterval)
+	defer poll.Stop()
+
+	for {
+		select {
+		case err := <-done:
+			return canceledError(ctx, err)
+
+		case <-poll.C:
+			if err := interruptProcesses(workingDir, interrupted); err != nil {
+				logger.Error(err, "Failed to interrupt Terraform")
+			}
+
+		case <-gracePeriodAfter:
+			logger.Info("Terraform did not stop within the grace period, killing it")
+			recipelogs.FromContext(ctx).Record(recipelogs.SourceEngine, "Terraform did not stop within the grace period and was killed, its state may be inconsistent")
+			kill()
+			return canceledError(ctx, <-done)
+		}
+	}
+}
+
+// canceledError returns the error of a Terraform command that ran after ctx was canceled. The error wraps the error of
+// the context, so callers can tell the command did not fail on its own.
+func canceledError(ctx context.Context, err error) error {
+	if err == nil {
+		return ctx.Err()
+	}
+
+	return fmt.Errorf("%w: %w", ctx.Err(), err)
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+// interruptProcesses sends SIGINT to the child processes of the current process that run in the working directory and
+// were not interrupted yet. The processes are found in the proc filesystem because terraform-exec does not expose
+// the processes it starts.
+func interruptProcesses(workingDir string, interrupted map[int]bool) error {
+	entries, err := os.ReadDir("/proc")
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, entry := range entries {
+		pid, err := strconv.Atoi(entry.Name())
+		if err != nil || interrupted[pid] || !isChildProcess(pid) {
+			continue
+		}
+
+		cwd, err := os.Readlink(filepath.Join("/proc", entry.Name(), "cwd"))
+		if err != nil || cwd != workingDir {
+			continue
+		}
+
+		if err := syscall.Kill(pid, syscall.SIGINT); err != nil && !errors.Is(err, syscall.ESRCH) {
+			errs = append(errs, err)
+			continue
+		}
+		interrupted[pid] = true
+	}
+
+	return errors.Join(errs...)
+}
+
+// isChildProcess returns true if the parent of the process is the current process.
+func isChildProcess(pid int) bool {
+	stat, err := os.ReadFile(filepath.Join("/proc", strconv.Itoa(pid), "stat"))
+	if err != nil {
+		return false
+	}
+
+	// The name of the executable is enclosed in parentheses and can contain spaces, so the fields are read after it.
+	// The parent process ID is the second field after the name, following the state.
+	i := strings.LastIndexByte(string(stat), ')')
+	if i < 0 {
+		return false
+	}
+	fields := strings.Fields(string(stat[i+1:]))
+	if len(fields) < 2 {
+		return false
+	}
+
+	ppid, err := strconv.Atoi(fields[1])
+	return err == nil && ppid == os.Getpid()
+}
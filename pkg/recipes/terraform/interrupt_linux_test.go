@@ -0,0 +1,86 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"context"
+	"errors"
+	"os/exec"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RunInterruptible_Completed(t *testing.T) {
+	err := runInterruptible(context.Background(), t.TempDir(), func(ctx context.Context) error {
+		return exec.CommandContext(ctx, "true").Run()
+	})
+	require.NoError(t, err)
+}
+
+func Test_RunInterruptible_Interrupted(t *testing.T) {
+	workingDir := t.TempDir()
+	ctx, cancel := context.WithCancel(context.Background())
+
+	started := make(chan struct{})
+	go func() {
+		<-started
+		cancel()
+	}()
+
+	var runErr error
+	err := runInterruptible(ctx, workingDir, func(ctx context.Context) error {
+		cmd := exec.CommandContext(ctx, "sleep", "30")
+		cmd.Dir = workingDir
+		if err := cmd.Start(); err != nil {
+			return err
+		}
+		close(started)
+
+		runErr = cmd.Wait()
+		return runErr
+	})
+
+	require.ErrorIs(t, err, context.Canceled)
+
+	// The process is interrupted rather than killed.
+	exitErr := &exec.ExitError{}
+	require.True(t, errors.As(runErr, &exitErr))
+	status, ok := exitErr.Sys().(syscall.WaitStatus)
+	require.True(t, ok)
+	require.Equal(t, syscall.SIGINT, status.Signal())
+}
+
+func Test_InterruptProcesses_OtherWorkingDir(t *testing.T) {
+	cmd := exec.Command("sleep", "30")
+	cmd.Dir = t.TempDir()
+	require.NoError(t, cmd.Start())
+	t.Cleanup(func() {
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+	})
+
+	interrupted := map[int]bool{}
+	require.NoError(t, interruptProcesses(t.TempDir(), interrupted))
+	require.Empty(t, interrupted)
+
+	// The process is still running.
+	time.Sleep(100 * time.Millisecond)
+	require.NoError(t, cmd.Process.Signal(syscall.Signal(0)))
+}
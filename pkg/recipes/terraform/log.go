@@ -18,12 +18,19 @@ package terraform
 
 import (
 	"context"
+	"io"
+	"regexp"
 
 	"github.com/go-logr/logr"
 	"github.com/hashicorp/terraform-exec/tfexec"
+	"github.com/radius-project/radius/pkg/recipes/recipelogs"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 )
 
+// internalLogPattern matches the lines of the internal logs of Terraform, e.g. "2023-10-01T00:00:00.000Z [TRACE] ...".
+// These lines are verbose and may contain the values of sensitive variables, so they are not recorded in the recipe logs.
+var internalLogPattern = regexp.MustCompile(`^\S+ \[(TRACE|DEBUG|INFO|WARN|ERROR)\]`)
+
 // tfLogWrapper is a wrapper around the Terraform logger to stream the logs to the Radius logger.
 type tfLogWrapper struct {
 	logger   logr.Logger
@@ -41,9 +48,17 @@ func (w *tfLogWrapper) Write(p []byte) (n int, err error) {
 	return len(p), nil
 }
 
-// configureTerraformLogs configures the Terraform logs to be streamed to the Radius logs.
+// isTerraformOutput returns true if the line is part of the output of the Terraform command rather than its
+// internal logs.
+func isTerraformOutput(line string) bool {
+	return !internalLogPattern.MatchString(line)
+}
+
+// configureTerraformLogs configures the Terraform logs to be streamed to the Radius logs. The output of the Terraform
+// commands is also recorded to the recipe logs of the context, so developers can retrieve it.
 func configureTerraformLogs(ctx context.Context, tf *tfexec.Terraform) {
 	logger := ucplog.FromContextOrDiscard(ctx)
+	recorder := recipelogs.FromContext(ctx)
 
 	err := tf.SetLog("TRACE")
 	if err != nil {
@@ -51,6 +66,6 @@ func configureTerraformLogs(ctx context.Context, tf *tfexec.Terraform) {
 		return
 	}
 
-	tf.SetStdout(&tfLogWrapper{logger: logger})
-	tf.SetStderr(&tfLogWrapper{logger: logger, isStdErr: true})
+	tf.SetStdout(io.MultiWriter(&tfLogWrapper{logger: logger}, recorder.FilteredWriter(recipelogs.SourceStdout, isTerraformOutput)))
+	tf.SetStderr(io.MultiWriter(&tfLogWrapper{logger: logger, isStdErr: true}, recorder.FilteredWriter(recipelogs.SourceStderr, isTerraformOutput)))
 }
@@ -0,0 +1,41 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_IsTerraformOutput(t *testing.T) {
+	tests := []struct {
+		line     string
+		expected bool
+	}{
+		{"Apply complete! Resources: 1 added, 0 changed, 0 destroyed.", true},
+		{"Error: Invalid value for variable", true},
+		{"  on main.tf line 3, in variable \"name\":", true},
+		{"2024-01-01T00:00:00.000Z [TRACE] statemgr.Filesystem: reading latest snapshot", false},
+		{"2024-01-01T00:00:00.000Z [DEBUG] provider.terraform-provider-aws: request body", false},
+		{"2024-01-01T00:00:00.000Z [ERROR] vertex \"aws_s3_bucket.b\" error: invalid bucket name", false},
+	}
+
+	for _, tc := range tests {
+		require.Equal(t, tc.expected, isTerraformOutput(tc.line), tc.line)
+	}
+}
@@ -14,6 +14,7 @@ import (
 	reflect "reflect"
 
 	tfjson "github.com/hashicorp/terraform-json"
+	recipes "github.com/radius-project/radius/pkg/recipes"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -155,3 +156,81 @@ func (c *MockTerraformExecutorGetRecipeMetadataCall) DoAndReturn(f func(context.
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// Import mocks base method.
+func (m *MockTerraformExecutor) Import(arg0 context.Context, arg1 Options, arg2 []recipes.ImportedResource) (*tfjson.State, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Import", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*tfjson.State)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Import indicates an expected call of Import.
+func (mr *MockTerraformExecutorMockRecorder) Import(arg0, arg1, arg2 any) *MockTerraformExecutorImportCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockTerraformExecutor)(nil).Import), arg0, arg1, arg2)
+	return &MockTerraformExecutorImportCall{Call: call}
+}
+
+// MockTerraformExecutorImportCall wrap *gomock.Call
+type MockTerraformExecutorImportCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTerraformExecutorImportCall) Return(arg0 *tfjson.State, arg1 error) *MockTerraformExecutorImportCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTerraformExecutorImportCall) Do(f func(context.Context, Options, []recipes.ImportedResource) (*tfjson.State, error)) *MockTerraformExecutorImportCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTerraformExecutorImportCall) DoAndReturn(f func(context.Context, Options, []recipes.ImportedResource) (*tfjson.State, error)) *MockTerraformExecutorImportCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Plan mocks base method.
+func (m *MockTerraformExecutor) Plan(arg0 context.Context, arg1 Options) (*tfjson.Plan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Plan", arg0, arg1)
+	ret0, _ := ret[0].(*tfjson.Plan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Plan indicates an expected call of Plan.
+func (mr *MockTerraformExecutorMockRecorder) Plan(arg0, arg1 any) *MockTerraformExecutorPlanCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Plan", reflect.TypeOf((*MockTerraformExecutor)(nil).Plan), arg0, arg1)
+	return &MockTerraformExecutorPlanCall{Call: call}
+}
+
+// MockTerraformExecutorPlanCall wrap *gomock.Call
+type MockTerraformExecutorPlanCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTerraformExecutorPlanCall) Return(arg0 *tfjson.Plan, arg1 error) *MockTerraformExecutorPlanCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTerraformExecutorPlanCall) Do(f func(context.Context, Options) (*tfjson.Plan, error)) *MockTerraformExecutorPlanCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTerraformExecutorPlanCall) DoAndReturn(f func(context.Context, Options) (*tfjson.Plan, error)) *MockTerraformExecutorPlanCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -19,6 +19,7 @@ package terraform
 import (
 	"context"
 	"fmt"
+	"os"
 	"path/filepath"
 	"strings"
 	"time"
@@ -57,7 +58,43 @@ type moduleInspectResult struct {
 }
 
 // downloadAndInspect handles downloading the TF module and retrieving the necessary information
-func downloadAndInspect(ctx context.Context, tf *tfexec.Terraform, options Options) (*moduleInspectResult, error) {
+func downloadAndInspect(ctx context.Context, tf *tfexec.Terraform, options Options, cache *Cache) (*moduleInspectResult, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	// Modules with a version are immutable, so they are restored from the cache when they were downloaded before.
+	if key := moduleCacheKey(options.EnvRecipe); key != "" {
+		lease := cache.acquire(ctx, cacheKindModules, key)
+		populated := false
+		defer func() { lease.release(ctx, populated) }()
+
+		if lease.Hit() {
+			err := os.CopyFS(filepath.Join(tf.WorkingDir(), moduleRootDir), os.DirFS(lease.Dir()))
+			if err == nil {
+				logger.Info(fmt.Sprintf("Restored Terraform module %s from the cache", options.EnvRecipe.TemplatePath))
+				return inspectModule(tf.WorkingDir(), options.EnvRecipe)
+			}
+
+			// Fall back to downloading the module.
+			logger.Info(fmt.Sprintf("Failed to restore Terraform module %s from the cache: %s", options.EnvRecipe.TemplatePath, err.Error()))
+			if err := os.RemoveAll(filepath.Join(tf.WorkingDir(), moduleRootDir)); err != nil {
+				return nil, fmt.Errorf("failed to clean up Terraform modules restored from the cache: %w", err)
+			}
+		}
+
+		result, err := download(ctx, tf, options)
+		if err != nil {
+			return nil, err
+		}
+
+		populated = storeModules(ctx, tf.WorkingDir(), lease)
+		return result, nil
+	}
+
+	return download(ctx, tf, options)
+}
+
+// download downloads the module of the recipe to the working directory and inspects it.
+func download(ctx context.Context, tf *tfexec.Terraform, options Options) (*moduleInspectResult, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
 	// Run Terraform Get command to download the module from the source specified in the config.
@@ -88,6 +125,29 @@ func downloadAndInspect(ctx context.Context, tf *tfexec.Terraform, options Optio
 	return loadedModule, nil
 }
 
+// storeModules copies the modules downloaded to the working directory to the leased cache entry. It returns true
+// if the entry is populated.
+func storeModules(ctx context.Context, workingDir string, lease *cacheLease) bool {
+	if lease == nil {
+		return false
+	}
+
+	logger := ucplog.FromContextOrDiscard(ctx)
+	if err := os.RemoveAll(lease.Dir()); err != nil {
+		logger.Info(fmt.Sprintf("Failed to clear Terraform module cache entry %q: %s", lease.Dir(), err.Error()))
+		return false
+	}
+
+	// Modules that cannot be copied, e.g. because they contain symbolic links, are not cached.
+	if err := os.CopyFS(lease.Dir(), os.DirFS(filepath.Join(workingDir, moduleRootDir))); err != nil {
+		logger.Info(fmt.Sprintf("Failed to cache Terraform modules: %s", err.Error()))
+		_ = os.RemoveAll(lease.Dir())
+		return false
+	}
+
+	return true
+}
+
 // inspectModule inspects the module present at workingDir/.terraform/modules/<localModuleName> directory
 // and returns the inspection result which includes the list of required provider names, existence of recipe context variable and result output.
 // localModuleName is the name of the module specified in the configuration used to download the module.
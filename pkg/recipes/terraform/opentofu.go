@@ -0,0 +1,363 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/hashicorp/go-version"
+	dm "github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// openTofuBinaryName is the name of the OpenTofu binary.
+	openTofuBinaryName = "tofu"
+
+	// openTofuCacheSubDir is the directory under the install cache directory where downloaded OpenTofu binaries are
+	// cached, so they cannot collide with the cached Terraform binaries.
+	openTofuCacheSubDir = "opentofu"
+
+	// stateEncryptionEnvVar is the environment variable OpenTofu reads the state and plan encryption configuration from.
+	stateEncryptionEnvVar = "TF_ENCRYPTION"
+
+	// maxChecksumsSize is the maximum size of the checksums file of an OpenTofu release.
+	maxChecksumsSize = 1024 * 1024
+
+	// maxSignatureSize is the maximum size of the signature of the checksums file of an OpenTofu release.
+	maxSignatureSize = 64 * 1024
+)
+
+var (
+	// openTofuReleasesURL is the base URL of the OpenTofu release artifacts.
+	openTofuReleasesURL = "https://github.com/opentofu/opentofu/releases/download"
+
+	// openTofuLatestReleaseURL is the URL of the metadata of the latest OpenTofu release.
+	openTofuLatestReleaseURL = "https://api.github.com/repos/opentofu/opentofu/releases/latest"
+)
+
+// isOpenTofu returns true if the install options select the OpenTofu distribution.
+func isOpenTofu(options InstallOptions) bool {
+	return options.Distribution == dm.TerraformDistributionOpenTofu
+}
+
+// ensureOpenTofuBinary returns the path to the OpenTofu binary for the provided options, downloading OpenTofu from
+// its GitHub releases if there is no pre-installed binary. Downloaded archives are verified against the checksums
+// published with the release, after verifying the signature of the checksums with the configured signing key.
+func ensureOpenTofuBinary(ctx context.Context, tfDir string, options InstallOptions, requiredVersion *version.Version) (string, error) {
+	if options.TofuBinaryPath != "" {
+		return options.TofuBinaryPath, checkBinary(options.TofuBinaryPath)
+	}
+
+	if options.MirrorDir != "" {
+		binaryPath := filepath.Join(options.MirrorDir, options.Version, openTofuBinaryName)
+		return binaryPath, checkBinary(binaryPath)
+	}
+
+	if requiredVersion == nil {
+		latest, err := latestOpenTofuVersion(ctx)
+		if err != nil {
+			return "", err
+		}
+		requiredVersion = latest
+	}
+
+	var cachedPath string
+	if options.CacheDir != "" {
+		cachedPath = filepath.Join(options.CacheDir, openTofuCacheSubDir, requiredVersion.String(), openTofuBinaryName)
+		if _, err := os.Stat(cachedPath); err == nil {
+			return cachedPath, nil
+		} else if !errors.Is(err, os.ErrNotExist) {
+			return "", fmt.Errorf("failed to read cached OpenTofu installation %q: %w", cachedPath, err)
+		}
+	}
+
+	if options.TofuSigningKeyPath == "" {
+		return "", errors.New("OpenTofu cannot be downloaded because no signing key is configured to verify its release. Configure the OpenTofu release signing key, a pre-installed OpenTofu binary or a mirror directory")
+	}
+
+	installDir := filepath.Join(tfDir, installSubDir)
+	if err := os.MkdirAll(installDir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create directory for OpenTofu installation for resource: %w", err)
+	}
+
+	ucplog.FromContextOrDiscard(ctx).Info(fmt.Sprintf("Installing OpenTofu %s in the directory: %q", requiredVersion, installDir))
+
+	execPath, err := downloadOpenTofu(ctx, installDir, requiredVersion, options.TofuSigningKeyPath)
+	if err != nil {
+		return "", err
+	}
+
+	if cachedPath == "" {
+		return execPath, nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(cachedPath), 0755); err != nil {
+		return "", fmt.Errorf("failed to create directory for OpenTofu installation cache: %w", err)
+	}
+	if err := os.Rename(execPath, cachedPath); err != nil {
+		return "", fmt.Errorf("failed to cache OpenTofu installation: %w", err)
+	}
+
+	return cachedPath, nil
+}
+
+// checkBinary returns an error if the path is not an executable file.
+func checkBinary(path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("failed to find OpenTofu binary %q: %w", path, err)
+	}
+
+	if !info.Mode().IsRegular() || info.Mode().Perm()&0111 == 0 {
+		return fmt.Errorf("OpenTofu binary %q is not an executable file", path)
+	}
+
+	return nil
+}
+
+// latestOpenTofuVersion returns the version of the latest release of OpenTofu.
+func latestOpenTofuVersion(ctx context.Context) (*version.Version, error) {
+	body, err := httpGet(ctx, openTofuLatestReleaseURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the latest OpenTofu release: %w", err)
+	}
+	defer body.Close()
+
+	release := struct {
+		TagName string `json:"tag_name"`
+	}{}
+	if err := json.NewDecoder(body).Decode(&release); err != nil {
+		return nil, fmt.Errorf("failed to read the latest OpenTofu release: %w", err)
+	}
+
+	v, err := version.NewVersion(strings.TrimPrefix(release.TagName, "v"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid version of the latest OpenTofu release %q: %w", release.TagName, err)
+	}
+
+	return v, nil
+}
+
+// downloadOpenTofu downloads the release archive of the given version of OpenTofu for the current platform, verifies
+// its checksum and extracts the binary to the install directory. It returns the path to the binary.
+func downloadOpenTofu(ctx context.Context, installDir string, v *version.Version, signingKeyPath string) (string, error) {
+	archiveName := fmt.Sprintf("tofu_%s_%s_%s.zip", v, runtime.GOOS, runtime.GOARCH)
+	releaseURL := fmt.Sprintf("%s/v%s", openTofuReleasesURL, v)
+
+	expectedSum, err := openTofuChecksum(ctx, releaseURL, v, archiveName, signingKeyPath)
+	if err != nil {
+		return "", err
+	}
+
+	archivePath := filepath.Join(installDir, archiveName)
+	archive, err := os.Create(archivePath)
+	if err != nil {
+		return "", fmt.Errorf("failed to create OpenTofu archive: %w", err)
+	}
+	defer os.Remove(archivePath)
+	defer archive.Close()
+
+	body, err := httpGet(ctx, releaseURL+"/"+archiveName)
+	if err != nil {
+		return "", fmt.Errorf("failed to download OpenTofu %s: %w", v, err)
+	}
+	defer body.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(archive, hash), body); err != nil {
+		return "", fmt.Errorf("failed to download OpenTofu %s: %w", v, err)
+	}
+
+	if actualSum := hex.EncodeToString(hash.Sum(nil)); actualSum != expectedSum {
+		return "", fmt.Errorf("checksum mismatch for OpenTofu archive %q: expected %s, got %s", archiveName, expectedSum, actualSum)
+	}
+
+	return extractOpenTofu(archivePath, installDir)
+}
+
+// openTofuChecksum returns the SHA-256 checksum of the archive published in the checksums file of the release. The
+// checksums are only trusted if the signature published with them is made by the signing key.
+func openTofuChecksum(ctx context.Context, releaseURL string, v *version.Version, archiveName string, signingKeyPath string) (string, error) {
+	checksumsURL := fmt.Sprintf("%s/tofu_%s_SHA256SUMS", releaseURL, v)
+	checksums, err := httpGetBytes(ctx, checksumsURL, maxChecksumsSize)
+	if err != nil {
+		return "", fmt.Errorf("failed to download the checksums of OpenTofu %s: %w", v, err)
+	}
+
+	signature, err := httpGetBytes(ctx, checksumsURL+".gpgsig", maxSignatureSize)
+	if err != nil {
+		return "", fmt.Errorf("failed to download the signature of the checksums of OpenTofu %s: %w", v, err)
+	}
+
+	if err := verifyOpenTofuSignature(signingKeyPath, checksums, signature); err != nil {
+		return "", fmt.Errorf("failed to verify the checksums of OpenTofu %s: %w", v, err)
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(checksums))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 2 && fields[1] == archiveName {
+			return fields[0], nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", fmt.Errorf("failed to read the checksums of OpenTofu %s: %w", v, err)
+	}
+
+	return "", fmt.Errorf("no checksum found for OpenTofu archive %q", archiveName)
+}
+
+// verifyOpenTofuSignature verifies that the detached signature of the signed data is made by the armored public key
+// at signingKeyPath. Both binary and armored signatures are accepted.
+func verifyOpenTofuSignature(signingKeyPath string, signed []byte, signature []byte) error {
+	keyFile, err := os.Open(signingKeyPath)
+	if err != nil {
+		return fmt.Errorf("failed to read the OpenTofu signing key: %w", err)
+	}
+	defer keyFile.Close()
+
+	keyring, err := openpgp.ReadArmoredKeyRing(keyFile)
+	if err != nil {
+		return fmt.Errorf("failed to read the OpenTofu signing key %q: %w", signingKeyPath, err)
+	}
+
+	if bytes.HasPrefix(bytes.TrimSpace(signature), []byte("-----BEGIN PGP SIGNATURE-----")) {
+		_, err = openpgp.CheckArmoredDetachedSignature(keyring, bytes.NewReader(signed), bytes.NewReader(signature), nil)
+	} else {
+		_, err = openpgp.CheckDetachedSignature(keyring, bytes.NewReader(signed), bytes.NewReader(signature), nil)
+	}
+	if err != nil {
+		return fmt.Errorf("invalid signature: %w", err)
+	}
+
+	return nil
+}
+
+// extractOpenTofu extracts the OpenTofu binary from the release archive to the install directory.
+func extractOpenTofu(archivePath string, installDir string) (string, error) {
+	reader, err := zip.OpenReader(archivePath)
+	if err != nil {
+		return "", fmt.Errorf("failed to open OpenTofu archive: %w", err)
+	}
+	defer reader.Close()
+
+	for _, file := range reader.File {
+		if file.Name != openTofuBinaryName {
+			continue
+		}
+
+		src, err := file.Open()
+		if err != nil {
+			return "", fmt.Errorf("failed to extract OpenTofu binary: %w", err)
+		}
+		defer src.Close()
+
+		execPath := filepath.Join(installDir, openTofuBinaryName)
+		dst, err := os.OpenFile(execPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0755)
+		if err != nil {
+			return "", fmt.Errorf("failed to extract OpenTofu binary: %w", err)
+		}
+		defer dst.Close()
+
+		if _, err := io.Copy(dst, src); err != nil {
+			return "", fmt.Errorf("failed to extract OpenTofu binary: %w", err)
+		}
+
+		return execPath, nil
+	}
+
+	return "", fmt.Errorf("OpenTofu archive does not contain the %q binary", openTofuBinaryName)
+}
+
+// httpGet sends a GET request to the URL and returns the body of the response. The caller must close the body.
+func httpGet(ctx context.Context, url string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("GET %s returned status %s", url, resp.Status)
+	}
+
+	return resp.Body, nil
+}
+
+// httpGetBytes sends a GET request to the URL and returns the body of the response. It returns an error if the body
+// is larger than maxSize bytes.
+func httpGetBytes(ctx context.Context, url string, maxSize int64) ([]byte, error) {
+	body, err := httpGet(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(body, maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > maxSize {
+		return nil, fmt.Errorf("GET %s returned more than %d bytes", url, maxSize)
+	}
+
+	return data, nil
+}
+
+// stateEncryptionEnv returns the environment variables that configure the state and plan encryption of OpenTofu,
+// reading the encryption configuration from the secret referenced by the environment.
+func stateEncryptionEnv(options Options) (map[string]string, error) {
+	env := map[string]string{}
+	if options.EnvConfig == nil || options.EnvConfig.RecipeConfig.Terraform.Encryption == nil {
+		return env, nil
+	}
+
+	secretReference := options.EnvConfig.RecipeConfig.Terraform.Encryption
+	secretData, ok := options.Secrets[secretReference.Source]
+	if !ok {
+		return nil, fmt.Errorf("missing secret source: %s", secretReference.Source)
+	}
+
+	value, ok := secretData.Data[secretReference.Key]
+	if !ok {
+		return nil, fmt.Errorf("missing secret key in secret store id: %s", secretReference.Source)
+	}
+
+	env[stateEncryptionEnvVar] = value
+	return env, nil
+}
@@ -0,0 +1,275 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	install "github.com/hashicorp/hc-install"
+	dm "github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeTofuScript returns a script that reports the given version like the OpenTofu CLI.
+func fakeTofuScript(version string) []byte {
+	return []byte(fmt.Sprintf("#!/bin/sh\necho '{\"terraform_version\":\"%s\",\"platform\":\"linux_amd64\",\"provider_selections\":{}}'\n", version))
+}
+
+// writeFakeTofu writes a script to dir that reports the given version like the OpenTofu CLI.
+func writeFakeTofu(t *testing.T, dir string, version string) string {
+	require.NoError(t, os.MkdirAll(dir, 0755))
+	execPath := filepath.Join(dir, openTofuBinaryName)
+	require.NoError(t, os.WriteFile(execPath, fakeTofuScript(version), 0755))
+	return execPath
+}
+
+// newSigningKey generates an OpenPGP key and writes its armored public key to a file. It returns the key and the path
+// to the file.
+func newSigningKey(t *testing.T) (*openpgp.Entity, string) {
+	entity, err := openpgp.NewEntity("OpenTofu", "", "core@opentofu.org", nil)
+	require.NoError(t, err)
+
+	publicKey := &bytes.Buffer{}
+	w, err := armor.Encode(publicKey, openpgp.PublicKeyType, nil)
+	require.NoError(t, err)
+	require.NoError(t, entity.Serialize(w))
+	require.NoError(t, w.Close())
+
+	keyPath := filepath.Join(t.TempDir(), "opentofu.asc")
+	require.NoError(t, os.WriteFile(keyPath, publicKey.Bytes(), 0644))
+	return entity, keyPath
+}
+
+// setupOpenTofuReleases serves the release archive of the given version of OpenTofu for the current platform, with
+// its checksums signed by a new key, and points the OpenTofu download URLs to the server for the duration of the
+// test. It returns the path to the armored public key of the signing key.
+func setupOpenTofuReleases(t *testing.T, version string, checksum string) string {
+	archive := &bytes.Buffer{}
+	w := zip.NewWriter(archive)
+	f, err := w.CreateHeader(&zip.FileHeader{Name: openTofuBinaryName, Method: zip.Deflate})
+	require.NoError(t, err)
+	_, err = f.Write(fakeTofuScript(version))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	archiveName := fmt.Sprintf("tofu_%s_%s_%s.zip", version, runtime.GOOS, runtime.GOARCH)
+	if checksum == "" {
+		sum := sha256.Sum256(archive.Bytes())
+		checksum = hex.EncodeToString(sum[:])
+	}
+
+	checksums := fmt.Sprintf("0000  tofu_%s_other_arch.zip\n%s  %s\n", version, checksum, archiveName)
+	signingKey, keyPath := newSigningKey(t)
+	signature := &bytes.Buffer{}
+	require.NoError(t, openpgp.DetachSign(signature, signingKey, bytes.NewReader([]byte(checksums)), nil))
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/latest", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"tag_name":"v%s"}`, version)
+	})
+	mux.HandleFunc(fmt.Sprintf("/download/v%s/tofu_%s_SHA256SUMS", version, version), func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(checksums))
+	})
+	mux.HandleFunc(fmt.Sprintf("/download/v%s/tofu_%s_SHA256SUMS.gpgsig", version, version), func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(signature.Bytes())
+	})
+	mux.HandleFunc(fmt.Sprintf("/download/v%s/%s", version, archiveName), func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(archive.Bytes())
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	releasesURL, latestURL := openTofuReleasesURL, openTofuLatestReleaseURL
+	openTofuReleasesURL, openTofuLatestReleaseURL = server.URL+"/download", server.URL+"/latest"
+	t.Cleanup(func() {
+		openTofuReleasesURL, openTofuLatestReleaseURL = releasesURL, latestURL
+	})
+
+	return keyPath
+}
+
+func Test_Install_OpenTofu(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("fake OpenTofu binary is a shell script")
+	}
+
+	t.Run("binary path", func(t *testing.T) {
+		execPath := writeFakeTofu(t, t.TempDir(), "1.8.0")
+		options := InstallOptions{Distribution: dm.TerraformDistributionOpenTofu, Version: "1.8.0", BinaryPath: "/not-used", TofuBinaryPath: execPath}
+
+		tf, err := Install(testcontext.New(t), install.NewInstaller(), t.TempDir(), options)
+		require.NoError(t, err)
+		require.Equal(t, execPath, tf.ExecPath())
+		cached, ok := installedBinary(options)
+		require.True(t, ok)
+		require.Equal(t, execPath, cached)
+	})
+
+	t.Run("mirror directory", func(t *testing.T) {
+		mirrorDir := t.TempDir()
+		writeFakeTerraform(t, filepath.Join(mirrorDir, "1.8.0"), "1.8.0")
+		execPath := writeFakeTofu(t, filepath.Join(mirrorDir, "1.8.0"), "1.8.0")
+
+		tf, err := Install(testcontext.New(t), install.NewInstaller(), t.TempDir(), InstallOptions{Distribution: dm.TerraformDistributionOpenTofu, Version: "1.8.0", MirrorDir: mirrorDir})
+		require.NoError(t, err)
+		require.Equal(t, execPath, tf.ExecPath())
+	})
+
+	t.Run("missing binary", func(t *testing.T) {
+		mirrorDir := t.TempDir()
+		_, err := Install(testcontext.New(t), install.NewInstaller(), t.TempDir(), InstallOptions{Distribution: dm.TerraformDistributionOpenTofu, Version: "1.8.0", MirrorDir: mirrorDir})
+		require.ErrorContains(t, err, fmt.Sprintf("failed to find OpenTofu binary %q", filepath.Join(mirrorDir, "1.8.0", "tofu")))
+	})
+
+	t.Run("version mismatch", func(t *testing.T) {
+		execPath := writeFakeTofu(t, t.TempDir(), "1.6.2")
+		options := InstallOptions{Distribution: dm.TerraformDistributionOpenTofu, Version: "1.8.0", TofuBinaryPath: execPath}
+
+		_, err := Install(testcontext.New(t), install.NewInstaller(), t.TempDir(), options)
+		require.EqualError(t, err, fmt.Sprintf("terraform binary %q has version 1.6.2, but version 1.8.0 is required", execPath))
+	})
+}
+
+func Test_Install_OpenTofuDownload(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("fake OpenTofu binary is a shell script")
+	}
+
+	t.Run("pinned version is downloaded and cached", func(t *testing.T) {
+		keyPath := setupOpenTofuReleases(t, "1.8.0", "")
+		cacheDir := t.TempDir()
+
+		tf, err := Install(testcontext.New(t), install.NewInstaller(), t.TempDir(), InstallOptions{Distribution: dm.TerraformDistributionOpenTofu, Version: "1.8.0", CacheDir: cacheDir, TofuSigningKeyPath: keyPath})
+		require.NoError(t, err)
+		require.Equal(t, filepath.Join(cacheDir, "opentofu", "1.8.0", "tofu"), tf.ExecPath())
+	})
+
+	t.Run("latest version is resolved before download", func(t *testing.T) {
+		keyPath := setupOpenTofuReleases(t, "1.8.3", "")
+		cacheDir := t.TempDir()
+
+		tf, err := Install(testcontext.New(t), install.NewInstaller(), t.TempDir(), InstallOptions{Distribution: dm.TerraformDistributionOpenTofu, CacheDir: cacheDir, TofuSigningKeyPath: keyPath})
+		require.NoError(t, err)
+		require.Equal(t, filepath.Join(cacheDir, "opentofu", "1.8.3", "tofu"), tf.ExecPath())
+	})
+
+	t.Run("download without cache", func(t *testing.T) {
+		keyPath := setupOpenTofuReleases(t, "1.7.1", "")
+		rootDir := t.TempDir()
+
+		tf, err := Install(testcontext.New(t), install.NewInstaller(), rootDir, InstallOptions{Distribution: dm.TerraformDistributionOpenTofu, Version: "1.7.1", TofuSigningKeyPath: keyPath})
+		require.NoError(t, err)
+		require.Equal(t, filepath.Join(rootDir, installSubDir, "tofu"), tf.ExecPath())
+	})
+
+	t.Run("checksum mismatch", func(t *testing.T) {
+		keyPath := setupOpenTofuReleases(t, "1.8.1", "0123456789abcdef")
+
+		_, err := Install(testcontext.New(t), install.NewInstaller(), t.TempDir(), InstallOptions{Distribution: dm.TerraformDistributionOpenTofu, Version: "1.8.1", TofuSigningKeyPath: keyPath})
+		require.ErrorContains(t, err, "checksum mismatch for OpenTofu archive")
+	})
+
+	t.Run("checksums signed by another key", func(t *testing.T) {
+		setupOpenTofuReleases(t, "1.8.1", "")
+		_, keyPath := newSigningKey(t)
+
+		_, err := Install(testcontext.New(t), install.NewInstaller(), t.TempDir(), InstallOptions{Distribution: dm.TerraformDistributionOpenTofu, Version: "1.8.1", TofuSigningKeyPath: keyPath})
+		require.ErrorContains(t, err, "failed to verify the checksums of OpenTofu 1.8.1: invalid signature")
+	})
+
+	t.Run("no signing key", func(t *testing.T) {
+		setupOpenTofuReleases(t, "1.8.1", "")
+
+		_, err := Install(testcontext.New(t), install.NewInstaller(), t.TempDir(), InstallOptions{Distribution: dm.TerraformDistributionOpenTofu, Version: "1.8.1"})
+		require.ErrorContains(t, err, "OpenTofu cannot be downloaded because no signing key is configured")
+	})
+
+	t.Run("version not released", func(t *testing.T) {
+		keyPath := setupOpenTofuReleases(t, "1.8.2", "")
+
+		_, err := Install(testcontext.New(t), install.NewInstaller(), t.TempDir(), InstallOptions{Distribution: dm.TerraformDistributionOpenTofu, Version: "1.5.0", TofuSigningKeyPath: keyPath})
+		require.ErrorContains(t, err, "failed to download the checksums of OpenTofu 1.5.0")
+	})
+}
+
+func Test_StateEncryptionEnv(t *testing.T) {
+	encryptionConfig := `key_provider "pbkdf2" "key" { passphrase = "correct-horse-battery-staple" }`
+	envConfig := &recipes.Configuration{
+		RecipeConfig: dm.RecipeConfigProperties{
+			Terraform: dm.TerraformConfigProperties{
+				Distribution: dm.TerraformDistributionOpenTofu,
+				Encryption: &dm.SecretReference{
+					Source: "/planes/radius/local/resourcegroups/default/providers/Applications.Core/secretStores/tofu",
+					Key:    "encryption",
+				},
+			},
+		},
+	}
+
+	t.Run("no encryption", func(t *testing.T) {
+		env, err := stateEncryptionEnv(Options{EnvConfig: &recipes.Configuration{}})
+		require.NoError(t, err)
+		require.Empty(t, env)
+	})
+
+	t.Run("encryption secret", func(t *testing.T) {
+		env, err := stateEncryptionEnv(Options{
+			EnvConfig: envConfig,
+			Secrets: map[string]recipes.SecretData{
+				"/planes/radius/local/resourcegroups/default/providers/Applications.Core/secretStores/tofu": {
+					Type: "generic",
+					Data: map[string]string{"encryption": encryptionConfig},
+				},
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"TF_ENCRYPTION": encryptionConfig}, env)
+	})
+
+	t.Run("missing secret source", func(t *testing.T) {
+		_, err := stateEncryptionEnv(Options{EnvConfig: envConfig})
+		require.EqualError(t, err, "missing secret source: /planes/radius/local/resourcegroups/default/providers/Applications.Core/secretStores/tofu")
+	})
+
+	t.Run("missing secret key", func(t *testing.T) {
+		_, err := stateEncryptionEnv(Options{
+			EnvConfig: envConfig,
+			Secrets: map[string]recipes.SecretData{
+				"/planes/radius/local/resourcegroups/default/providers/Applications.Core/secretStores/tofu": {
+					Type: "generic",
+					Data: map[string]string{},
+				},
+			},
+		})
+		require.EqualError(t, err, "missing secret key in secret store id: /planes/radius/local/resourcegroups/default/providers/Applications.Core/secretStores/tofu")
+	})
+}
@@ -41,10 +41,18 @@ type TerraformExecutor interface {
 	// Deploy installs terraform and runs terraform init and apply on the terraform module referenced by the recipe using terraform-exec.
 	Deploy(ctx context.Context, options Options) (*tfjson.State, error)
 
+	// Import installs terraform and runs terraform init and import on the terraform module referenced by the recipe using terraform-exec,
+	// adopting the existing resources into the terraform state of the recipe without changing them.
+	Import(ctx context.Context, options Options, resources []recipes.ImportedResource) (*tfjson.State, error)
+
 	// Delete installs terraform and runs terraform destroy on the terraform module referenced by the recipe using terraform-exec,
 	// and deletes the Kubernetes secret created for terraform state store.
 	Delete(ctx context.Context, options Options) error
 
+	// Plan installs terraform and runs terraform init and plan on the terraform module referenced by the recipe using terraform-exec,
+	// returning the changes that terraform apply would make.
+	Plan(ctx context.Context, options Options) (*tfjson.Plan, error)
+
 	// GetRecipeMetadata installs terraform and runs terraform get to retrieve information on the terraform module
 	GetRecipeMetadata(ctx context.Context, options Options) (map[string]any, error)
 }
@@ -96,7 +104,8 @@ func createWorkingDir(ctx context.Context, tfDir string) (string, error) {
 	return workingDir, nil
 }
 
-// GetProviderEnvSecretIDs parses the envConfig to extract secret IDs configured in providers configuration and environment variables
+// GetProviderEnvSecretIDs parses the envConfig to extract secret IDs configured in providers configuration, environment variables,
+// backend configuration and state encryption configuration
 // and returns a map of secret store IDs and corresponding slice of keys.
 func GetProviderEnvSecretIDs(envConfig recipes.Configuration) map[string][]string {
 	providerSecretIDs := make(map[string][]string)
@@ -108,6 +117,14 @@ func GetProviderEnvSecretIDs(envConfig recipes.Configuration) map[string][]strin
 	// Extract secrets from environment variables
 	extractEnvSecretIDs(envConfig.RecipeConfig.EnvSecrets, providerSecretIDs, &mu)
 
+	// Extract secrets from Terraform backend configuration
+	extractEnvSecretIDs(envConfig.RecipeConfig.Terraform.Backend.Secrets, providerSecretIDs, &mu)
+
+	// Extract the secret of the OpenTofu state encryption configuration
+	if encryption := envConfig.RecipeConfig.Terraform.Encryption; encryption != nil {
+		addSecretKeys(providerSecretIDs, encryption.Source, encryption.Key, &mu)
+	}
+
 	return providerSecretIDs
 }
 
@@ -124,7 +141,7 @@ func extractProviderSecretIDs(providers map[string][]dm.ProviderConfigProperties
 	}
 }
 
-// extractEnvSecrets extracts secrets from environment variable configurations
+// extractEnvSecrets extracts secrets from environment variable configurations, or from other secret references keyed by name
 func extractEnvSecretIDs(envSecrets map[string]dm.SecretReference, secrets map[string][]string, mu *sync.Mutex) {
 	for _, config := range envSecrets {
 		addSecretKeys(secrets, config.Source, config.Key, mu)
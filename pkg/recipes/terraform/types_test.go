@@ -193,6 +193,38 @@ func TestGetProviderEnvSecretIDs(t *testing.T) {
 			},
 			want: map[string][]string{},
 		},
+		{
+			name: "backend secrets populated",
+			envConfig: recipes.Configuration{
+				RecipeConfig: datamodel.RecipeConfigProperties{
+					Terraform: datamodel.TerraformConfigProperties{
+						Backend: datamodel.TerraformBackendConfig{
+							Kind: datamodel.TerraformBackendPostgres,
+							Secrets: map[string]datamodel.SecretReference{
+								"conn_str": {Source: "my-backend-secret-source-id", Key: "connectionString"},
+							},
+						},
+					},
+				},
+			},
+			want: map[string][]string{
+				"my-backend-secret-source-id": {"connectionString"},
+			},
+		},
+		{
+			name: "OpenTofu state encryption secret",
+			envConfig: recipes.Configuration{
+				RecipeConfig: datamodel.RecipeConfigProperties{
+					Terraform: datamodel.TerraformConfigProperties{
+						Distribution: datamodel.TerraformDistributionOpenTofu,
+						Encryption:   &datamodel.SecretReference{Source: "my-encryption-secret-source-id", Key: "encryption"},
+					},
+				},
+			},
+			want: map[string][]string{
+				"my-encryption-secret-source-id": {"encryption"},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -65,6 +65,8 @@ type EnvironmentDefinition struct {
 	TemplateVersion string
 	// Allows insecure connections to registry without SSL check.
 	PlainHTTP bool
+	// Outputs represents the outputs the recipe must produce, or nil if the recipe does not declare any.
+	Outputs *OutputContract
 }
 
 // ResourceMetadata represents recipe details provided while creating a portable resource.
@@ -79,18 +81,39 @@ type ResourceMetadata struct {
 	ResourceID string
 	// Parameters represents key/value pairs to pass into the recipe template. Overrides any parameters set by the environment.
 	Parameters map[string]any
+	// Action represents the name of the custom action the recipe is handling. It is empty when the recipe deploys the resource.
+	Action string
+}
+
+// RecipeImport represents existing cloud resources that are adopted by a recipe instead of being deployed by it.
+type RecipeImport struct {
+	// Resources represents the existing cloud resources to adopt.
+	Resources []ImportedResource
+	// Values represents the key/value pairs of properties of the adopted resources, as the recipe would output them.
+	Values map[string]any
+	// Secrets represents the key/value pairs of secret values of the adopted resources, as the recipe would output them.
+	Secrets map[string]any
+}
+
+// ImportedResource represents an existing cloud resource adopted by a recipe.
+type ImportedResource struct {
+	// ID represents the ID of the cloud resource. For Terraform recipes, it is the ID used to import the resource.
+	ID string
+	// Address represents the address of the resource in the Terraform module of the recipe. It is only used by Terraform recipes.
+	Address string
 }
 
 const (
 	TemplateKindBicep     = "bicep"
 	TemplateKindTerraform = "terraform"
+	TemplateKindHelm      = "helm"
 
 	// Recipe outputs are expected to be wrapped under an object named "result"
 	ResultPropertyName = "result"
 )
 
 var (
-	SupportedTemplateKind = []string{TemplateKindBicep, TemplateKindTerraform}
+	SupportedTemplateKind = []string{TemplateKindBicep, TemplateKindTerraform, TemplateKindHelm}
 )
 
 // RecipeOutput represents recipe deployment output.
@@ -108,6 +131,42 @@ type RecipeOutput struct {
 	Status *rpv1.RecipeStatus
 }
 
+// RecipePlan represents the changes a recipe would make to the resources it manages if it was deployed.
+type RecipePlan struct {
+	// TemplateKind represents the kind of the template of the recipe that was planned.
+	TemplateKind string
+	// TemplatePath represents the path of the template of the recipe that was planned.
+	TemplatePath string
+	// ResourceChanges represents the changes to the resources managed by the recipe.
+	ResourceChanges []ResourceChange
+}
+
+// ResourceChange represents a change a recipe would make to a resource it manages.
+type ResourceChange struct {
+	// ID represents the ID of the resource. For Terraform recipes, it is the address of the resource in the Terraform configuration.
+	ID string
+	// Type represents the type of the resource.
+	Type string
+	// Action represents the change to the resource.
+	Action ResourceChangeAction
+}
+
+// ResourceChangeAction represents the kind of change a recipe would make to a resource.
+type ResourceChangeAction string
+
+const (
+	// ResourceChangeCreate means the resource would be created.
+	ResourceChangeCreate ResourceChangeAction = "create"
+	// ResourceChangeUpdate means the resource would be updated in-place.
+	ResourceChangeUpdate ResourceChangeAction = "update"
+	// ResourceChangeDelete means the resource would be deleted.
+	ResourceChangeDelete ResourceChangeAction = "delete"
+	// ResourceChangeReplace means the resource would be deleted and created again.
+	ResourceChangeReplace ResourceChangeAction = "replace"
+	// ResourceChangeNone means the resource would not be changed.
+	ResourceChangeNone ResourceChangeAction = "noChange"
+)
+
 // SecretData represents secrets data and includes secret type and a map of secret keys to their values.
 type SecretData struct {
 	Type string            `json:"type"`
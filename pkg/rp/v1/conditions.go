@@ -0,0 +1,117 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"strings"
+	"time"
+)
+
+// ConditionStatus is the status of a condition.
+type ConditionStatus string
+
+const (
+	// ConditionTrue means the resource is in the condition.
+	ConditionTrue ConditionStatus = "True"
+	// ConditionFalse means the resource is not in the condition.
+	ConditionFalse ConditionStatus = "False"
+	// ConditionUnknown means it could not be determined whether the resource is in the condition.
+	ConditionUnknown ConditionStatus = "Unknown"
+)
+
+const (
+	// ConditionDrifted is the condition type reporting whether the cloud resources deployed by the recipe of a
+	// portable resource have drifted from the recipe.
+	ConditionDrifted = "Drifted"
+)
+
+// Condition represents an observation of the state of a resource.
+type Condition struct {
+	// Type is the type of the condition, for example Drifted.
+	Type string `json:"type"`
+
+	// Status is the status of the condition.
+	Status ConditionStatus `json:"status"`
+
+	// Reason is a machine-readable reason for the last transition of the condition.
+	Reason string `json:"reason,omitempty"`
+
+	// Message is a human-readable message with details about the condition.
+	Message string `json:"message,omitempty"`
+
+	// LastTransitionTime is the last time the status of the condition changed.
+	LastTransitionTime time.Time `json:"lastTransitionTime,omitempty"`
+}
+
+// FindCondition returns the condition with the given type, or nil if the condition is not set.
+func FindCondition(conditions []Condition, conditionType string) *Condition {
+	for i := range conditions {
+		if strings.EqualFold(conditions[i].Type, conditionType) {
+			return &conditions[i]
+		}
+	}
+
+	return nil
+}
+
+// SetCondition adds the condition to the conditions, replacing the condition of the same type if one is set.
+// The last transition time is only updated when the status changes. It returns the updated conditions and
+// whether they changed.
+func SetCondition(conditions []Condition, condition Condition, now time.Time) ([]Condition, bool) {
+	existing := FindCondition(conditions, condition.Type)
+	if existing == nil {
+		condition.LastTransitionTime = now
+		return append(conditions, condition), true
+	}
+
+	if existing.Status == condition.Status && existing.Reason == condition.Reason && existing.Message == condition.Message {
+		return conditions, false
+	}
+
+	updated := make([]Condition, 0, len(conditions))
+	for _, c := range conditions {
+		if !strings.EqualFold(c.Type, condition.Type) {
+			updated = append(updated, c)
+			continue
+		}
+
+		if c.Status == condition.Status {
+			condition.LastTransitionTime = c.LastTransitionTime
+		} else {
+			condition.LastTransitionTime = now
+		}
+		updated = append(updated, condition)
+	}
+
+	return updated, true
+}
+
+// RemoveCondition returns the conditions without the condition of the given type.
+func RemoveCondition(conditions []Condition, conditionType string) []Condition {
+	if FindCondition(conditions, conditionType) == nil {
+		return conditions
+	}
+
+	updated := []Condition{}
+	for _, c := range conditions {
+		if !strings.EqualFold(c.Type, conditionType) {
+			updated = append(updated, c)
+		}
+	}
+
+	return updated
+}
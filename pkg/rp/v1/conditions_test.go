@@ -0,0 +1,60 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_SetCondition(t *testing.T) {
+	first := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	second := first.Add(time.Hour)
+	third := second.Add(time.Hour)
+
+	conditions, changed := SetCondition(nil, Condition{Type: ConditionDrifted, Status: ConditionFalse, Reason: "InSync"}, first)
+	require.True(t, changed)
+	require.Equal(t, []Condition{{Type: ConditionDrifted, Status: ConditionFalse, Reason: "InSync", LastTransitionTime: first}}, conditions)
+
+	// Setting the same condition again is not a change.
+	conditions, changed = SetCondition(conditions, Condition{Type: ConditionDrifted, Status: ConditionFalse, Reason: "InSync"}, second)
+	require.False(t, changed)
+	require.Equal(t, first, conditions[0].LastTransitionTime)
+
+	conditions, changed = SetCondition(conditions, Condition{Type: ConditionDrifted, Status: ConditionTrue, Reason: "ResourcesChanged", Message: "a"}, second)
+	require.True(t, changed)
+	require.Equal(t, []Condition{{Type: ConditionDrifted, Status: ConditionTrue, Reason: "ResourcesChanged", Message: "a", LastTransitionTime: second}}, conditions)
+
+	// Changing only the message keeps the transition time.
+	conditions, changed = SetCondition(conditions, Condition{Type: ConditionDrifted, Status: ConditionTrue, Reason: "ResourcesChanged", Message: "b"}, third)
+	require.True(t, changed)
+	require.Equal(t, []Condition{{Type: ConditionDrifted, Status: ConditionTrue, Reason: "ResourcesChanged", Message: "b", LastTransitionTime: second}}, conditions)
+}
+
+func Test_RemoveCondition(t *testing.T) {
+	conditions := []Condition{
+		{Type: "Ready", Status: ConditionTrue},
+		{Type: ConditionDrifted, Status: ConditionTrue},
+	}
+
+	require.Equal(t, []Condition{{Type: "Ready", Status: ConditionTrue}}, RemoveCondition(conditions, "drifted"))
+	require.Equal(t, conditions, RemoveCondition(conditions, "Other"))
+	require.Nil(t, FindCondition(RemoveCondition(conditions, ConditionDrifted), ConditionDrifted))
+	require.Equal(t, ConditionTrue, FindCondition(conditions, ConditionDrifted).Status)
+}
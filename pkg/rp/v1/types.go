@@ -101,6 +101,9 @@ type ResourceStatus struct {
 	// OutputResources represents the output resources associated with the radius resource.
 	OutputResources []OutputResource `json:"outputResources,omitempty"`
 	Recipe          *RecipeStatus    `json:"recipe,omitempty"`
+
+	// Conditions represents the latest observations of the state of the resource.
+	Conditions []Condition `json:"conditions,omitempty"`
 }
 
 // DeepCopyRecipeStatus creates a copy of ResourceStatus.
@@ -0,0 +1,236 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/spec"
+)
+
+// ApplyDefaults sets the default values declared by the schema for any properties that are not
+// present. Nested objects are processed recursively. The properties are modified in place.
+func ApplyDefaults(raw map[string]any, properties map[string]any) error {
+	s, err := Parse(raw)
+	if err != nil {
+		return err
+	} else if s == nil {
+		return nil
+	}
+
+	return applyDefaults(s, properties)
+}
+
+func applyDefaults(s *spec.Schema, properties map[string]any) error {
+	for name, property := range s.Properties {
+		value, ok := properties[name]
+		if !ok && property.Default != nil {
+			// Defaults are copied so that resources never share the same instance of a value.
+			copied, err := deepCopy(property.Default)
+			if err != nil {
+				return fmt.Errorf("failed to apply default value for property %q: %w", name, err)
+			}
+
+			properties[name] = copied
+			value = copied
+		}
+
+		nested, ok := value.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		err := applyDefaults(&property, nested)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ReadOnlyProperties returns the paths of the properties marked as readOnly by the schema, including the properties
+// of nested objects. The names in a path are joined with ".", e.g. "connection.host". Read-only properties are set by
+// Radius from the outputs of a recipe and cannot be set by clients.
+func ReadOnlyProperties(raw map[string]any) ([]string, error) {
+	s, err := Parse(raw)
+	if err != nil {
+		return nil, err
+	} else if s == nil {
+		return nil, nil
+	}
+
+	paths := readOnlyProperties(s, "")
+	sort.Strings(paths)
+	return paths, nil
+}
+
+func readOnlyProperties(s *spec.Schema, prefix string) []string {
+	paths := []string{}
+	for name, property := range s.Properties {
+		path := name
+		if prefix != "" {
+			path = prefix + "." + name
+		}
+
+		if property.ReadOnly {
+			paths = append(paths, path)
+			continue
+		}
+
+		paths = append(paths, readOnlyProperties(&property, path)...)
+	}
+
+	return paths
+}
+
+// GetPath returns the value at the path in the properties, as returned by ReadOnlyProperties. It returns false if the
+// path is not present.
+func GetPath(properties map[string]any, path string) (any, bool) {
+	names := strings.Split(path, ".")
+	current := properties
+	for _, name := range names[:len(names)-1] {
+		nested, ok := current[name].(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		current = nested
+	}
+
+	value, ok := current[names[len(names)-1]]
+	return value, ok
+}
+
+// SetPath sets the value at the path in the properties, as returned by ReadOnlyProperties. Missing nested objects
+// are created, and values that are not objects are replaced by objects. The properties are modified in place.
+func SetPath(properties map[string]any, path string, value any) {
+	names := strings.Split(path, ".")
+	current := properties
+	for _, name := range names[:len(names)-1] {
+		nested, ok := current[name].(map[string]any)
+		if !ok {
+			nested = map[string]any{}
+			current[name] = nested
+		}
+		current = nested
+	}
+
+	current[names[len(names)-1]] = value
+}
+
+// RemoveReadOnly removes any properties marked as readOnly by the schema. Nested objects are processed
+// recursively. The properties are modified in place.
+func RemoveReadOnly(raw map[string]any, properties map[string]any) error {
+	s, err := Parse(raw)
+	if err != nil {
+		return err
+	} else if s == nil {
+		return nil
+	}
+
+	removeReadOnly(s, properties)
+	return nil
+}
+
+func removeReadOnly(s *spec.Schema, properties map[string]any) {
+	for name, property := range s.Properties {
+		if property.ReadOnly {
+			delete(properties, name)
+			continue
+		}
+
+		nested, ok := properties[name].(map[string]any)
+		if ok {
+			removeReadOnly(&property, nested)
+		}
+	}
+}
+
+// PreserveReadOnly copies the values of the properties marked as readOnly by the schema from the existing properties
+// to the new properties. Nested objects are processed recursively when they are present in both. The new properties
+// are modified in place.
+func PreserveReadOnly(raw map[string]any, existing map[string]any, properties map[string]any) error {
+	s, err := Parse(raw)
+	if err != nil {
+		return err
+	} else if s == nil {
+		return nil
+	}
+
+	preserveReadOnly(s, existing, properties)
+	return nil
+}
+
+func preserveReadOnly(s *spec.Schema, existing map[string]any, properties map[string]any) {
+	for name, property := range s.Properties {
+		value, ok := existing[name]
+		if !ok {
+			continue
+		}
+
+		if property.ReadOnly {
+			properties[name] = value
+			continue
+		}
+
+		existingNested, ok := value.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		nested, ok := properties[name].(map[string]any)
+		if ok {
+			preserveReadOnly(&property, existingNested, nested)
+		}
+	}
+}
+
+// removeReadOnlyRequirements removes readOnly properties from the list of required properties. Clients
+// cannot provide readOnly properties, so they must not be required on input.
+func removeReadOnlyRequirements(s *spec.Schema) {
+	required := []string{}
+	for _, name := range s.Required {
+		if property, ok := s.Properties[name]; ok && property.ReadOnly {
+			continue
+		}
+		required = append(required, name)
+	}
+	s.Required = required
+
+	for name, property := range s.Properties {
+		removeReadOnlyRequirements(&property)
+		s.Properties[name] = property
+	}
+}
+
+func deepCopy(value any) (any, error) {
+	bs, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	var result any
+	err = json.Unmarshal(bs, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
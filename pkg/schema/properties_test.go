@@ -0,0 +1,273 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var propertiesSchema = map[string]any{
+	"type": "object",
+	"properties": map[string]any{
+		"size": map[string]any{
+			"type":    "string",
+			"default": "S",
+		},
+		"host": map[string]any{
+			"type":     "string",
+			"readOnly": true,
+		},
+		"port": map[string]any{
+			"type":     "integer",
+			"readOnly": true,
+		},
+		"network": map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"protocol": map[string]any{
+					"type":    "string",
+					"default": "tcp",
+				},
+				"address": map[string]any{
+					"type":     "string",
+					"readOnly": true,
+				},
+			},
+		},
+		"tags": map[string]any{
+			"type":    "array",
+			"default": []any{"a", "b"},
+		},
+	},
+	"required": []any{"size", "host"},
+}
+
+func Test_ApplyDefaults(t *testing.T) {
+	t.Run("no schema", func(t *testing.T) {
+		properties := map[string]any{"foo": "bar"}
+		err := ApplyDefaults(nil, properties)
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{"foo": "bar"}, properties)
+	})
+
+	t.Run("missing properties", func(t *testing.T) {
+		properties := map[string]any{
+			"network": map[string]any{},
+		}
+		err := ApplyDefaults(propertiesSchema, properties)
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{
+			"size": "S",
+			"network": map[string]any{
+				"protocol": "tcp",
+			},
+			"tags": []any{"a", "b"},
+		}, properties)
+	})
+
+	t.Run("existing properties are not overwritten", func(t *testing.T) {
+		properties := map[string]any{
+			"size": "L",
+			"network": map[string]any{
+				"protocol": "udp",
+			},
+			"tags": []any{},
+		}
+		err := ApplyDefaults(propertiesSchema, properties)
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{
+			"size": "L",
+			"network": map[string]any{
+				"protocol": "udp",
+			},
+			"tags": []any{},
+		}, properties)
+	})
+
+	t.Run("defaults are copied", func(t *testing.T) {
+		first := map[string]any{}
+		second := map[string]any{}
+		require.NoError(t, ApplyDefaults(propertiesSchema, first))
+		require.NoError(t, ApplyDefaults(propertiesSchema, second))
+
+		first["tags"].([]any)[0] = "changed"
+		require.Equal(t, []any{"a", "b"}, second["tags"])
+	})
+}
+
+func Test_ReadOnlyProperties(t *testing.T) {
+	names, err := ReadOnlyProperties(propertiesSchema)
+	require.NoError(t, err)
+	require.Equal(t, []string{"host", "network.address", "port"}, names)
+
+	names, err = ReadOnlyProperties(nil)
+	require.NoError(t, err)
+	require.Empty(t, names)
+}
+
+func Test_GetPath(t *testing.T) {
+	properties := map[string]any{
+		"host": "example.com",
+		"network": map[string]any{
+			"address": "10.0.0.1",
+		},
+	}
+
+	tests := []struct {
+		path     string
+		expected any
+		found    bool
+	}{
+		{path: "host", expected: "example.com", found: true},
+		{path: "network.address", expected: "10.0.0.1", found: true},
+		{path: "network.protocol"},
+		{path: "host.name"},
+		{path: "missing.address"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			value, found := GetPath(properties, tt.path)
+			require.Equal(t, tt.found, found)
+			require.Equal(t, tt.expected, value)
+		})
+	}
+}
+
+func Test_SetPath(t *testing.T) {
+	properties := map[string]any{
+		"host": "example.com",
+		"network": map[string]any{
+			"protocol": "tcp",
+		},
+	}
+
+	SetPath(properties, "port", 8080)
+	SetPath(properties, "network.address", "10.0.0.1")
+	SetPath(properties, "connection.host", "example.com")
+	SetPath(properties, "host.name", "example")
+
+	require.Equal(t, map[string]any{
+		"host": map[string]any{"name": "example"},
+		"port": 8080,
+		"network": map[string]any{
+			"protocol": "tcp",
+			"address":  "10.0.0.1",
+		},
+		"connection": map[string]any{"host": "example.com"},
+	}, properties)
+}
+
+func Test_RemoveReadOnly(t *testing.T) {
+	properties := map[string]any{
+		"size": "M",
+		"host": "example.com",
+		"port": 8080,
+		"network": map[string]any{
+			"protocol": "tcp",
+			"address":  "10.0.0.1",
+		},
+	}
+
+	err := RemoveReadOnly(propertiesSchema, properties)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{
+		"size": "M",
+		"network": map[string]any{
+			"protocol": "tcp",
+		},
+	}, properties)
+}
+
+func Test_PreserveReadOnly(t *testing.T) {
+	existing := map[string]any{
+		"size": "S",
+		"host": "example.com",
+		"network": map[string]any{
+			"protocol": "tcp",
+			"address":  "10.0.0.1",
+		},
+	}
+
+	t.Run("nested", func(t *testing.T) {
+		properties := map[string]any{
+			"size": "M",
+			"network": map[string]any{
+				"protocol": "udp",
+			},
+		}
+
+		err := PreserveReadOnly(propertiesSchema, existing, properties)
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{
+			"size": "M",
+			"host": "example.com",
+			"network": map[string]any{
+				"protocol": "udp",
+				"address":  "10.0.0.1",
+			},
+		}, properties)
+	})
+
+	t.Run("nested object removed", func(t *testing.T) {
+		properties := map[string]any{
+			"size": "M",
+		}
+
+		err := PreserveReadOnly(propertiesSchema, existing, properties)
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{
+			"size": "M",
+			"host": "example.com",
+		}, properties)
+	})
+
+	t.Run("no schema", func(t *testing.T) {
+		properties := map[string]any{"size": "M"}
+
+		err := PreserveReadOnly(nil, existing, properties)
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{"size": "M"}, properties)
+	})
+}
+
+func Test_ValidateProperties_ReadOnlyNotRequired(t *testing.T) {
+	schema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"size": map[string]any{
+				"type": "string",
+			},
+			"host": map[string]any{
+				"type":     "string",
+				"readOnly": true,
+			},
+		},
+		"required": []any{"size", "host"},
+	}
+
+	errs, err := ValidateProperties(schema, map[string]any{"size": "M"})
+	require.NoError(t, err)
+	require.Empty(t, errs)
+
+	errs, err = ValidateProperties(schema, map[string]any{})
+	require.NoError(t, err)
+	require.Len(t, errs, 1)
+	require.Equal(t, "$.properties.size", errs[0].Path)
+}
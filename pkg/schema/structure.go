@@ -0,0 +1,286 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+
+	"github.com/go-openapi/spec"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+var supportedTypes = []string{"string", "integer", "number", "boolean", "object", "array"}
+
+// ValidateStructure checks that a schema is structural, meaning that the type of every field of a resource can be
+// determined from the schema alone:
+//
+//   - The root of the schema must be an object.
+//   - Every schema must declare a single supported type, and cannot use $ref.
+//   - Arrays must declare the schema of their items.
+//   - Required properties must be declared, and only objects may declare properties.
+//   - Enum values and defaults must match the schema they are declared on.
+//
+// An error is returned if the schema cannot be parsed. Violations are returned as ValidationErrors using the path
+// of the field described by the invalid schema. A nil or empty schema is valid.
+func ValidateStructure(raw map[string]any) (ValidationErrors, error) {
+	s, err := Parse(raw)
+	if err != nil {
+		return nil, err
+	} else if s == nil {
+		return nil, nil
+	}
+
+	errs := ValidationErrors{}
+	if !s.Type.Contains("object") || len(s.Type) != 1 {
+		errs = append(errs, &ValidationError{Path: RootPath, Message: fmt.Sprintf("%s must be of type object", RootPath)})
+	}
+
+	errs = append(errs, validateStructure(s, RootPath)...)
+	return errs, nil
+}
+
+func validateStructure(s *spec.Schema, path string) ValidationErrors {
+	errs := ValidationErrors{}
+	invalid := func(format string, args ...any) {
+		errs = append(errs, &ValidationError{Path: path, Message: path + " " + fmt.Sprintf(format, args...)})
+	}
+
+	if s.Ref.String() != "" {
+		invalid("uses $ref, which is not supported")
+		return errs
+	}
+
+	if len(s.Type) == 0 {
+		invalid("must declare a type")
+		return errs
+	} else if len(s.Type) > 1 {
+		invalid("must declare a single type")
+		return errs
+	} else if !slices.Contains(supportedTypes, s.Type[0]) {
+		invalid("declares the type %q, which is not supported", s.Type[0])
+		return errs
+	}
+
+	typ := s.Type[0]
+	if typ != "object" && (len(s.Properties) > 0 || len(s.Required) > 0 || s.AdditionalProperties != nil) {
+		invalid("declares properties but is not of type object")
+	}
+
+	if typ == "array" && (s.Items == nil || s.Items.Schema == nil) {
+		invalid("must declare the schema of its items")
+	}
+
+	for _, name := range s.Required {
+		if _, ok := s.Properties[name]; !ok {
+			invalid("requires the property %q, which is not declared", name)
+		}
+	}
+
+	for _, value := range s.Enum {
+		if !matchesType(typ, value) {
+			invalid("declares the enum value %v, which is not of type %s", value, typ)
+		}
+	}
+
+	if s.Default != nil {
+		result := validate.NewSchemaValidator(s, nil, "default", strfmt.Default).Validate(s.Default)
+		if result != nil && !result.IsValid() {
+			for _, err := range result.Errors {
+				for _, e := range toValidationErrors(err) {
+					invalid("declares an invalid default value: %s", e.Message)
+				}
+			}
+		}
+	}
+
+	for _, name := range sortedKeys(s.Properties) {
+		property := s.Properties[name]
+		errs = append(errs, validateStructure(&property, path+"."+name)...)
+	}
+
+	if s.Items != nil && s.Items.Schema != nil {
+		errs = append(errs, validateStructure(s.Items.Schema, path+"[*]")...)
+	}
+
+	if s.AdditionalProperties != nil && s.AdditionalProperties.Schema != nil {
+		errs = append(errs, validateStructure(s.AdditionalProperties.Schema, path+".*")...)
+	}
+
+	return errs
+}
+
+// BreakingChange describes a change between two versions of a schema that can cause resources that are valid
+// for the previous version to be invalid for the next version.
+type BreakingChange struct {
+	// Path is the location of the changed field, e.g. "$.properties.size".
+	Path string
+
+	// Message describes the change, e.g. "$.properties.size removed the enum value L".
+	Message string
+}
+
+// FindBreakingChanges compares two versions of a schema and returns the breaking changes, sorted by path:
+//
+//   - A property was removed or changed its type.
+//   - A property became required.
+//   - An enum was added, or enum values were removed.
+//   - A length or range constraint was narrowed.
+//
+// A nil or empty schema accepts any properties, so there are no breaking changes if either schema is empty.
+func FindBreakingChanges(previous map[string]any, next map[string]any) ([]BreakingChange, error) {
+	previousSchema, err := Parse(previous)
+	if err != nil {
+		return nil, err
+	}
+
+	nextSchema, err := Parse(next)
+	if err != nil {
+		return nil, err
+	}
+
+	if previousSchema == nil || nextSchema == nil {
+		return nil, nil
+	}
+
+	changes := findBreakingChanges(previousSchema, nextSchema, RootPath)
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+
+	return changes, nil
+}
+
+func findBreakingChanges(previous *spec.Schema, next *spec.Schema, path string) []BreakingChange {
+	changes := []BreakingChange{}
+	changed := func(path string, format string, args ...any) {
+		changes = append(changes, BreakingChange{Path: path, Message: path + " " + fmt.Sprintf(format, args...)})
+	}
+
+	if len(previous.Type) > 0 && len(next.Type) > 0 && !slices.Equal(previous.Type, next.Type) {
+		changed(path, "changed type from %v to %v", previous.Type, next.Type)
+		return changes
+	}
+
+	for _, name := range sortedKeys(previous.Properties) {
+		previousProperty := previous.Properties[name]
+		nextProperty, ok := next.Properties[name]
+		if !ok {
+			if slices.Contains(previous.Required, name) {
+				changed(path+"."+name, "was required and has been removed")
+			} else {
+				changed(path+"."+name, "has been removed")
+			}
+			continue
+		}
+
+		changes = append(changes, findBreakingChanges(&previousProperty, &nextProperty, path+"."+name)...)
+	}
+
+	for _, name := range next.Required {
+		// Read-only properties are set by Radius, so requiring them does not affect clients.
+		if slices.Contains(previous.Required, name) || next.Properties[name].ReadOnly {
+			continue
+		}
+
+		changed(path+"."+name, "is now required")
+	}
+
+	if len(next.Enum) > 0 {
+		if len(previous.Enum) == 0 {
+			changed(path, "now declares an enum")
+		} else {
+			for _, value := range previous.Enum {
+				if !slices.ContainsFunc(next.Enum, func(v any) bool { return fmt.Sprint(v) == fmt.Sprint(value) }) {
+					changed(path, "removed the enum value %v", value)
+				}
+			}
+		}
+	}
+
+	if narrowed(previous.MinLength, next.MinLength, func(p, n int64) bool { return n > p }) {
+		changed(path, "increased minLength")
+	}
+	if narrowed(previous.MaxLength, next.MaxLength, func(p, n int64) bool { return n < p }) {
+		changed(path, "decreased maxLength")
+	}
+	if narrowed(previous.Minimum, next.Minimum, func(p, n float64) bool { return n > p }) {
+		changed(path, "increased minimum")
+	}
+	if narrowed(previous.Maximum, next.Maximum, func(p, n float64) bool { return n < p }) {
+		changed(path, "decreased maximum")
+	}
+
+	if previous.Items != nil && previous.Items.Schema != nil && next.Items != nil && next.Items.Schema != nil {
+		changes = append(changes, findBreakingChanges(previous.Items.Schema, next.Items.Schema, path+"[*]")...)
+	}
+
+	if previous.AdditionalProperties != nil && previous.AdditionalProperties.Schema != nil && next.AdditionalProperties != nil && next.AdditionalProperties.Schema != nil {
+		changes = append(changes, findBreakingChanges(previous.AdditionalProperties.Schema, next.AdditionalProperties.Schema, path+".*")...)
+	}
+
+	return changes
+}
+
+// narrowed determines if a constraint was added or narrowed. A constraint that was removed is never narrowed.
+func narrowed[T int64 | float64](previous *T, next *T, isNarrower func(p T, n T) bool) bool {
+	if next == nil {
+		return false
+	} else if previous == nil {
+		return true
+	}
+
+	return isNarrower(*previous, *next)
+}
+
+// matchesType determines if a JSON value matches a schema type.
+func matchesType(typ string, value any) bool {
+	switch typ {
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "integer":
+		v, ok := value.(float64)
+		return ok && v == math.Trunc(v)
+	case "number":
+		_, ok := value.(float64)
+		return ok
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "object":
+		_, ok := value.(map[string]any)
+		return ok
+	case "array":
+		_, ok := value.([]any)
+		return ok
+	default:
+		return false
+	}
+}
+
+func sortedKeys(properties spec.SchemaProperties) []string {
+	keys := make([]string, 0, len(properties))
+	for key := range properties {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,282 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ValidateStructure(t *testing.T) {
+	tests := []struct {
+		name     string
+		schema   map[string]any
+		expected []string
+	}{
+		{
+			name:     "no schema",
+			schema:   nil,
+			expected: nil,
+		},
+		{
+			name:     "structural schema",
+			schema:   testSchema,
+			expected: []string{},
+		},
+		{
+			name:     "root is not an object",
+			schema:   map[string]any{"type": "string"},
+			expected: []string{"$.properties must be of type object"},
+		},
+		{
+			name: "missing type",
+			schema: map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"size": map[string]any{"description": "The size."},
+				},
+			},
+			expected: []string{"$.properties.size must declare a type"},
+		},
+		{
+			name: "unsupported type and $ref",
+			schema: map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"size":    map[string]any{"type": "null"},
+					"network": map[string]any{"$ref": "#/definitions/network"},
+				},
+			},
+			expected: []string{
+				"$.properties.network uses $ref, which is not supported",
+				"$.properties.size declares the type \"null\", which is not supported",
+			},
+		},
+		{
+			name: "array without items",
+			schema: map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"tags": map[string]any{"type": "array"},
+				},
+			},
+			expected: []string{"$.properties.tags must declare the schema of its items"},
+		},
+		{
+			name: "undeclared required property",
+			schema: map[string]any{
+				"type":     "object",
+				"required": []any{"size"},
+			},
+			expected: []string{"$.properties requires the property \"size\", which is not declared"},
+		},
+		{
+			name: "properties on a non-object",
+			schema: map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"size": map[string]any{
+						"type": "string",
+						"properties": map[string]any{
+							"value": map[string]any{"type": "string"},
+						},
+					},
+				},
+			},
+			expected: []string{"$.properties.size declares properties but is not of type object"},
+		},
+		{
+			name: "invalid enum and default",
+			schema: map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"replicas": map[string]any{
+						"type":    "integer",
+						"enum":    []any{1, "two"},
+						"default": 1,
+					},
+					"size": map[string]any{
+						"type":    "string",
+						"default": 1.5,
+					},
+				},
+			},
+			expected: []string{
+				"$.properties.replicas declares the enum value two, which is not of type integer",
+				"$.properties.size declares an invalid default value: default in body must be of type string: \"number\"",
+			},
+		},
+		{
+			name: "nested items",
+			schema: map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"ports": map[string]any{
+						"type":  "array",
+						"items": map[string]any{"type": "object", "required": []any{"port"}},
+					},
+				},
+			},
+			expected: []string{"$.properties.ports[*] requires the property \"port\", which is not declared"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs, err := ValidateStructure(tt.schema)
+			require.NoError(t, err)
+
+			if tt.expected == nil {
+				require.Nil(t, errs)
+				return
+			}
+
+			messages := []string{}
+			for _, e := range errs {
+				messages = append(messages, e.Message)
+			}
+			require.Equal(t, tt.expected, messages)
+		})
+	}
+}
+
+func Test_FindBreakingChanges(t *testing.T) {
+	tests := []struct {
+		name     string
+		previous map[string]any
+		next     map[string]any
+		expected []string
+	}{
+		{
+			name:     "no schema",
+			previous: nil,
+			next:     testSchema,
+			expected: nil,
+		},
+		{
+			name:     "no changes",
+			previous: testSchema,
+			next:     testSchema,
+			expected: []string{},
+		},
+		{
+			name:     "compatible changes",
+			previous: testSchema,
+			next: map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"size": map[string]any{
+						"type": "string",
+						"enum": []any{"S", "M", "L", "XL"},
+					},
+					"replicas": map[string]any{
+						"type": "integer",
+					},
+					"network": map[string]any{
+						"type": "object",
+						"properties": map[string]any{
+							"port": map[string]any{
+								"type": "integer",
+							},
+						},
+					},
+					"region": map[string]any{
+						"type": "string",
+					},
+					"host": map[string]any{
+						"type":     "string",
+						"readOnly": true,
+					},
+				},
+				"required": []any{"size", "host"},
+			},
+			expected: []string{},
+		},
+		{
+			name:     "breaking changes",
+			previous: testSchema,
+			next: map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"replicas": map[string]any{
+						"type":    "integer",
+						"minimum": 2,
+						"maximum": 10,
+					},
+					"network": map[string]any{
+						"type": "object",
+						"properties": map[string]any{
+							"port": map[string]any{
+								"type": "string",
+							},
+						},
+						"required": []any{"port"},
+					},
+				},
+				"required": []any{"replicas"},
+			},
+			expected: []string{
+				"$.properties.network.port changed type from [integer] to [string]",
+				"$.properties.network.port is now required",
+				"$.properties.replicas increased minimum",
+				"$.properties.replicas decreased maximum",
+				"$.properties.replicas is now required",
+				"$.properties.size was required and has been removed",
+			},
+		},
+		{
+			name: "narrowed enum",
+			previous: map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"size": map[string]any{"type": "string", "enum": []any{"S", "M", "L"}},
+					"tier": map[string]any{"type": "string"},
+				},
+			},
+			next: map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"size": map[string]any{"type": "string", "enum": []any{"S", "M"}},
+					"tier": map[string]any{"type": "string", "enum": []any{"free"}},
+				},
+			},
+			expected: []string{
+				"$.properties.size removed the enum value L",
+				"$.properties.tier now declares an enum",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changes, err := FindBreakingChanges(tt.previous, tt.next)
+			require.NoError(t, err)
+
+			if tt.expected == nil {
+				require.Nil(t, changes)
+				return
+			}
+
+			messages := []string{}
+			for _, c := range changes {
+				messages = append(messages, c.Message)
+			}
+			require.Equal(t, tt.expected, messages)
+		})
+	}
+}
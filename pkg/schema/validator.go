@@ -0,0 +1,150 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	oai_errors "github.com/go-openapi/errors"
+	"github.com/go-openapi/spec"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+const (
+	// RootPath is the path used to report errors for the resource properties.
+	RootPath = "$.properties"
+)
+
+// ValidationError represents a single violation of a resource type schema.
+type ValidationError struct {
+	// Path is the location of the invalid field, e.g. "$.properties.size".
+	Path string
+
+	// Message contains the error message, e.g. "$.properties.size in body must be of type string".
+	Message string
+}
+
+// Error implements the error interface.
+func (e *ValidationError) Error() string {
+	return e.Message
+}
+
+// ValidationErrors is a list of schema violations.
+type ValidationErrors []*ValidationError
+
+// Error implements the error interface.
+func (e ValidationErrors) Error() string {
+	if len(e) == 0 {
+		return "no validation errors"
+	}
+
+	errs := []error{}
+	for _, err := range e {
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...).Error()
+}
+
+// Parse converts the raw schema stored by UCP into an OpenAPI schema. A nil or empty schema
+// returns nil, which means that the resource type does not define a schema.
+func Parse(raw map[string]any) (*spec.Schema, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	bs, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal schema: %w", err)
+	}
+
+	result := &spec.Schema{}
+	err = json.Unmarshal(bs, result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse schema: %w", err)
+	}
+
+	return result, nil
+}
+
+// ValidateProperties validates the properties of a resource against the schema of its resource type.
+//
+// An error is returned if the schema cannot be parsed. Violations of the schema are returned as
+// ValidationErrors sorted by path. A nil or empty schema accepts any properties. Properties marked
+// as readOnly are never required, because they are set by Radius rather than the client.
+func ValidateProperties(raw map[string]any, properties map[string]any) (ValidationErrors, error) {
+	s, err := Parse(raw)
+	if err != nil {
+		return nil, err
+	} else if s == nil {
+		return nil, nil
+	}
+
+	// The validator works on JSON-like data, so we round-trip the properties to normalize
+	// numeric types and nested structures.
+	bs, err := json.Marshal(properties)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal properties: %w", err)
+	}
+
+	var data any
+	err = json.Unmarshal(bs, &data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal properties: %w", err)
+	}
+
+	removeReadOnlyRequirements(s)
+
+	result := validate.NewSchemaValidator(s, nil, RootPath, strfmt.Default).Validate(data)
+	if result == nil || result.IsValid() {
+		return nil, nil
+	}
+
+	errs := ValidationErrors{}
+	for _, err := range result.Errors {
+		errs = append(errs, toValidationErrors(err)...)
+	}
+
+	sort.SliceStable(errs, func(i, j int) bool {
+		return errs[i].Path < errs[j].Path
+	})
+
+	return errs, nil
+}
+
+func toValidationErrors(err error) ValidationErrors {
+	switch e := err.(type) {
+	case *oai_errors.CompositeError:
+		errs := ValidationErrors{}
+		for _, inner := range e.Errors {
+			errs = append(errs, toValidationErrors(inner)...)
+		}
+		return errs
+	case *oai_errors.Validation:
+		path := e.Name
+		if path == "" {
+			path = RootPath
+		}
+		return ValidationErrors{{Path: path, Message: e.Error()}}
+	default:
+		return ValidationErrors{{Path: RootPath, Message: err.Error()}}
+	}
+}
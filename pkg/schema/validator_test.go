@@ -0,0 +1,135 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var testSchema = map[string]any{
+	"type": "object",
+	"properties": map[string]any{
+		"size": map[string]any{
+			"type": "string",
+			"enum": []any{"S", "M", "L"},
+		},
+		"replicas": map[string]any{
+			"type":    "integer",
+			"minimum": 1,
+		},
+		"network": map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"port": map[string]any{
+					"type": "integer",
+				},
+			},
+		},
+	},
+	"required":             []any{"size"},
+	"additionalProperties": false,
+}
+
+func Test_Parse(t *testing.T) {
+	t.Run("nil schema", func(t *testing.T) {
+		s, err := Parse(nil)
+		require.NoError(t, err)
+		require.Nil(t, s)
+	})
+
+	t.Run("valid schema", func(t *testing.T) {
+		s, err := Parse(testSchema)
+		require.NoError(t, err)
+		require.NotNil(t, s)
+		require.Contains(t, s.Properties, "size")
+		require.Equal(t, []string{"size"}, s.Required)
+	})
+
+	t.Run("invalid schema", func(t *testing.T) {
+		s, err := Parse(map[string]any{"required": "size"})
+		require.Error(t, err)
+		require.Nil(t, s)
+	})
+}
+
+func Test_ValidateProperties(t *testing.T) {
+	tests := []struct {
+		name       string
+		schema     map[string]any
+		properties map[string]any
+		expected   []string
+	}{
+		{
+			name:       "no schema",
+			schema:     nil,
+			properties: map[string]any{"anything": "goes"},
+			expected:   nil,
+		},
+		{
+			name:       "valid",
+			schema:     testSchema,
+			properties: map[string]any{"size": "S", "replicas": 3, "network": map[string]any{"port": 8080}},
+			expected:   nil,
+		},
+		{
+			name:       "missing required property",
+			schema:     testSchema,
+			properties: map[string]any{"replicas": 3},
+			expected:   []string{"$.properties.size"},
+		},
+		{
+			name:       "invalid enum",
+			schema:     testSchema,
+			properties: map[string]any{"size": "XL"},
+			expected:   []string{"$.properties.size"},
+		},
+		{
+			name:       "nested type mismatch",
+			schema:     testSchema,
+			properties: map[string]any{"size": "S", "network": map[string]any{"port": "http"}},
+			expected:   []string{"$.properties.network.port"},
+		},
+		{
+			name:       "multiple errors",
+			schema:     testSchema,
+			properties: map[string]any{"size": "S", "replicas": 0, "extra": true},
+			expected:   []string{"$.properties", "$.properties.replicas"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs, err := ValidateProperties(tt.schema, tt.properties)
+			require.NoError(t, err)
+
+			paths := []string{}
+			for _, e := range errs {
+				paths = append(paths, e.Path)
+				require.NotEmpty(t, e.Message)
+			}
+
+			if tt.expected == nil {
+				require.Empty(t, errs)
+			} else {
+				require.Equal(t, tt.expected, paths)
+				require.NotEmpty(t, errs.Error())
+			}
+		})
+	}
+}
@@ -19,17 +19,21 @@ package server
 import (
 	"context"
 	"fmt"
+	"time"
 
 	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
 	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
 	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/worker"
 	"github.com/radius-project/radius/pkg/armrpc/builder"
 	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
 	"github.com/radius-project/radius/pkg/components/database/databaseprovider"
 	"github.com/radius-project/radius/pkg/components/queue/queueprovider"
 	"github.com/radius-project/radius/pkg/corerp/backend/deployment"
 	"github.com/radius-project/radius/pkg/corerp/model"
 	"github.com/radius-project/radius/pkg/kubeutil"
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
 )
 
 // AsyncWorker is a service to run AsyncRequestProcessWorker.
@@ -65,6 +69,12 @@ func (w *AsyncWorker) init(ctx context.Context) error {
 		if w.options.Config.WorkerServer.MaxOperationRetryCount != nil {
 			workerOptions.MaxOperationRetryCount = *w.options.Config.WorkerServer.MaxOperationRetryCount
 		}
+		if w.options.Config.WorkerServer.CancellationPollIntervalSeconds != nil {
+			workerOptions.CancellationPollInterval = time.Duration(*w.options.Config.WorkerServer.CancellationPollIntervalSeconds) * time.Second
+		}
+		if w.options.Config.WorkerServer.MaxCancellationPollIntervalSeconds != nil {
+			workerOptions.MaxCancellationPollInterval = time.Duration(*w.options.Config.WorkerServer.MaxCancellationPollIntervalSeconds) * time.Second
+		}
 	}
 
 	queueProvider := queueprovider.New(w.options.Config.QueueProvider)
@@ -109,12 +119,21 @@ func (w *AsyncWorker) Run(ctx context.Context) error {
 		return fmt.Errorf("failed to initialize async worker: %w", err)
 	}
 
+	// The UCP client is used to look up user-defined resource types. It's not available in every hosting environment.
+	var ucp *v20231001preview.ClientFactory
+	if w.options.UCPConnection != nil {
+		ucp, err = v20231001preview.NewClientFactory(&aztoken.AnonymousCredential{}, sdk.NewClientOptions(w.options.UCPConnection))
+		if err != nil {
+			return fmt.Errorf("failed to initialize UCP client: %w", err)
+		}
+	}
+
 	for _, b := range w.handlerBuilder {
 		opts := ctrl.Options{
 			DatabaseClient: w.DatabaseClient,
 			KubeClient:     k8s.RuntimeClient,
 			GetDeploymentProcessor: func() deployment.DeploymentProcessor {
-				return deployment.NewDeploymentProcessor(appModel, w.DatabaseClient, k8s.RuntimeClient, k8s.ClientSet)
+				return deployment.NewDeploymentProcessor(appModel, w.DatabaseClient, k8s.RuntimeClient, k8s.ClientSet, ucp)
 			},
 		}
 
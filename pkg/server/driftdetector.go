@@ -0,0 +1,144 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	"github.com/radius-project/radius/pkg/components/database/databaseprovider"
+	"github.com/radius-project/radius/pkg/portableresources/backend/drift"
+	"github.com/radius-project/radius/pkg/recipes/controllerconfig"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+)
+
+const (
+	// driftDetectorLeaseName is the name of the lease that elects the replica that checks for drift.
+	driftDetectorLeaseName = "applications-rp-drift-detector"
+
+	// driftDetectorLeaseNamespace is the namespace of the lease that elects the replica that checks for drift.
+	driftDetectorLeaseNamespace = "radius-system"
+)
+
+// DriftDetector is a service to periodically check the resources deployed by recipes for drift. The service runs in
+// every replica of the resource provider, and the replicas elect a leader with a Kubernetes lease so that the
+// resources are only checked by one replica at a time.
+type DriftDetector struct {
+	options       hostoptions.HostOptions
+	config        *controllerconfig.RecipeControllerConfig
+	resourceTypes []string
+}
+
+// NewDriftDetector creates new service instance to check the resources deployed by the recipes of the given
+// portable resource types for drift.
+func NewDriftDetector(options hostoptions.HostOptions, config *controllerconfig.RecipeControllerConfig, resourceTypes []string) *DriftDetector {
+	return &DriftDetector{
+		options:       options,
+		config:        config,
+		resourceTypes: resourceTypes,
+	}
+}
+
+// Name represents the service name.
+func (d *DriftDetector) Name() string {
+	return "radiusdriftdetector"
+}
+
+// Run starts the drift detector.
+func (d *DriftDetector) Run(ctx context.Context) error {
+	var interval time.Duration
+	if d.options.Config.DriftDetection.Interval != "" {
+		var err error
+		interval, err = time.ParseDuration(d.options.Config.DriftDetection.Interval)
+		if err != nil {
+			return fmt.Errorf("failed to parse drift detection interval: %w", err)
+		}
+	}
+
+	databaseClient, err := databaseprovider.FromOptions(d.options.Config.DatabaseProvider).GetClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	detector := drift.NewDetector(drift.Options{
+		DatabaseClient: databaseClient,
+		Engine:         d.config.Engine,
+		ResourceTypes:  d.resourceTypes,
+		RootScope:      d.options.Config.DriftDetection.RootScope,
+		Interval:       interval,
+	})
+
+	return d.runAsLeader(ctx, detector.Run)
+}
+
+// runAsLeader runs run while the replica holds the drift detector lease. The replica campaigns for the lease again
+// if it loses it, until ctx is cancelled.
+func (d *DriftDetector) runAsLeader(ctx context.Context, run func(ctx context.Context) error) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	client, err := d.config.Kubernetes.ClientGoClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client for drift detection: %w", err)
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		return fmt.Errorf("failed to get hostname for drift detection: %w", err)
+	}
+	identity := hostname + "_" + uuid.NewString()
+
+	elector, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+		Lock: &resourcelock.LeaseLock{
+			LeaseMeta:  metav1.ObjectMeta{Name: driftDetectorLeaseName, Namespace: driftDetectorLeaseNamespace},
+			Client:     client.CoordinationV1(),
+			LockConfig: resourcelock.ResourceLockConfig{Identity: identity},
+		},
+		LeaseDuration:   60 * time.Second,
+		RenewDeadline:   40 * time.Second,
+		RetryPeriod:     10 * time.Second,
+		ReleaseOnCancel: true,
+		Name:            driftDetectorLeaseName,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(ctx context.Context) {
+				logger.Info("Acquired the drift detector lease", "identity", identity)
+				if err := run(ctx); err != nil {
+					logger.Error(err, "drift detector failed")
+				}
+			},
+			OnStoppedLeading: func() {
+				logger.Info("Released the drift detector lease", "identity", identity)
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create leader elector for drift detection: %w", err)
+	}
+
+	// Run returns when the lease is lost or ctx is cancelled.
+	for ctx.Err() == nil {
+		elector.Run(ctx)
+	}
+
+	return nil
+}
@@ -40,6 +40,10 @@ func (src *APIVersionResource) ConvertTo() (v1.DataModelInterface, error) {
 	}
 
 	dst.Properties = datamodel.APIVersionProperties{}
+	if src.Properties != nil {
+		dst.Properties.Schema = src.Properties.Schema
+		dst.Properties.Conversion = toAPIVersionConversionDataModel(src.Properties.Conversion)
+	}
 
 	return dst, nil
 }
@@ -59,7 +63,56 @@ func (dst *APIVersionResource) ConvertFrom(src v1.DataModelInterface) error {
 
 	dst.Properties = &APIVersionProperties{
 		ProvisioningState: to.Ptr(ProvisioningState(dm.InternalMetadata.AsyncProvisioningState)),
+		Schema:            dm.Properties.Schema,
+		Conversion:        fromAPIVersionConversionDataModel(dm.Properties.Conversion),
 	}
 
 	return nil
 }
+
+func toAPIVersionConversionDataModel(src *APIVersionConversion) *datamodel.APIVersionConversion {
+	if src == nil {
+		return nil
+	}
+
+	dst := &datamodel.APIVersionConversion{}
+	for _, mapping := range src.FieldMappings {
+		if mapping == nil {
+			continue
+		}
+		dst.FieldMappings = append(dst.FieldMappings, datamodel.FieldMapping{
+			From: to.String(mapping.From),
+			To:   to.String(mapping.To),
+		})
+	}
+
+	if src.Webhook != nil {
+		dst.Webhook = &datamodel.ConversionWebhook{
+			URL: to.String(src.Webhook.URL),
+		}
+	}
+
+	return dst
+}
+
+func fromAPIVersionConversionDataModel(src *datamodel.APIVersionConversion) *APIVersionConversion {
+	if src == nil {
+		return nil
+	}
+
+	dst := &APIVersionConversion{}
+	for _, mapping := range src.FieldMappings {
+		dst.FieldMappings = append(dst.FieldMappings, &FieldMapping{
+			From: to.Ptr(mapping.From),
+			To:   to.Ptr(mapping.To),
+		})
+	}
+
+	if src.Webhook != nil {
+		dst.Webhook = &ConversionWebhook{
+			URL: to.Ptr(src.Webhook.URL),
+		}
+	}
+
+	return dst
+}
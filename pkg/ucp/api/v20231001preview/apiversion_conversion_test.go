@@ -47,7 +47,24 @@ func Test_APIVersion_VersionedToDataModel(t *testing.T) {
 						UpdatedAPIVersion: Version,
 					},
 				},
-				Properties: datamodel.APIVersionProperties{},
+				Properties: datamodel.APIVersionProperties{
+					Conversion: &datamodel.APIVersionConversion{
+						FieldMappings: []datamodel.FieldMapping{
+							{From: "size", To: "sku.size"},
+						},
+						Webhook: &datamodel.ConversionWebhook{
+							URL: "http://localhost:8080/convert",
+						},
+					},
+					Schema: map[string]any{
+						"type": "object",
+						"properties": map[string]any{
+							"size": map[string]any{
+								"type": "string",
+							},
+						},
+					},
+				},
 			},
 		},
 	}
@@ -85,6 +102,22 @@ func Test_APIVersion_DataModelToVersioned(t *testing.T) {
 				Name: to.Ptr("2025-01-01"),
 				Properties: &APIVersionProperties{
 					ProvisioningState: to.Ptr(ProvisioningStateSucceeded),
+					Conversion: &APIVersionConversion{
+						FieldMappings: []*FieldMapping{
+							{From: to.Ptr("size"), To: to.Ptr("sku.size")},
+						},
+						Webhook: &ConversionWebhook{
+							URL: to.Ptr("http://localhost:8080/convert"),
+						},
+					},
+					Schema: map[string]any{
+						"type": "object",
+						"properties": map[string]any{
+							"size": map[string]any{
+								"type": "string",
+							},
+						},
+					},
 				},
 			},
 		},
@@ -18,12 +18,19 @@ package v20231001preview
 
 import (
 	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
 )
 
+// actionNameRegex matches valid names of custom actions. Action names are camelCased.
+var actionNameRegex = regexp.MustCompile(`^[a-z][A-Za-z0-9]+$`)
+
 // ConvertTo converts from the versioned ResourceTypeResource resource to version-agnostic datamodel.
 func (src *ResourceTypeResource) ConvertTo() (v1.DataModelInterface, error) {
 	dst := &datamodel.ResourceType{
@@ -51,9 +58,20 @@ func (src *ResourceTypeResource) ConvertTo() (v1.DataModelInterface, error) {
 		capabilities = append(capabilities, *capability)
 	}
 
+	err := validateCapabilities(capabilities)
+	if err != nil {
+		return nil, err
+	}
+
+	actions, err := convertActions(src.Properties.Actions, capabilities)
+	if err != nil {
+		return nil, err
+	}
+
 	dst.Properties = datamodel.ResourceTypeProperties{
 		Capabilities:      capabilities,
 		DefaultAPIVersion: src.Properties.DefaultAPIVersion,
+		Actions:           actions,
 	}
 
 	return dst, nil
@@ -78,6 +96,16 @@ func (dst *ResourceTypeResource) ConvertFrom(src v1.DataModelInterface) error {
 		DefaultAPIVersion: dm.Properties.DefaultAPIVersion,
 	}
 
+	if len(dm.Properties.Actions) > 0 {
+		dst.Properties.Actions = map[string]*ResourceTypeAction{}
+		for name, action := range dm.Properties.Actions {
+			dst.Properties.Actions[name] = &ResourceTypeAction{
+				Description: to.Ptr(action.Description),
+				RecipeName:  to.Ptr(action.RecipeName),
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -86,9 +114,62 @@ func validateCapability(input *string) error {
 		return v1.NewClientErrInvalidRequest("capability cannot be null")
 	}
 
-	if *input == datamodel.CapabilitySupportsRecipes {
+	if slices.Contains(datamodel.KnownCapabilities, *input) {
 		return nil
 	}
 
-	return v1.NewClientErrInvalidRequest(fmt.Sprintf("capability %q is not recognized. Supported capabilities: %s", *input, datamodel.CapabilitySupportsRecipes))
+	return v1.NewClientErrInvalidRequest(fmt.Sprintf("capability %q is not recognized. Supported capabilities: %s", *input, strings.Join(datamodel.KnownCapabilities, ", ")))
+}
+
+// validateCapabilities validates the combination of the capabilities of a resource type. Secrets are read from the
+// outputs of a recipe, so the ExposesSecrets capability requires the SupportsRecipes capability.
+func validateCapabilities(capabilities []string) error {
+	if slices.Contains(capabilities, datamodel.CapabilityExposesSecrets) && !slices.Contains(capabilities, datamodel.CapabilitySupportsRecipes) {
+		return v1.NewClientErrInvalidRequest(fmt.Sprintf("capability %q requires the capability %q", datamodel.CapabilityExposesSecrets, datamodel.CapabilitySupportsRecipes))
+	}
+
+	return nil
+}
+
+// convertActions validates and converts the custom actions of a resource type. Actions are handled by recipes, so
+// they require the SupportsRecipes capability. The recipe name of an action defaults to the name of the action.
+func convertActions(input map[string]*ResourceTypeAction, capabilities []string) (map[string]datamodel.ResourceTypeAction, error) {
+	if len(input) == 0 {
+		return nil, nil
+	}
+
+	if !slices.Contains(capabilities, datamodel.CapabilitySupportsRecipes) {
+		return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("actions require the %q capability", datamodel.CapabilitySupportsRecipes))
+	}
+
+	// Actions are routed case-insensitively, so names must be unique regardless of case.
+	names := map[string]string{}
+	actions := map[string]datamodel.ResourceTypeAction{}
+	for _, name := range slices.Sorted(maps.Keys(input)) {
+		action := input[name]
+		if !actionNameRegex.MatchString(name) {
+			return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("action %q is invalid. Action names must be camelCased. Example: rotateCredentials", name))
+		}
+
+		if slices.ContainsFunc(datamodel.ReservedActionNames, func(reserved string) bool { return strings.EqualFold(reserved, name) }) {
+			return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("action %q is reserved by Radius", name))
+		}
+
+		if other, ok := names[strings.ToLower(name)]; ok {
+			return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("actions %q and %q differ only by case", other, name))
+		}
+		names[strings.ToLower(name)] = name
+
+		converted := datamodel.ResourceTypeAction{RecipeName: name}
+		if action != nil {
+			converted.Description = to.String(action.Description)
+			if to.String(action.RecipeName) != "" {
+				converted.RecipeName = *action.RecipeName
+			}
+		}
+
+		actions[name] = converted
+	}
+
+	return actions, nil
 }
@@ -50,6 +50,16 @@ func Test_ResourceType_VersionedToDataModel(t *testing.T) {
 				Properties: datamodel.ResourceTypeProperties{
 					Capabilities:      []string{"SupportsRecipes"},
 					DefaultAPIVersion: to.Ptr("2025-01-01"),
+					Actions: map[string]datamodel.ResourceTypeAction{
+						"restart": {
+							Description: "Restarts the resource.",
+							RecipeName:  "restart",
+						},
+						"rotateCredentials": {
+							Description: "Rotates the credentials of the resource.",
+							RecipeName:  "rotate",
+						},
+					},
 				},
 			},
 		},
@@ -90,6 +100,12 @@ func Test_ResourceType_DataModelToVersioned(t *testing.T) {
 					ProvisioningState: to.Ptr(ProvisioningStateSucceeded),
 					Capabilities:      []*string{to.Ptr("SupportsRecipes")},
 					DefaultAPIVersion: to.Ptr("2025-01-01"),
+					Actions: map[string]*ResourceTypeAction{
+						"restart": {
+							Description: to.Ptr("Restarts the resource."),
+							RecipeName:  to.Ptr("restart"),
+						},
+					},
 				},
 			},
 		},
@@ -126,10 +142,22 @@ func Test_validateCapability(t *testing.T) {
 			name:  "valid capability",
 			input: to.Ptr(datamodel.CapabilitySupportsRecipes),
 		},
+		{
+			name:  "valid capability: ManualResourceProvisioning",
+			input: to.Ptr(datamodel.CapabilityManualResourceProvisioning),
+		},
+		{
+			name:  "valid capability: ExposesSecrets",
+			input: to.Ptr(datamodel.CapabilityExposesSecrets),
+		},
+		{
+			name:  "valid capability: ApplicationScopedOnly",
+			input: to.Ptr(datamodel.CapabilityApplicationScopedOnly),
+		},
 		{
 			name:        "invalid capability",
 			input:       to.Ptr("InvalidCapability"),
-			expectedErr: v1.NewClientErrInvalidRequest("capability \"InvalidCapability\" is not recognized. Supported capabilities: SupportsRecipes"),
+			expectedErr: v1.NewClientErrInvalidRequest("capability \"InvalidCapability\" is not recognized. Supported capabilities: SupportsRecipes, ManualResourceProvisioning, ExposesSecrets, ApplicationScopedOnly"),
 		},
 		{
 			name:        "nil capability",
@@ -150,3 +178,114 @@ func Test_validateCapability(t *testing.T) {
 		})
 	}
 }
+
+func Test_validateCapabilities(t *testing.T) {
+	tests := []struct {
+		name         string
+		capabilities []string
+		expectedErr  error
+	}{
+		{
+			name:         "recipes with secrets",
+			capabilities: []string{datamodel.CapabilitySupportsRecipes, datamodel.CapabilityExposesSecrets},
+		},
+		{
+			name:         "manual provisioning",
+			capabilities: []string{datamodel.CapabilityManualResourceProvisioning},
+		},
+		{
+			name:         "manual provisioning with secrets",
+			capabilities: []string{datamodel.CapabilityManualResourceProvisioning, datamodel.CapabilityExposesSecrets},
+			expectedErr:  v1.NewClientErrInvalidRequest("capability \"ExposesSecrets\" requires the capability \"SupportsRecipes\""),
+		},
+		{
+			name:         "secrets without recipes",
+			capabilities: []string{datamodel.CapabilityExposesSecrets},
+			expectedErr:  v1.NewClientErrInvalidRequest("capability \"ExposesSecrets\" requires the capability \"SupportsRecipes\""),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateCapabilities(tt.capabilities)
+			if tt.expectedErr != nil {
+				require.Equal(t, tt.expectedErr, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_convertActions(t *testing.T) {
+	tests := []struct {
+		name         string
+		input        map[string]*ResourceTypeAction
+		capabilities []string
+		expected     map[string]datamodel.ResourceTypeAction
+		expectedErr  error
+	}{
+		{
+			name:         "no actions",
+			input:        nil,
+			capabilities: []string{},
+			expected:     nil,
+		},
+		{
+			name: "default recipe name",
+			input: map[string]*ResourceTypeAction{
+				"backup": {},
+			},
+			capabilities: []string{datamodel.CapabilitySupportsRecipes},
+			expected: map[string]datamodel.ResourceTypeAction{
+				"backup": {RecipeName: "backup"},
+			},
+		},
+		{
+			name: "requires SupportsRecipes",
+			input: map[string]*ResourceTypeAction{
+				"backup": {},
+			},
+			capabilities: []string{datamodel.CapabilityManualResourceProvisioning},
+			expectedErr:  v1.NewClientErrInvalidRequest("actions require the \"SupportsRecipes\" capability"),
+		},
+		{
+			name: "invalid name",
+			input: map[string]*ResourceTypeAction{
+				"Backup": {},
+			},
+			capabilities: []string{datamodel.CapabilitySupportsRecipes},
+			expectedErr:  v1.NewClientErrInvalidRequest("action \"Backup\" is invalid. Action names must be camelCased. Example: rotateCredentials"),
+		},
+		{
+			name: "reserved name",
+			input: map[string]*ResourceTypeAction{
+				"listSecrets": {},
+			},
+			capabilities: []string{datamodel.CapabilitySupportsRecipes},
+			expectedErr:  v1.NewClientErrInvalidRequest("action \"listSecrets\" is reserved by Radius"),
+		},
+		{
+			name: "names differ only by case",
+			input: map[string]*ResourceTypeAction{
+				"backUp": {},
+				"backup": {},
+			},
+			capabilities: []string{datamodel.CapabilitySupportsRecipes},
+			expectedErr:  v1.NewClientErrInvalidRequest("actions \"backUp\" and \"backup\" differ only by case"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actions, err := convertActions(tt.input, tt.capabilities)
+			if tt.expectedErr != nil {
+				require.Equal(t, tt.expectedErr, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, actions)
+		})
+	}
+}
@@ -6,8 +6,25 @@ package v20231001preview
 
 import "time"
 
+// APIVersionConversion - The conversion between an API version and the default API version of a resource type. Field mappings
+// are applied before the webhook when converting to the default API version, and after the webhook when converting from the
+// default API version.
+type APIVersionConversion struct {
+// The fields that are moved when converting between the API versions.
+	FieldMappings []*FieldMapping
+
+// The webhook that is called to convert between the API versions.
+	Webhook *ConversionWebhook
+}
+
 // APIVersionProperties - The properties of an API version.
 type APIVersionProperties struct {
+// The conversion between this API version and the default API version of the resource type.
+	Conversion *APIVersionConversion
+
+// The OpenAPI v3 schema for the resource type at this API version.
+	Schema map[string]any
+
 // READ-ONLY; The status of the asynchronous operation.
 	ProvisioningState *ProvisioningState
 }
@@ -359,6 +376,12 @@ type ComponentsKhmx01SchemasGenericresourceAllof0 struct {
 	Type *string
 }
 
+// ConversionWebhook - A webhook that converts the properties of a resource between API versions.
+type ConversionWebhook struct {
+// REQUIRED; The URL of the webhook.
+	URL *string
+}
+
 // CredentialStorageProperties - The base credential storage properties
 type CredentialStorageProperties struct {
 // REQUIRED; The kind of credential storage
@@ -402,6 +425,15 @@ type ErrorResponse struct {
 	Error *ErrorDetail
 }
 
+// FieldMapping - A field that is moved when converting between API versions.
+type FieldMapping struct {
+// REQUIRED; The dot-separated path of the field in this API version.
+	From *string
+
+// REQUIRED; The dot-separated path of the field in the default API version.
+	To *string
+}
+
 // GenericPlaneResource - The generic representation of a plane resource
 type GenericPlaneResource struct {
 // REQUIRED; The geo-location where the resource lives
@@ -733,8 +765,21 @@ type ResourceProviderSummaryResourceType struct {
 	DefaultAPIVersion *string
 }
 
+// ResourceTypeAction - A custom action supported by a resource type. Actions are invoked with a POST request on a resource
+// and are handled by a recipe.
+type ResourceTypeAction struct {
+// The description of the action.
+	Description *string
+
+// The name of the recipe that handles the action. Defaults to the name of the action.
+	RecipeName *string
+}
+
 // ResourceTypeProperties - The properties of a resource type.
 type ResourceTypeProperties struct {
+// The custom actions supported by the resource type, keyed by action name.
+	Actions map[string]*ResourceTypeAction
+
 // The resource type capabilities.
 	Capabilities []*string
 
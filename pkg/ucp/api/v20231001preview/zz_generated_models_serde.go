@@ -11,10 +11,43 @@ import (
 	"reflect"
 )
 
+// MarshalJSON implements the json.Marshaller interface for type APIVersionConversion.
+func (a APIVersionConversion) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "fieldMappings", a.FieldMappings)
+	populate(objectMap, "webhook", a.Webhook)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type APIVersionConversion.
+func (a *APIVersionConversion) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", a, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "fieldMappings":
+				err = unpopulate(val, "FieldMappings", &a.FieldMappings)
+			delete(rawMsg, key)
+		case "webhook":
+				err = unpopulate(val, "Webhook", &a.Webhook)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", a, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type APIVersionProperties.
 func (a APIVersionProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
+	populate(objectMap, "conversion", a.Conversion)
 	populate(objectMap, "provisioningState", a.ProvisioningState)
+	populate(objectMap, "schema", a.Schema)
 	return json.Marshal(objectMap)
 }
 
@@ -27,9 +60,15 @@ func (a *APIVersionProperties) UnmarshalJSON(data []byte) error {
 	for key, val := range rawMsg {
 		var err error
 		switch key {
+		case "conversion":
+				err = unpopulate(val, "Conversion", &a.Conversion)
+			delete(rawMsg, key)
 		case "provisioningState":
 				err = unpopulate(val, "ProvisioningState", &a.ProvisioningState)
 			delete(rawMsg, key)
+		case "schema":
+				err = unpopulate(val, "Schema", &a.Schema)
+			delete(rawMsg, key)
 		}
 		if err != nil {
 			return fmt.Errorf("unmarshalling type %T: %v", a, err)
@@ -883,6 +922,33 @@ func (c *ComponentsKhmx01SchemasGenericresourceAllof0) UnmarshalJSON(data []byte
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type ConversionWebhook.
+func (c ConversionWebhook) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "url", c.URL)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type ConversionWebhook.
+func (c *ConversionWebhook) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", c, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "url":
+				err = unpopulate(val, "URL", &c.URL)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", c, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type CredentialStorageProperties.
 func (c CredentialStorageProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -1011,6 +1077,37 @@ func (e *ErrorResponse) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type FieldMapping.
+func (f FieldMapping) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "from", f.From)
+	populate(objectMap, "to", f.To)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type FieldMapping.
+func (f *FieldMapping) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", f, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "from":
+				err = unpopulate(val, "From", &f.From)
+			delete(rawMsg, key)
+		case "to":
+				err = unpopulate(val, "To", &f.To)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", f, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type GenericPlaneResource.
 func (g GenericPlaneResource) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -1952,9 +2049,41 @@ func (r *ResourceProviderSummaryResourceType) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type ResourceTypeAction.
+func (r ResourceTypeAction) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "description", r.Description)
+	populate(objectMap, "recipeName", r.RecipeName)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type ResourceTypeAction.
+func (r *ResourceTypeAction) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", r, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "description":
+				err = unpopulate(val, "Description", &r.Description)
+			delete(rawMsg, key)
+		case "recipeName":
+				err = unpopulate(val, "RecipeName", &r.RecipeName)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", r, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type ResourceTypeProperties.
 func (r ResourceTypeProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
+	populate(objectMap, "actions", r.Actions)
 	populate(objectMap, "capabilities", r.Capabilities)
 	populate(objectMap, "defaultApiVersion", r.DefaultAPIVersion)
 	populate(objectMap, "provisioningState", r.ProvisioningState)
@@ -1970,6 +2099,9 @@ func (r *ResourceTypeProperties) UnmarshalJSON(data []byte) error {
 	for key, val := range rawMsg {
 		var err error
 		switch key {
+		case "actions":
+				err = unpopulate(val, "Actions", &r.Actions)
+			delete(rawMsg, key)
 		case "capabilities":
 				err = unpopulate(val, "Capabilities", &r.Capabilities)
 			delete(rawMsg, key)
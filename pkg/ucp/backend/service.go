@@ -21,6 +21,7 @@ import (
 	"errors"
 	"net/http"
 	"net/url"
+	"time"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
@@ -64,6 +65,12 @@ func (w *Service) Run(ctx context.Context) error {
 	if w.options.Config.Worker.MaxOperationRetryCount != nil {
 		w.Service.Options.MaxOperationRetryCount = *w.options.Config.Worker.MaxOperationRetryCount
 	}
+	if w.options.Config.Worker.CancellationPollIntervalSeconds != nil {
+		w.Service.Options.CancellationPollInterval = time.Duration(*w.options.Config.Worker.CancellationPollIntervalSeconds) * time.Second
+	}
+	if w.options.Config.Worker.MaxCancellationPollIntervalSeconds != nil {
+		w.Service.Options.MaxCancellationPollInterval = time.Duration(*w.options.Config.Worker.MaxCancellationPollIntervalSeconds) * time.Second
+	}
 
 	databaseClient, err := w.options.DatabaseProvider.GetClient(ctx)
 	if err != nil {
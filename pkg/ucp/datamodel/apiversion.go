@@ -16,7 +16,13 @@ limitations under the License.
 
 package datamodel
 
-import v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+)
 
 const (
 	// APIVersionResourceType is the resource type for an API version.
@@ -38,5 +44,74 @@ func (r *APIVersion) ResourceTypeName() string {
 
 // APIVersion stores the properties of an API version.
 type APIVersionProperties struct {
-	// Empty for now.
+	// Schema is the OpenAPI v3 schema for the resource type at this API version. The schema describes
+	// the contents of the resource's "properties" bag.
+	Schema map[string]any `json:"schema,omitempty"`
+
+	// Conversion is the conversion between this API version and the default API version of the resource type.
+	Conversion *APIVersionConversion `json:"conversion,omitempty"`
+}
+
+// APIVersionConversion stores the conversion between an API version and the default API version of a resource type.
+//
+// When converting to the default API version the field mappings are applied before the webhook is called. When converting
+// from the default API version the webhook is called before the field mappings are applied.
+type APIVersionConversion struct {
+	// FieldMappings is the list of fields that are moved when converting between the API versions.
+	FieldMappings []FieldMapping `json:"fieldMappings,omitempty"`
+
+	// Webhook is the webhook that is called to convert between the API versions.
+	Webhook *ConversionWebhook `json:"webhook,omitempty"`
+}
+
+// FieldMapping stores a field that is moved when converting between API versions.
+type FieldMapping struct {
+	// From is the dot-separated path of the field in this API version.
+	From string `json:"from"`
+
+	// To is the dot-separated path of the field in the default API version.
+	To string `json:"to"`
+}
+
+// ConversionWebhook stores a webhook that converts the properties of a resource between API versions.
+type ConversionWebhook struct {
+	// URL is the URL of the webhook.
+	URL string `json:"url"`
+}
+
+// ConversionWebhookHostSuffixes is the list of host suffixes that a conversion webhook may use. Webhooks are called
+// by Radius with the properties of resources, so they are restricted to Kubernetes services in the cluster.
+var ConversionWebhookHostSuffixes = []string{".svc", ".svc.cluster.local"}
+
+// Validate validates the URL of the webhook. The URL must be an absolute HTTP or HTTPS URL without credentials whose
+// host is a Kubernetes service in the cluster, e.g. "https://converter.my-namespace.svc/convert".
+func (w *ConversionWebhook) Validate() error {
+	u, err := url.Parse(w.URL)
+	if err != nil {
+		return fmt.Errorf("conversion webhook URL %q is invalid: %w", w.URL, err)
+	}
+
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("conversion webhook URL %q must use the http or https scheme", w.URL)
+	}
+
+	if u.User != nil {
+		return fmt.Errorf("conversion webhook URL %q must not contain credentials", w.URL)
+	}
+
+	host := strings.ToLower(u.Hostname())
+	for _, suffix := range ConversionWebhookHostSuffixes {
+		// The host must be <service>.<namespace> followed by the suffix.
+		name, ok := strings.CutSuffix(host, suffix)
+		if !ok {
+			continue
+		}
+
+		service, namespace, ok := strings.Cut(name, ".")
+		if ok && service != "" && namespace != "" && !strings.Contains(namespace, ".") {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("conversion webhook URL %q must address a Kubernetes service in the cluster. The host must end with one of: %s", w.URL, strings.Join(ConversionWebhookHostSuffixes, ", "))
 }
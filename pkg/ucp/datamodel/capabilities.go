@@ -16,7 +16,62 @@ limitations under the License.
 
 package datamodel
 
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
 const (
 	// CapabilitySupportsRecipes is a capability that indicates the resource type supports recipes.
 	CapabilitySupportsRecipes = "SupportsRecipes"
+
+	// CapabilityManualResourceProvisioning is a capability that indicates the resources of the resource type are
+	// provisioned by the user rather than a recipe. The user supplies the outputs of the resource, including
+	// read-only properties, as part of the resource body.
+	CapabilityManualResourceProvisioning = "ManualResourceProvisioning"
+
+	// CapabilityExposesSecrets is a capability that indicates the resource type returns the secret outputs of its
+	// recipe through the listSecrets action. It requires the SupportsRecipes capability.
+	CapabilityExposesSecrets = "ExposesSecrets"
+
+	// CapabilityApplicationScopedOnly is a capability that indicates the resources of the resource type must
+	// belong to an application.
+	CapabilityApplicationScopedOnly = "ApplicationScopedOnly"
 )
+
+// KnownCapabilities is the list of capabilities that change the behavior of Radius.
+var KnownCapabilities = []string{
+	CapabilitySupportsRecipes,
+	CapabilityManualResourceProvisioning,
+	CapabilityExposesSecrets,
+	CapabilityApplicationScopedOnly,
+}
+
+// ValidateCapabilities validates the capabilities of a resource type. Each capability must be known to Radius, and
+// the capabilities must be consistent with each other. The error describes what the resource type declares, e.g.
+// "declares the capability X more than once".
+func ValidateCapabilities(capabilities []string) error {
+	declared := map[string]bool{}
+	for _, capability := range capabilities {
+		if !slices.Contains(KnownCapabilities, capability) {
+			return fmt.Errorf("declares the capability %s which is not recognized. Supported capabilities: %s", capability, strings.Join(KnownCapabilities, ", "))
+		}
+
+		if declared[capability] {
+			return fmt.Errorf("declares the capability %s more than once", capability)
+		}
+		declared[capability] = true
+	}
+
+	if declared[CapabilitySupportsRecipes] && declared[CapabilityManualResourceProvisioning] {
+		return fmt.Errorf("cannot declare both the %s and %s capabilities", CapabilitySupportsRecipes, CapabilityManualResourceProvisioning)
+	}
+
+	// Secrets are read from the outputs of a recipe. Manually provisioned resources have no recipe outputs.
+	if declared[CapabilityExposesSecrets] && !declared[CapabilitySupportsRecipes] {
+		return fmt.Errorf("declares the %s capability, which requires the %s capability", CapabilityExposesSecrets, CapabilitySupportsRecipes)
+	}
+
+	return nil
+}
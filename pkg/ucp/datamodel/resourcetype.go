@@ -16,7 +16,13 @@ limitations under the License.
 
 package datamodel
 
-import v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+)
 
 const (
 	// ResourceTypesResourceType is the resource type for a resource type.
@@ -26,6 +32,10 @@ const (
 	ResourceTypeResourceUnqualifiedResourceType = "resourceTypes"
 )
 
+// ReservedActionNames is the list of actions implemented by Radius. Resource types cannot declare custom actions
+// with these names.
+var ReservedActionNames = []string{"listSecrets"}
+
 // ResourceType represents a resource type.
 type ResourceType struct {
 	v1.BaseResource
@@ -46,4 +56,50 @@ type ResourceTypeProperties struct {
 
 	// DefaultAPIVersion is the default API version for this resource type.
 	DefaultAPIVersion *string `json:"defaultApiVersion"`
+
+	// Actions is the set of custom actions supported by the resource type, keyed by action name.
+	Actions map[string]ResourceTypeAction `json:"actions,omitempty"`
+}
+
+// ResourceTypeAction stores a custom action supported by a resource type. Actions are invoked with a POST request
+// on a resource, and are handled by executing a recipe with the context of the resource.
+type ResourceTypeAction struct {
+	// Description is the description of the action.
+	Description string `json:"description,omitempty"`
+
+	// RecipeName is the name of the recipe that handles the action.
+	RecipeName string `json:"recipeName"`
+}
+
+// ValidateActions validates the names of the custom actions of a resource type with the given capabilities. Actions
+// are handled by recipes, so a resource type with actions must declare the SupportsRecipes capability. Action names
+// are case-insensitive and cannot conflict with the actions implemented by Radius. The error describes what the
+// resource type declares, e.g. "declares the action X, which is reserved by Radius".
+func ValidateActions(capabilities []string, actionNames []string) error {
+	if len(actionNames) == 0 {
+		return nil
+	}
+
+	if !slices.Contains(capabilities, CapabilitySupportsRecipes) {
+		return fmt.Errorf("declares actions, which require the %s capability", CapabilitySupportsRecipes)
+	}
+
+	sorted := slices.Clone(actionNames)
+	slices.Sort(sorted)
+
+	names := map[string]string{}
+	for _, actionName := range sorted {
+		for _, reserved := range ReservedActionNames {
+			if strings.EqualFold(reserved, actionName) {
+				return fmt.Errorf("declares the action %s, which is reserved by Radius", actionName)
+			}
+		}
+
+		if other, ok := names[strings.ToLower(actionName)]; ok {
+			return fmt.Errorf("declares the actions %s and %s, which differ only by case", other, actionName)
+		}
+		names[strings.ToLower(actionName)] = actionName
+	}
+
+	return nil
 }
@@ -0,0 +1,71 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceproviders
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+// ValidateAPIVersionPut is an update filter that validates an API version before it is stored. It enforces the
+// rules that 'rad resource-type create' checks in the manifest for conversions:
+//
+//   - Conversions are defined relative to the default API version, so the resource type must declare a default
+//     API version and the default API version cannot declare a conversion.
+//   - The conversion webhook is called by Radius, so it must address a Kubernetes service in the cluster.
+func ValidateAPIVersionPut(ctx context.Context, newResource *datamodel.APIVersion, oldResource *datamodel.APIVersion, options *armrpc_controller.Options) (armrpc_rest.Response, error) {
+	conversion := newResource.Properties.Conversion
+	if conversion == nil {
+		return nil, nil
+	}
+
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	apiVersionName := serviceCtx.ResourceID.Name()
+	resourceTypeID := serviceCtx.ResourceID.Truncate()
+
+	resourceType, err := database.GetResource[datamodel.ResourceType](ctx, options.DatabaseClient, resourceTypeID.String())
+	if errors.Is(err, &database.ErrNotFound{}) {
+		return armrpc_rest.NewBadRequestResponse(fmt.Sprintf("resource type %s does not exist", resourceTypeID.Name())), nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to fetch resource type: %w", err)
+	}
+
+	defaultAPIVersion := resourceType.Properties.DefaultAPIVersion
+	if defaultAPIVersion == nil {
+		return armrpc_rest.NewBadRequestResponse(fmt.Sprintf("resource type %s declares a conversion for API version %s but does not declare a defaultApiVersion", resourceTypeID.Name(), apiVersionName)), nil
+	}
+
+	if strings.EqualFold(*defaultAPIVersion, apiVersionName) {
+		return armrpc_rest.NewBadRequestResponse(fmt.Sprintf("resource type %s declares a conversion for its default API version %s", resourceTypeID.Name(), apiVersionName)), nil
+	}
+
+	if conversion.Webhook != nil {
+		if err := conversion.Webhook.Validate(); err != nil {
+			return armrpc_rest.NewBadRequestResponse(err.Error()), nil
+		}
+	}
+
+	return nil, nil
+}
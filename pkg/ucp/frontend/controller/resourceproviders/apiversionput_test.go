@@ -0,0 +1,125 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceproviders
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_ValidateAPIVersionPut(t *testing.T) {
+	resourceTypeID := "/planes/radius/local/providers/System.Resources/resourceProviders/Applications.Test/resourceTypes/testResources"
+	apiVersionID := resourceTypeID + "/apiVersions/2024-01-01"
+
+	fieldMappings := &datamodel.APIVersionConversion{
+		FieldMappings: []datamodel.FieldMapping{{From: "$.hostname", To: "$.host"}},
+	}
+	resourceType := func(defaultAPIVersion *string) *datamodel.ResourceType {
+		return &datamodel.ResourceType{Properties: datamodel.ResourceTypeProperties{DefaultAPIVersion: defaultAPIVersion}}
+	}
+
+	tests := []struct {
+		name         string
+		conversion   *datamodel.APIVersionConversion
+		resourceType *datamodel.ResourceType
+		err          string
+	}{
+		{
+			name: "no conversion",
+		},
+		{
+			name:         "field mappings",
+			conversion:   fieldMappings,
+			resourceType: resourceType(to.Ptr("2025-01-01")),
+		},
+		{
+			name: "in-cluster webhook",
+			conversion: &datamodel.APIVersionConversion{
+				Webhook: &datamodel.ConversionWebhook{URL: "https://converter.my-namespace.svc/convert"},
+			},
+			resourceType: resourceType(to.Ptr("2025-01-01")),
+		},
+		{
+			name: "external webhook",
+			conversion: &datamodel.APIVersionConversion{
+				Webhook: &datamodel.ConversionWebhook{URL: "http://169.254.169.254/latest/meta-data"},
+			},
+			resourceType: resourceType(to.Ptr("2025-01-01")),
+			err:          "must address a Kubernetes service in the cluster",
+		},
+		{
+			name:       "resource type not found",
+			conversion: fieldMappings,
+			err:        "resource type testResources does not exist",
+		},
+		{
+			name:         "no default API version",
+			conversion:   fieldMappings,
+			resourceType: resourceType(nil),
+			err:          "resource type testResources declares a conversion for API version 2024-01-01 but does not declare a defaultApiVersion",
+		},
+		{
+			name:         "default API version",
+			conversion:   fieldMappings,
+			resourceType: resourceType(to.Ptr("2024-01-01")),
+			err:          "resource type testResources declares a conversion for its default API version 2024-01-01",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			databaseClient := database.NewMockClient(gomock.NewController(t))
+			if tt.conversion != nil {
+				databaseClient.EXPECT().
+					Get(gomock.Any(), resourceTypeID).
+					DoAndReturn(func(_ context.Context, id string, _ ...database.GetOptions) (*database.Object, error) {
+						if tt.resourceType == nil {
+							return nil, &database.ErrNotFound{ID: id}
+						}
+						return &database.Object{Metadata: database.Metadata{ID: id}, Data: tt.resourceType}, nil
+					}).
+					Times(1)
+			}
+
+			ctx := v1.WithARMRequestContext(testcontext.New(t), &v1.ARMRequestContext{ResourceID: resources.MustParse(apiVersionID)})
+			apiVersion := &datamodel.APIVersion{Properties: datamodel.APIVersionProperties{Conversion: tt.conversion}}
+
+			response, err := ValidateAPIVersionPut(ctx, apiVersion, nil, &armrpc_controller.Options{DatabaseClient: databaseClient})
+			require.NoError(t, err)
+
+			if tt.err == "" {
+				require.Nil(t, response)
+				return
+			}
+
+			badRequest, ok := response.(*armrpc_rest.BadRequestResponse)
+			require.True(t, ok)
+			require.Contains(t, badRequest.Body.Error.Message, tt.err)
+		})
+	}
+}
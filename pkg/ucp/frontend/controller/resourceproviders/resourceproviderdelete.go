@@ -0,0 +1,72 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceproviders
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// ValidateResourceProviderDelete is a delete filter that rejects the deletion of a resource provider while resources
+// of any of its resource types exist. Deleting the resource provider deletes its resource types, so the same rule
+// as ValidateResourceTypeDelete applies.
+func ValidateResourceProviderDelete(ctx context.Context, oldResource *datamodel.ResourceProvider, options *armrpc_controller.Options) (armrpc_rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	id := serviceCtx.ResourceID
+
+	// The summary of the resource provider lists its resource types.
+	summaryID, err := datamodel.ResourceProviderSummaryIDFromParts(id.PlaneScope(), id.Name())
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := database.GetResource[datamodel.ResourceProviderSummary](ctx, options.DatabaseClient, summaryID.String())
+	if errors.Is(err, &database.ErrNotFound{}) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to fetch resource provider summary: %w", err)
+	}
+
+	instances := []string{}
+	for _, resourceTypeName := range slices.Sorted(maps.Keys(summary.Properties.ResourceTypes)) {
+		ids, err := resourceTypeInstances(ctx, options.DatabaseClient, id.PlaneScope(), id.Name()+resources.SegmentSeparator+resourceTypeName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list resources: %w", err)
+		}
+		instances = append(instances, ids...)
+	}
+
+	if len(instances) == 0 {
+		return nil, nil
+	}
+
+	return &armrpc_rest.ConflictResponse{
+		Body: v1.ErrorResponse{
+			Error: instancesExistError(id.String(), "resource provider", instances),
+		},
+	}, nil
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceproviders
+
+import (
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_ValidateResourceProviderDelete(t *testing.T) {
+	resourceProviderID := "/planes/radius/local/providers/System.Resources/resourceProviders/Applications.Test"
+	summaryID := "/planes/radius/local/providers/System.Resources/resourceProviderSummaries/Applications.Test"
+	summary := &datamodel.ResourceProviderSummary{
+		Properties: datamodel.ResourceProviderSummaryProperties{
+			ResourceTypes: map[string]datamodel.ResourceProviderSummaryPropertiesResourceType{
+				"testResources":  {},
+				"otherResources": {},
+			},
+		},
+	}
+	query := func(resourceType string) database.Query {
+		return database.Query{
+			RootScope:      "/planes/radius/local",
+			ScopeRecursive: true,
+			ResourceType:   resourceType,
+		}
+	}
+
+	setup := func(t *testing.T) (*database.MockClient, *armrpc_controller.Options, *v1.ARMRequestContext) {
+		databaseClient := database.NewMockClient(gomock.NewController(t))
+		return databaseClient, &armrpc_controller.Options{DatabaseClient: databaseClient}, &v1.ARMRequestContext{ResourceID: resources.MustParse(resourceProviderID)}
+	}
+
+	t.Run("no summary", func(t *testing.T) {
+		databaseClient, options, serviceCtx := setup(t)
+		databaseClient.EXPECT().
+			Get(gomock.Any(), summaryID).
+			Return(nil, &database.ErrNotFound{ID: summaryID}).
+			Times(1)
+		ctx := v1.WithARMRequestContext(testcontext.New(t), serviceCtx)
+
+		response, err := ValidateResourceProviderDelete(ctx, &datamodel.ResourceProvider{}, options)
+		require.NoError(t, err)
+		require.Nil(t, response)
+	})
+
+	t.Run("no resources", func(t *testing.T) {
+		databaseClient, options, serviceCtx := setup(t)
+		databaseClient.EXPECT().
+			Get(gomock.Any(), summaryID).
+			Return(&database.Object{Metadata: database.Metadata{ID: summaryID}, Data: summary}, nil).
+			Times(1)
+		databaseClient.EXPECT().
+			Query(gomock.Any(), query("Applications.Test/otherResources")).
+			Return(&database.ObjectQueryResult{}, nil).
+			Times(1)
+		databaseClient.EXPECT().
+			Query(gomock.Any(), query("Applications.Test/testResources")).
+			Return(&database.ObjectQueryResult{}, nil).
+			Times(1)
+		ctx := v1.WithARMRequestContext(testcontext.New(t), serviceCtx)
+
+		response, err := ValidateResourceProviderDelete(ctx, &datamodel.ResourceProvider{}, options)
+		require.NoError(t, err)
+		require.Nil(t, response)
+	})
+
+	t.Run("resources exist", func(t *testing.T) {
+		instanceID := "/planes/radius/local/resourceGroups/group1/providers/Applications.Test/testResources/resource1"
+		databaseClient, options, serviceCtx := setup(t)
+		databaseClient.EXPECT().
+			Get(gomock.Any(), summaryID).
+			Return(&database.Object{Metadata: database.Metadata{ID: summaryID}, Data: summary}, nil).
+			Times(1)
+		databaseClient.EXPECT().
+			Query(gomock.Any(), query("Applications.Test/otherResources")).
+			Return(&database.ObjectQueryResult{}, nil).
+			Times(1)
+		databaseClient.EXPECT().
+			Query(gomock.Any(), query("Applications.Test/testResources")).
+			Return(&database.ObjectQueryResult{Items: []database.Object{{Metadata: database.Metadata{ID: instanceID}}}}, nil).
+			Times(1)
+		ctx := v1.WithARMRequestContext(testcontext.New(t), serviceCtx)
+
+		response, err := ValidateResourceProviderDelete(ctx, &datamodel.ResourceProvider{}, options)
+		require.NoError(t, err)
+
+		conflict, ok := response.(*armrpc_rest.ConflictResponse)
+		require.True(t, ok)
+		require.Equal(t, v1.CodeConflict, conflict.Body.Error.Code)
+		require.Equal(t, resourceProviderID, conflict.Body.Error.Target)
+		require.Equal(t, "The resource provider cannot be deleted because 1 resource(s) exist: "+instanceID+". Delete the resources before deleting the resource provider.", conflict.Body.Error.Message)
+		require.Len(t, conflict.Body.Error.Details, 1)
+		require.Equal(t, instanceID, conflict.Body.Error.Details[0].Target)
+	})
+}
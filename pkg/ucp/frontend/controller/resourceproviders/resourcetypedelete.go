@@ -0,0 +1,105 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceproviders
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// maxListedInstances is the maximum number of resources that are listed in the error returned when a resource
+	// type or resource provider cannot be deleted.
+	maxListedInstances = 10
+)
+
+// ValidateResourceTypeDelete is a delete filter that rejects the deletion of a resource type while resources of
+// that type exist. Deleting the resource type would orphan the resources and the infrastructure deployed by their
+// recipes, so the resources must be deleted first.
+func ValidateResourceTypeDelete(ctx context.Context, oldResource *datamodel.ResourceType, options *armrpc_controller.Options) (armrpc_rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	resourceType := serviceCtx.ResourceID.TypeSegments()[0].Name + resources.SegmentSeparator + serviceCtx.ResourceID.Name()
+	instances, err := resourceTypeInstances(ctx, options.DatabaseClient, serviceCtx.ResourceID.PlaneScope(), resourceType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list resources: %w", err)
+	}
+
+	if len(instances) == 0 {
+		return nil, nil
+	}
+
+	return &armrpc_rest.ConflictResponse{
+		Body: v1.ErrorResponse{
+			Error: instancesExistError(serviceCtx.ResourceID.String(), "resource type", instances),
+		},
+	}, nil
+}
+
+// resourceTypeInstances returns the IDs of the resources of the fully-qualified resource type in the given plane.
+func resourceTypeInstances(ctx context.Context, databaseClient database.Client, planeScope string, resourceType string) ([]string, error) {
+	result, err := databaseClient.Query(ctx, database.Query{
+		RootScope:      planeScope,
+		ScopeRecursive: true,
+		ResourceType:   resourceType,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ids := []string{}
+	for _, item := range result.Items {
+		ids = append(ids, item.ID)
+	}
+
+	return ids, nil
+}
+
+// instancesExistError creates the error returned when a resource type or resource provider cannot be deleted because
+// resources exist. The first maxListedInstances resources are included in the message and the details of the error.
+func instancesExistError(rawID string, kind string, instances []string) *v1.ErrorDetails {
+	listed := instances[:min(len(instances), maxListedInstances)]
+
+	details := []*v1.ErrorDetails{}
+	for _, instance := range listed {
+		details = append(details, &v1.ErrorDetails{
+			Code:    v1.CodeConflict,
+			Message: fmt.Sprintf("Resource %s must be deleted before the %s.", instance, kind),
+			Target:  instance,
+		})
+	}
+
+	list := strings.Join(listed, ", ")
+	if remaining := len(instances) - len(listed); remaining > 0 {
+		list = fmt.Sprintf("%s and %d more", list, remaining)
+	}
+
+	return &v1.ErrorDetails{
+		Code:    v1.CodeConflict,
+		Message: fmt.Sprintf("The %s cannot be deleted because %d resource(s) exist: %s. Delete the resources before deleting the %s.", kind, len(instances), list, kind),
+		Target:  rawID,
+		Details: details,
+	}
+}
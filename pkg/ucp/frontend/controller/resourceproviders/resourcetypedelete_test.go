@@ -0,0 +1,104 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceproviders
+
+import (
+	"fmt"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_ValidateResourceTypeDelete(t *testing.T) {
+	resourceTypeID := "/planes/radius/local/providers/System.Resources/resourceProviders/Applications.Test/resourceTypes/testResources"
+	query := database.Query{
+		RootScope:      "/planes/radius/local",
+		ScopeRecursive: true,
+		ResourceType:   "Applications.Test/testResources",
+	}
+
+	setup := func(t *testing.T, items []database.Object) (*armrpc_controller.Options, *v1.ARMRequestContext) {
+		databaseClient := database.NewMockClient(gomock.NewController(t))
+		databaseClient.EXPECT().
+			Query(gomock.Any(), query).
+			Return(&database.ObjectQueryResult{Items: items}, nil).
+			Times(1)
+
+		return &armrpc_controller.Options{DatabaseClient: databaseClient}, &v1.ARMRequestContext{ResourceID: resources.MustParse(resourceTypeID)}
+	}
+
+	t.Run("no resources", func(t *testing.T) {
+		options, serviceCtx := setup(t, []database.Object{})
+		ctx := v1.WithARMRequestContext(testcontext.New(t), serviceCtx)
+
+		response, err := ValidateResourceTypeDelete(ctx, &datamodel.ResourceType{}, options)
+		require.NoError(t, err)
+		require.Nil(t, response)
+	})
+
+	t.Run("resources exist", func(t *testing.T) {
+		instanceIDs := []string{
+			"/planes/radius/local/resourceGroups/group1/providers/Applications.Test/testResources/resource1",
+			"/planes/radius/local/resourceGroups/group2/providers/Applications.Test/testResources/resource2",
+		}
+		options, serviceCtx := setup(t, []database.Object{
+			{Metadata: database.Metadata{ID: instanceIDs[0]}},
+			{Metadata: database.Metadata{ID: instanceIDs[1]}},
+		})
+		ctx := v1.WithARMRequestContext(testcontext.New(t), serviceCtx)
+
+		response, err := ValidateResourceTypeDelete(ctx, &datamodel.ResourceType{}, options)
+		require.NoError(t, err)
+
+		conflict, ok := response.(*armrpc_rest.ConflictResponse)
+		require.True(t, ok)
+		require.Equal(t, v1.CodeConflict, conflict.Body.Error.Code)
+		require.Equal(t, resourceTypeID, conflict.Body.Error.Target)
+		require.Contains(t, conflict.Body.Error.Message, "The resource type cannot be deleted because 2 resource(s) exist: "+instanceIDs[0]+", "+instanceIDs[1]+".")
+		require.Len(t, conflict.Body.Error.Details, 2)
+		for i, detail := range conflict.Body.Error.Details {
+			require.Equal(t, instanceIDs[i], detail.Target)
+		}
+	})
+
+	t.Run("many resources exist", func(t *testing.T) {
+		items := []database.Object{}
+		for i := range maxListedInstances + 5 {
+			items = append(items, database.Object{Metadata: database.Metadata{ID: fmt.Sprintf("/planes/radius/local/resourceGroups/group/providers/Applications.Test/testResources/resource%d", i)}})
+		}
+		options, serviceCtx := setup(t, items)
+		ctx := v1.WithARMRequestContext(testcontext.New(t), serviceCtx)
+
+		response, err := ValidateResourceTypeDelete(ctx, &datamodel.ResourceType{}, options)
+		require.NoError(t, err)
+
+		conflict, ok := response.(*armrpc_rest.ConflictResponse)
+		require.True(t, ok)
+		require.Contains(t, conflict.Body.Error.Message, "because 15 resource(s) exist")
+		require.Contains(t, conflict.Body.Error.Message, "/resource9 and 5 more.")
+		require.NotContains(t, conflict.Body.Error.Message, "/resource10")
+		require.Len(t, conflict.Body.Error.Details, maxListedInstances)
+	})
+}
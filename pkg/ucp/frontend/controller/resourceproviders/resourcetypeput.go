@@ -0,0 +1,72 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceproviders
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// ValidateResourceTypePut is an update filter that validates a resource type before it is stored. It enforces the
+// rules that 'rad resource-type create' checks in the manifest, so that clients other than the CLI cannot store an
+// inconsistent resource type:
+//
+//   - The capabilities must be known to Radius and consistent with each other.
+//   - The actions require the SupportsRecipes capability and cannot use reserved names.
+//   - The default API version cannot declare a conversion.
+func ValidateResourceTypePut(ctx context.Context, newResource *datamodel.ResourceType, oldResource *datamodel.ResourceType, options *armrpc_controller.Options) (armrpc_rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	typeName := serviceCtx.ResourceID.Name()
+
+	err := datamodel.ValidateCapabilities(newResource.Properties.Capabilities)
+	if err != nil {
+		return armrpc_rest.NewBadRequestResponse(fmt.Sprintf("resource type %s %s", typeName, err.Error())), nil
+	}
+
+	err = datamodel.ValidateActions(newResource.Properties.Capabilities, slices.Collect(maps.Keys(newResource.Properties.Actions)))
+	if err != nil {
+		return armrpc_rest.NewBadRequestResponse(fmt.Sprintf("resource type %s %s", typeName, err.Error())), nil
+	}
+
+	if newResource.Properties.DefaultAPIVersion == nil {
+		return nil, nil
+	}
+
+	defaultAPIVersionID := serviceCtx.ResourceID.Append(resources.TypeSegment{Type: "apiVersions", Name: *newResource.Properties.DefaultAPIVersion})
+	defaultAPIVersion, err := database.GetResource[datamodel.APIVersion](ctx, options.DatabaseClient, defaultAPIVersionID.String())
+	if errors.Is(err, &database.ErrNotFound{}) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to fetch API version: %w", err)
+	}
+
+	if defaultAPIVersion.Properties.Conversion != nil {
+		return armrpc_rest.NewBadRequestResponse(fmt.Sprintf("resource type %s declares a conversion for its default API version %s", typeName, *newResource.Properties.DefaultAPIVersion)), nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceproviders
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_ValidateResourceTypePut(t *testing.T) {
+	resourceTypeID := "/planes/radius/local/providers/System.Resources/resourceProviders/Applications.Test/resourceTypes/testResources"
+	recipeAction := map[string]datamodel.ResourceTypeAction{"backup": {RecipeName: "backup"}}
+
+	tests := []struct {
+		name              string
+		properties        datamodel.ResourceTypeProperties
+		defaultAPIVersion *datamodel.APIVersion
+		err               string
+	}{
+		{
+			name: "valid",
+			properties: datamodel.ResourceTypeProperties{
+				Capabilities: []string{datamodel.CapabilitySupportsRecipes, datamodel.CapabilityExposesSecrets},
+				Actions:      recipeAction,
+			},
+		},
+		{
+			name:       "unknown capability",
+			properties: datamodel.ResourceTypeProperties{Capabilities: []string{"MyCapability"}},
+			err:        "resource type testResources declares the capability MyCapability which is not recognized",
+		},
+		{
+			name: "conflicting capabilities",
+			properties: datamodel.ResourceTypeProperties{
+				Capabilities: []string{datamodel.CapabilitySupportsRecipes, datamodel.CapabilityManualResourceProvisioning},
+			},
+			err: "resource type testResources cannot declare both the SupportsRecipes and ManualResourceProvisioning capabilities",
+		},
+		{
+			name:       "actions without recipes",
+			properties: datamodel.ResourceTypeProperties{Actions: recipeAction},
+			err:        "resource type testResources declares actions, which require the SupportsRecipes capability",
+		},
+		{
+			name: "reserved action",
+			properties: datamodel.ResourceTypeProperties{
+				Capabilities: []string{datamodel.CapabilitySupportsRecipes},
+				Actions:      map[string]datamodel.ResourceTypeAction{"ListSecrets": {RecipeName: "secrets"}},
+			},
+			err: "resource type testResources declares the action ListSecrets, which is reserved by Radius",
+		},
+		{
+			name:              "default API version without conversion",
+			properties:        datamodel.ResourceTypeProperties{DefaultAPIVersion: to.Ptr("2025-01-01")},
+			defaultAPIVersion: &datamodel.APIVersion{},
+		},
+		{
+			name:       "default API version not registered",
+			properties: datamodel.ResourceTypeProperties{DefaultAPIVersion: to.Ptr("2025-01-01")},
+		},
+		{
+			name:       "default API version with conversion",
+			properties: datamodel.ResourceTypeProperties{DefaultAPIVersion: to.Ptr("2025-01-01")},
+			defaultAPIVersion: &datamodel.APIVersion{
+				Properties: datamodel.APIVersionProperties{
+					Conversion: &datamodel.APIVersionConversion{
+						FieldMappings: []datamodel.FieldMapping{{From: "$.hostname", To: "$.host"}},
+					},
+				},
+			},
+			err: "resource type testResources declares a conversion for its default API version 2025-01-01",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			databaseClient := database.NewMockClient(gomock.NewController(t))
+			if tt.properties.DefaultAPIVersion != nil {
+				databaseClient.EXPECT().
+					Get(gomock.Any(), resourceTypeID+"/apiVersions/"+*tt.properties.DefaultAPIVersion).
+					DoAndReturn(func(_ context.Context, id string, _ ...database.GetOptions) (*database.Object, error) {
+						if tt.defaultAPIVersion == nil {
+							return nil, &database.ErrNotFound{ID: id}
+						}
+						return &database.Object{Metadata: database.Metadata{ID: id}, Data: tt.defaultAPIVersion}, nil
+					}).
+					Times(1)
+			}
+
+			ctx := v1.WithARMRequestContext(testcontext.New(t), &v1.ARMRequestContext{ResourceID: resources.MustParse(resourceTypeID)})
+			resourceType := &datamodel.ResourceType{Properties: tt.properties}
+
+			response, err := ValidateResourceTypePut(ctx, resourceType, nil, &armrpc_controller.Options{DatabaseClient: databaseClient})
+			require.NoError(t, err)
+
+			if tt.err == "" {
+				require.Nil(t, response)
+				return
+			}
+
+			badRequest, ok := response.(*armrpc_rest.BadRequestResponse)
+			require.True(t, ok)
+			require.Contains(t, badRequest.Body.Error.Message, tt.err)
+		})
+	}
+}
@@ -272,7 +272,11 @@ func resourceProviderPutHandler(ctx context.Context, ctrlOptions controller.Opti
 
 func resourceProviderDeleteHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
 	return server.CreateHandler(ctx, datamodel.ResourceProviderResourceType, v1.OperationDelete, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
-		return defaultoperation.NewDefaultAsyncDelete(opts, resourceProviderResourceOptions)
+		resourceOptions := resourceProviderResourceOptions
+		resourceOptions.DeleteFilters = []controller.DeleteFilter[datamodel.ResourceProvider]{
+			resourceproviders_ctrl.ValidateResourceProviderDelete,
+		}
+		return defaultoperation.NewDefaultAsyncDelete(opts, resourceOptions)
 	})
 }
 
@@ -296,13 +300,21 @@ func resourceTypeGetHandler(ctx context.Context, ctrlOptions controller.Options)
 
 func resourceTypePutHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
 	return server.CreateHandler(ctx, datamodel.ResourceTypeResourceType, v1.OperationPut, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
-		return defaultoperation.NewDefaultAsyncPut(opts, resourceTypeResourceOptions)
+		resourceOptions := resourceTypeResourceOptions
+		resourceOptions.UpdateFilters = []controller.UpdateFilter[datamodel.ResourceType]{
+			resourceproviders_ctrl.ValidateResourceTypePut,
+		}
+		return defaultoperation.NewDefaultAsyncPut(opts, resourceOptions)
 	})
 }
 
 func resourceTypeDeleteHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
 	return server.CreateHandler(ctx, datamodel.ResourceTypeResourceType, v1.OperationDelete, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
-		return defaultoperation.NewDefaultAsyncDelete(opts, resourceTypeResourceOptions)
+		resourceOptions := resourceTypeResourceOptions
+		resourceOptions.DeleteFilters = []controller.DeleteFilter[datamodel.ResourceType]{
+			resourceproviders_ctrl.ValidateResourceTypeDelete,
+		}
+		return defaultoperation.NewDefaultAsyncDelete(opts, resourceOptions)
 	})
 }
 
@@ -326,7 +338,11 @@ func apiVersionGetHandler(ctx context.Context, ctrlOptions controller.Options) (
 
 func apiVersionPutHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
 	return server.CreateHandler(ctx, datamodel.APIVersionResourceType, v1.OperationPut, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
-		return defaultoperation.NewDefaultAsyncPut(opts, apiVersionResourceOptions)
+		resourceOptions := apiVersionResourceOptions
+		resourceOptions.UpdateFilters = []controller.UpdateFilter[datamodel.APIVersion]{
+			resourceproviders_ctrl.ValidateAPIVersionPut,
+		}
+		return defaultoperation.NewDefaultAsyncPut(opts, resourceOptions)
 	})
 }
 